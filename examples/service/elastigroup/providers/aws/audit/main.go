@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/audit"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+)
+
+func main() {
+	// Open the audit trail, a JSON Lines file.
+	sink, err := audit.OpenFile("audit.jsonl")
+	if err != nil {
+		log.Fatalf("spotinst: failed to open audit trail: %v", err)
+	}
+	defer sink.Close()
+
+	// All clients require a Session. The Session provides the client with
+	// shared configuration such as account and credentials.
+	// A Session should be shared where possible to take advantage of
+	// configuration and credential caching. See the session package for
+	// more information.
+	//
+	// With an audit sink, a record is written for every request other than
+	// GET.
+	sess := session.New(spotinst.DefaultConfig().WithAudit(sink))
+
+	// Create a new instance of the provider's client with a Session.
+	svc := aws.New(sess)
+
+	// Create a new context carrying the actor of the requests.
+	ctx := audit.WithActor(context.Background(), map[string]string{
+		"user":     "jane",
+		"pipeline": "deploy-42",
+	})
+
+	// Update the group. The record of the update holds the fields it
+	// changes.
+	group := new(aws.Group).
+		SetId(spotinst.String("sig-12345")).
+		SetCapacity(new(aws.Capacity).SetMaximum(spotinst.Int(10)))
+
+	if _, err := svc.Update(ctx, &aws.UpdateGroupInput{Group: group}); err != nil {
+		log.Fatalf("spotinst: failed to update group: %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/bulk"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+)
+
+func main() {
+	// All clients require a Session. The Session provides the client with
+	// shared configuration such as account and credentials.
+	// A Session should be shared where possible to take advantage of
+	// configuration and credential caching. See the session package for
+	// more information.
+	sess := session.New()
+
+	// Create a new instance of the service's client with a Session.
+	svc := elastigroup.New(sess)
+
+	// Create a new context.
+	ctx := context.Background()
+
+	// Create a new executor that updates up to 5 groups at once and retries
+	// throttled and server errors up to 3 times.
+	executor := &bulk.Executor{
+		Concurrency: 5,
+		Retries:     3,
+		RetryDelay:  2 * time.Second,
+		OnResult: func(r *bulk.Result) {
+			log.Printf("%s: %s", r.ID, r.Status)
+		},
+	}
+
+	// Set the target capacity of each group to 2.
+	ids := []string{"sig-12345", "sig-67890", "sig-abcde"}
+	report := executor.Run(ctx, ids, func(ctx context.Context, id string) (interface{}, error) {
+		group := &aws.Group{
+			ID:       spotinst.String(id),
+			Capacity: &aws.Capacity{Target: spotinst.Int(2)},
+		}
+		out, err := svc.CloudProviderAWS().Update(ctx, &aws.UpdateGroupInput{Group: group})
+		if err != nil {
+			return nil, err
+		}
+		return out.Group, nil
+	})
+
+	// Export the results as JSON.
+	if err := report.WriteJSON(os.Stdout); err != nil {
+		log.Fatalf("spotinst: failed to write report: %v", err)
+	}
+	if err := report.Err(); err != nil {
+		log.Fatalf("spotinst: failed to update %d group(s): %v", len(report.Failed()), err)
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/credentials"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+)
+
+func main() {
+	// All clients require a Session. The Session provides the client with
+	// shared configuration such as account and credentials.
+	// A Session should be shared where possible to take advantage of
+	// configuration and credential caching. See the session package for
+	// more information.
+	sess := session.New()
+
+	// Create a new instance of the provider's client with a Session.
+	svc := aws.New(sess)
+
+	// Create a client of the target account, e.g. from another profile of
+	// the credentials file.
+	dst := aws.New(sess, spotinst.DefaultConfig().
+		WithCredentials(credentials.NewFileCredentials("prod", "")))
+
+	// Create a new context.
+	ctx := context.Background()
+
+	// Clone a staging group to production in another region.
+	group, err := svc.Clone(ctx, "sig-12345", dst, &aws.CloneRules{
+		Region:       "us-west-2",
+		NameTemplate: "{{.Name}}-prod",
+		Subnets: map[string]string{
+			"subnet-11111111": "subnet-22222222",
+		},
+		AvailabilityZones: map[string]string{
+			"us-east-1a": "us-west-2a",
+		},
+		SecurityGroups: map[string]string{
+			"sg-11111111": "sg-22222222",
+		},
+		KeyPairs: map[string]string{
+			"staging": "prod",
+		},
+		IAMInstanceProfiles: map[string]string{
+			"arn:aws:iam::123456789012:instance-profile/staging": "arn:aws:iam::210987654321:instance-profile/prod",
+		},
+		Images: map[string]string{
+			"us-west-2": "ami-22222222",
+		},
+		Tags: map[string]string{
+			"env": "prod",
+		},
+	})
+	if err != nil {
+		log.Fatalf("spotinst: failed to clone group: %v", err)
+	}
+
+	// Output.
+	log.Printf("Group %q: created", spotinst.StringValue(group.ID))
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/dryrun"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+)
+
+func main() {
+	// Create a recorder for the requests of the dry run.
+	rec := dryrun.New()
+
+	// All clients require a Session. The Session provides the client with
+	// shared configuration such as account and credentials.
+	// A Session should be shared where possible to take advantage of
+	// configuration and credential caching. See the session package for
+	// more information.
+	//
+	// With a dry run recorder, requests other than GET are recorded instead
+	// of being sent.
+	sess := session.New(spotinst.DefaultConfig().WithDryRun(rec))
+
+	// Create a new instance of the provider's client with a Session.
+	svc := aws.New(sess)
+
+	// Create a new context.
+	ctx := context.Background()
+
+	// Update the capacity of the group, and clear its description.
+	group := new(aws.Group).
+		SetId(spotinst.String("sig-12345")).
+		SetCapacity(new(aws.Capacity).SetTarget(spotinst.Int(0))).
+		SetDescription(nil)
+
+	if _, err := svc.Update(ctx, &aws.UpdateGroupInput{Group: group}); err != nil {
+		log.Fatalf("spotinst: failed to update group: %v", err)
+	}
+
+	// Roll the group.
+	if _, err := svc.Roll(ctx, &aws.RollGroupInput{
+		GroupID:             spotinst.String("sig-12345"),
+		BatchSizePercentage: spotinst.Int(20),
+	}); err != nil {
+		log.Fatalf("spotinst: failed to roll group: %v", err)
+	}
+
+	// Output the requests that would have been sent.
+	if err := rec.WriteJSON(os.Stdout); err != nil {
+		log.Fatalf("spotinst: failed to write requests: %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/guardrail"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+)
+
+func main() {
+	// All clients require a Session. The Session provides the client with
+	// shared configuration such as account and credentials.
+	// A Session should be shared where possible to take advantage of
+	// configuration and credential caching. See the session package for
+	// more information.
+	//
+	// Guardrails set on the configuration are checked before every request
+	// other than GET.
+	sess := session.New(spotinst.DefaultConfig().WithGuardrails(
+		// Never delete production groups.
+		&guardrail.DenyTag{
+			Match: guardrail.Match{
+				Methods: []string{http.MethodDelete},
+				Paths:   []string{"/aws/ec2/group/*"},
+			},
+			Key:   "env",
+			Value: "prod",
+		},
+
+		// Bound the maximum capacity of groups.
+		&guardrail.MaxValue{
+			Field: "group.capacity.maximum",
+			Max:   100,
+		},
+
+		// Terminating detached instances requires an approval.
+		guardrail.MustExpr("approve-terminate",
+			`operation == "DetachGroupInput" && input.shouldTerminateInstances == true && !approved`),
+	))
+
+	// Create a new instance of the provider's client with a Session.
+	svc := aws.New(sess)
+
+	// Create a new context.
+	ctx := context.Background()
+
+	// Detach instances, terminating them once approved.
+	_, err := svc.Detach(guardrail.WithApproval(ctx), &aws.DetachGroupInput{
+		GroupID:                       spotinst.String("sig-12345"),
+		InstanceIDs:                   []string{"i-12345"},
+		ShouldDecrementTargetCapacity: spotinst.Bool(true),
+		ShouldTerminateInstances:      spotinst.Bool(true),
+	})
+	if errors.Is(err, guardrail.ErrDenied) {
+		log.Fatalf("spotinst: denied by guardrail: %v", err)
+	}
+	if err != nil {
+		log.Fatalf("spotinst: failed to detach instances: %v", err)
+	}
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+)
+
+func main() {
+	// All clients require a Session. The Session provides the client with
+	// shared configuration such as account and credentials.
+	// A Session should be shared where possible to take advantage of
+	// configuration and credential caching. See the session package for
+	// more information.
+	sess := session.New()
+
+	// Create a new instance of the provider's client with a Session.
+	svc := aws.New(sess)
+
+	// Create a new context.
+	ctx := context.Background()
+
+	// Raise the maximum capacity of the group by one, re-reading the group
+	// and retrying if it is modified concurrently.
+	group, err := svc.RetryOnConflict(ctx, "sig-12345", nil, func(current *aws.Group) (*aws.Group, error) {
+		maximum := spotinst.IntValue(current.Capacity.Maximum) + 1
+
+		desired := new(aws.Group).
+			SetCapacity(new(aws.Capacity).SetMaximum(spotinst.Int(maximum)))
+
+		return desired, nil
+	})
+	if err != nil {
+		log.Fatalf("spotinst: failed to update group: %v", err)
+	}
+
+	// Output.
+	log.Printf("Group %q: maximum capacity is %d",
+		spotinst.StringValue(group.ID),
+		spotinst.IntValue(group.Capacity.Maximum))
+}
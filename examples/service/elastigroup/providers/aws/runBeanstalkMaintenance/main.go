@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+)
+
+func main() {
+	// All clients require a Session. The Session provides the client with
+	// shared configuration such as account and credentials.
+	// A Session should be shared where possible to take advantage of
+	// configuration and credential caching. See the session package for
+	// more information.
+	sess := session.New()
+
+	// Create a new instance of the provider's client with a Session.
+	svc := aws.New(sess)
+
+	// Create a new context.
+	ctx := context.Background()
+
+	// Update the Beanstalk environment during a maintenance. The maintenance
+	// is finished even if the update fails.
+	err := svc.RunBeanstalkMaintenance(ctx, "sig-12345", func(ctx context.Context) error {
+		log.Println("Maintenance is active, updating the environment")
+		return nil
+	})
+	if err != nil {
+		log.Fatalf("spotinst: failed to run beanstalk maintenance: %v", err)
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+)
+
+func main() {
+	// All clients require a Session. The Session provides the client with
+	// shared configuration such as account and credentials.
+	// A Session should be shared where possible to take advantage of
+	// configuration and credential caching. See the session package for
+	// more information.
+	sess := session.New()
+
+	// Create a new instance of the provider's client with a Session.
+	svc := aws.New(sess)
+
+	// Create a new context, canceled on interrupt.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	// Stream the events of two groups, resuming from the last delivered
+	// event after a restart.
+	events := svc.StreamGroupEvents(ctx, &aws.EventStreamOptions{
+		GroupIDs:   []string{"sig-12345", "sig-67890"},
+		TokenStore: aws.NewFileTokenStore("events.json"),
+		OnError: func(err error) {
+			log.Printf("spotinst: failed to stream group events: %v", err)
+		},
+	})
+	for e := range events {
+		switch e := e.(type) {
+		case *aws.ScaleUpEvent:
+			log.Printf("%s: launched %d instance(s)", e.GroupID, e.NewInstances)
+		case *aws.ScaleDownEvent:
+			log.Printf("%s: terminated %d instance(s)", e.GroupID, e.TerminatedInstances)
+		case *aws.SpotInterruptionEvent:
+			log.Printf("%s: replaced interrupted spot request(s) %v", e.GroupID, e.OldSpotRequestIDs)
+		case *aws.RollEvent:
+			log.Printf("%s: roll %s is %s (batch %d/%d)", e.GroupID, e.RollID, e.Status, e.CurrentBatch, e.NumberOfBatches)
+		default:
+			log.Printf("%s: %s event", e.Meta().GroupID, e.Meta().SubEvent.GetType())
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/manifest"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/tagging"
+)
+
+func main() {
+	// All clients require a Session. The Session provides the client with
+	// shared configuration such as account and credentials.
+	// A Session should be shared where possible to take advantage of
+	// configuration and credential caching. See the session package for
+	// more information.
+	sess := session.New()
+
+	// Create a new tagger with a Session. Tag changes are applied to the
+	// running instances of the groups too.
+	t := tagging.New(sess)
+	t.AutoApplyTags = true
+
+	// Create a new context.
+	ctx := context.Background()
+
+	// Plan the changes of the tags of the groups and clusters of the core
+	// team.
+	sel := &tagging.Selector{
+		Kinds: []manifest.Kind{manifest.KindElastigroupAWS, manifest.KindOceanAWS},
+		Tags:  map[string]string{"team": "core"},
+	}
+	plan, err := t.Plan(ctx, sel, &tagging.Changes{
+		Add:    map[string]string{"cost-center": "1234"},
+		Remove: []string{"tmp"},
+		Rename: map[string]string{"Owner": "owner"},
+	})
+	if err != nil {
+		log.Fatalf("spotinst: failed to plan tag changes: %v", err)
+	}
+	if err := plan.WriteText(os.Stdout); err != nil {
+		log.Fatalf("spotinst: failed to write plan: %v", err)
+	}
+	if !plan.HasChanges() {
+		return
+	}
+
+	// Apply the plan.
+	report, err := t.Apply(ctx, plan)
+	if err != nil {
+		log.Fatalf("spotinst: failed to apply tag changes: %v", err)
+	}
+	if err := report.Err(); err != nil {
+		log.Fatalf("spotinst: failed to change tags: %v", err)
+	}
+}
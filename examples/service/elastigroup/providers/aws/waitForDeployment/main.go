@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+func main() {
+	// All clients require a Session. The Session provides the client with
+	// shared configuration such as account and credentials.
+	// A Session should be shared where possible to take advantage of
+	// configuration and credential caching. See the session package for
+	// more information.
+	sess := session.New()
+
+	// Create a new instance of the provider's client with a Session.
+	svc := aws.New(sess)
+
+	// Create a new context that bounds the wait.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+
+	// Wait for the deployment to finish, stopping it if instances become
+	// unhealthy or the deadline is exceeded.
+	out, err := svc.WaitForDeployment(ctx, "sig-123456", "sbgd-123456", &aws.WaitForDeploymentOptions{
+		CheckHealth:           true,
+		MaxUnhealthyInstances: 1,
+		StopOnUnhealthy:       true,
+		StopOnDeadline:        true,
+		WaiterOptions: []waiter.Option{
+			waiter.WithMinDelay(30 * time.Second),
+		},
+		OnUpdate: func(u *aws.DeploymentUpdate) {
+			log.Printf("Deployment %s: batch %d/%d, progress %.0f%%",
+				u.Status, u.CurrentBatch, u.NumberOfBatches, u.Progress.GetValue())
+		},
+	})
+	if err != nil {
+		log.Fatalf("spotinst: failed to wait for deployment: %v", err)
+	}
+
+	// Output.
+	log.Printf("Deployment %s after %s", out.Status, out.Elapsed)
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/backup"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/credentials"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+)
+
+func main() {
+	// All clients require a Session. The Session provides the client with
+	// shared configuration such as account and credentials.
+	// A Session should be shared where possible to take advantage of
+	// configuration and credential caching. See the session package for
+	// more information.
+	sess := session.New()
+
+	// Create a new context.
+	ctx := context.Background()
+
+	// Export all the resources of the account of the Session.
+	index, err := backup.Export(ctx, sess, "backup")
+	if err != nil {
+		log.Fatalf("spotinst: failed to export resources: %v", err)
+	}
+	log.Printf("Exported %d resource(s)", len(index.Resources))
+
+	// Restore the resources into another account.
+	creds := credentials.NewFileCredentials("target", "")
+	ids, err := backup.Restore(ctx, sess, "backup",
+		spotinst.DefaultConfig().WithCredentials(creds))
+	if err != nil {
+		log.Fatalf("spotinst: failed to restore resources: %v", err)
+	}
+	for oldID, newID := range ids {
+		log.Printf("Restored %s as %s", oldID, newID)
+	}
+}
@@ -39,9 +39,8 @@ func main() {
 			Strategy: &aws.Strategy{
 				SpotPercentage:     spotinst.Float64(100),
 				FallbackToOnDemand: spotinst.Bool(true),
-				ClusterOrientation: &aws.ClusterOrientation{
-					AvailabilityVsCost: spotinst.String("cheapest"),
-				},
+				ClusterOrientation: new(aws.ClusterOrientation).
+					SetAvailabilityVsCostEnum(aws.AvailabilityVsCostCheapest),
 				SpreadNodesBy: spotinst.String("vcpu"),
 			},
 			Compute: &aws.Compute{
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/drift"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/manifest"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+)
+
+func main() {
+	// All clients require a Session. The Session provides the client with
+	// shared configuration such as account and credentials.
+	// A Session should be shared where possible to take advantage of
+	// configuration and credential caching. See the session package for
+	// more information.
+	sess := session.New()
+
+	// Create a new drift detector with a Session. The baseline is a
+	// snapshot, so fields set by hand on the live resources are reported
+	// too.
+	d := drift.New(sess)
+	d.Strict = true
+
+	// Create a new context.
+	ctx := context.Background()
+
+	// Take a snapshot on the first run.
+	const baselineFile = "baseline.yaml"
+	baseline, err := manifest.LoadFile(baselineFile)
+	if errors.Is(err, os.ErrNotExist) {
+		snapshot, err := d.Snapshot(ctx)
+		if err != nil {
+			log.Fatalf("spotinst: failed to take snapshot: %v", err)
+		}
+		if err := manifest.SaveFile(baselineFile, snapshot...); err != nil {
+			log.Fatalf("spotinst: failed to save snapshot: %v", err)
+		}
+		return
+	}
+	if err != nil {
+		log.Fatalf("spotinst: failed to load baseline: %v", err)
+	}
+
+	// Compare the live resources against the snapshot, and fail the build
+	// on drift.
+	report, err := d.Detect(ctx, baseline)
+	if err != nil {
+		log.Fatalf("spotinst: failed to detect drift: %v", err)
+	}
+	if err := report.WriteJUnit(os.Stdout); err != nil {
+		log.Fatalf("spotinst: failed to write report: %v", err)
+	}
+	if report.HasDrift() {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/informer"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+)
+
+func main() {
+	// All clients require a Session. The Session provides the client with
+	// shared configuration such as account and credentials.
+	// A Session should be shared where possible to take advantage of
+	// configuration and credential caching. See the session package for
+	// more information.
+	sess := session.New()
+
+	// Create a new instance of the provider's client with a Session.
+	svc := aws.New(sess)
+
+	// Create a new context, canceled on interrupt.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	// List the clusters every 2 minutes and log the changes.
+	inf := svc.NewClusterInformer(informer.Config{
+		Interval: 2 * time.Minute,
+		OnError: func(err error) {
+			log.Printf("spotinst: failed to list clusters: %v", err)
+		},
+	})
+	inf.AddEventHandler(informer.HandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			log.Printf("Cluster added: %s", obj.(*aws.Cluster).GetId())
+		},
+		UpdateFunc: func(_, obj interface{}) {
+			log.Printf("Cluster modified: %s", obj.(*aws.Cluster).GetId())
+		},
+		DeleteFunc: func(obj interface{}) {
+			log.Printf("Cluster deleted: %s", obj.(*aws.Cluster).GetId())
+		},
+	})
+	go inf.Run(ctx)
+
+	// Query the cache instead of the API.
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Minute):
+			clusters, err := inf.Cache().ByIndex(informer.IndexRegion, "us-west-2")
+			if err != nil {
+				log.Fatalf("spotinst: failed to query cache: %v", err)
+			}
+			log.Printf("%d cluster(s) in us-west-2", len(clusters))
+		}
+	}
+}
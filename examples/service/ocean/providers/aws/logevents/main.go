@@ -30,14 +30,15 @@ func main() {
 	ctx := context.Background()
 
 	// Get log events.
-	out, err := svc.CloudProviderAWS().GetLogEvents(ctx, &aws.GetLogEventsInput{
+	input := &aws.GetLogEventsInput{
 		ClusterID: spotinst.String("o-12345"),
-		FromDate:  spotinst.String("yyyy-mm-dd"),
-		ToDate:    spotinst.String("yyyy-mm-dd"),
-		// Severity:   spotinst.String("INFO"),    // +optional
 		// ResourceID: spotinst.String("i-12345"), // +optional
 		// Limit:      spotinst.Int(100),          // +optional
-	})
+	}
+	input.WithFromDate(time.Now().Add(-24 * time.Hour)).WithToDate(time.Now())
+	// input.SetSeverityEnum(aws.LogSeverityInfo) // +optional
+
+	out, err := svc.CloudProviderAWS().GetLogEvents(ctx, input)
 	if err != nil {
 		log.Fatalf("spotinst: failed to get log events: %v", err)
 	}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/manifest"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/reconcile"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+)
+
+func main() {
+	// All clients require a Session. The Session provides the client with
+	// shared configuration such as account and credentials.
+	// A Session should be shared where possible to take advantage of
+	// configuration and credential caching. See the session package for
+	// more information.
+	sess := session.New()
+
+	// Create a new reconciler with a Session.
+	r := reconcile.New(sess)
+
+	// Create a new context.
+	ctx := context.Background()
+
+	// Load the desired clusters and launch specs. Launch specs may refer to
+	// the clusters of the same file by name in their oceanId.
+	manifests, err := manifest.LoadFile("ocean.yaml")
+	if err != nil {
+		log.Fatalf("spotinst: failed to load manifests: %v", err)
+	}
+	desired, err := reconcile.FromManifests(manifests)
+	if err != nil {
+		log.Fatalf("spotinst: %v", err)
+	}
+
+	// Compute and print the plan.
+	plan, err := r.Plan(ctx, desired)
+	if err != nil {
+		log.Fatalf("spotinst: failed to plan: %v", err)
+	}
+	if err := plan.WriteText(os.Stdout); err != nil {
+		log.Fatalf("spotinst: failed to write plan: %v", err)
+	}
+	if !plan.HasChanges() {
+		return
+	}
+
+	// Apply the plan.
+	if err := r.Apply(ctx, plan); err != nil {
+		log.Fatalf("spotinst: %v", err)
+	}
+}
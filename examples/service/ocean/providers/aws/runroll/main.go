@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+)
+
+func main() {
+	// All clients require a Session. The Session provides the client with
+	// shared configuration such as account and credentials.
+	// A Session should be shared where possible to take advantage of
+	// configuration and credential caching. See the session package for
+	// more information.
+	sess := session.New()
+
+	// Create a new instance of the provider's client with a Session.
+	svc := aws.New(sess)
+
+	// Create a new context.
+	ctx := context.Background()
+
+	// Roll the cluster, stopping the roll if it stalls for 20 minutes or
+	// error log events appear.
+	report, err := svc.RunRoll(ctx, &aws.CreateRollInput{
+		Roll: &aws.RollSpec{
+			ClusterID:           spotinst.String("o-12345"),
+			BatchSizePercentage: spotinst.Int(20),
+			Comment:             spotinst.String("example"),
+		},
+	}, &aws.RunRollOptions{
+		StallTimeout:    20 * time.Minute,
+		StopOnLogErrors: true,
+		OnUpdate: func(r *aws.RollReport) {
+			log.Printf("Roll %s: %s (%.0f%%)", r.RollID, r.Status, r.Progress.GetValue())
+		},
+	})
+	if report != nil {
+		for _, b := range report.Batches {
+			log.Printf("Batch %d took %s", b.Number, b.Duration())
+		}
+		log.Printf("Collected %d log event(s)", len(report.Events))
+	}
+	if err != nil {
+		log.Fatalf("spotinst: failed to roll cluster: %v", err)
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+)
+
+func main() {
+	// All clients require a Session. The Session provides the client with
+	// shared configuration such as account and credentials.
+	// A Session should be shared where possible to take advantage of
+	// configuration and credential caching. See the session package for
+	// more information.
+	sess := session.New()
+
+	// Create a new instance of the provider's client with a Session.
+	svc := aws.New(sess)
+
+	// Create a new context, canceled on interrupt.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	// Export the last day of error events as JSON Lines.
+	n, err := svc.ExportLogEvents(ctx, "o-12345", os.Stdout, &aws.ExportLogEventsOptions{
+		From:      time.Now().Add(-24 * time.Hour),
+		Severity:  aws.LogSeverityError,
+		MaxEvents: 500,
+	})
+	if err != nil {
+		log.Fatalf("spotinst: failed to export log events: %v", err)
+	}
+	log.Printf("Exported %d log event(s)", n)
+
+	// Follow new log events until interrupted.
+	events := svc.TailLogEvents(ctx, "o-12345", &aws.TailLogEventsOptions{
+		OnError: func(err error) {
+			log.Printf("spotinst: failed to get log events: %v", err)
+		},
+	})
+	for e := range events {
+		log.Printf("%s [%s] %s", e.GetCreatedAt().Format(time.RFC3339), e.GetSeverity(), e.GetMessage())
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+)
+
+func main() {
+	// All clients require a Session. The Session provides the client with
+	// shared configuration such as account and credentials.
+	// A Session should be shared where possible to take advantage of
+	// configuration and credential caching. See the session package for
+	// more information.
+	sess := session.New()
+
+	// Create a new instance of the provider's client with a Session.
+	svc := aws.New(sess)
+
+	// Create a new context.
+	ctx := context.Background()
+
+	// Track the cluster's most recent migration.
+	tracker := svc.TrackMigration(ctx, &aws.ReadMigrationStatusInput{
+		ClusterID: spotinst.String("o-12345"),
+	})
+
+	// Print progress events as they arrive.
+	for p := range tracker.Progress() {
+		log.Printf("Migration %s: %d/%d instance(s) drained, %d launched, %d pod(s) rescheduled, %d unscheduled",
+			p.MigrationID, p.InstancesDrained, p.OldInstances, p.InstancesLaunched,
+			p.PodsRescheduled, len(p.UnscheduledPods))
+	}
+
+	summary, err := tracker.Wait()
+	if summary != nil {
+		for _, i := range summary.LeftBehind {
+			log.Printf("Left behind: %s (%s)", i.GetInstanceId(), i.GetK8sNodeName())
+		}
+	}
+	if err != nil {
+		log.Fatalf("spotinst: failed to track migration: %v", err)
+	}
+}
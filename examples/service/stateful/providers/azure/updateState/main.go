@@ -26,10 +26,12 @@ func main() {
 	ctx := context.Background()
 
 	// Read stateful node configuration.
-	_, err := svc.UpdateState(ctx, &azure.UpdateStatefulNodeStateInput{
-		ID:                spotinst.String("ssn-01234567"),
-		StatefulNodeState: spotinst.String("pause"),
-	})
+	input := &azure.UpdateStatefulNodeStateInput{
+		ID: spotinst.String("ssn-01234567"),
+	}
+	input.SetStatefulNodeStateEnum(azure.StatefulNodeActionPause)
+
+	_, err := svc.UpdateState(ctx, input)
 	if err != nil {
 		log.Fatalf("spotinst: failed to update stateful node state: %v", err)
 	}
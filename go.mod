@@ -2,7 +2,10 @@ module github.com/spotinst/spotinst-sdk-go
 
 go 1.20
 
-require gopkg.in/ini.v1 v1.67.0
+require (
+	gopkg.in/ini.v1 v1.67.0
+	gopkg.in/yaml.v3 v3.0.1
+)
 
 require github.com/stretchr/testify v1.9.0 // indirect
 
@@ -1,2 +1,4 @@
 // Package sdk is the official Spotinst SDK for the Go programming language.
 package sdk
+
+//go:generate go run ./spotinst/cmd/gettergen ./service/...
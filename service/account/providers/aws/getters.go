@@ -0,0 +1,175 @@
+// Code generated by gettergen. DO NOT EDIT.
+
+package aws
+
+import (
+	"time"
+)
+
+func (o *Account) GetId() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *Account) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Account) GetOrganizationId() string {
+	if o == nil || o.OrganizationId == nil {
+		return ""
+	}
+	return *o.OrganizationId
+}
+
+func (o *Account) GetAccountId() string {
+	if o == nil || o.AccountId == nil {
+		return ""
+	}
+	return *o.AccountId
+}
+
+func (o *Account) GetCloudProvider() string {
+	if o == nil || o.CloudProvider == nil {
+		return ""
+	}
+	return *o.CloudProvider
+}
+
+func (o *Account) GetProviderExternalId() string {
+	if o == nil || o.ProviderExternalId == nil {
+		return ""
+	}
+	return *o.ProviderExternalId
+}
+
+func (o *Account) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *AwsAccountExternalId) GetAccountId() string {
+	if o == nil || o.AccountId == nil {
+		return ""
+	}
+	return *o.AccountId
+}
+
+func (o *AwsAccountExternalId) GetExternalId() string {
+	if o == nil || o.ExternalId == nil {
+		return ""
+	}
+	return *o.ExternalId
+}
+
+func (o *CreateAWSAccountExternalIdInput) GetAccountID() string {
+	if o == nil || o.AccountID == nil {
+		return ""
+	}
+	return *o.AccountID
+}
+
+func (o *CreateAWSAccountExternalIdOutput) GetAWSAccountExternalId() *AwsAccountExternalId {
+	if o == nil {
+		return nil
+	}
+	return o.AWSAccountExternalId
+}
+
+func (o *CreateAccountInput) GetAccount() *Account {
+	if o == nil {
+		return nil
+	}
+	return o.Account
+}
+
+func (o *CreateAccountOutput) GetAccount() *Account {
+	if o == nil {
+		return nil
+	}
+	return o.Account
+}
+
+func (o *Credentials) GetIamRole() string {
+	if o == nil || o.IamRole == nil {
+		return ""
+	}
+	return *o.IamRole
+}
+
+func (o *Credentials) GetAccountId() string {
+	if o == nil || o.AccountId == nil {
+		return ""
+	}
+	return *o.AccountId
+}
+
+func (o *DeleteAccountInput) GetAccountID() string {
+	if o == nil || o.AccountID == nil {
+		return ""
+	}
+	return *o.AccountID
+}
+
+func (o *ReadAWSAccountExternalIdInput) GetAccountID() string {
+	if o == nil || o.AccountID == nil {
+		return ""
+	}
+	return *o.AccountID
+}
+
+func (o *ReadAWSAccountExternalIdOutput) GetAwsAccountExternalId() *AwsAccountExternalId {
+	if o == nil {
+		return nil
+	}
+	return o.AwsAccountExternalId
+}
+
+func (o *ReadAccountInput) GetAccountID() string {
+	if o == nil || o.AccountID == nil {
+		return ""
+	}
+	return *o.AccountID
+}
+
+func (o *ReadAccountOutput) GetAccount() *Account {
+	if o == nil {
+		return nil
+	}
+	return o.Account
+}
+
+func (o *ReadCredentialsInput) GetAccountId() string {
+	if o == nil || o.AccountId == nil {
+		return ""
+	}
+	return *o.AccountId
+}
+
+func (o *ReadCredentialsOutput) GetCredentials() *Credentials {
+	if o == nil {
+		return nil
+	}
+	return o.Credentials
+}
+
+func (o *SetCredentialsInput) GetCredentials() *Credentials {
+	if o == nil {
+		return nil
+	}
+	return o.Credentials
+}
+
+func (o *SetCredentialsOutput) GetCredentials() *Credentials {
+	if o == nil {
+		return nil
+	}
+	return o.Credentials
+}
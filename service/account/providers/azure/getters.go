@@ -0,0 +1,73 @@
+// Code generated by gettergen. DO NOT EDIT.
+
+package azure
+
+func (o *Credentials) GetAccountId() string {
+	if o == nil || o.AccountId == nil {
+		return ""
+	}
+	return *o.AccountId
+}
+
+func (o *Credentials) GetClientId() string {
+	if o == nil || o.ClientId == nil {
+		return ""
+	}
+	return *o.ClientId
+}
+
+func (o *Credentials) GetClientSecret() string {
+	if o == nil || o.ClientSecret == nil {
+		return ""
+	}
+	return *o.ClientSecret
+}
+
+func (o *Credentials) GetTenantId() string {
+	if o == nil || o.TenantId == nil {
+		return ""
+	}
+	return *o.TenantId
+}
+
+func (o *Credentials) GetSubscriptionId() string {
+	if o == nil || o.SubscriptionId == nil {
+		return ""
+	}
+	return *o.SubscriptionId
+}
+
+func (o *Credentials) GetExpirationDate() string {
+	if o == nil || o.ExpirationDate == nil {
+		return ""
+	}
+	return *o.ExpirationDate
+}
+
+func (o *ReadCredentialsInput) GetAccountId() string {
+	if o == nil || o.AccountId == nil {
+		return ""
+	}
+	return *o.AccountId
+}
+
+func (o *ReadCredentialsOutput) GetCredentials() *Credentials {
+	if o == nil {
+		return nil
+	}
+	return o.Credentials
+}
+
+func (o *SetCredentialsInput) GetCredentials() *Credentials {
+	if o == nil {
+		return nil
+	}
+	return o.Credentials
+}
+
+func (o *SetCredentialsOutput) GetCredentials() *Credentials {
+	if o == nil {
+		return nil
+	}
+	return o.Credentials
+}
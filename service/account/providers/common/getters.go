@@ -0,0 +1,91 @@
+// Code generated by gettergen. DO NOT EDIT.
+
+package common
+
+import (
+	"time"
+)
+
+func (o *Account) GetId() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *Account) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Account) GetOrganizationId() string {
+	if o == nil || o.OrganizationId == nil {
+		return ""
+	}
+	return *o.OrganizationId
+}
+
+func (o *Account) GetAccountId() string {
+	if o == nil || o.AccountId == nil {
+		return ""
+	}
+	return *o.AccountId
+}
+
+func (o *Account) GetCloudProvider() string {
+	if o == nil || o.CloudProvider == nil {
+		return ""
+	}
+	return *o.CloudProvider
+}
+
+func (o *Account) GetProviderExternalId() string {
+	if o == nil || o.ProviderExternalId == nil {
+		return ""
+	}
+	return *o.ProviderExternalId
+}
+
+func (o *Account) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *CreateAccountInput) GetAccount() *Account {
+	if o == nil {
+		return nil
+	}
+	return o.Account
+}
+
+func (o *CreateAccountOutput) GetAccount() *Account {
+	if o == nil {
+		return nil
+	}
+	return o.Account
+}
+
+func (o *DeleteAccountInput) GetAccountID() string {
+	if o == nil || o.AccountID == nil {
+		return ""
+	}
+	return *o.AccountID
+}
+
+func (o *ReadAccountInput) GetAccountID() string {
+	if o == nil || o.AccountID == nil {
+		return ""
+	}
+	return *o.AccountID
+}
+
+func (o *ReadAccountOutput) GetAccount() *Account {
+	if o == nil {
+		return nil
+	}
+	return o.Account
+}
@@ -0,0 +1,108 @@
+// Code generated by gettergen. DO NOT EDIT.
+
+package gcp
+
+func (o *ReadServiceAccountsInput) GetAccountId() string {
+	if o == nil || o.AccountId == nil {
+		return ""
+	}
+	return *o.AccountId
+}
+
+func (o *ReadServiceAccountsOutput) GetServiceAccounts() *ServiceAccounts {
+	if o == nil {
+		return nil
+	}
+	return o.ServiceAccounts
+}
+
+func (o *ServiceAccounts) GetAccountId() string {
+	if o == nil || o.AccountId == nil {
+		return ""
+	}
+	return *o.AccountId
+}
+
+func (o *ServiceAccounts) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *ServiceAccounts) GetProjectId() string {
+	if o == nil || o.ProjectId == nil {
+		return ""
+	}
+	return *o.ProjectId
+}
+
+func (o *ServiceAccounts) GetPrivateKeyId() string {
+	if o == nil || o.PrivateKeyId == nil {
+		return ""
+	}
+	return *o.PrivateKeyId
+}
+
+func (o *ServiceAccounts) GetPrivateKey() string {
+	if o == nil || o.PrivateKey == nil {
+		return ""
+	}
+	return *o.PrivateKey
+}
+
+func (o *ServiceAccounts) GetClientEmail() string {
+	if o == nil || o.ClientEmail == nil {
+		return ""
+	}
+	return *o.ClientEmail
+}
+
+func (o *ServiceAccounts) GetClientId() string {
+	if o == nil || o.ClientId == nil {
+		return ""
+	}
+	return *o.ClientId
+}
+
+func (o *ServiceAccounts) GetAuthUri() string {
+	if o == nil || o.AuthUri == nil {
+		return ""
+	}
+	return *o.AuthUri
+}
+
+func (o *ServiceAccounts) GetTokenUri() string {
+	if o == nil || o.TokenUri == nil {
+		return ""
+	}
+	return *o.TokenUri
+}
+
+func (o *ServiceAccounts) GetAuthProviderX509CertUrl() string {
+	if o == nil || o.AuthProviderX509CertUrl == nil {
+		return ""
+	}
+	return *o.AuthProviderX509CertUrl
+}
+
+func (o *ServiceAccounts) GetClientX509CertUrl() string {
+	if o == nil || o.ClientX509CertUrl == nil {
+		return ""
+	}
+	return *o.ClientX509CertUrl
+}
+
+func (o *SetServiceAccountsInput) GetServiceAccounts() *ServiceAccounts {
+	if o == nil {
+		return nil
+	}
+	return o.ServiceAccounts
+}
+
+func (o *SetServiceAccountsOutput) GetServiceAccounts() *ServiceAccounts {
+	if o == nil {
+		return nil
+	}
+	return o.ServiceAccounts
+}
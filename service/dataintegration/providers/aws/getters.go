@@ -0,0 +1,122 @@
+// Code generated by gettergen. DO NOT EDIT.
+
+package aws
+
+func (o *Config) GetBucketName() string {
+	if o == nil || o.BucketName == nil {
+		return ""
+	}
+	return *o.BucketName
+}
+
+func (o *Config) GetSubDir() string {
+	if o == nil || o.SubDir == nil {
+		return ""
+	}
+	return *o.SubDir
+}
+
+func (o *CreateDataIntegrationInput) GetDataIntegration() *DataIntegration {
+	if o == nil {
+		return nil
+	}
+	return o.DataIntegration
+}
+
+func (o *CreateDataIntegrationOutput) GetDataIntegration() *DataIntegration {
+	if o == nil {
+		return nil
+	}
+	return o.DataIntegration
+}
+
+func (o *DataIntegration) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *DataIntegration) GetConfig() *Config {
+	if o == nil {
+		return nil
+	}
+	return o.Config
+}
+
+func (o *DataIntegration) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *DataIntegration) GetVendor() string {
+	if o == nil || o.Vendor == nil {
+		return ""
+	}
+	return *o.Vendor
+}
+
+func (o *DataIntegration) GetStatus() string {
+	if o == nil || o.Status == nil {
+		return ""
+	}
+	return *o.Status
+}
+
+func (o *DataIntegration) GetHealth() string {
+	if o == nil || o.Health == nil {
+		return ""
+	}
+	return *o.Health
+}
+
+func (o *DataIntegration) GetLastHealthCheck() string {
+	if o == nil || o.LastHealthCheck == nil {
+		return ""
+	}
+	return *o.LastHealthCheck
+}
+
+func (o *DeleteDataIntegrationInput) GetDataIntegrationId() string {
+	if o == nil || o.DataIntegrationId == nil {
+		return ""
+	}
+	return *o.DataIntegrationId
+}
+
+func (o *ListDataIntegrationsOutput) GetDataIntegrations() []*DataIntegration {
+	if o == nil {
+		return nil
+	}
+	return o.DataIntegrations
+}
+
+func (o *ReadDataIntegrationInput) GetDataIntegrationId() string {
+	if o == nil || o.DataIntegrationId == nil {
+		return ""
+	}
+	return *o.DataIntegrationId
+}
+
+func (o *ReadDataIntegrationOutput) GetDataIntegration() *DataIntegration {
+	if o == nil {
+		return nil
+	}
+	return o.DataIntegration
+}
+
+func (o *UpdateDataIntegrationInput) GetDataIntegration() *DataIntegration {
+	if o == nil {
+		return nil
+	}
+	return o.DataIntegration
+}
+
+func (o *UpdateDataIntegrationOutput) GetDataIntegration() *DataIntegration {
+	if o == nil {
+		return nil
+	}
+	return o.DataIntegration
+}
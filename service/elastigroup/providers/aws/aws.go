@@ -969,7 +969,13 @@ type GetInstanceHealthinessOutput struct {
 
 type GetGroupEventsInput struct {
 	GroupID  *string `json:"groupId,omitempty"`
-	FromDate *string `json:"fromDate,omitempty"`
+	FromDate *string `json:"fromDate,omitempty"` // see WithFromDate
+}
+
+// WithFromDate sets FromDate to t, formatted as expected by the API.
+func (i *GetGroupEventsInput) WithFromDate(t time.Time) *GetGroupEventsInput {
+	i.FromDate = spotinst.String(spotinst.FormatTimestamp(t))
+	return i
 }
 
 type GetGroupEventsOutput struct {
@@ -983,6 +989,11 @@ type GroupEvent struct {
 	SubEvents []*SubEvent `json:"subEvents,omitempty"`
 }
 
+// CreatedAtTime parses CreatedAt. It returns the zero time if CreatedAt is nil.
+func (o *GroupEvent) CreatedAtTime() (time.Time, error) {
+	return spotinst.TimeFromString(o.CreatedAt)
+}
+
 type SubEvent struct {
 	// common fields
 	Type *string `json:"type,omitempty"`
@@ -1023,6 +1034,11 @@ type SubEvent struct {
 	NewInstanceIDs    []*string `json:"newInstanceIDs,omitempty"`
 }
 
+// CreatedAtTime parses CreatedAt. It returns the zero time if CreatedAt is nil.
+func (o *SubEvent) CreatedAtTime() (time.Time, error) {
+	return spotinst.TimeFromString(o.CreatedAt)
+}
+
 type Spot struct {
 	SpotInstanceRequestID *string `json:"spotInstanceRequestId,omitempty"`
 }
@@ -1047,6 +1063,16 @@ type StatefulInstance struct {
 	nullFields      []string
 }
 
+// CreatedAtTime parses CreatedAt. It returns the zero time if CreatedAt is nil.
+func (o *StatefulInstance) CreatedAtTime() (time.Time, error) {
+	return spotinst.TimeFromString(o.CreatedAt)
+}
+
+// LaunchedAtTime parses LaunchedAt. It returns the zero time if LaunchedAt is nil.
+func (o *StatefulInstance) LaunchedAtTime() (time.Time, error) {
+	return spotinst.TimeFromString(o.LaunchedAt)
+}
+
 type Device struct {
 	DeviceName *string `json:"deviceName,omitempty"`
 	VolumeID   *string `json:"volumeId,omitempty"`
@@ -1179,6 +1205,16 @@ type RollGroupStatus struct {
 	UpdatedAt  *string   `json:"updatedAt,omitempty"`
 }
 
+// CreatedAtTime parses CreatedAt. It returns the zero time if CreatedAt is nil.
+func (o *RollGroupStatus) CreatedAtTime() (time.Time, error) {
+	return spotinst.TimeFromString(o.CreatedAt)
+}
+
+// UpdatedAtTime parses UpdatedAt. It returns the zero time if UpdatedAt is nil.
+func (o *RollGroupStatus) UpdatedAtTime() (time.Time, error) {
+	return spotinst.TimeFromString(o.UpdatedAt)
+}
+
 type Progress struct {
 	Unit  *string  `json:"unit,omitempty"`
 	Value *float64 `json:"value,omitempty"`
@@ -1711,7 +1747,7 @@ func (s *ServiceOp) StopDeployment(ctx context.Context, input *StopDeploymentInp
 
 	r := client.NewRequest(http.MethodPut, path)
 	input.Roll = &Roll{
-		Status: spotinst.String("STOPPED"),
+		Status: spotinst.String(DeploymentStatusStopped.String()),
 	}
 	r.Obj = input
 
@@ -0,0 +1,331 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+)
+
+// ErrNoImageForRegion is returned by CloneRules.Apply when a group is cloned
+// to another region and CloneRules.Images has no image for that region.
+var ErrNoImageForRegion = errors.New("spotinst: no image for target region")
+
+// CloneRules describe how a group is rewritten when cloned. The maps of
+// identifiers are keyed by the value in the source group; values without an
+// entry are kept as-is.
+type CloneRules struct {
+	// Region is the region of the clone. If empty, the region of the source
+	// group is kept.
+	Region string
+
+	// NameTemplate is a text/template producing the name of the clone, e.g.
+	// "{{.Name}}-{{.Region}}". The template is executed with the Name, ID and
+	// Region of the source group and the target Region. If empty, the name of
+	// the source group is kept.
+	NameTemplate string
+
+	// Subnets maps subnet IDs, in the group, its availability zones and its
+	// network interfaces.
+	Subnets map[string]string
+
+	// AvailabilityZones maps availability zone names.
+	AvailabilityZones map[string]string
+
+	// SecurityGroups maps security group IDs, in the launch specification and
+	// its network interfaces.
+	SecurityGroups map[string]string
+
+	// KeyPairs maps key pair names.
+	KeyPairs map[string]string
+
+	// IAMInstanceProfiles maps instance profile names and ARNs.
+	IAMInstanceProfiles map[string]string
+
+	// LoadBalancers maps load balancer and target group names and ARNs.
+	LoadBalancers map[string]string
+
+	// Images maps regions to the image of the clone in that region. It is
+	// required when cloning to another region, since image IDs are regional.
+	Images map[string]string
+
+	// Tags are set on the clone, overriding the tags of the source group.
+	Tags map[string]string
+
+	// RemoveTags are the keys of the tags removed from the clone.
+	RemoveTags []string
+}
+
+// A SourceRegionError is returned by CloneRules.Apply when fields of a clone
+// still reference the region of the source group once rewritten.
+type SourceRegionError struct {
+	Region string
+
+	// Paths are the JSON paths of the fields, e.g.
+	// "compute.launchSpecification.iamRole.arn".
+	Paths []string
+}
+
+func (e *SourceRegionError) Error() string {
+	return fmt.Sprintf("spotinst: %d field(s) reference source region %q: %s",
+		len(e.Paths), e.Region, strings.Join(e.Paths, ", "))
+}
+
+// Clone reads the group groupID, rewrites it with rules and creates the
+// clone with dst, which may target another account, e.g. a Service created
+// with another session. It returns the created group.
+//
+// Fields not covered by rules, such as Elastic IPs or EBS volume pools,
+// are copied as-is and may need to be rewritten by reading the group and
+// calling CloneRules.Apply and Create instead.
+func (s *ServiceOp) Clone(ctx context.Context, groupID string, dst Service, rules *CloneRules) (*Group, error) {
+	out, err := s.Read(ctx, &ReadGroupInput{GroupID: spotinst.String(groupID)})
+	if err != nil {
+		return nil, err
+	}
+
+	clone, err := rules.Apply(out.Group)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := dst.Create(ctx, &CreateGroupInput{Group: clone})
+	if err != nil {
+		return nil, err
+	}
+	return created.Group, nil
+}
+
+// Apply returns a copy of group rewritten with the rules, without its
+// read-only fields. It fails if the group is cloned to another region and
+// a field of the copy still references the source region.
+func (r *CloneRules) Apply(group *Group) (*Group, error) {
+	if r == nil {
+		r = new(CloneRules)
+	}
+
+	clone, err := copyGroup(group)
+	if err != nil {
+		return nil, err
+	}
+	ClearReadOnly(clone)
+
+	sourceRegion := spotinst.StringValue(group.Region)
+	targetRegion := sourceRegion
+	if r.Region != "" {
+		targetRegion = r.Region
+		clone.Region = spotinst.String(r.Region)
+	}
+
+	if r.NameTemplate != "" {
+		name, err := r.name(group, targetRegion)
+		if err != nil {
+			return nil, err
+		}
+		clone.Name = spotinst.String(name)
+	}
+
+	if c := clone.Compute; c != nil {
+		c.SubnetIDs = mapStrings(r.Subnets, c.SubnetIDs)
+		c.PreferredAvailabilityZones = mapStrings(r.AvailabilityZones, c.PreferredAvailabilityZones)
+		for _, az := range c.AvailabilityZones {
+			az.Name = mapString(r.AvailabilityZones, az.Name)
+			az.SubnetID = mapString(r.Subnets, az.SubnetID)
+			az.SubnetIDs = mapStrings(r.Subnets, az.SubnetIDs)
+		}
+		if spec := c.LaunchSpecification; spec != nil {
+			if err := r.applyLaunchSpecification(spec, sourceRegion, targetRegion); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if targetRegion != sourceRegion && sourceRegion != "" {
+		if paths := regionPaths(clone, sourceRegion); len(paths) > 0 {
+			return nil, &SourceRegionError{Region: sourceRegion, Paths: paths}
+		}
+	}
+	return clone, nil
+}
+
+func (r *CloneRules) name(group *Group, region string) (string, error) {
+	tmpl, err := template.New("name").Option("missingkey=error").Parse(r.NameTemplate)
+	if err != nil {
+		return "", fmt.Errorf("spotinst: invalid name template: %w", err)
+	}
+	data := map[string]string{
+		"Name":         spotinst.StringValue(group.Name),
+		"ID":           spotinst.StringValue(group.ID),
+		"SourceRegion": spotinst.StringValue(group.Region),
+		"Region":       region,
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("spotinst: invalid name template: %w", err)
+	}
+	return b.String(), nil
+}
+
+func (r *CloneRules) applyLaunchSpecification(spec *LaunchSpecification, sourceRegion, targetRegion string) error {
+	spec.SecurityGroupIDs = mapStrings(r.SecurityGroups, spec.SecurityGroupIDs)
+	spec.KeyPair = mapString(r.KeyPairs, spec.KeyPair)
+
+	if image, ok := r.Images[targetRegion]; ok {
+		if len(spec.Images) > 0 {
+			spec.Images = []*Image{{Id: spotinst.String(image)}}
+		} else {
+			spec.ImageID = spotinst.String(image)
+		}
+	} else if targetRegion != sourceRegion && (spec.ImageID != nil || len(spec.Images) > 0) {
+		return fmt.Errorf("%w: %s", ErrNoImageForRegion, targetRegion)
+	}
+
+	if p := spec.IAMInstanceProfile; p != nil {
+		p.Name = mapString(r.IAMInstanceProfiles, p.Name)
+		p.Arn = mapString(r.IAMInstanceProfiles, p.Arn)
+	}
+
+	spec.LoadBalancerNames = mapStrings(r.LoadBalancers, spec.LoadBalancerNames)
+	if lbs := spec.LoadBalancersConfig; lbs != nil {
+		for _, lb := range lbs.LoadBalancers {
+			lb.Name = mapString(r.LoadBalancers, lb.Name)
+			lb.Arn = mapString(r.LoadBalancers, lb.Arn)
+		}
+	}
+
+	for _, ni := range spec.NetworkInterfaces {
+		ni.SubnetID = mapString(r.Subnets, ni.SubnetID)
+		ni.SecurityGroupsIDs = mapStrings(r.SecurityGroups, ni.SecurityGroupsIDs)
+	}
+
+	spec.Tags = r.applyTags(spec.Tags)
+	return nil
+}
+
+func (r *CloneRules) applyTags(tags []*Tag) []*Tag {
+	if len(r.Tags) == 0 && len(r.RemoveTags) == 0 {
+		return tags
+	}
+
+	remove := make(map[string]bool, len(r.RemoveTags)+len(r.Tags))
+	for _, key := range r.RemoveTags {
+		remove[key] = true
+	}
+	for key := range r.Tags {
+		remove[key] = true
+	}
+
+	var out []*Tag
+	for _, tag := range tags {
+		if !remove[spotinst.StringValue(tag.Key)] {
+			out = append(out, tag)
+		}
+	}
+
+	keys := make([]string, 0, len(r.Tags))
+	for key := range r.Tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		out = append(out, &Tag{Key: spotinst.String(key), Value: spotinst.String(r.Tags[key])})
+	}
+	return out
+}
+
+func mapString(m map[string]string, v *string) *string {
+	if v == nil {
+		return nil
+	}
+	if mapped, ok := m[*v]; ok {
+		return spotinst.String(mapped)
+	}
+	return v
+}
+
+func mapStrings(m map[string]string, vs []string) []string {
+	if len(m) == 0 || vs == nil {
+		return vs
+	}
+	out := make([]string, len(vs))
+	for i, v := range vs {
+		if mapped, ok := m[v]; ok {
+			v = mapped
+		}
+		out[i] = v
+	}
+	return out
+}
+
+// copyGroup returns a deep copy of group.
+func copyGroup(group *Group) (*Group, error) {
+	b, err := json.Marshal(group)
+	if err != nil {
+		return nil, err
+	}
+	clone := new(Group)
+	if err := json.Unmarshal(b, clone); err != nil {
+		return nil, err
+	}
+	return clone, nil
+}
+
+// ClearReadOnly clears the read-only fields of group, which are set by the
+// API and rejected when creating or updating a group.
+func ClearReadOnly(group *Group) {
+	group.ID, group.CreatedAt, group.UpdatedAt = nil, nil, nil
+	if spec := group.GetCompute().GetLaunchSpecification(); spec != nil {
+		for _, ni := range spec.NetworkInterfaces {
+			ni.ID = nil
+		}
+	}
+}
+
+// regionPaths returns the JSON paths of the string fields of group that
+// contain region.
+func regionPaths(group *Group, region string) []string {
+	b, err := json.Marshal(group)
+	if err != nil {
+		return nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil
+	}
+
+	var paths []string
+	var walk func(path string, v interface{})
+	walk = func(path string, v interface{}) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			keys := make([]string, 0, len(v))
+			for k := range v {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				p := k
+				if path != "" {
+					p = path + "." + k
+				}
+				walk(p, v[k])
+			}
+		case []interface{}:
+			for i, e := range v {
+				walk(path+"["+strconv.Itoa(i)+"]", e)
+			}
+		case string:
+			if strings.Contains(v, region) {
+				paths = append(paths, path)
+			}
+		}
+	}
+	walk("", v)
+	return paths
+}
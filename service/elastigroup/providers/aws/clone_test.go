@@ -0,0 +1,183 @@
+package aws
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+)
+
+func sourceGroup() *Group {
+	now := time.Unix(100, 0)
+	return &Group{
+		ID:        spotinst.String("sig-1"),
+		Name:      spotinst.String("web"),
+		Region:    spotinst.String("us-east-1"),
+		CreatedAt: &now,
+		UpdatedAt: &now,
+		Compute: &Compute{
+			SubnetIDs: []string{"subnet-a"},
+			AvailabilityZones: []*AvailabilityZone{
+				{Name: spotinst.String("us-east-1a"), SubnetID: spotinst.String("subnet-a")},
+			},
+			LaunchSpecification: &LaunchSpecification{
+				SecurityGroupIDs: []string{"sg-a"},
+				ImageID:          spotinst.String("ami-1"),
+				KeyPair:          spotinst.String("key-a"),
+				IAMInstanceProfile: &IAMInstanceProfile{
+					Arn: spotinst.String("arn:aws:iam::123:instance-profile/web"),
+				},
+				NetworkInterfaces: []*NetworkInterface{
+					{ID: spotinst.String("eni-1"), SubnetID: spotinst.String("subnet-a")},
+				},
+				Tags: []*Tag{
+					{Key: spotinst.String("env"), Value: spotinst.String("prod")},
+					{Key: spotinst.String("owner"), Value: spotinst.String("ops")},
+					{Key: spotinst.String("team"), Value: spotinst.String("web")},
+				},
+			},
+		},
+	}
+}
+
+func tagStrings(tags []*Tag) []string {
+	var out []string
+	for _, t := range tags {
+		out = append(out, t.GetKey()+"="+t.GetValue())
+	}
+	return out
+}
+
+func TestCloneRulesApply(t *testing.T) {
+	crossRegion := CloneRules{
+		Region:            "us-west-2",
+		Subnets:           map[string]string{"subnet-a": "subnet-b"},
+		AvailabilityZones: map[string]string{"us-east-1a": "us-west-2a"},
+		SecurityGroups:    map[string]string{"sg-a": "sg-b"},
+		Images:            map[string]string{"us-west-2": "ami-2"},
+	}
+
+	for _, tt := range []struct {
+		name  string
+		rules func() *CloneRules
+		fails bool
+		errIs error
+
+		// regionPaths are the paths of the SourceRegionError, if any.
+		regionPaths []string
+		check       func(t *testing.T, clone *Group)
+	}{
+		{
+			name:  "nil rules",
+			rules: func() *CloneRules { return nil },
+			check: func(t *testing.T, clone *Group) {
+				if clone.ID != nil || clone.CreatedAt != nil || clone.UpdatedAt != nil {
+					t.Error("read-only fields copied")
+				}
+				if clone.GetName() != "web" || clone.GetRegion() != "us-east-1" {
+					t.Errorf("got name %q in %q", clone.GetName(), clone.GetRegion())
+				}
+				if spec := clone.GetCompute().GetLaunchSpecification(); spec.GetImageId() != "ami-1" || len(spec.GetTags()) != 3 {
+					t.Errorf("got launch specification %+v", spec)
+				}
+			},
+		},
+		{
+			name: "tags",
+			rules: func() *CloneRules {
+				return &CloneRules{Tags: map[string]string{"env": "staging", "cost": "web"}, RemoveTags: []string{"owner"}}
+			},
+			check: func(t *testing.T, clone *Group) {
+				got := tagStrings(clone.GetCompute().GetLaunchSpecification().GetTags())
+				if want := []string{"team=web", "cost=web", "env=staging"}; !reflect.DeepEqual(got, want) {
+					t.Errorf("got tags %v, want %v", got, want)
+				}
+			},
+		},
+		{
+			name: "cross region",
+			rules: func() *CloneRules {
+				r := crossRegion
+				r.NameTemplate = "{{.Name}}-{{.Region}}"
+				return &r
+			},
+			check: func(t *testing.T, clone *Group) {
+				if clone.GetName() != "web-us-west-2" || clone.GetRegion() != "us-west-2" {
+					t.Errorf("got name %q in %q", clone.GetName(), clone.GetRegion())
+				}
+				c := clone.GetCompute()
+				spec := c.GetLaunchSpecification()
+				if spec.GetImageId() != "ami-2" || spec.GetKeyPair() != "key-a" {
+					t.Errorf("got image %q and key pair %q", spec.GetImageId(), spec.GetKeyPair())
+				}
+				if !reflect.DeepEqual(c.GetSubnetIDs(), []string{"subnet-b"}) || !reflect.DeepEqual(spec.GetSecurityGroupIDs(), []string{"sg-b"}) {
+					t.Errorf("got subnets %v and security groups %v", c.GetSubnetIDs(), spec.GetSecurityGroupIDs())
+				}
+				if az := c.GetAvailabilityZones()[0]; az.GetName() != "us-west-2a" || az.GetSubnetId() != "subnet-b" {
+					t.Errorf("got availability zone %+v", az)
+				}
+				if ni := spec.GetNetworkInterfaces()[0]; ni.ID != nil || ni.GetSubnetId() != "subnet-b" {
+					t.Errorf("got network interface %+v", ni)
+				}
+			},
+		},
+		{
+			name: "source region referenced",
+			rules: func() *CloneRules {
+				r := crossRegion
+				r.AvailabilityZones = nil
+				return &r
+			},
+			fails:       true,
+			regionPaths: []string{"compute.availabilityZones[0].name"},
+		},
+		{
+			name: "no image for region",
+			rules: func() *CloneRules {
+				r := crossRegion
+				r.Images = map[string]string{"eu-west-1": "ami-3"}
+				return &r
+			},
+			fails: true,
+			errIs: ErrNoImageForRegion,
+		},
+		{
+			name:  "invalid name template",
+			rules: func() *CloneRules { return &CloneRules{NameTemplate: "{{.Missing}}"} },
+			fails: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			source := sourceGroup()
+			clone, err := tt.rules().Apply(source)
+
+			var rerr *SourceRegionError
+			switch {
+			case tt.regionPaths != nil:
+				if !errors.As(err, &rerr) || rerr.Region != "us-east-1" || !reflect.DeepEqual(rerr.Paths, tt.regionPaths) {
+					t.Fatalf("got error %v, want paths %v", err, tt.regionPaths)
+				}
+				return
+			case tt.fails && tt.errIs == nil:
+				if err == nil {
+					t.Fatal("got no error")
+				}
+				return
+			case tt.errIs != nil:
+				if !errors.Is(err, tt.errIs) {
+					t.Fatalf("got error %v, want %v", err, tt.errIs)
+				}
+				return
+			case err != nil:
+				t.Fatal(err)
+			}
+
+			tt.check(t, clone)
+			if !reflect.DeepEqual(source, sourceGroup()) {
+				t.Error("source group modified")
+			}
+		})
+	}
+}
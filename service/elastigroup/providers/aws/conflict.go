@@ -0,0 +1,83 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/concurrency"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/httpcache"
+)
+
+// UpdateIfUnchanged is like Update, but first re-reads the group and returns
+// a *concurrency.ConflictError, without updating it, if it changed since
+// previous was read.
+func (s *ServiceOp) UpdateIfUnchanged(ctx context.Context, input *UpdateGroupInput, previous *Group) (*UpdateGroupOutput, error) {
+	return updateIfUnchanged(ctx, s, input, previous)
+}
+
+func updateIfUnchanged(ctx context.Context, svc Service, input *UpdateGroupInput, previous *Group) (*UpdateGroupOutput, error) {
+	version, err := concurrency.Version(previous)
+	if err != nil {
+		return nil, err
+	}
+	return updateIfVersion(ctx, svc, input, version)
+}
+
+// updateIfVersion re-reads the group and updates it if its version is still
+// version.
+func updateIfVersion(ctx context.Context, svc Service, input *UpdateGroupInput, version string) (*UpdateGroupOutput, error) {
+	id := spotinst.StringValue(input.Group.ID)
+	out, err := svc.Read(httpcache.WithBypass(ctx), &ReadGroupInput{GroupID: spotinst.String(id)})
+	if err != nil {
+		return nil, err
+	}
+	if err := concurrency.Check(id, version, out.Group); err != nil {
+		return nil, err
+	}
+	return svc.Update(ctx, input)
+}
+
+// RetryOnConflict reads the group, calls mutate with it and updates the
+// group with the returned desired state if it is unchanged since the read,
+// until the update succeeds or fails with an error other than a conflict.
+// mutate may return nil to leave the group as-is, modify and return current,
+// whose read-only fields are then cleared, or return an error matching
+// concurrency.ErrConflict to start over with a fresh read. It returns the
+// updated group.
+func (s *ServiceOp) RetryOnConflict(ctx context.Context, groupID string, retry *concurrency.Retry, mutate func(current *Group) (*Group, error)) (*Group, error) {
+	return retryOnConflict(ctx, s, groupID, retry, mutate)
+}
+
+func retryOnConflict(ctx context.Context, svc Service, groupID string, retry *concurrency.Retry, mutate func(current *Group) (*Group, error)) (*Group, error) {
+	var updated *Group
+	err := concurrency.RetryOnConflict(ctx, retry, func(ctx context.Context) error {
+		out, err := svc.Read(httpcache.WithBypass(ctx), &ReadGroupInput{GroupID: spotinst.String(groupID)})
+		if err != nil {
+			return err
+		}
+		// mutate may modify the group in place.
+		version, err := concurrency.Version(out.Group)
+		if err != nil {
+			return err
+		}
+
+		desired, err := mutate(out.Group)
+		if err != nil || desired == nil {
+			updated = out.Group
+			return err
+		}
+		ClearReadOnly(desired)
+		desired.ID = spotinst.String(groupID)
+
+		res, err := updateIfVersion(ctx, svc, &UpdateGroupInput{Group: desired}, version)
+		if err != nil {
+			return err
+		}
+		updated = res.Group
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
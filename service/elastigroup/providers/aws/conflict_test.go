@@ -0,0 +1,123 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/concurrency"
+)
+
+type fakeGroups struct {
+	Service
+
+	// groups are returned by successive Read calls, the last one repeated.
+	groups []*Group
+
+	n       int
+	reads   int
+	updates []*Group
+}
+
+func (f *fakeGroups) Read(context.Context, *ReadGroupInput) (*ReadGroupOutput, error) {
+	f.reads++
+	g := f.groups[f.n]
+	if f.n < len(f.groups)-1 {
+		f.n++
+	}
+	clone, err := copyGroup(g)
+	return &ReadGroupOutput{Group: clone}, err
+}
+
+func (f *fakeGroups) Update(_ context.Context, input *UpdateGroupInput) (*UpdateGroupOutput, error) {
+	f.updates = append(f.updates, input.Group)
+	return &UpdateGroupOutput{Group: input.Group}, nil
+}
+
+func groupAt(sec int64, target int) *Group {
+	updated := time.Unix(sec, 0).UTC()
+	return &Group{
+		ID:        spotinst.String("sig-1"),
+		Capacity:  &Capacity{Target: spotinst.Int(target)},
+		UpdatedAt: &updated,
+		Compute: &Compute{LaunchSpecification: &LaunchSpecification{
+			NetworkInterfaces: []*NetworkInterface{{ID: spotinst.String("eni-1"), DeviceIndex: spotinst.Int(0)}},
+		}},
+	}
+}
+
+func TestUpdateIfUnchanged(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		current *Group
+		errIs   error
+	}{
+		{name: "unchanged", current: groupAt(100, 2)},
+		{name: "changed", current: groupAt(200, 3), errIs: concurrency.ErrConflict},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &fakeGroups{groups: []*Group{tt.current, groupAt(300, 4)}}
+			input := &UpdateGroupInput{Group: &Group{ID: spotinst.String("sig-1"), Capacity: &Capacity{Target: spotinst.Int(5)}}}
+
+			_, err := updateIfUnchanged(context.Background(), svc, input, groupAt(100, 2))
+			if !errors.Is(err, tt.errIs) || (tt.errIs == nil && err != nil) {
+				t.Fatalf("got error %v, want %v", err, tt.errIs)
+			}
+			if svc.reads != 1 {
+				t.Errorf("got %d reads, want 1", svc.reads)
+			}
+			if updated := len(svc.updates) == 1; updated != (tt.errIs == nil) {
+				t.Errorf("got updated %v", updated)
+			}
+		})
+	}
+}
+
+func TestRetryOnConflict(t *testing.T) {
+	svc := &fakeGroups{groups: []*Group{groupAt(100, 2), groupAt(200, 3)}}
+
+	var calls int
+	group, err := retryOnConflict(context.Background(), svc, "sig-1", &concurrency.Retry{Delay: time.Millisecond}, func(current *Group) (*Group, error) {
+		if calls++; calls == 1 {
+			return nil, &concurrency.ConflictError{ResourceID: "sig-1"}
+		}
+		current.Capacity.SetTarget(spotinst.Int(current.Capacity.GetTarget() + 1))
+		return current, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 || svc.reads != 3 || len(svc.updates) != 1 {
+		t.Fatalf("got %d mutations, %d reads and %d updates, want 2, 3 and 1", calls, svc.reads, len(svc.updates))
+	}
+	if group.Capacity.GetTarget() != 4 || group.GetId() != "sig-1" {
+		t.Errorf("got group %+v", group)
+	}
+	if group.UpdatedAt != nil || group.Compute.LaunchSpecification.NetworkInterfaces[0].ID != nil {
+		t.Error("read-only fields sent")
+	}
+}
+
+func TestRetryOnConflictChangedBeforeUpdate(t *testing.T) {
+	// The group changes between the read and the update of the first
+	// attempt.
+	svc := &fakeGroups{groups: []*Group{groupAt(100, 2), groupAt(200, 3)}}
+
+	var calls int
+	group, err := retryOnConflict(context.Background(), svc, "sig-1", &concurrency.Retry{Delay: time.Millisecond}, func(current *Group) (*Group, error) {
+		calls++
+		current.Capacity.SetTarget(spotinst.Int(current.Capacity.GetTarget() + 1))
+		return current, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 || svc.reads != 4 || len(svc.updates) != 1 {
+		t.Fatalf("got %d mutations, %d reads and %d updates, want 2, 4 and 1", calls, svc.reads, len(svc.updates))
+	}
+	if group.Capacity.GetTarget() != 4 {
+		t.Errorf("got target %d, want 4", group.Capacity.GetTarget())
+	}
+}
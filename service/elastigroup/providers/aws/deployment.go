@@ -0,0 +1,247 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+// ErrUnhealthyInstances is returned by WaitForDeployment when the number of
+// unhealthy instances exceeds WaitForDeploymentOptions.MaxUnhealthyInstances.
+var ErrUnhealthyInstances = errors.New("spotinst: too many unhealthy instances")
+
+// stopDeploymentTimeout bounds the StopDeployment request made after the
+// caller's context is done.
+const stopDeploymentTimeout = 30 * time.Second
+
+// WaitForDeploymentOptions configure WaitForDeployment.
+type WaitForDeploymentOptions struct {
+	// ECS polls DeploymentStatusECS instead of DeploymentStatus. RollStatus
+	// is not available for ECS cluster rolls, so batch and instance details
+	// are not reported.
+	ECS bool
+
+	// OnUpdate, if set, is called after each poll.
+	OnUpdate func(*DeploymentUpdate)
+
+	// CheckHealth polls GetInstanceHealthiness along with the deployment
+	// status and fails when more than MaxUnhealthyInstances instances are
+	// unhealthy.
+	CheckHealth           bool
+	MaxUnhealthyInstances int
+
+	// StopOnUnhealthy calls StopDeployment when the health check fails.
+	StopOnUnhealthy bool
+
+	// StopOnDeadline calls StopDeployment when the context's deadline is
+	// exceeded or the waiter's maximum duration elapses before the deployment
+	// finishes.
+	StopOnDeadline bool
+
+	// WaiterOptions configure polling, e.g. waiter.WithMinDelay.
+	WaiterOptions []waiter.Option
+}
+
+// A DeploymentUpdate describes the progress of a deployment.
+type DeploymentUpdate struct {
+	Status             DeploymentStatus
+	Progress           *Progress
+	CurrentBatch       int
+	NumberOfBatches    int
+	Instances          []*BGInstances
+	UnhealthyInstances []*InstanceHealth
+	Elapsed            time.Duration
+}
+
+// DeploymentStoppedError is returned by WaitForDeployment when it stopped the
+// deployment.
+type DeploymentStoppedError struct {
+	GroupID string
+	RollID  string
+
+	// Reason is the error that caused the deployment to be stopped.
+	Reason error
+}
+
+func (e *DeploymentStoppedError) Error() string {
+	return fmt.Sprintf("spotinst: stopped deployment %q of group %q: %v", e.RollID, e.GroupID, e.Reason)
+}
+
+// Unwrap returns the reason the deployment was stopped.
+func (e *DeploymentStoppedError) Unwrap() error {
+	return e.Reason
+}
+
+// WaitForDeployment polls a deployment until it reaches a terminal status
+// and returns the last update. A deployment that is stopped or fails is
+// reported as an error wrapping waiter.ErrFailureState. Polls that fail
+// with a retryable error (429, 5xx or a network error) are retried.
+//
+// If configured, the deployment is stopped when instances become unhealthy
+// or the context's deadline is exceeded, in which case a
+// *DeploymentStoppedError is returned.
+func (s *ServiceOp) WaitForDeployment(ctx context.Context, groupID, rollID string, opts *WaitForDeploymentOptions) (*DeploymentUpdate, error) {
+	return waitForDeployment(ctx, s, groupID, rollID, opts)
+}
+
+func waitForDeployment(ctx context.Context, svc Service, groupID, rollID string, opts *WaitForDeploymentOptions) (*DeploymentUpdate, error) {
+	if opts == nil {
+		opts = &WaitForDeploymentOptions{}
+	}
+
+	w := &waiter.Waiter{
+		Name: "DeploymentFinished",
+		Poll: func(ctx context.Context) (interface{}, error) {
+			return pollDeployment(ctx, svc, groupID, rollID, opts)
+		},
+		Acceptors: []waiter.Acceptor{
+			{
+				State: waiter.Success,
+				Matcher: deploymentUpdateMatcher(func(u *DeploymentUpdate) bool {
+					return u.Status == DeploymentStatusFinished
+				}),
+			},
+			{
+				State: waiter.Failure,
+				Matcher: deploymentUpdateMatcher(func(u *DeploymentUpdate) bool {
+					return u.Status == DeploymentStatusStopped || u.Status == DeploymentStatusFailed
+				}),
+			},
+			{
+				State: waiter.Failure,
+				Matcher: deploymentUpdateMatcher(func(u *DeploymentUpdate) bool {
+					return opts.CheckHealth && len(u.UnhealthyInstances) > opts.MaxUnhealthyInstances
+				}),
+			},
+			{
+				// The deployment keeps running on the server, so transient
+				// poll errors don't end the wait.
+				State:   waiter.Retry,
+				Matcher: waiter.RetryableErrorMatcher,
+			},
+		},
+		Options: deploymentWaiterOptions(),
+	}
+	w.Apply(opts.WaiterOptions...)
+
+	var last *DeploymentUpdate
+	progress := w.Options.Progress
+	w.Apply(waiter.WithProgress(func(a waiter.Attempt) {
+		if u, ok := a.Output.(*DeploymentUpdate); ok && u != nil {
+			u.Elapsed = a.Elapsed
+			last = u
+			if opts.OnUpdate != nil {
+				opts.OnUpdate(u)
+			}
+		}
+		if progress != nil {
+			progress(a)
+		}
+	}))
+
+	_, err := w.Wait(ctx)
+	u := last
+	if err == nil {
+		return u, nil
+	}
+
+	var stop bool
+	switch {
+	case errors.Is(err, waiter.ErrFailureState) && u != nil && u.Status != DeploymentStatusStopped && u.Status != DeploymentStatusFailed:
+		err = fmt.Errorf("%w: %d unhealthy instance(s)", ErrUnhealthyInstances, len(u.UnhealthyInstances))
+		stop = opts.StopOnUnhealthy
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, waiter.ErrMaxDuration):
+		stop = opts.StopOnDeadline
+	}
+	if !stop {
+		return u, err
+	}
+
+	// The caller's context may be done already.
+	stopCtx, cancel := context.WithTimeout(context.Background(), stopDeploymentTimeout)
+	defer cancel()
+
+	if _, stopErr := svc.StopDeployment(stopCtx, &StopDeploymentInput{
+		GroupID: spotinst.String(groupID),
+		RollID:  spotinst.String(rollID),
+	}); stopErr != nil {
+		return u, errors.Join(err, fmt.Errorf("spotinst: failed to stop deployment: %w", stopErr))
+	}
+
+	return u, &DeploymentStoppedError{
+		GroupID: groupID,
+		RollID:  rollID,
+		Reason:  err,
+	}
+}
+
+func pollDeployment(ctx context.Context, svc Service, groupID, rollID string, opts *WaitForDeploymentOptions) (*DeploymentUpdate, error) {
+	input := &DeploymentStatusInput{
+		GroupID: spotinst.String(groupID),
+		RollID:  spotinst.String(rollID),
+	}
+
+	var (
+		out *RollGroupOutput
+		err error
+	)
+	if opts.ECS {
+		out, err = svc.DeploymentStatusECS(ctx, input)
+	} else {
+		out, err = svc.DeploymentStatus(ctx, input)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	u := new(DeploymentUpdate)
+	if len(out.RollGroupStatus) > 0 {
+		status := out.RollGroupStatus[0]
+		u.Status = DeploymentStatus(strings.ToUpper(status.GetRollStatus()))
+		u.Progress = status.Progress
+	}
+
+	if !opts.ECS {
+		rs, err := svc.RollStatus(ctx, &RollStatusInput{
+			GroupID: spotinst.String(groupID),
+			RollID:  spotinst.String(rollID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		u.CurrentBatch = rs.GetCurrentBatch()
+		u.NumberOfBatches = rs.GetNumberOfBatches()
+		u.Instances = rs.Instances
+		if rs.Progress != nil {
+			u.Progress = rs.Progress
+		}
+	}
+
+	if opts.CheckHealth && !u.Status.IsTerminal() {
+		health, err := svc.GetInstanceHealthiness(ctx, &GetInstanceHealthinessInput{
+			GroupID: spotinst.String(groupID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range health.Instances {
+			if strings.EqualFold(i.GetHealthStatus(), InstanceHealthStatusUnhealthy.String()) {
+				u.UnhealthyInstances = append(u.UnhealthyInstances, i)
+			}
+		}
+	}
+
+	return u, nil
+}
+
+func deploymentUpdateMatcher(fn func(*DeploymentUpdate) bool) waiter.Matcher {
+	return waiter.OutputMatcher(func(output interface{}) bool {
+		u, ok := output.(*DeploymentUpdate)
+		return ok && fn(u)
+	})
+}
@@ -0,0 +1,187 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+// fakeClock advances instantly when slept on.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.now = c.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+type fakeDeployments struct {
+	Service
+
+	// statuses are returned by successive DeploymentStatus calls, the last
+	// one repeated.
+	statuses  []DeploymentStatus
+	unhealthy int
+	stopErr   error
+
+	// pollErr, if set, is returned by the first DeploymentStatus call.
+	pollErr error
+
+	polls   int
+	stopped bool
+}
+
+func (f *fakeDeployments) DeploymentStatus(context.Context, *DeploymentStatusInput) (*RollGroupOutput, error) {
+	if err := f.pollErr; err != nil {
+		f.pollErr = nil
+		return nil, err
+	}
+	status := f.statuses[f.polls]
+	if f.polls < len(f.statuses)-1 {
+		f.polls++
+	}
+	return &RollGroupOutput{RollGroupStatus: []*RollGroupStatus{{RollStatus: spotinst.String(string(status))}}}, nil
+}
+
+func (f *fakeDeployments) RollStatus(context.Context, *RollStatusInput) (*RollStatusOutput, error) {
+	return &RollStatusOutput{CurrentBatch: spotinst.Int(1), NumberOfBatches: spotinst.Int(2)}, nil
+}
+
+func (f *fakeDeployments) GetInstanceHealthiness(context.Context, *GetInstanceHealthinessInput) (*GetInstanceHealthinessOutput, error) {
+	out := &GetInstanceHealthinessOutput{
+		Instances: []*InstanceHealth{{InstanceID: spotinst.String("i-healthy"), HealthStatus: spotinst.String("HEALTHY")}},
+	}
+	for i := 0; i < f.unhealthy; i++ {
+		out.Instances = append(out.Instances, &InstanceHealth{HealthStatus: spotinst.String("UNHEALTHY")})
+	}
+	return out, nil
+}
+
+func (f *fakeDeployments) StopDeployment(context.Context, *StopDeploymentInput) (*StopDeploymentOutput, error) {
+	f.stopped = true
+	return &StopDeploymentOutput{}, f.stopErr
+}
+
+func TestWaitForDeployment(t *testing.T) {
+	stopErr := errors.New("stop failed")
+	for _, tt := range []struct {
+		name    string
+		svc     *fakeDeployments
+		opts    WaitForDeploymentOptions
+		stopped bool
+		errIs   []error
+		status  DeploymentStatus
+	}{
+		{
+			name:   "finished",
+			svc:    &fakeDeployments{statuses: []DeploymentStatus{DeploymentStatusStarting, DeploymentStatusInProgress, DeploymentStatusFinished}},
+			status: DeploymentStatusFinished,
+		},
+		{
+			name: "transient poll error",
+			svc: &fakeDeployments{
+				statuses: []DeploymentStatus{DeploymentStatusInProgress, DeploymentStatusFinished},
+				pollErr:  client.Errors{{Response: &http.Response{StatusCode: http.StatusBadGateway}}},
+			},
+			status: DeploymentStatusFinished,
+		},
+		{
+			name:   "failed",
+			svc:    &fakeDeployments{statuses: []DeploymentStatus{DeploymentStatusInProgress, DeploymentStatusFailed}},
+			opts:   WaitForDeploymentOptions{StopOnUnhealthy: true, StopOnDeadline: true},
+			errIs:  []error{waiter.ErrFailureState},
+			status: DeploymentStatusFailed,
+		},
+		{
+			name:   "unhealthy",
+			svc:    &fakeDeployments{statuses: []DeploymentStatus{DeploymentStatusInProgress}, unhealthy: 2},
+			opts:   WaitForDeploymentOptions{CheckHealth: true, MaxUnhealthyInstances: 1},
+			errIs:  []error{ErrUnhealthyInstances},
+			status: DeploymentStatusInProgress,
+		},
+		{
+			name:   "unhealthy within limit",
+			svc:    &fakeDeployments{statuses: []DeploymentStatus{DeploymentStatusInProgress, DeploymentStatusFinished}, unhealthy: 1},
+			opts:   WaitForDeploymentOptions{CheckHealth: true, MaxUnhealthyInstances: 1, StopOnUnhealthy: true},
+			status: DeploymentStatusFinished,
+		},
+		{
+			name:    "stop on unhealthy",
+			svc:     &fakeDeployments{statuses: []DeploymentStatus{DeploymentStatusInProgress}, unhealthy: 1},
+			opts:    WaitForDeploymentOptions{CheckHealth: true, StopOnUnhealthy: true},
+			stopped: true,
+			errIs:   []error{ErrUnhealthyInstances},
+			status:  DeploymentStatusInProgress,
+		},
+		{
+			name:   "deadline",
+			svc:    &fakeDeployments{statuses: []DeploymentStatus{DeploymentStatusInProgress}},
+			errIs:  []error{waiter.ErrMaxDuration},
+			status: DeploymentStatusInProgress,
+		},
+		{
+			name:    "stop on deadline",
+			svc:     &fakeDeployments{statuses: []DeploymentStatus{DeploymentStatusInProgress}},
+			opts:    WaitForDeploymentOptions{StopOnDeadline: true},
+			stopped: true,
+			errIs:   []error{waiter.ErrMaxDuration},
+			status:  DeploymentStatusInProgress,
+		},
+		{
+			name:    "stop fails",
+			svc:     &fakeDeployments{statuses: []DeploymentStatus{DeploymentStatusInProgress}, stopErr: stopErr},
+			opts:    WaitForDeploymentOptions{StopOnDeadline: true},
+			stopped: true,
+			errIs:   []error{waiter.ErrMaxDuration, stopErr},
+			status:  DeploymentStatusInProgress,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var updates int
+			opts := tt.opts
+			opts.OnUpdate = func(*DeploymentUpdate) { updates++ }
+			opts.WaiterOptions = []waiter.Option{
+				waiter.WithClock(&fakeClock{now: time.Unix(0, 0)}),
+				waiter.WithMaxDuration(time.Hour),
+			}
+
+			u, err := waitForDeployment(context.Background(), tt.svc, "sig-1", "sbgd-1", &opts)
+			for _, target := range tt.errIs {
+				if !errors.Is(err, target) {
+					t.Errorf("got error %v, want %v", err, target)
+				}
+			}
+			if len(tt.errIs) == 0 && err != nil {
+				t.Fatalf("got error %v", err)
+			}
+
+			var stopped *DeploymentStoppedError
+			if got := errors.As(err, &stopped); got != (tt.stopped && tt.svc.stopErr == nil) {
+				t.Errorf("got stopped error %v, want %v", got, tt.stopped)
+			}
+			if tt.svc.stopped != tt.stopped {
+				t.Errorf("got StopDeployment called %v, want %v", tt.svc.stopped, tt.stopped)
+			}
+			if u == nil || u.Status != tt.status {
+				t.Fatalf("got update %+v, want status %s", u, tt.status)
+			}
+			if u.NumberOfBatches != 2 {
+				t.Errorf("got %d batches, want 2", u.NumberOfBatches)
+			}
+			if updates == 0 {
+				t.Error("OnUpdate not called")
+			}
+		})
+	}
+}
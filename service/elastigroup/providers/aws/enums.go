@@ -0,0 +1,444 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+)
+
+// A ScaleType represents the direction of a manual scaling operation.
+type ScaleType string
+
+const (
+	// ScaleTypeUp represents a scale up operation.
+	ScaleTypeUp ScaleType = "up"
+
+	// ScaleTypeDown represents a scale down operation.
+	ScaleTypeDown ScaleType = "down"
+)
+
+var scaleTypes = []ScaleType{
+	ScaleTypeUp,
+	ScaleTypeDown,
+}
+
+// ParseScaleType parses a string into a ScaleType. The comparison is case
+// insensitive and an error is returned for unknown values.
+func ParseScaleType(s string) (ScaleType, error) {
+	for _, v := range scaleTypes {
+		if strings.EqualFold(s, string(v)) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("spotinst: invalid scale type %q", s)
+}
+
+// IsValid reports whether the scale type is a known value.
+func (t ScaleType) IsValid() bool {
+	_, err := ParseScaleType(string(t))
+	return err == nil
+}
+
+func (t ScaleType) String() string {
+	return string(t)
+}
+
+// A DeploymentStatus represents the status of a group deployment (roll).
+type DeploymentStatus string
+
+const (
+	// DeploymentStatusStarting represents a deployment that has been
+	// requested but has not started replacing instances yet.
+	DeploymentStatusStarting DeploymentStatus = "STARTING"
+
+	// DeploymentStatusInProgress represents a running deployment.
+	DeploymentStatusInProgress DeploymentStatus = "IN_PROGRESS"
+
+	// DeploymentStatusFinished represents a successfully completed deployment.
+	DeploymentStatusFinished DeploymentStatus = "FINISHED"
+
+	// DeploymentStatusStopped represents a deployment stopped by the user.
+	DeploymentStatusStopped DeploymentStatus = "STOPPED"
+
+	// DeploymentStatusFailed represents a failed deployment.
+	DeploymentStatusFailed DeploymentStatus = "FAILED"
+)
+
+var deploymentStatuses = []DeploymentStatus{
+	DeploymentStatusStarting,
+	DeploymentStatusInProgress,
+	DeploymentStatusFinished,
+	DeploymentStatusStopped,
+	DeploymentStatusFailed,
+}
+
+// ParseDeploymentStatus parses a string into a DeploymentStatus. The
+// comparison is case insensitive and an error is returned for unknown values.
+func ParseDeploymentStatus(s string) (DeploymentStatus, error) {
+	for _, v := range deploymentStatuses {
+		if strings.EqualFold(s, string(v)) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("spotinst: invalid deployment status %q", s)
+}
+
+// IsValid reports whether the deployment status is a known value.
+func (s DeploymentStatus) IsValid() bool {
+	_, err := ParseDeploymentStatus(string(s))
+	return err == nil
+}
+
+// IsTerminal reports whether the deployment status is final, i.e. the
+// deployment will not make any further progress.
+func (s DeploymentStatus) IsTerminal() bool {
+	switch s {
+	case DeploymentStatusFinished, DeploymentStatusStopped, DeploymentStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (s DeploymentStatus) String() string {
+	return string(s)
+}
+
+// A StatefulInstanceState represents the state of a stateful instance.
+type StatefulInstanceState string
+
+const (
+	// StatefulInstanceStateActive represents a running stateful instance.
+	StatefulInstanceStateActive StatefulInstanceState = "ACTIVE"
+
+	// StatefulInstanceStatePausing represents a stateful instance being paused.
+	StatefulInstanceStatePausing StatefulInstanceState = "PAUSING"
+
+	// StatefulInstanceStatePaused represents a paused stateful instance.
+	StatefulInstanceStatePaused StatefulInstanceState = "PAUSED"
+
+	// StatefulInstanceStateResuming represents a stateful instance being resumed.
+	StatefulInstanceStateResuming StatefulInstanceState = "RESUMING"
+
+	// StatefulInstanceStateRecycling represents a stateful instance being recycled.
+	StatefulInstanceStateRecycling StatefulInstanceState = "RECYCLING"
+
+	// StatefulInstanceStateDeallocating represents a stateful instance whose
+	// resources are being deallocated.
+	StatefulInstanceStateDeallocating StatefulInstanceState = "DEALLOCATING"
+
+	// StatefulInstanceStateDeallocated represents a stateful instance whose
+	// resources have been deallocated.
+	StatefulInstanceStateDeallocated StatefulInstanceState = "DEALLOCATED"
+
+	// StatefulInstanceStateError represents a stateful instance in error.
+	StatefulInstanceStateError StatefulInstanceState = "ERROR"
+)
+
+var statefulInstanceStates = []StatefulInstanceState{
+	StatefulInstanceStateActive,
+	StatefulInstanceStatePausing,
+	StatefulInstanceStatePaused,
+	StatefulInstanceStateResuming,
+	StatefulInstanceStateRecycling,
+	StatefulInstanceStateDeallocating,
+	StatefulInstanceStateDeallocated,
+	StatefulInstanceStateError,
+}
+
+// ParseStatefulInstanceState parses a string into a StatefulInstanceState.
+// The comparison is case insensitive and an error is returned for unknown
+// values.
+func ParseStatefulInstanceState(s string) (StatefulInstanceState, error) {
+	for _, v := range statefulInstanceStates {
+		if strings.EqualFold(s, string(v)) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("spotinst: invalid stateful instance state %q", s)
+}
+
+// IsValid reports whether the stateful instance state is a known value.
+func (s StatefulInstanceState) IsValid() bool {
+	_, err := ParseStatefulInstanceState(string(s))
+	return err == nil
+}
+
+func (s StatefulInstanceState) String() string {
+	return string(s)
+}
+
+// An Orientation represents the availability vs. cost orientation of a group.
+type Orientation string
+
+const (
+	// OrientationBalanced balances between availability and cost.
+	OrientationBalanced Orientation = "balanced"
+
+	// OrientationCostOriented prefers cheaper markets.
+	OrientationCostOriented Orientation = "costOriented"
+
+	// OrientationAvailabilityOriented prefers markets with the lowest
+	// interruption rate.
+	OrientationAvailabilityOriented Orientation = "availabilityOriented"
+
+	// OrientationEqualAZDistribution distributes instances equally across
+	// availability zones.
+	OrientationEqualAZDistribution Orientation = "equalAzDistribution"
+)
+
+var orientations = []Orientation{
+	OrientationBalanced,
+	OrientationCostOriented,
+	OrientationAvailabilityOriented,
+	OrientationEqualAZDistribution,
+}
+
+// ParseOrientation parses a string into an Orientation. The comparison is
+// case insensitive and an error is returned for unknown values.
+func ParseOrientation(s string) (Orientation, error) {
+	for _, v := range orientations {
+		if strings.EqualFold(s, string(v)) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("spotinst: invalid orientation %q", s)
+}
+
+// IsValid reports whether the orientation is a known value.
+func (o Orientation) IsValid() bool {
+	_, err := ParseOrientation(string(o))
+	return err == nil
+}
+
+func (o Orientation) String() string {
+	return string(o)
+}
+
+// An InstanceHealthStatus represents the health of an instance as reported by
+// GetInstanceHealthiness.
+type InstanceHealthStatus string
+
+const (
+	// InstanceHealthStatusHealthy represents an instance passing its health
+	// checks.
+	InstanceHealthStatusHealthy InstanceHealthStatus = "HEALTHY"
+
+	// InstanceHealthStatusUnhealthy represents an instance failing its health
+	// checks.
+	InstanceHealthStatusUnhealthy InstanceHealthStatus = "UNHEALTHY"
+
+	// InstanceHealthStatusInsufficientData represents an instance whose health
+	// could not be determined yet.
+	InstanceHealthStatusInsufficientData InstanceHealthStatus = "INSUFFICIENT_DATA"
+
+	// InstanceHealthStatusUnknown represents an instance of unknown health.
+	InstanceHealthStatusUnknown InstanceHealthStatus = "UNKNOWN"
+)
+
+var instanceHealthStatuses = []InstanceHealthStatus{
+	InstanceHealthStatusHealthy,
+	InstanceHealthStatusUnhealthy,
+	InstanceHealthStatusInsufficientData,
+	InstanceHealthStatusUnknown,
+}
+
+// ParseInstanceHealthStatus parses a string into an InstanceHealthStatus. The
+// comparison is case insensitive and an error is returned for unknown values.
+func ParseInstanceHealthStatus(s string) (InstanceHealthStatus, error) {
+	for _, v := range instanceHealthStatuses {
+		if strings.EqualFold(s, string(v)) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("spotinst: invalid instance health status %q", s)
+}
+
+// IsValid reports whether the health status is a known value.
+func (s InstanceHealthStatus) IsValid() bool {
+	_, err := ParseInstanceHealthStatus(string(s))
+	return err == nil
+}
+
+func (s InstanceHealthStatus) String() string {
+	return string(s)
+}
+
+// A BeanstalkMaintenanceStatus represents the status of a Beanstalk
+// maintenance as reported by GetBeanstalkMaintenanceStatus.
+type BeanstalkMaintenanceStatus string
+
+const (
+	// BeanstalkMaintenanceStatusStarted represents a maintenance that was
+	// requested and is being prepared.
+	BeanstalkMaintenanceStatusStarted BeanstalkMaintenanceStatus = "STARTED"
+
+	// BeanstalkMaintenanceStatusAwaitUserUpdate represents an active
+	// maintenance, during which the environment may be updated.
+	BeanstalkMaintenanceStatusAwaitUserUpdate BeanstalkMaintenanceStatus = "AWAIT_USER_UPDATE"
+
+	// BeanstalkMaintenanceStatusEnded represents a finished maintenance.
+	BeanstalkMaintenanceStatusEnded BeanstalkMaintenanceStatus = "ENDED"
+)
+
+var beanstalkMaintenanceStatuses = []BeanstalkMaintenanceStatus{
+	BeanstalkMaintenanceStatusStarted,
+	BeanstalkMaintenanceStatusAwaitUserUpdate,
+	BeanstalkMaintenanceStatusEnded,
+}
+
+// ParseBeanstalkMaintenanceStatus parses a string into a
+// BeanstalkMaintenanceStatus. The comparison is case insensitive and an error
+// is returned for unknown values.
+func ParseBeanstalkMaintenanceStatus(s string) (BeanstalkMaintenanceStatus, error) {
+	for _, v := range beanstalkMaintenanceStatuses {
+		if strings.EqualFold(s, string(v)) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("spotinst: invalid beanstalk maintenance status %q", s)
+}
+
+// IsValid reports whether the maintenance status is a known value.
+func (s BeanstalkMaintenanceStatus) IsValid() bool {
+	_, err := ParseBeanstalkMaintenanceStatus(string(s))
+	return err == nil
+}
+
+func (s BeanstalkMaintenanceStatus) String() string {
+	return string(s)
+}
+
+// A SubEventType represents the type of a group sub-event, which determines
+// the SubEvent fields that are set.
+type SubEventType string
+
+const (
+	// SubEventTypeScaleUp represents instances being launched.
+	SubEventTypeScaleUp SubEventType = "scaleUp"
+
+	// SubEventTypeScaleDown represents instances being terminated.
+	SubEventTypeScaleDown SubEventType = "scaleDown"
+
+	// SubEventTypeScaleReason represents the scaling policy that triggered a
+	// scaling activity.
+	SubEventTypeScaleReason SubEventType = "scaleReason"
+
+	// SubEventTypeDetachedInstance represents an instance detached from the
+	// group.
+	SubEventTypeDetachedInstance SubEventType = "detachedInstance"
+
+	// SubEventTypeUnhealthyInstances represents instances found unhealthy.
+	SubEventTypeUnhealthyInstances SubEventType = "unhealthyInstances"
+
+	// SubEventTypeRollInfo represents the progress of a deployment.
+	SubEventTypeRollInfo SubEventType = "rollInfo"
+
+	// SubEventTypeRecoverInstances represents instances being replaced.
+	SubEventTypeRecoverInstances SubEventType = "recoverInstances"
+)
+
+var subEventTypes = []SubEventType{
+	SubEventTypeScaleUp,
+	SubEventTypeScaleDown,
+	SubEventTypeScaleReason,
+	SubEventTypeDetachedInstance,
+	SubEventTypeUnhealthyInstances,
+	SubEventTypeRollInfo,
+	SubEventTypeRecoverInstances,
+}
+
+// ParseSubEventType parses a string into a SubEventType. The comparison is
+// case insensitive and an error is returned for unknown values.
+func ParseSubEventType(s string) (SubEventType, error) {
+	for _, v := range subEventTypes {
+		if strings.EqualFold(s, string(v)) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("spotinst: invalid sub-event type %q", s)
+}
+
+// IsValid reports whether the sub-event type is a known value.
+func (t SubEventType) IsValid() bool {
+	_, err := ParseSubEventType(string(t))
+	return err == nil
+}
+
+func (t SubEventType) String() string {
+	return string(t)
+}
+
+// HealthStatusEnum returns the HealthStatus field as a typed
+// InstanceHealthStatus, or an empty InstanceHealthStatus if it isn't set.
+func (o *InstanceHealth) HealthStatusEnum() InstanceHealthStatus {
+	if o == nil || o.HealthStatus == nil {
+		return ""
+	}
+	return InstanceHealthStatus(*o.HealthStatus)
+}
+
+// TypeEnum returns the Type field as a typed SubEventType, or an empty
+// SubEventType if it isn't set.
+func (o *SubEvent) TypeEnum() SubEventType {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return SubEventType(*o.Type)
+}
+
+// StateEnum returns the State field as a typed StatefulInstanceState, or an
+// empty StatefulInstanceState if it isn't set.
+func (o *StatefulInstance) StateEnum() StatefulInstanceState {
+	if o == nil || o.State == nil {
+		return ""
+	}
+	return StatefulInstanceState(*o.State)
+}
+
+// RollStatusEnum returns the RollStatus field as a typed DeploymentStatus,
+// or an empty DeploymentStatus if it isn't set.
+func (o *RollGroupStatus) RollStatusEnum() DeploymentStatus {
+	if o == nil || o.RollStatus == nil {
+		return ""
+	}
+	return DeploymentStatus(*o.RollStatus)
+}
+
+// StatusEnum returns the Status field as a typed BeanstalkMaintenanceStatus,
+// or an empty BeanstalkMaintenanceStatus if it isn't set.
+func (o *BeanstalkMaintenanceItem) StatusEnum() BeanstalkMaintenanceStatus {
+	if o == nil || o.Status == nil {
+		return ""
+	}
+	return BeanstalkMaintenanceStatus(*o.Status)
+}
+
+// StatusEnum returns the Status field as a typed BeanstalkMaintenanceStatus,
+// or an empty BeanstalkMaintenanceStatus if it isn't set.
+func (o *BeanstalkMaintenanceOutput) StatusEnum() BeanstalkMaintenanceStatus {
+	if o == nil || o.Status == nil {
+		return ""
+	}
+	return BeanstalkMaintenanceStatus(*o.Status)
+}
+
+// ScaleTypeEnum returns the ScaleType field as a typed ScaleType, or an
+// empty ScaleType if it isn't set.
+func (o *ScaleGroupInput) ScaleTypeEnum() ScaleType {
+	if o == nil || o.ScaleType == nil {
+		return ""
+	}
+	return ScaleType(*o.ScaleType)
+}
+
+// SetScaleTypeEnum sets the ScaleType field to v, or clears it if v is
+// empty.
+func (o *ScaleGroupInput) SetScaleTypeEnum(v ScaleType) *ScaleGroupInput {
+	if v == "" {
+		o.ScaleType = nil
+	} else {
+		o.ScaleType = spotinst.String(string(v))
+	}
+	return o
+}
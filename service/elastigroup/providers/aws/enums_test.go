@@ -0,0 +1,112 @@
+package aws
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type enumCase struct {
+	in, want string
+	ok       bool
+}
+
+func TestParseEnums(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		parse func(string) (string, bool, error)
+		cases []enumCase
+	}{
+		{"ScaleType", func(s string) (string, bool, error) {
+			v, err := ParseScaleType(s)
+			return string(v), v.IsValid(), err
+		}, []enumCase{
+			{"down", "down", true},
+			{"DOWN", "down", true},
+			{"bogus", "", false},
+			{"", "", false},
+		}},
+		{"DeploymentStatus", func(s string) (string, bool, error) {
+			v, err := ParseDeploymentStatus(s)
+			return string(v), v.IsValid(), err
+		}, []enumCase{
+			{"FAILED", "FAILED", true},
+			{"failed", "FAILED", true},
+			{"bogus", "", false},
+			{"", "", false},
+		}},
+		{"StatefulInstanceState", func(s string) (string, bool, error) {
+			v, err := ParseStatefulInstanceState(s)
+			return string(v), v.IsValid(), err
+		}, []enumCase{
+			{"ERROR", "ERROR", true},
+			{"error", "ERROR", true},
+			{"bogus", "", false},
+			{"", "", false},
+		}},
+		{"Orientation", func(s string) (string, bool, error) {
+			v, err := ParseOrientation(s)
+			return string(v), v.IsValid(), err
+		}, []enumCase{
+			{"equalAzDistribution", "equalAzDistribution", true},
+			{"EQUALAZDISTRIBUTION", "equalAzDistribution", true},
+			{"bogus", "", false},
+			{"", "", false},
+		}},
+		{"InstanceHealthStatus", func(s string) (string, bool, error) {
+			v, err := ParseInstanceHealthStatus(s)
+			return string(v), v.IsValid(), err
+		}, []enumCase{
+			{"UNKNOWN", "UNKNOWN", true},
+			{"unknown", "UNKNOWN", true},
+			{"bogus", "", false},
+			{"", "", false},
+		}},
+		{"BeanstalkMaintenanceStatus", func(s string) (string, bool, error) {
+			v, err := ParseBeanstalkMaintenanceStatus(s)
+			return string(v), v.IsValid(), err
+		}, []enumCase{
+			{"ENDED", "ENDED", true},
+			{"ended", "ENDED", true},
+			{"bogus", "", false},
+			{"", "", false},
+		}},
+		{"SubEventType", func(s string) (string, bool, error) {
+			v, err := ParseSubEventType(s)
+			return string(v), v.IsValid(), err
+		}, []enumCase{
+			{"recoverInstances", "recoverInstances", true},
+			{"RECOVERINSTANCES", "recoverInstances", true},
+			{"bogus", "", false},
+			{"", "", false},
+		}},
+	} {
+		for _, c := range tt.cases {
+			got, valid, err := tt.parse(c.in)
+			if (err == nil) != c.ok || got != c.want || valid != c.ok {
+				t.Errorf("Parse%s(%q) = %q (valid %v), %v; want %q, ok %v", tt.name, c.in, got, valid, err, c.want, c.ok)
+			}
+		}
+	}
+}
+
+func TestEnumAccessors(t *testing.T) {
+	var o *ScaleGroupInput
+	if got := o.ScaleTypeEnum(); got != "" {
+		t.Errorf("got %q from nil ScaleGroupInput, want empty", got)
+	}
+
+	o = new(ScaleGroupInput).SetScaleTypeEnum(ScaleTypeUp)
+	if got := o.ScaleTypeEnum(); got != ScaleTypeUp {
+		t.Errorf("got %q, want %q", got, ScaleTypeUp)
+	}
+
+	// An empty value clears the field.
+	o.SetScaleTypeEnum("")
+	b, err := json.Marshal(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), `{}`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
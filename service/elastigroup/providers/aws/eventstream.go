@@ -0,0 +1,514 @@
+package aws
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+const (
+	// DefaultEventStreamInterval is the default delay between polls of each
+	// group's events.
+	DefaultEventStreamInterval = 30 * time.Second
+
+	// DefaultEventStreamOverlap is the default overlap between consecutive
+	// polls, which covers events that become visible late.
+	DefaultEventStreamOverlap = time.Minute
+)
+
+// An Event is a typed group event. The concrete type is one of
+// *ScaleUpEvent, *ScaleDownEvent, *ScaleReasonEvent, *DetachedInstanceEvent,
+// *UnhealthyInstancesEvent, *RollEvent, *SpotInterruptionEvent,
+// *ReplacementEvent or *UnknownEvent.
+type Event interface {
+	Meta() *EventMeta
+}
+
+// EventMeta holds the fields common to all events.
+type EventMeta struct {
+	GroupID   string
+	EventType string
+	CreatedAt time.Time
+
+	// SubEvent is the sub-event the event was decoded from.
+	SubEvent *SubEvent
+}
+
+// Meta returns the common fields of the event.
+func (m *EventMeta) Meta() *EventMeta { return m }
+
+// A ScaleUpEvent reports instances being launched.
+type ScaleUpEvent struct {
+	EventMeta
+	SpotRequestIDs []string
+	NewInstances   int
+}
+
+// A ScaleDownEvent reports instances being terminated.
+type ScaleDownEvent struct {
+	EventMeta
+	SpotRequestIDs      []string
+	TerminatedInstances int
+}
+
+// A ScaleReasonEvent reports the scaling policy that triggered a scaling
+// activity.
+type ScaleReasonEvent struct {
+	EventMeta
+	PolicyName string
+	Value      int
+	Unit       string
+	Threshold  int
+}
+
+// A DetachedInstanceEvent reports an instance detached from the group.
+type DetachedInstanceEvent struct {
+	EventMeta
+	InstanceID string
+}
+
+// An UnhealthyInstancesEvent reports instances found unhealthy.
+type UnhealthyInstancesEvent struct {
+	EventMeta
+	InstanceIDs []string
+}
+
+// A RollEvent reports the progress of a deployment.
+type RollEvent struct {
+	EventMeta
+	RollID          string
+	Status          DeploymentStatus
+	CurrentBatch    int
+	NumberOfBatches int
+}
+
+// A ReplacementEvent reports instances being replaced.
+type ReplacementEvent struct {
+	EventMeta
+	OldSpotRequestIDs []string
+	NewSpotRequestIDs []string
+	OldInstanceIDs    []string
+	NewInstanceIDs    []string
+}
+
+// A SpotInterruptionEvent reports spot instances being replaced after they
+// were interrupted. It's a replacement whose old instances include spot
+// requests.
+type SpotInterruptionEvent struct {
+	ReplacementEvent
+}
+
+// An UnknownEvent holds a sub-event of an unknown type.
+type UnknownEvent struct {
+	EventMeta
+}
+
+// NewEvent returns the typed events of a group event, one per sub-event. If
+// the group event's CreatedAt can't be parsed, the events have a zero
+// creation time.
+func NewEvent(e *GroupEvent) []Event {
+	created, _ := e.CreatedAtTime()
+	return newEvents(e, created)
+}
+
+func newEvents(e *GroupEvent, created time.Time) []Event {
+	events := make([]Event, 0, len(e.SubEvents))
+	for _, sub := range e.SubEvents {
+		if sub == nil {
+			continue
+		}
+		meta := EventMeta{
+			GroupID:   e.GetGroupID(),
+			EventType: e.GetEventType(),
+			CreatedAt: created,
+			SubEvent:  sub,
+		}
+		events = append(events, newSubEvent(meta, sub))
+	}
+
+	return events
+}
+
+func newSubEvent(meta EventMeta, sub *SubEvent) Event {
+	typ, _ := ParseSubEventType(sub.GetType())
+	switch typ {
+	case SubEventTypeScaleUp:
+		return &ScaleUpEvent{
+			EventMeta:      meta,
+			SpotRequestIDs: spotRequestIDs(sub.NewSpots),
+			NewInstances:   len(sub.NewInstances),
+		}
+	case SubEventTypeScaleDown:
+		return &ScaleDownEvent{
+			EventMeta:           meta,
+			SpotRequestIDs:      spotRequestIDs(sub.TerminatedSpots),
+			TerminatedInstances: len(sub.TerminatedInstances),
+		}
+	case SubEventTypeScaleReason:
+		return &ScaleReasonEvent{
+			EventMeta:  meta,
+			PolicyName: sub.GetScalingPolicyName(),
+			Value:      sub.GetValue(),
+			Unit:       sub.GetUnit(),
+			Threshold:  sub.GetThreshold(),
+		}
+	case SubEventTypeDetachedInstance:
+		return &DetachedInstanceEvent{
+			EventMeta:  meta,
+			InstanceID: sub.GetInstanceID(),
+		}
+	case SubEventTypeUnhealthyInstances:
+		return &UnhealthyInstancesEvent{
+			EventMeta:   meta,
+			InstanceIDs: spotinst.StringValueSlice(sub.InstanceIDs),
+		}
+	case SubEventTypeRollInfo:
+		if t, err := sub.CreatedAtTime(); err == nil && !t.IsZero() {
+			meta.CreatedAt = t
+		}
+		return &RollEvent{
+			EventMeta:       meta,
+			RollID:          sub.GetID(),
+			Status:          DeploymentStatus(strings.ToUpper(sub.GetStatus())),
+			CurrentBatch:    sub.GetCurrentBatch(),
+			NumberOfBatches: sub.GetNumberOfBatches(),
+		}
+	case SubEventTypeRecoverInstances:
+		e := ReplacementEvent{
+			EventMeta:         meta,
+			OldSpotRequestIDs: spotinst.StringValueSlice(sub.OldSpotRequestIDs),
+			NewSpotRequestIDs: spotinst.StringValueSlice(sub.NewSpotRequestIDs),
+			OldInstanceIDs:    spotinst.StringValueSlice(sub.OldInstanceIDs),
+			NewInstanceIDs:    spotinst.StringValueSlice(sub.NewInstanceIDs),
+		}
+		if len(e.OldSpotRequestIDs) > 0 {
+			return &SpotInterruptionEvent{ReplacementEvent: e}
+		}
+		return &e
+	default:
+		return &UnknownEvent{EventMeta: meta}
+	}
+}
+
+func spotRequestIDs(spots []*Spot) []string {
+	var ids []string
+	for _, s := range spots {
+		if id := s.GetSpotInstanceRequestID(); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// A ResumeToken records the position of an event stream in a group's
+// events.
+type ResumeToken struct {
+	GroupID string `json:"groupId"`
+
+	// Since is the creation time of the most recent event delivered.
+	Since time.Time `json:"since"`
+
+	// Seen holds the keys of the events delivered within the overlap before
+	// Since, and their creation times.
+	Seen map[string]time.Time `json:"seen,omitempty"`
+}
+
+// A TokenStore persists resume tokens.
+type TokenStore interface {
+	// Load returns the token of a group, or nil if there is none.
+	Load(groupID string) (*ResumeToken, error)
+
+	// Save stores the token of a group.
+	Save(token *ResumeToken) error
+}
+
+// FileTokenStore is a TokenStore that keeps the tokens of all groups in a
+// single JSON file.
+type FileTokenStore struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+// NewFileTokenStore returns a TokenStore backed by the file at path.
+func NewFileTokenStore(path string) *FileTokenStore {
+	return &FileTokenStore{Path: path}
+}
+
+// Load returns the token of a group, or nil if there is none.
+func (s *FileTokenStore) Load(groupID string) (*ResumeToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tokens, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	return tokens[groupID], nil
+}
+
+// Save stores the token of a group. The file is replaced atomically.
+func (s *FileTokenStore) Save(token *ResumeToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tokens, err := s.read()
+	if err != nil {
+		return err
+	}
+	tokens[token.GroupID] = token
+
+	b, err := json.MarshalIndent(tokens, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.Path)
+}
+
+func (s *FileTokenStore) read() (map[string]*ResumeToken, error) {
+	tokens := make(map[string]*ResumeToken)
+	b, err := os.ReadFile(s.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return tokens, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(b, &tokens); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// EventStreamOptions configure StreamGroupEvents.
+type EventStreamOptions struct {
+	// GroupIDs are the groups whose events are streamed.
+	GroupIDs []string
+
+	// Since is where streaming starts for groups without a resume token.
+	// Defaults to now.
+	Since time.Time
+
+	// Interval is the delay between polls of each group. Defaults to
+	// DefaultEventStreamInterval.
+	Interval time.Duration
+
+	// Overlap is subtracted from the start of each poll to catch events that
+	// become visible late. Defaults to DefaultEventStreamOverlap.
+	Overlap time.Duration
+
+	// TokenStore, if set, persists a resume token per group after its
+	// events are delivered, so that a restarted stream continues where it
+	// left off.
+	TokenStore TokenStore
+
+	// OnError, if set, is called when polling a group, decoding one of its
+	// events or saving its token fails. Events that can't be decoded are
+	// skipped; otherwise streaming continues with the next poll.
+	OnError func(error)
+
+	// Clock measures time and sleeps between polls. Defaults to
+	// waiter.SystemClock.
+	Clock waiter.Clock
+}
+
+// StreamGroupEvents polls GetGroupEvents for each group and sends the typed
+// events on the returned channel, oldest first per group. Events are
+// delivered once per resume token. The channel is closed when ctx is done.
+func (s *ServiceOp) StreamGroupEvents(ctx context.Context, opts *EventStreamOptions) <-chan Event {
+	return streamGroupEvents(ctx, s, opts)
+}
+
+func streamGroupEvents(ctx context.Context, svc Service, opts *EventStreamOptions) <-chan Event {
+	o := EventStreamOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Interval <= 0 {
+		o.Interval = DefaultEventStreamInterval
+	}
+	if o.Overlap <= 0 {
+		o.Overlap = DefaultEventStreamOverlap
+	}
+	if o.Clock == nil {
+		o.Clock = waiter.SystemClock
+	}
+	if o.Since.IsZero() {
+		o.Since = o.Clock.Now()
+	}
+
+	onError := func(err error) {
+		if ctx.Err() == nil && o.OnError != nil {
+			o.OnError(err)
+		}
+	}
+
+	ch := make(chan Event)
+	go func() {
+		defer close(ch)
+
+		tokens := make(map[string]*ResumeToken, len(o.GroupIDs))
+		for {
+			for _, groupID := range o.GroupIDs {
+				token, err := resumeToken(groupID, tokens, &o)
+				if err != nil {
+					onError(err)
+					continue
+				}
+
+				events, next, err := pollGroupEvents(ctx, svc, token, o.Overlap, onError)
+				if err != nil {
+					onError(err)
+					continue
+				}
+				for _, e := range events {
+					select {
+					case ch <- e:
+					case <-ctx.Done():
+						return
+					}
+				}
+
+				// The token only moves once the whole page is delivered.
+				tokens[groupID] = next
+				if len(events) > 0 && o.TokenStore != nil {
+					if err := o.TokenStore.Save(next); err != nil {
+						onError(err)
+					}
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-o.Clock.After(o.Interval):
+			}
+		}
+	}()
+
+	return ch
+}
+
+// resumeToken returns the in-memory token of a group, loading it from the
+// store on first use.
+func resumeToken(groupID string, tokens map[string]*ResumeToken, o *EventStreamOptions) (*ResumeToken, error) {
+	if token, ok := tokens[groupID]; ok {
+		return token, nil
+	}
+
+	var token *ResumeToken
+	if o.TokenStore != nil {
+		var err error
+		if token, err = o.TokenStore.Load(groupID); err != nil {
+			return nil, err
+		}
+	}
+	if token == nil {
+		token = &ResumeToken{GroupID: groupID, Since: o.Since}
+	}
+
+	tokens[groupID] = token
+	return token, nil
+}
+
+// pollGroupEvents returns the events of a group that the token hasn't seen,
+// oldest first, and the token advanced past them. The token itself is left
+// untouched. Events whose creation time can't be parsed are reported to
+// onError and skipped.
+func pollGroupEvents(ctx context.Context, svc Service, token *ResumeToken, overlap time.Duration, onError func(error)) ([]Event, *ResumeToken, error) {
+	input := &GetGroupEventsInput{GroupID: spotinst.String(token.GroupID)}
+	input.WithFromDate(token.Since.Add(-overlap))
+
+	out, err := svc.GetGroupEvents(ctx, input)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	next := &ResumeToken{
+		GroupID: token.GroupID,
+		Since:   token.Since,
+		Seen:    make(map[string]time.Time, len(token.Seen)),
+	}
+	for key, created := range token.Seen {
+		next.Seen[key] = created
+	}
+
+	var events []Event
+	for _, ge := range out.GroupEvents {
+		created, err := ge.CreatedAtTime()
+		if err != nil {
+			onError(fmt.Errorf("spotinst: skipping event of group %q: %w", token.GroupID, err))
+			continue
+		}
+		for _, e := range newEvents(ge, created) {
+			meta := e.Meta()
+			if meta.CreatedAt.Before(token.Since.Add(-overlap)) {
+				continue
+			}
+			key, err := eventKey(meta)
+			if err != nil {
+				onError(err)
+				continue
+			}
+			if _, ok := next.Seen[key]; ok {
+				continue // polls overlap by design
+			}
+			next.Seen[key] = meta.CreatedAt
+			events = append(events, e)
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Meta().CreatedAt.Before(events[j].Meta().CreatedAt)
+	})
+
+	for _, e := range events {
+		if created := e.Meta().CreatedAt; created.After(next.Since) {
+			next.Since = created
+		}
+	}
+	for key, created := range next.Seen {
+		if created.Before(next.Since.Add(-overlap)) {
+			delete(next.Seen, key)
+		}
+	}
+
+	return events, next, nil
+}
+
+// eventKey identifies an event by its group, type, creation time and
+// sub-event contents.
+func eventKey(meta *EventMeta) (string, error) {
+	b, err := json.Marshal(meta.SubEvent)
+	if err != nil {
+		return "", err
+	}
+	h := sha1.New()
+	h.Write([]byte(meta.GroupID + "|" + meta.EventType + "|" + meta.CreatedAt.UTC().Format(time.RFC3339Nano) + "|"))
+	h.Write(b)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
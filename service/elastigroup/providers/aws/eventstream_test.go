@@ -0,0 +1,226 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+)
+
+type fakeGroupEvents struct {
+	Service
+
+	// pages are returned by successive GetGroupEvents calls, the last one
+	// repeated. A nil page fails the call.
+	pages [][]*GroupEvent
+
+	n int
+}
+
+func (f *fakeGroupEvents) GetGroupEvents(context.Context, *GetGroupEventsInput) (*GetGroupEventsOutput, error) {
+	page := f.pages[f.n]
+	if f.n < len(f.pages)-1 {
+		f.n++
+	}
+	if page == nil {
+		return nil, errors.New("poll failed")
+	}
+	return &GetGroupEventsOutput{GroupEvents: page}, nil
+}
+
+type fakeTokenStore struct {
+	tokens  map[string]*ResumeToken
+	saveErr error
+	saves   int
+}
+
+func (f *fakeTokenStore) Load(groupID string) (*ResumeToken, error) {
+	return f.tokens[groupID], nil
+}
+
+func (f *fakeTokenStore) Save(token *ResumeToken) error {
+	f.saves++
+	if f.saveErr != nil {
+		return f.saveErr
+	}
+	f.tokens[token.GroupID] = token
+	return nil
+}
+
+func detached(sec int64, instanceID string) *GroupEvent {
+	return &GroupEvent{
+		GroupID:   spotinst.String("sig-1"),
+		EventType: spotinst.String("GROUP_UPDATE"),
+		CreatedAt: spotinst.String(spotinst.FormatTimestamp(time.Unix(sec, 0))),
+		SubEvents: []*SubEvent{{
+			Type:       spotinst.String(string(SubEventTypeDetachedInstance)),
+			InstanceID: spotinst.String(instanceID),
+		}},
+	}
+}
+
+func instanceIDs(events []Event) []string {
+	var ids []string
+	for _, e := range events {
+		ids = append(ids, e.(*DetachedInstanceEvent).InstanceID)
+	}
+	return ids
+}
+
+func TestPollGroupEvents(t *testing.T) {
+	svc := &fakeGroupEvents{pages: [][]*GroupEvent{{
+		detached(130, "i-c"), detached(110, "i-a"), detached(120, "i-b"), detached(10, "i-old"),
+	}}}
+	token := &ResumeToken{GroupID: "sig-1", Since: time.Unix(100, 0)}
+
+	events, next, err := pollGroupEvents(context.Background(), svc, token, time.Minute, func(err error) {
+		t.Errorf("got error %v", err)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := instanceIDs(events), []string{"i-a", "i-b", "i-c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got events %v, want %v", got, want)
+	}
+	if !token.Since.Equal(time.Unix(100, 0)) || len(token.Seen) != 0 {
+		t.Errorf("token changed to %+v", token)
+	}
+	if !next.Since.Equal(time.Unix(130, 0)) || len(next.Seen) != 3 {
+		t.Errorf("got next token %+v", next)
+	}
+
+	// The next poll overlaps the previous one.
+	svc.pages = [][]*GroupEvent{{detached(140, "i-d"), detached(130, "i-c"), detached(120, "i-b")}}
+	svc.n = 0
+	events, next, err = pollGroupEvents(context.Background(), svc, next, time.Minute, func(err error) {
+		t.Errorf("got error %v", err)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := instanceIDs(events), []string{"i-d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got events %v, want %v", got, want)
+	}
+	if !next.Since.Equal(time.Unix(140, 0)) {
+		t.Errorf("got next token %+v", next)
+	}
+}
+
+func TestPollGroupEventsMalformed(t *testing.T) {
+	events, err := groupEventsFromJSON([]byte(`{"response":{"items":[
+		{"groupId":"sig-1","createdAt":"2023-03-07T10:20:30.000+0000","subEvents":[{"type":"detachedInstance","instanceId":"i-a"}]},
+		{"groupId":"sig-1","createdAt":"yesterday","subEvents":[{"type":"detachedInstance","instanceId":"i-bad"}]},
+		{"groupId":"sig-1","createdAt":"2023-03-07T10:20:40.000+0000","subEvents":[{"type":"detachedInstance","instanceId":"i-b"}]}
+	]}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := &fakeGroupEvents{pages: [][]*GroupEvent{events}}
+	token := &ResumeToken{GroupID: "sig-1", Since: time.Date(2023, 3, 7, 10, 0, 0, 0, time.UTC)}
+
+	var errs []error
+	got, _, err := pollGroupEvents(context.Background(), svc, token, 0, func(err error) {
+		errs = append(errs, err)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids, want := instanceIDs(got), []string{"i-a", "i-b"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("got events %v, want %v", ids, want)
+	}
+	if len(errs) != 1 {
+		t.Errorf("got errors %v, want 1", errs)
+	}
+}
+
+func TestStreamGroupEvents(t *testing.T) {
+	svc := &fakeGroupEvents{pages: [][]*GroupEvent{
+		{detached(110, "i-a"), detached(120, "i-b")},
+		nil,
+		{detached(110, "i-a"), detached(120, "i-b"), detached(130, "i-c")},
+	}}
+	store := &fakeTokenStore{tokens: map[string]*ResumeToken{}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var errs []error
+	events := streamGroupEvents(ctx, svc, &EventStreamOptions{
+		GroupIDs:   []string{"sig-1"},
+		Since:      time.Unix(100, 0),
+		TokenStore: store,
+		OnError:    func(err error) { errs = append(errs, err) },
+		Clock:      &fakeClock{now: time.Unix(100, 0)},
+	})
+
+	var got []Event
+	for e := range events {
+		if got = append(got, e); len(got) == 3 {
+			cancel()
+		}
+	}
+	if ids, want := instanceIDs(got), []string{"i-a", "i-b", "i-c"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("got events %v, want %v", ids, want)
+	}
+	if len(errs) != 1 {
+		t.Errorf("got errors %v, want the failed poll", errs)
+	}
+	if token := store.tokens["sig-1"]; token == nil || !token.Since.Equal(time.Unix(130, 0)) {
+		t.Errorf("got saved token %+v, want since 130", token)
+	}
+}
+
+func TestStreamGroupEventsPartialPage(t *testing.T) {
+	svc := &fakeGroupEvents{pages: [][]*GroupEvent{{detached(110, "i-a"), detached(120, "i-b")}}}
+	store := &fakeTokenStore{tokens: map[string]*ResumeToken{}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := streamGroupEvents(ctx, svc, &EventStreamOptions{
+		GroupIDs:   []string{"sig-1"},
+		Since:      time.Unix(100, 0),
+		TokenStore: store,
+		Clock:      &fakeClock{now: time.Unix(100, 0)},
+	})
+	<-events
+	cancel()
+	for range events {
+	}
+	if store.saves != 0 {
+		t.Errorf("token saved %d times before the page was delivered", store.saves)
+	}
+}
+
+func TestStreamGroupEventsResume(t *testing.T) {
+	noError := func(err error) { t.Errorf("got error %v", err) }
+
+	// A previous stream delivered the first two events.
+	first := &fakeGroupEvents{pages: [][]*GroupEvent{{detached(110, "i-a"), detached(120, "i-b")}}}
+	_, token, err := pollGroupEvents(context.Background(), first, &ResumeToken{GroupID: "sig-1", Since: time.Unix(100, 0)}, time.Minute, noError)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := &fakeTokenStore{tokens: map[string]*ResumeToken{"sig-1": token}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	svc := &fakeGroupEvents{pages: [][]*GroupEvent{{detached(110, "i-a"), detached(120, "i-b"), detached(130, "i-c")}}}
+	events := streamGroupEvents(ctx, svc, &EventStreamOptions{
+		GroupIDs:   []string{"sig-1"},
+		TokenStore: store,
+		OnError:    noError,
+		Clock:      &fakeClock{now: time.Unix(200, 0)},
+	})
+	e := <-events
+	cancel()
+	for range events {
+	}
+	if id := e.(*DetachedInstanceEvent).InstanceID; id != "i-c" {
+		t.Errorf("got event %s, want i-c", id)
+	}
+}
@@ -0,0 +1,3612 @@
+// Code generated by gettergen. DO NOT EDIT.
+
+package aws
+
+import (
+	"time"
+)
+
+func (o *AMIs) GetShouldTag() bool {
+	if o == nil || o.ShouldTag == nil {
+		return false
+	}
+	return *o.ShouldTag
+}
+
+func (o *Action) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *Action) GetAdjustment() string {
+	if o == nil || o.Adjustment == nil {
+		return ""
+	}
+	return *o.Adjustment
+}
+
+func (o *Action) GetMinTargetCapacity() string {
+	if o == nil || o.MinTargetCapacity == nil {
+		return ""
+	}
+	return *o.MinTargetCapacity
+}
+
+func (o *Action) GetMaxTargetCapacity() string {
+	if o == nil || o.MaxTargetCapacity == nil {
+		return ""
+	}
+	return *o.MaxTargetCapacity
+}
+
+func (o *Action) GetMaximum() string {
+	if o == nil || o.Maximum == nil {
+		return ""
+	}
+	return *o.Maximum
+}
+
+func (o *Action) GetMinimum() string {
+	if o == nil || o.Minimum == nil {
+		return ""
+	}
+	return *o.Minimum
+}
+
+func (o *Action) GetTarget() string {
+	if o == nil || o.Target == nil {
+		return ""
+	}
+	return *o.Target
+}
+
+func (o *AutoScale) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *AutoScale) GetIsAutoConfig() bool {
+	if o == nil || o.IsAutoConfig == nil {
+		return false
+	}
+	return *o.IsAutoConfig
+}
+
+func (o *AutoScale) GetCooldown() int {
+	if o == nil || o.Cooldown == nil {
+		return 0
+	}
+	return *o.Cooldown
+}
+
+func (o *AutoScale) GetHeadroom() *AutoScaleHeadroom {
+	if o == nil {
+		return nil
+	}
+	return o.Headroom
+}
+
+func (o *AutoScale) GetDown() *AutoScaleDown {
+	if o == nil {
+		return nil
+	}
+	return o.Down
+}
+
+func (o *AutoScaleAttributes) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *AutoScaleAttributes) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *AutoScaleConstraint) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *AutoScaleConstraint) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *AutoScaleDown) GetEvaluationPeriods() int {
+	if o == nil || o.EvaluationPeriods == nil {
+		return 0
+	}
+	return *o.EvaluationPeriods
+}
+
+func (o *AutoScaleDown) GetMaxScaleDownPercentage() float64 {
+	if o == nil || o.MaxScaleDownPercentage == nil {
+		return 0
+	}
+	return *o.MaxScaleDownPercentage
+}
+
+func (o *AutoScaleECS) GetAttributes() []*AutoScaleAttributes {
+	if o == nil {
+		return nil
+	}
+	return o.Attributes
+}
+
+func (o *AutoScaleECS) GetShouldScaleDownNonServiceTasks() bool {
+	if o == nil || o.ShouldScaleDownNonServiceTasks == nil {
+		return false
+	}
+	return *o.ShouldScaleDownNonServiceTasks
+}
+
+func (o *AutoScaleHeadroom) GetCPUPerUnit() int {
+	if o == nil || o.CPUPerUnit == nil {
+		return 0
+	}
+	return *o.CPUPerUnit
+}
+
+func (o *AutoScaleHeadroom) GetGPUPerUnit() int {
+	if o == nil || o.GPUPerUnit == nil {
+		return 0
+	}
+	return *o.GPUPerUnit
+}
+
+func (o *AutoScaleHeadroom) GetMemoryPerUnit() int {
+	if o == nil || o.MemoryPerUnit == nil {
+		return 0
+	}
+	return *o.MemoryPerUnit
+}
+
+func (o *AutoScaleHeadroom) GetNumOfUnits() int {
+	if o == nil || o.NumOfUnits == nil {
+		return 0
+	}
+	return *o.NumOfUnits
+}
+
+func (o *AutoScaleKubernetes) GetLabels() []*AutoScaleLabel {
+	if o == nil {
+		return nil
+	}
+	return o.Labels
+}
+
+func (o *AutoScaleLabel) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *AutoScaleLabel) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *AutoScaleNomad) GetConstraints() []*AutoScaleConstraint {
+	if o == nil {
+		return nil
+	}
+	return o.Constraints
+}
+
+func (o *AvailabilityZone) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *AvailabilityZone) GetSubnetId() string {
+	if o == nil || o.SubnetID == nil {
+		return ""
+	}
+	return *o.SubnetID
+}
+
+func (o *AvailabilityZone) GetPlacementGroupName() string {
+	if o == nil || o.PlacementGroupName == nil {
+		return ""
+	}
+	return *o.PlacementGroupName
+}
+
+func (o *AvailabilityZone) GetSubnetIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.SubnetIDs
+}
+
+func (o *BGInstance) GetInstanceID() string {
+	if o == nil || o.InstanceID == nil {
+		return ""
+	}
+	return *o.InstanceID
+}
+
+func (o *BGInstance) GetLifecycle() string {
+	if o == nil || o.Lifecycle == nil {
+		return ""
+	}
+	return *o.Lifecycle
+}
+
+func (o *BGInstance) GetBatchNum() int {
+	if o == nil || o.BatchNum == nil {
+		return 0
+	}
+	return *o.BatchNum
+}
+
+func (o *BGInstance) GetStatus() string {
+	if o == nil || o.Status == nil {
+		return ""
+	}
+	return *o.Status
+}
+
+func (o *BGInstances) GetBlue() []*BGInstance {
+	if o == nil {
+		return nil
+	}
+	return o.Blue
+}
+
+func (o *BGInstances) GetGreen() []*BGInstance {
+	if o == nil {
+		return nil
+	}
+	return o.Green
+}
+
+func (o *Batch) GetJobQueueNames() []string {
+	if o == nil {
+		return nil
+	}
+	return o.JobQueueNames
+}
+
+func (o *BeanstalkDeploymentPreferences) GetAutomaticRoll() bool {
+	if o == nil || o.AutomaticRoll == nil {
+		return false
+	}
+	return *o.AutomaticRoll
+}
+
+func (o *BeanstalkDeploymentPreferences) GetBatchSizePercentage() int {
+	if o == nil || o.BatchSizePercentage == nil {
+		return 0
+	}
+	return *o.BatchSizePercentage
+}
+
+func (o *BeanstalkDeploymentPreferences) GetGracePeriod() int {
+	if o == nil || o.GracePeriod == nil {
+		return 0
+	}
+	return *o.GracePeriod
+}
+
+func (o *BeanstalkDeploymentPreferences) GetStrategy() *BeanstalkDeploymentStrategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategy
+}
+
+func (o *BeanstalkDeploymentStrategy) GetAction() string {
+	if o == nil || o.Action == nil {
+		return ""
+	}
+	return *o.Action
+}
+
+func (o *BeanstalkDeploymentStrategy) GetShouldDrainInstances() bool {
+	if o == nil || o.ShouldDrainInstances == nil {
+		return false
+	}
+	return *o.ShouldDrainInstances
+}
+
+func (o *BeanstalkMaintenanceInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *BeanstalkMaintenanceItem) GetStatus() string {
+	if o == nil || o.Status == nil {
+		return ""
+	}
+	return *o.Status
+}
+
+func (o *BeanstalkMaintenanceOutput) GetItems() []*BeanstalkMaintenanceItem {
+	if o == nil {
+		return nil
+	}
+	return o.Items
+}
+
+func (o *BeanstalkMaintenanceOutput) GetStatus() string {
+	if o == nil || o.Status == nil {
+		return ""
+	}
+	return *o.Status
+}
+
+func (o *BeanstalkManagedActions) GetPlatformUpdate() *BeanstalkPlatformUpdate {
+	if o == nil {
+		return nil
+	}
+	return o.PlatformUpdate
+}
+
+func (o *BeanstalkPlatformUpdate) GetPerformAt() string {
+	if o == nil || o.PerformAt == nil {
+		return ""
+	}
+	return *o.PerformAt
+}
+
+func (o *BeanstalkPlatformUpdate) GetTimeWindow() string {
+	if o == nil || o.TimeWindow == nil {
+		return ""
+	}
+	return *o.TimeWindow
+}
+
+func (o *BeanstalkPlatformUpdate) GetUpdateLevel() string {
+	if o == nil || o.UpdateLevel == nil {
+		return ""
+	}
+	return *o.UpdateLevel
+}
+
+func (o *BlockDeviceMapping) GetDeviceName() string {
+	if o == nil || o.DeviceName == nil {
+		return ""
+	}
+	return *o.DeviceName
+}
+
+func (o *BlockDeviceMapping) GetVirtualName() string {
+	if o == nil || o.VirtualName == nil {
+		return ""
+	}
+	return *o.VirtualName
+}
+
+func (o *BlockDeviceMapping) GetEBS() *EBS {
+	if o == nil {
+		return nil
+	}
+	return o.EBS
+}
+
+func (o *CPUOptions) GetThreadsPerCore() int {
+	if o == nil || o.ThreadsPerCore == nil {
+		return 0
+	}
+	return *o.ThreadsPerCore
+}
+
+func (o *Capacity) GetMinimum() int {
+	if o == nil || o.Minimum == nil {
+		return 0
+	}
+	return *o.Minimum
+}
+
+func (o *Capacity) GetMaximum() int {
+	if o == nil || o.Maximum == nil {
+		return 0
+	}
+	return *o.Maximum
+}
+
+func (o *Capacity) GetTarget() int {
+	if o == nil || o.Target == nil {
+		return 0
+	}
+	return *o.Target
+}
+
+func (o *Capacity) GetUnit() string {
+	if o == nil || o.Unit == nil {
+		return ""
+	}
+	return *o.Unit
+}
+
+func (o *ChefIntegration) GetServer() string {
+	if o == nil || o.Server == nil {
+		return ""
+	}
+	return *o.Server
+}
+
+func (o *ChefIntegration) GetOrganization() string {
+	if o == nil || o.Organization == nil {
+		return ""
+	}
+	return *o.Organization
+}
+
+func (o *ChefIntegration) GetUser() string {
+	if o == nil || o.User == nil {
+		return ""
+	}
+	return *o.User
+}
+
+func (o *ChefIntegration) GetPEMKey() string {
+	if o == nil || o.PEMKey == nil {
+		return ""
+	}
+	return *o.PEMKey
+}
+
+func (o *ChefIntegration) GetVersion() string {
+	if o == nil || o.Version == nil {
+		return ""
+	}
+	return *o.Version
+}
+
+func (o *CodeDeployIntegration) GetDeploymentGroups() []*DeploymentGroup {
+	if o == nil {
+		return nil
+	}
+	return o.DeploymentGroups
+}
+
+func (o *CodeDeployIntegration) GetCleanUpOnFailure() bool {
+	if o == nil || o.CleanUpOnFailure == nil {
+		return false
+	}
+	return *o.CleanUpOnFailure
+}
+
+func (o *CodeDeployIntegration) GetTerminateInstanceOnFailure() bool {
+	if o == nil || o.TerminateInstanceOnFailure == nil {
+		return false
+	}
+	return *o.TerminateInstanceOnFailure
+}
+
+func (o *Compute) GetProduct() string {
+	if o == nil || o.Product == nil {
+		return ""
+	}
+	return *o.Product
+}
+
+func (o *Compute) GetInstanceTypes() *InstanceTypes {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceTypes
+}
+
+func (o *Compute) GetLaunchSpecification() *LaunchSpecification {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecification
+}
+
+func (o *Compute) GetAvailabilityZones() []*AvailabilityZone {
+	if o == nil {
+		return nil
+	}
+	return o.AvailabilityZones
+}
+
+func (o *Compute) GetPreferredAvailabilityZones() []string {
+	if o == nil {
+		return nil
+	}
+	return o.PreferredAvailabilityZones
+}
+
+func (o *Compute) GetElasticIPs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ElasticIPs
+}
+
+func (o *Compute) GetEBSVolumePool() []*EBSVolume {
+	if o == nil {
+		return nil
+	}
+	return o.EBSVolumePool
+}
+
+func (o *Compute) GetPrivateIPs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.PrivateIPs
+}
+
+func (o *Compute) GetSubnetIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.SubnetIDs
+}
+
+func (o *CreateGroupInput) GetGroup() *Group {
+	if o == nil {
+		return nil
+	}
+	return o.Group
+}
+
+func (o *CreateGroupOutput) GetGroup() *Group {
+	if o == nil {
+		return nil
+	}
+	return o.Group
+}
+
+func (o *CreateSuspensionsInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *CreateSuspensionsInput) GetSuspensions() []*Suspension {
+	if o == nil {
+		return nil
+	}
+	return o.Suspensions
+}
+
+func (o *CreateSuspensionsOutput) GetSuspendProcesses() *SuspendProcesses {
+	if o == nil {
+		return nil
+	}
+	return o.SuspendProcesses
+}
+
+func (o *CreditSpecification) GetCPUCredits() string {
+	if o == nil || o.CPUCredits == nil {
+		return ""
+	}
+	return *o.CPUCredits
+}
+
+func (o *DeallocateStatefulInstanceInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *DeallocateStatefulInstanceInput) GetStatefulInstanceID() string {
+	if o == nil || o.StatefulInstanceID == nil {
+		return ""
+	}
+	return *o.StatefulInstanceID
+}
+
+func (o *DeleteGroupInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *DeleteGroupInput) GetStatefulDeallocation() *StatefulDeallocation {
+	if o == nil {
+		return nil
+	}
+	return o.StatefulDeallocation
+}
+
+func (o *DeleteSuspensionsInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *DeleteSuspensionsInput) GetProcesses() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Processes
+}
+
+func (o *DeploymentGroup) GetApplicationName() string {
+	if o == nil || o.ApplicationName == nil {
+		return ""
+	}
+	return *o.ApplicationName
+}
+
+func (o *DeploymentGroup) GetDeploymentGroupName() string {
+	if o == nil || o.DeploymentGroupName == nil {
+		return ""
+	}
+	return *o.DeploymentGroupName
+}
+
+func (o *DeploymentStatusInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *DeploymentStatusInput) GetRollID() string {
+	if o == nil || o.RollID == nil {
+		return ""
+	}
+	return *o.RollID
+}
+
+func (o *DetachGroupInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *DetachGroupInput) GetInstanceIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceIDs
+}
+
+func (o *DetachGroupInput) GetShouldDecrementTargetCapacity() bool {
+	if o == nil || o.ShouldDecrementTargetCapacity == nil {
+		return false
+	}
+	return *o.ShouldDecrementTargetCapacity
+}
+
+func (o *DetachGroupInput) GetShouldTerminateInstances() bool {
+	if o == nil || o.ShouldTerminateInstances == nil {
+		return false
+	}
+	return *o.ShouldTerminateInstances
+}
+
+func (o *DetachGroupInput) GetDrainingTimeout() int {
+	if o == nil || o.DrainingTimeout == nil {
+		return 0
+	}
+	return *o.DrainingTimeout
+}
+
+func (o *Device) GetDeviceName() string {
+	if o == nil || o.DeviceName == nil {
+		return ""
+	}
+	return *o.DeviceName
+}
+
+func (o *Device) GetVolumeID() string {
+	if o == nil || o.VolumeID == nil {
+		return ""
+	}
+	return *o.VolumeID
+}
+
+func (o *Device) GetSnapshotID() string {
+	if o == nil || o.SnapshotID == nil {
+		return ""
+	}
+	return *o.SnapshotID
+}
+
+func (o *Dimension) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Dimension) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *DockerSwarmIntegration) GetMasterHost() string {
+	if o == nil || o.MasterHost == nil {
+		return ""
+	}
+	return *o.MasterHost
+}
+
+func (o *DockerSwarmIntegration) GetMasterPort() int {
+	if o == nil || o.MasterPort == nil {
+		return 0
+	}
+	return *o.MasterPort
+}
+
+func (o *DockerSwarmIntegration) GetAutoScale() *AutoScaleDockerSwarm {
+	if o == nil {
+		return nil
+	}
+	return o.AutoScale
+}
+
+func (o *Domain) GetHostedZoneID() string {
+	if o == nil || o.HostedZoneID == nil {
+		return ""
+	}
+	return *o.HostedZoneID
+}
+
+func (o *Domain) GetSpotinstAccountID() string {
+	if o == nil || o.SpotinstAccountID == nil {
+		return ""
+	}
+	return *o.SpotinstAccountID
+}
+
+func (o *Domain) GetRecordSetType() string {
+	if o == nil || o.RecordSetType == nil {
+		return ""
+	}
+	return *o.RecordSetType
+}
+
+func (o *Domain) GetRecordSets() []*RecordSet {
+	if o == nil {
+		return nil
+	}
+	return o.RecordSets
+}
+
+func (o *DynamicIOPS) GetBaseSize() int {
+	if o == nil || o.BaseSize == nil {
+		return 0
+	}
+	return *o.BaseSize
+}
+
+func (o *DynamicIOPS) GetResource() string {
+	if o == nil || o.Resource == nil {
+		return ""
+	}
+	return *o.Resource
+}
+
+func (o *DynamicIOPS) GetSizePerResourceUnit() int {
+	if o == nil || o.SizePerResourceUnit == nil {
+		return 0
+	}
+	return *o.SizePerResourceUnit
+}
+
+func (o *DynamicVolumeSize) GetBaseSize() int {
+	if o == nil || o.BaseSize == nil {
+		return 0
+	}
+	return *o.BaseSize
+}
+
+func (o *DynamicVolumeSize) GetResource() string {
+	if o == nil || o.Resource == nil {
+		return ""
+	}
+	return *o.Resource
+}
+
+func (o *DynamicVolumeSize) GetSizePerResourceUnit() int {
+	if o == nil || o.SizePerResourceUnit == nil {
+		return 0
+	}
+	return *o.SizePerResourceUnit
+}
+
+func (o *EBS) GetDeleteOnTermination() bool {
+	if o == nil || o.DeleteOnTermination == nil {
+		return false
+	}
+	return *o.DeleteOnTermination
+}
+
+func (o *EBS) GetEncrypted() bool {
+	if o == nil || o.Encrypted == nil {
+		return false
+	}
+	return *o.Encrypted
+}
+
+func (o *EBS) GetKmsKeyId() string {
+	if o == nil || o.KmsKeyId == nil {
+		return ""
+	}
+	return *o.KmsKeyId
+}
+
+func (o *EBS) GetSnapshotId() string {
+	if o == nil || o.SnapshotID == nil {
+		return ""
+	}
+	return *o.SnapshotID
+}
+
+func (o *EBS) GetVolumeType() string {
+	if o == nil || o.VolumeType == nil {
+		return ""
+	}
+	return *o.VolumeType
+}
+
+func (o *EBS) GetVolumeSize() int {
+	if o == nil || o.VolumeSize == nil {
+		return 0
+	}
+	return *o.VolumeSize
+}
+
+func (o *EBS) GetIOPS() int {
+	if o == nil || o.IOPS == nil {
+		return 0
+	}
+	return *o.IOPS
+}
+
+func (o *EBS) GetThroughput() int {
+	if o == nil || o.Throughput == nil {
+		return 0
+	}
+	return *o.Throughput
+}
+
+func (o *EBS) GetDynamicIOPS() *DynamicIOPS {
+	if o == nil {
+		return nil
+	}
+	return o.DynamicIOPS
+}
+
+func (o *EBS) GetDynamicVolumeSize() *DynamicVolumeSize {
+	if o == nil {
+		return nil
+	}
+	return o.DynamicVolumeSize
+}
+
+func (o *EBSVolume) GetDeviceName() string {
+	if o == nil || o.DeviceName == nil {
+		return ""
+	}
+	return *o.DeviceName
+}
+
+func (o *EBSVolume) GetVolumeIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.VolumeIDs
+}
+
+func (o *EC2ContainerServiceIntegration) GetClusterName() string {
+	if o == nil || o.ClusterName == nil {
+		return ""
+	}
+	return *o.ClusterName
+}
+
+func (o *EC2ContainerServiceIntegration) GetAutoScale() *AutoScaleECS {
+	if o == nil {
+		return nil
+	}
+	return o.AutoScale
+}
+
+func (o *EC2ContainerServiceIntegration) GetBatch() *Batch {
+	if o == nil {
+		return nil
+	}
+	return o.Batch
+}
+
+func (o *ENIs) GetShouldTag() bool {
+	if o == nil || o.ShouldTag == nil {
+		return false
+	}
+	return *o.ShouldTag
+}
+
+func (o *ElasticBeanstalkIntegration) GetEnvironmentID() string {
+	if o == nil || o.EnvironmentID == nil {
+		return ""
+	}
+	return *o.EnvironmentID
+}
+
+func (o *ElasticBeanstalkIntegration) GetManagedActions() *BeanstalkManagedActions {
+	if o == nil {
+		return nil
+	}
+	return o.ManagedActions
+}
+
+func (o *ElasticBeanstalkIntegration) GetDeploymentPreferences() *BeanstalkDeploymentPreferences {
+	if o == nil {
+		return nil
+	}
+	return o.DeploymentPreferences
+}
+
+func (o *Export) GetS3() *S3 {
+	if o == nil {
+		return nil
+	}
+	return o.S3
+}
+
+func (o *Expressions) GetExpression() string {
+	if o == nil || o.Expression == nil {
+		return ""
+	}
+	return *o.Expression
+}
+
+func (o *Expressions) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *GetGroupEventsInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *GetGroupEventsInput) GetFromDate() string {
+	if o == nil || o.FromDate == nil {
+		return ""
+	}
+	return *o.FromDate
+}
+
+func (o *GetGroupEventsOutput) GetGroupEvents() []*GroupEvent {
+	if o == nil {
+		return nil
+	}
+	return o.GroupEvents
+}
+
+func (o *GetInstanceHealthinessInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *GetInstanceHealthinessOutput) GetInstances() []*InstanceHealth {
+	if o == nil {
+		return nil
+	}
+	return o.Instances
+}
+
+func (o *GitlabIntegration) GetRunner() *GitlabRunner {
+	if o == nil {
+		return nil
+	}
+	return o.Runner
+}
+
+func (o *GitlabRunner) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *Group) GetId() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *Group) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Group) GetDescription() string {
+	if o == nil || o.Description == nil {
+		return ""
+	}
+	return *o.Description
+}
+
+func (o *Group) GetRegion() string {
+	if o == nil || o.Region == nil {
+		return ""
+	}
+	return *o.Region
+}
+
+func (o *Group) GetCapacity() *Capacity {
+	if o == nil {
+		return nil
+	}
+	return o.Capacity
+}
+
+func (o *Group) GetCompute() *Compute {
+	if o == nil {
+		return nil
+	}
+	return o.Compute
+}
+
+func (o *Group) GetStrategy() *Strategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategy
+}
+
+func (o *Group) GetScaling() *Scaling {
+	if o == nil {
+		return nil
+	}
+	return o.Scaling
+}
+
+func (o *Group) GetScheduling() *Scheduling {
+	if o == nil {
+		return nil
+	}
+	return o.Scheduling
+}
+
+func (o *Group) GetIntegration() *Integration {
+	if o == nil {
+		return nil
+	}
+	return o.Integration
+}
+
+func (o *Group) GetLogging() *Logging {
+	if o == nil {
+		return nil
+	}
+	return o.Logging
+}
+
+func (o *Group) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *Group) GetUpdatedAt() time.Time {
+	if o == nil || o.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *o.UpdatedAt
+}
+
+func (o *GroupEvent) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *GroupEvent) GetEventType() string {
+	if o == nil || o.EventType == nil {
+		return ""
+	}
+	return *o.EventType
+}
+
+func (o *GroupEvent) GetCreatedAt() string {
+	if o == nil || o.CreatedAt == nil {
+		return ""
+	}
+	return *o.CreatedAt
+}
+
+func (o *GroupEvent) GetSubEvents() []*SubEvent {
+	if o == nil {
+		return nil
+	}
+	return o.SubEvents
+}
+
+func (o *IAMInstanceProfile) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *IAMInstanceProfile) GetArn() string {
+	if o == nil || o.Arn == nil {
+		return ""
+	}
+	return *o.Arn
+}
+
+func (o *ITF) GetLoadBalancers() []*ITFLoadBalancer {
+	if o == nil {
+		return nil
+	}
+	return o.LoadBalancers
+}
+
+func (o *ITF) GetMigrationHealthinessThreshold() int {
+	if o == nil || o.MigrationHealthinessThreshold == nil {
+		return 0
+	}
+	return *o.MigrationHealthinessThreshold
+}
+
+func (o *ITF) GetFixedTargetGroups() bool {
+	if o == nil || o.FixedTargetGroups == nil {
+		return false
+	}
+	return *o.FixedTargetGroups
+}
+
+func (o *ITF) GetWeightStrategy() string {
+	if o == nil || o.WeightStrategy == nil {
+		return ""
+	}
+	return *o.WeightStrategy
+}
+
+func (o *ITF) GetTargetGroupConfig() *TargetGroupConfig {
+	if o == nil {
+		return nil
+	}
+	return o.TargetGroupConfig
+}
+
+func (o *ITF) GetDefaultStaticTargetGroups() []*StaticTargetGroup {
+	if o == nil {
+		return nil
+	}
+	return o.DefaultStaticTargetGroups
+}
+
+func (o *ITFLoadBalancer) GetListenerRules() []*ListenerRule {
+	if o == nil {
+		return nil
+	}
+	return o.ListenerRules
+}
+
+func (o *ITFLoadBalancer) GetLoadBalancerARN() string {
+	if o == nil || o.LoadBalancerARN == nil {
+		return ""
+	}
+	return *o.LoadBalancerARN
+}
+
+func (o *Image) GetId() string {
+	if o == nil || o.Id == nil {
+		return ""
+	}
+	return *o.Id
+}
+
+func (o *ImportBeanstalkInput) GetEnvironmentId() string {
+	if o == nil || o.EnvironmentId == nil {
+		return ""
+	}
+	return *o.EnvironmentId
+}
+
+func (o *ImportBeanstalkInput) GetEnvironmentName() string {
+	if o == nil || o.EnvironmentName == nil {
+		return ""
+	}
+	return *o.EnvironmentName
+}
+
+func (o *ImportBeanstalkInput) GetRegion() string {
+	if o == nil || o.Region == nil {
+		return ""
+	}
+	return *o.Region
+}
+
+func (o *ImportBeanstalkOutput) GetGroup() *Group {
+	if o == nil {
+		return nil
+	}
+	return o.Group
+}
+
+func (o *Instance) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *Instance) GetSpotRequestID() string {
+	if o == nil || o.SpotRequestID == nil {
+		return ""
+	}
+	return *o.SpotRequestID
+}
+
+func (o *Instance) GetInstanceType() string {
+	if o == nil || o.InstanceType == nil {
+		return ""
+	}
+	return *o.InstanceType
+}
+
+func (o *Instance) GetStatus() string {
+	if o == nil || o.Status == nil {
+		return ""
+	}
+	return *o.Status
+}
+
+func (o *Instance) GetProduct() string {
+	if o == nil || o.Product == nil {
+		return ""
+	}
+	return *o.Product
+}
+
+func (o *Instance) GetAvailabilityZone() string {
+	if o == nil || o.AvailabilityZone == nil {
+		return ""
+	}
+	return *o.AvailabilityZone
+}
+
+func (o *Instance) GetPrivateIP() string {
+	if o == nil || o.PrivateIP == nil {
+		return ""
+	}
+	return *o.PrivateIP
+}
+
+func (o *Instance) GetPublicIP() string {
+	if o == nil || o.PublicIP == nil {
+		return ""
+	}
+	return *o.PublicIP
+}
+
+func (o *Instance) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *Instance) GetIPv6Address() string {
+	if o == nil || o.IPv6Address == nil {
+		return ""
+	}
+	return *o.IPv6Address
+}
+
+func (o *InstanceHealth) GetInstanceID() string {
+	if o == nil || o.InstanceID == nil {
+		return ""
+	}
+	return *o.InstanceID
+}
+
+func (o *InstanceHealth) GetSpotRequestID() string {
+	if o == nil || o.SpotRequestID == nil {
+		return ""
+	}
+	return *o.SpotRequestID
+}
+
+func (o *InstanceHealth) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *InstanceHealth) GetAvailabilityZone() string {
+	if o == nil || o.AvailabilityZone == nil {
+		return ""
+	}
+	return *o.AvailabilityZone
+}
+
+func (o *InstanceHealth) GetLifeCycle() string {
+	if o == nil || o.LifeCycle == nil {
+		return ""
+	}
+	return *o.LifeCycle
+}
+
+func (o *InstanceHealth) GetHealthStatus() string {
+	if o == nil || o.HealthStatus == nil {
+		return ""
+	}
+	return *o.HealthStatus
+}
+
+func (o *InstanceTypeWeight) GetInstanceType() string {
+	if o == nil || o.InstanceType == nil {
+		return ""
+	}
+	return *o.InstanceType
+}
+
+func (o *InstanceTypeWeight) GetWeight() int {
+	if o == nil || o.Weight == nil {
+		return 0
+	}
+	return *o.Weight
+}
+
+func (o *InstanceTypes) GetOnDemand() string {
+	if o == nil || o.OnDemand == nil {
+		return ""
+	}
+	return *o.OnDemand
+}
+
+func (o *InstanceTypes) GetSpot() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Spot
+}
+
+func (o *InstanceTypes) GetPreferredSpot() []string {
+	if o == nil {
+		return nil
+	}
+	return o.PreferredSpot
+}
+
+func (o *InstanceTypes) GetWeights() []*InstanceTypeWeight {
+	if o == nil {
+		return nil
+	}
+	return o.Weights
+}
+
+func (o *InstanceTypes) GetOnDemandTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.OnDemandTypes
+}
+
+func (o *InstanceTypes) GetResourceRequirements() *ResourceRequirements {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceRequirements
+}
+
+func (o *Integration) GetEC2ContainerService() *EC2ContainerServiceIntegration {
+	if o == nil {
+		return nil
+	}
+	return o.EC2ContainerService
+}
+
+func (o *Integration) GetElasticBeanstalk() *ElasticBeanstalkIntegration {
+	if o == nil {
+		return nil
+	}
+	return o.ElasticBeanstalk
+}
+
+func (o *Integration) GetCodeDeploy() *CodeDeployIntegration {
+	if o == nil {
+		return nil
+	}
+	return o.CodeDeploy
+}
+
+func (o *Integration) GetOpsWorks() *OpsWorksIntegration {
+	if o == nil {
+		return nil
+	}
+	return o.OpsWorks
+}
+
+func (o *Integration) GetRancher() *RancherIntegration {
+	if o == nil {
+		return nil
+	}
+	return o.Rancher
+}
+
+func (o *Integration) GetKubernetes() *KubernetesIntegration {
+	if o == nil {
+		return nil
+	}
+	return o.Kubernetes
+}
+
+func (o *Integration) GetMesosphere() *MesosphereIntegration {
+	if o == nil {
+		return nil
+	}
+	return o.Mesosphere
+}
+
+func (o *Integration) GetNomad() *NomadIntegration {
+	if o == nil {
+		return nil
+	}
+	return o.Nomad
+}
+
+func (o *Integration) GetChef() *ChefIntegration {
+	if o == nil {
+		return nil
+	}
+	return o.Chef
+}
+
+func (o *Integration) GetGitlab() *GitlabIntegration {
+	if o == nil {
+		return nil
+	}
+	return o.Gitlab
+}
+
+func (o *Integration) GetRoute53() *Route53Integration {
+	if o == nil {
+		return nil
+	}
+	return o.Route53
+}
+
+func (o *Integration) GetDockerSwarm() *DockerSwarmIntegration {
+	if o == nil {
+		return nil
+	}
+	return o.DockerSwarm
+}
+
+func (o *KubernetesIntegration) GetIntegrationMode() string {
+	if o == nil || o.IntegrationMode == nil {
+		return ""
+	}
+	return *o.IntegrationMode
+}
+
+func (o *KubernetesIntegration) GetClusterIdentifier() string {
+	if o == nil || o.ClusterIdentifier == nil {
+		return ""
+	}
+	return *o.ClusterIdentifier
+}
+
+func (o *KubernetesIntegration) GetServer() string {
+	if o == nil || o.Server == nil {
+		return ""
+	}
+	return *o.Server
+}
+
+func (o *KubernetesIntegration) GetToken() string {
+	if o == nil || o.Token == nil {
+		return ""
+	}
+	return *o.Token
+}
+
+func (o *KubernetesIntegration) GetAutoScale() *AutoScaleKubernetes {
+	if o == nil {
+		return nil
+	}
+	return o.AutoScale
+}
+
+func (o *LaunchSpecification) GetLoadBalancerNames() []string {
+	if o == nil {
+		return nil
+	}
+	return o.LoadBalancerNames
+}
+
+func (o *LaunchSpecification) GetLoadBalancersConfig() *LoadBalancersConfig {
+	if o == nil {
+		return nil
+	}
+	return o.LoadBalancersConfig
+}
+
+func (o *LaunchSpecification) GetSecurityGroupIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.SecurityGroupIDs
+}
+
+func (o *LaunchSpecification) GetHealthCheckType() string {
+	if o == nil || o.HealthCheckType == nil {
+		return ""
+	}
+	return *o.HealthCheckType
+}
+
+func (o *LaunchSpecification) GetHealthCheckGracePeriod() int {
+	if o == nil || o.HealthCheckGracePeriod == nil {
+		return 0
+	}
+	return *o.HealthCheckGracePeriod
+}
+
+func (o *LaunchSpecification) GetHealthCheckUnhealthyDurationBeforeReplacement() int {
+	if o == nil || o.HealthCheckUnhealthyDurationBeforeReplacement == nil {
+		return 0
+	}
+	return *o.HealthCheckUnhealthyDurationBeforeReplacement
+}
+
+func (o *LaunchSpecification) GetImages() []*Image {
+	if o == nil {
+		return nil
+	}
+	return o.Images
+}
+
+func (o *LaunchSpecification) GetImageId() string {
+	if o == nil || o.ImageID == nil {
+		return ""
+	}
+	return *o.ImageID
+}
+
+func (o *LaunchSpecification) GetKeyPair() string {
+	if o == nil || o.KeyPair == nil {
+		return ""
+	}
+	return *o.KeyPair
+}
+
+func (o *LaunchSpecification) GetUserData() string {
+	if o == nil || o.UserData == nil {
+		return ""
+	}
+	return *o.UserData
+}
+
+func (o *LaunchSpecification) GetShutdownScript() string {
+	if o == nil || o.ShutdownScript == nil {
+		return ""
+	}
+	return *o.ShutdownScript
+}
+
+func (o *LaunchSpecification) GetTenancy() string {
+	if o == nil || o.Tenancy == nil {
+		return ""
+	}
+	return *o.Tenancy
+}
+
+func (o *LaunchSpecification) GetMonitoring() bool {
+	if o == nil || o.Monitoring == nil {
+		return false
+	}
+	return *o.Monitoring
+}
+
+func (o *LaunchSpecification) GetEBSOptimized() bool {
+	if o == nil || o.EBSOptimized == nil {
+		return false
+	}
+	return *o.EBSOptimized
+}
+
+func (o *LaunchSpecification) GetIAMInstanceProfile() *IAMInstanceProfile {
+	if o == nil {
+		return nil
+	}
+	return o.IAMInstanceProfile
+}
+
+func (o *LaunchSpecification) GetCreditSpecification() *CreditSpecification {
+	if o == nil {
+		return nil
+	}
+	return o.CreditSpecification
+}
+
+func (o *LaunchSpecification) GetBlockDeviceMappings() []*BlockDeviceMapping {
+	if o == nil {
+		return nil
+	}
+	return o.BlockDeviceMappings
+}
+
+func (o *LaunchSpecification) GetNetworkInterfaces() []*NetworkInterface {
+	if o == nil {
+		return nil
+	}
+	return o.NetworkInterfaces
+}
+
+func (o *LaunchSpecification) GetTags() []*Tag {
+	if o == nil {
+		return nil
+	}
+	return o.Tags
+}
+
+func (o *LaunchSpecification) GetMetadataOptions() *MetadataOptions {
+	if o == nil {
+		return nil
+	}
+	return o.MetadataOptions
+}
+
+func (o *LaunchSpecification) GetCPUOptions() *CPUOptions {
+	if o == nil {
+		return nil
+	}
+	return o.CPUOptions
+}
+
+func (o *LaunchSpecification) GetResourceTagSpecification() *ResourceTagSpecification {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceTagSpecification
+}
+
+func (o *LaunchSpecification) GetITF() *ITF {
+	if o == nil {
+		return nil
+	}
+	return o.ITF
+}
+
+func (o *LaunchSpecification) GetAutoHealing() bool {
+	if o == nil || o.AutoHealing == nil {
+		return false
+	}
+	return *o.AutoHealing
+}
+
+func (o *ListGroupsOutput) GetGroups() []*Group {
+	if o == nil {
+		return nil
+	}
+	return o.Groups
+}
+
+func (o *ListStatefulInstancesInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *ListStatefulInstancesOutput) GetStatefulInstances() []*StatefulInstance {
+	if o == nil {
+		return nil
+	}
+	return o.StatefulInstances
+}
+
+func (o *ListSuspensionsInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *ListSuspensionsOutput) GetSuspendProcesses() *SuspendProcesses {
+	if o == nil {
+		return nil
+	}
+	return o.SuspendProcesses
+}
+
+func (o *ListenerRule) GetRuleARN() string {
+	if o == nil || o.RuleARN == nil {
+		return ""
+	}
+	return *o.RuleARN
+}
+
+func (o *ListenerRule) GetStaticTargetGroups() []*StaticTargetGroup {
+	if o == nil {
+		return nil
+	}
+	return o.StaticTargetGroups
+}
+
+func (o *LoadBalancer) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *LoadBalancer) GetArn() string {
+	if o == nil || o.Arn == nil {
+		return ""
+	}
+	return *o.Arn
+}
+
+func (o *LoadBalancer) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *LoadBalancersConfig) GetLoadBalancers() []*LoadBalancer {
+	if o == nil {
+		return nil
+	}
+	return o.LoadBalancers
+}
+
+func (o *Logging) GetExport() *Export {
+	if o == nil {
+		return nil
+	}
+	return o.Export
+}
+
+func (o *Matcher) GetHTTPCode() string {
+	if o == nil || o.HTTPCode == nil {
+		return ""
+	}
+	return *o.HTTPCode
+}
+
+func (o *Matcher) GetGRPCCode() string {
+	if o == nil || o.GRPCCode == nil {
+		return ""
+	}
+	return *o.GRPCCode
+}
+
+func (o *MesosphereIntegration) GetServer() string {
+	if o == nil || o.Server == nil {
+		return ""
+	}
+	return *o.Server
+}
+
+func (o *MetadataOptions) GetHTTPTokens() string {
+	if o == nil || o.HTTPTokens == nil {
+		return ""
+	}
+	return *o.HTTPTokens
+}
+
+func (o *MetadataOptions) GetHTTPPutResponseHopLimit() int {
+	if o == nil || o.HTTPPutResponseHopLimit == nil {
+		return 0
+	}
+	return *o.HTTPPutResponseHopLimit
+}
+
+func (o *MetadataOptions) GetInstanceMetadataTags() string {
+	if o == nil || o.InstanceMetadataTags == nil {
+		return ""
+	}
+	return *o.InstanceMetadataTags
+}
+
+func (o *Metrics) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Metrics) GetMetricName() string {
+	if o == nil || o.MetricName == nil {
+		return ""
+	}
+	return *o.MetricName
+}
+
+func (o *Metrics) GetNamespace() string {
+	if o == nil || o.Namespace == nil {
+		return ""
+	}
+	return *o.Namespace
+}
+
+func (o *Metrics) GetDimensions() []*Dimension {
+	if o == nil {
+		return nil
+	}
+	return o.Dimensions
+}
+
+func (o *Metrics) GetExtendedStatistic() string {
+	if o == nil || o.ExtendedStatistic == nil {
+		return ""
+	}
+	return *o.ExtendedStatistic
+}
+
+func (o *Metrics) GetStatistic() string {
+	if o == nil || o.Statistic == nil {
+		return ""
+	}
+	return *o.Statistic
+}
+
+func (o *Metrics) GetUnit() string {
+	if o == nil || o.Unit == nil {
+		return ""
+	}
+	return *o.Unit
+}
+
+func (o *MultipleMetrics) GetMetrics() []*Metrics {
+	if o == nil {
+		return nil
+	}
+	return o.Metrics
+}
+
+func (o *MultipleMetrics) GetExpressions() []*Expressions {
+	if o == nil {
+		return nil
+	}
+	return o.Expressions
+}
+
+func (o *NetworkInterface) GetId() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *NetworkInterface) GetDescription() string {
+	if o == nil || o.Description == nil {
+		return ""
+	}
+	return *o.Description
+}
+
+func (o *NetworkInterface) GetDeviceIndex() int {
+	if o == nil || o.DeviceIndex == nil {
+		return 0
+	}
+	return *o.DeviceIndex
+}
+
+func (o *NetworkInterface) GetSecondaryPrivateIPAddressCount() int {
+	if o == nil || o.SecondaryPrivateIPAddressCount == nil {
+		return 0
+	}
+	return *o.SecondaryPrivateIPAddressCount
+}
+
+func (o *NetworkInterface) GetAssociatePublicIPAddress() bool {
+	if o == nil || o.AssociatePublicIPAddress == nil {
+		return false
+	}
+	return *o.AssociatePublicIPAddress
+}
+
+func (o *NetworkInterface) GetAssociateIPV6Address() bool {
+	if o == nil || o.AssociateIPV6Address == nil {
+		return false
+	}
+	return *o.AssociateIPV6Address
+}
+
+func (o *NetworkInterface) GetDeleteOnTermination() bool {
+	if o == nil || o.DeleteOnTermination == nil {
+		return false
+	}
+	return *o.DeleteOnTermination
+}
+
+func (o *NetworkInterface) GetSecurityGroupsIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.SecurityGroupsIDs
+}
+
+func (o *NetworkInterface) GetPrivateIPAddress() string {
+	if o == nil || o.PrivateIPAddress == nil {
+		return ""
+	}
+	return *o.PrivateIPAddress
+}
+
+func (o *NetworkInterface) GetSubnetId() string {
+	if o == nil || o.SubnetID == nil {
+		return ""
+	}
+	return *o.SubnetID
+}
+
+func (o *NomadIntegration) GetMasterHost() string {
+	if o == nil || o.MasterHost == nil {
+		return ""
+	}
+	return *o.MasterHost
+}
+
+func (o *NomadIntegration) GetMasterPort() int {
+	if o == nil || o.MasterPort == nil {
+		return 0
+	}
+	return *o.MasterPort
+}
+
+func (o *NomadIntegration) GetAclToken() string {
+	if o == nil || o.ACLToken == nil {
+		return ""
+	}
+	return *o.ACLToken
+}
+
+func (o *NomadIntegration) GetAutoScale() *AutoScaleNomad {
+	if o == nil {
+		return nil
+	}
+	return o.AutoScale
+}
+
+func (o *OnFailure) GetActionType() string {
+	if o == nil || o.ActionType == nil {
+		return ""
+	}
+	return *o.ActionType
+}
+
+func (o *OnFailure) GetShouldHandleAllBatches() bool {
+	if o == nil || o.ShouldHandleAllBatches == nil {
+		return false
+	}
+	return *o.ShouldHandleAllBatches
+}
+
+func (o *OnFailure) GetBatchNum() int {
+	if o == nil || o.BatchNum == nil {
+		return 0
+	}
+	return *o.BatchNum
+}
+
+func (o *OnFailure) GetDrainingTimeout() int {
+	if o == nil || o.DrainingTimeout == nil {
+		return 0
+	}
+	return *o.DrainingTimeout
+}
+
+func (o *OnFailure) GetShouldDecrementTargetCapacity() bool {
+	if o == nil || o.ShouldDecrementTargetCapacity == nil {
+		return false
+	}
+	return *o.ShouldDecrementTargetCapacity
+}
+
+func (o *OpsWorksIntegration) GetLayerId() string {
+	if o == nil || o.LayerID == nil {
+		return ""
+	}
+	return *o.LayerID
+}
+
+func (o *OpsWorksIntegration) GetStackType() string {
+	if o == nil || o.StackType == nil {
+		return ""
+	}
+	return *o.StackType
+}
+
+func (o *PauseStatefulInstanceInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *PauseStatefulInstanceInput) GetStatefulInstanceID() string {
+	if o == nil || o.StatefulInstanceID == nil {
+		return ""
+	}
+	return *o.StatefulInstanceID
+}
+
+func (o *Persistence) GetShouldPersistPrivateIP() bool {
+	if o == nil || o.ShouldPersistPrivateIP == nil {
+		return false
+	}
+	return *o.ShouldPersistPrivateIP
+}
+
+func (o *Persistence) GetShouldPersistBlockDevices() bool {
+	if o == nil || o.ShouldPersistBlockDevices == nil {
+		return false
+	}
+	return *o.ShouldPersistBlockDevices
+}
+
+func (o *Persistence) GetShouldPersistRootDevice() bool {
+	if o == nil || o.ShouldPersistRootDevice == nil {
+		return false
+	}
+	return *o.ShouldPersistRootDevice
+}
+
+func (o *Persistence) GetBlockDevicesMode() string {
+	if o == nil || o.BlockDevicesMode == nil {
+		return ""
+	}
+	return *o.BlockDevicesMode
+}
+
+func (o *Predictive) GetMode() string {
+	if o == nil || o.Mode == nil {
+		return ""
+	}
+	return *o.Mode
+}
+
+func (o *Progress) GetUnit() string {
+	if o == nil || o.Unit == nil {
+		return ""
+	}
+	return *o.Unit
+}
+
+func (o *Progress) GetValue() float64 {
+	if o == nil || o.Value == nil {
+		return 0
+	}
+	return *o.Value
+}
+
+func (o *RancherIntegration) GetMasterHost() string {
+	if o == nil || o.MasterHost == nil {
+		return ""
+	}
+	return *o.MasterHost
+}
+
+func (o *RancherIntegration) GetAccessKey() string {
+	if o == nil || o.AccessKey == nil {
+		return ""
+	}
+	return *o.AccessKey
+}
+
+func (o *RancherIntegration) GetSecretKey() string {
+	if o == nil || o.SecretKey == nil {
+		return ""
+	}
+	return *o.SecretKey
+}
+
+func (o *RancherIntegration) GetVersion() string {
+	if o == nil || o.Version == nil {
+		return ""
+	}
+	return *o.Version
+}
+
+func (o *ReadGroupInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *ReadGroupOutput) GetGroup() *Group {
+	if o == nil {
+		return nil
+	}
+	return o.Group
+}
+
+func (o *RecordSet) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *RecordSet) GetUsePublicIP() bool {
+	if o == nil || o.UsePublicIP == nil {
+		return false
+	}
+	return *o.UsePublicIP
+}
+
+func (o *RecordSet) GetUsePublicDNS() bool {
+	if o == nil || o.UsePublicDNS == nil {
+		return false
+	}
+	return *o.UsePublicDNS
+}
+
+func (o *RecycleStatefulInstanceInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *RecycleStatefulInstanceInput) GetStatefulInstanceID() string {
+	if o == nil || o.StatefulInstanceID == nil {
+		return ""
+	}
+	return *o.StatefulInstanceID
+}
+
+func (o *RequiredGpu) GetMaximum() int {
+	if o == nil || o.Maximum == nil {
+		return 0
+	}
+	return *o.Maximum
+}
+
+func (o *RequiredGpu) GetMinimum() int {
+	if o == nil || o.Minimum == nil {
+		return 0
+	}
+	return *o.Minimum
+}
+
+func (o *RequiredMemory) GetMaximum() int {
+	if o == nil || o.Maximum == nil {
+		return 0
+	}
+	return *o.Maximum
+}
+
+func (o *RequiredMemory) GetMinimum() int {
+	if o == nil || o.Minimum == nil {
+		return 0
+	}
+	return *o.Minimum
+}
+
+func (o *RequiredVCpu) GetMaximum() int {
+	if o == nil || o.Maximum == nil {
+		return 0
+	}
+	return *o.Maximum
+}
+
+func (o *RequiredVCpu) GetMinimum() int {
+	if o == nil || o.Minimum == nil {
+		return 0
+	}
+	return *o.Minimum
+}
+
+func (o *ResourceRequirements) GetExcludedInstanceFamilies() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ExcludedInstanceFamilies
+}
+
+func (o *ResourceRequirements) GetExcludedInstanceGenerations() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ExcludedInstanceGenerations
+}
+
+func (o *ResourceRequirements) GetExcludedInstanceTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ExcludedInstanceTypes
+}
+
+func (o *ResourceRequirements) GetRequiredGpu() *RequiredGpu {
+	if o == nil {
+		return nil
+	}
+	return o.RequiredGpu
+}
+
+func (o *ResourceRequirements) GetRequiredMemory() *RequiredMemory {
+	if o == nil {
+		return nil
+	}
+	return o.RequiredMemory
+}
+
+func (o *ResourceRequirements) GetRequiredVCpu() *RequiredVCpu {
+	if o == nil {
+		return nil
+	}
+	return o.RequiredVCpu
+}
+
+func (o *ResourceTagSpecification) GetVolumes() *Volumes {
+	if o == nil {
+		return nil
+	}
+	return o.Volumes
+}
+
+func (o *ResourceTagSpecification) GetSnapshots() *Snapshots {
+	if o == nil {
+		return nil
+	}
+	return o.Snapshots
+}
+
+func (o *ResourceTagSpecification) GetENIs() *ENIs {
+	if o == nil {
+		return nil
+	}
+	return o.ENIs
+}
+
+func (o *ResourceTagSpecification) GetAMIs() *AMIs {
+	if o == nil {
+		return nil
+	}
+	return o.AMIs
+}
+
+func (o *ResumeStatefulInstanceInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *ResumeStatefulInstanceInput) GetStatefulInstanceID() string {
+	if o == nil || o.StatefulInstanceID == nil {
+		return ""
+	}
+	return *o.StatefulInstanceID
+}
+
+func (o *ResumeToken) GetGroupID() string {
+	if o == nil {
+		return ""
+	}
+	return o.GroupID
+}
+
+func (o *ResumeToken) GetSince() time.Time {
+	if o == nil {
+		return time.Time{}
+	}
+	return o.Since
+}
+
+func (o *ResumeToken) GetSeen() map[string]time.Time {
+	if o == nil {
+		return nil
+	}
+	return o.Seen
+}
+
+func (o *RevertToSpot) GetPerformAt() string {
+	if o == nil || o.PerformAt == nil {
+		return ""
+	}
+	return *o.PerformAt
+}
+
+func (o *RevertToSpot) GetTimeWindows() []string {
+	if o == nil {
+		return nil
+	}
+	return o.TimeWindows
+}
+
+func (o *Roll) GetStatus() string {
+	if o == nil || o.Status == nil {
+		return ""
+	}
+	return *o.Status
+}
+
+func (o *RollECSGroupInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *RollECSGroupInput) GetRoll() *RollECSWrapper {
+	if o == nil {
+		return nil
+	}
+	return o.Roll
+}
+
+func (o *RollECSWrapper) GetBatchSizePercentage() int {
+	if o == nil || o.BatchSizePercentage == nil {
+		return 0
+	}
+	return *o.BatchSizePercentage
+}
+
+func (o *RollECSWrapper) GetComment() string {
+	if o == nil || o.Comment == nil {
+		return ""
+	}
+	return *o.Comment
+}
+
+func (o *RollGroupInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *RollGroupInput) GetBatchSizePercentage() int {
+	if o == nil || o.BatchSizePercentage == nil {
+		return 0
+	}
+	return *o.BatchSizePercentage
+}
+
+func (o *RollGroupInput) GetGracePeriod() int {
+	if o == nil || o.GracePeriod == nil {
+		return 0
+	}
+	return *o.GracePeriod
+}
+
+func (o *RollGroupInput) GetHealthCheckType() string {
+	if o == nil || o.HealthCheckType == nil {
+		return ""
+	}
+	return *o.HealthCheckType
+}
+
+func (o *RollGroupInput) GetStrategy() *RollStrategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategy
+}
+
+func (o *RollGroupOutput) GetRollGroupStatus() []*RollGroupStatus {
+	if o == nil {
+		return nil
+	}
+	return o.RollGroupStatus
+}
+
+func (o *RollGroupStatus) GetRollID() string {
+	if o == nil || o.RollID == nil {
+		return ""
+	}
+	return *o.RollID
+}
+
+func (o *RollGroupStatus) GetRollStatus() string {
+	if o == nil || o.RollStatus == nil {
+		return ""
+	}
+	return *o.RollStatus
+}
+
+func (o *RollGroupStatus) GetProgress() *Progress {
+	if o == nil {
+		return nil
+	}
+	return o.Progress
+}
+
+func (o *RollGroupStatus) GetCreatedAt() string {
+	if o == nil || o.CreatedAt == nil {
+		return ""
+	}
+	return *o.CreatedAt
+}
+
+func (o *RollGroupStatus) GetUpdatedAt() string {
+	if o == nil || o.UpdatedAt == nil {
+		return ""
+	}
+	return *o.UpdatedAt
+}
+
+func (o *RollStatusInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *RollStatusInput) GetRollID() string {
+	if o == nil || o.RollID == nil {
+		return ""
+	}
+	return *o.RollID
+}
+
+func (o *RollStatusOutput) GetProgress() *Progress {
+	if o == nil {
+		return nil
+	}
+	return o.Progress
+}
+
+func (o *RollStatusOutput) GetNumberOfBatches() int {
+	if o == nil || o.NumberOfBatches == nil {
+		return 0
+	}
+	return *o.NumberOfBatches
+}
+
+func (o *RollStatusOutput) GetCurrentBatch() int {
+	if o == nil || o.CurrentBatch == nil {
+		return 0
+	}
+	return *o.CurrentBatch
+}
+
+func (o *RollStatusOutput) GetGracePeriod() int {
+	if o == nil || o.GracePeriod == nil {
+		return 0
+	}
+	return *o.GracePeriod
+}
+
+func (o *RollStatusOutput) GetStrategyAction() string {
+	if o == nil || o.StrategyAction == nil {
+		return ""
+	}
+	return *o.StrategyAction
+}
+
+func (o *RollStatusOutput) GetHealthCheck() string {
+	if o == nil || o.HealthCheck == nil {
+		return ""
+	}
+	return *o.HealthCheck
+}
+
+func (o *RollStatusOutput) GetInstances() []*BGInstances {
+	if o == nil {
+		return nil
+	}
+	return o.Instances
+}
+
+func (o *RollStrategy) GetAction() string {
+	if o == nil || o.Action == nil {
+		return ""
+	}
+	return *o.Action
+}
+
+func (o *RollStrategy) GetShouldDrainInstances() bool {
+	if o == nil || o.ShouldDrainInstances == nil {
+		return false
+	}
+	return *o.ShouldDrainInstances
+}
+
+func (o *RollStrategy) GetBatchMinHealthyPercentage() int {
+	if o == nil || o.BatchMinHealthyPercentage == nil {
+		return 0
+	}
+	return *o.BatchMinHealthyPercentage
+}
+
+func (o *RollStrategy) GetOnFailure() *OnFailure {
+	if o == nil {
+		return nil
+	}
+	return o.OnFailure
+}
+
+func (o *Route53Integration) GetDomains() []*Domain {
+	if o == nil {
+		return nil
+	}
+	return o.Domains
+}
+
+func (o *S3) GetId() string {
+	if o == nil || o.Id == nil {
+		return ""
+	}
+	return *o.Id
+}
+
+func (o *ScaleDownOnDemandItem) GetInstanceID() string {
+	if o == nil || o.InstanceID == nil {
+		return ""
+	}
+	return *o.InstanceID
+}
+
+func (o *ScaleDownSpotItem) GetSpotInstanceRequestID() string {
+	if o == nil || o.SpotInstanceRequestID == nil {
+		return ""
+	}
+	return *o.SpotInstanceRequestID
+}
+
+func (o *ScaleGroupInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *ScaleGroupInput) GetScaleType() string {
+	if o == nil || o.ScaleType == nil {
+		return ""
+	}
+	return *o.ScaleType
+}
+
+func (o *ScaleGroupInput) GetAdjustment() int {
+	if o == nil || o.Adjustment == nil {
+		return 0
+	}
+	return *o.Adjustment
+}
+
+func (o *ScaleGroupOutput) GetItems() []*ScaleItem {
+	if o == nil {
+		return nil
+	}
+	return o.Items
+}
+
+func (o *ScaleItem) GetNewSpotRequests() []*ScaleUpSpotItem {
+	if o == nil {
+		return nil
+	}
+	return o.NewSpotRequests
+}
+
+func (o *ScaleItem) GetNewInstances() []*ScaleUpOnDemandItem {
+	if o == nil {
+		return nil
+	}
+	return o.NewInstances
+}
+
+func (o *ScaleItem) GetVictimSpotRequests() []*ScaleDownSpotItem {
+	if o == nil {
+		return nil
+	}
+	return o.VictimSpotRequests
+}
+
+func (o *ScaleItem) GetVictimInstances() []*ScaleDownOnDemandItem {
+	if o == nil {
+		return nil
+	}
+	return o.VictimInstances
+}
+
+func (o *ScaleUpOnDemandItem) GetInstanceID() string {
+	if o == nil || o.InstanceID == nil {
+		return ""
+	}
+	return *o.InstanceID
+}
+
+func (o *ScaleUpOnDemandItem) GetAvailabilityZone() string {
+	if o == nil || o.AvailabilityZone == nil {
+		return ""
+	}
+	return *o.AvailabilityZone
+}
+
+func (o *ScaleUpOnDemandItem) GetInstanceType() string {
+	if o == nil || o.InstanceType == nil {
+		return ""
+	}
+	return *o.InstanceType
+}
+
+func (o *ScaleUpSpotItem) GetSpotInstanceRequestID() string {
+	if o == nil || o.SpotInstanceRequestID == nil {
+		return ""
+	}
+	return *o.SpotInstanceRequestID
+}
+
+func (o *ScaleUpSpotItem) GetAvailabilityZone() string {
+	if o == nil || o.AvailabilityZone == nil {
+		return ""
+	}
+	return *o.AvailabilityZone
+}
+
+func (o *ScaleUpSpotItem) GetInstanceType() string {
+	if o == nil || o.InstanceType == nil {
+		return ""
+	}
+	return *o.InstanceType
+}
+
+func (o *Scaling) GetUp() []*ScalingPolicy {
+	if o == nil {
+		return nil
+	}
+	return o.Up
+}
+
+func (o *Scaling) GetDown() []*ScalingPolicy {
+	if o == nil {
+		return nil
+	}
+	return o.Down
+}
+
+func (o *Scaling) GetTarget() []*ScalingPolicy {
+	if o == nil {
+		return nil
+	}
+	return o.Target
+}
+
+func (o *Scaling) GetMultipleMetrics() *MultipleMetrics {
+	if o == nil {
+		return nil
+	}
+	return o.MultipleMetrics
+}
+
+func (o *ScalingPolicy) GetPolicyName() string {
+	if o == nil || o.PolicyName == nil {
+		return ""
+	}
+	return *o.PolicyName
+}
+
+func (o *ScalingPolicy) GetMetricName() string {
+	if o == nil || o.MetricName == nil {
+		return ""
+	}
+	return *o.MetricName
+}
+
+func (o *ScalingPolicy) GetNamespace() string {
+	if o == nil || o.Namespace == nil {
+		return ""
+	}
+	return *o.Namespace
+}
+
+func (o *ScalingPolicy) GetSource() string {
+	if o == nil || o.Source == nil {
+		return ""
+	}
+	return *o.Source
+}
+
+func (o *ScalingPolicy) GetStatistic() string {
+	if o == nil || o.Statistic == nil {
+		return ""
+	}
+	return *o.Statistic
+}
+
+func (o *ScalingPolicy) GetUnit() string {
+	if o == nil || o.Unit == nil {
+		return ""
+	}
+	return *o.Unit
+}
+
+func (o *ScalingPolicy) GetThreshold() float64 {
+	if o == nil || o.Threshold == nil {
+		return 0
+	}
+	return *o.Threshold
+}
+
+func (o *ScalingPolicy) GetAdjustment() int {
+	if o == nil || o.Adjustment == nil {
+		return 0
+	}
+	return *o.Adjustment
+}
+
+func (o *ScalingPolicy) GetMinTargetCapacity() int {
+	if o == nil || o.MinTargetCapacity == nil {
+		return 0
+	}
+	return *o.MinTargetCapacity
+}
+
+func (o *ScalingPolicy) GetMaxTargetCapacity() int {
+	if o == nil || o.MaxTargetCapacity == nil {
+		return 0
+	}
+	return *o.MaxTargetCapacity
+}
+
+func (o *ScalingPolicy) GetEvaluationPeriods() int {
+	if o == nil || o.EvaluationPeriods == nil {
+		return 0
+	}
+	return *o.EvaluationPeriods
+}
+
+func (o *ScalingPolicy) GetPeriod() int {
+	if o == nil || o.Period == nil {
+		return 0
+	}
+	return *o.Period
+}
+
+func (o *ScalingPolicy) GetCooldown() int {
+	if o == nil || o.Cooldown == nil {
+		return 0
+	}
+	return *o.Cooldown
+}
+
+func (o *ScalingPolicy) GetOperator() string {
+	if o == nil || o.Operator == nil {
+		return ""
+	}
+	return *o.Operator
+}
+
+func (o *ScalingPolicy) GetDimensions() []*Dimension {
+	if o == nil {
+		return nil
+	}
+	return o.Dimensions
+}
+
+func (o *ScalingPolicy) GetAction() *Action {
+	if o == nil {
+		return nil
+	}
+	return o.Action
+}
+
+func (o *ScalingPolicy) GetTarget() float64 {
+	if o == nil || o.Target == nil {
+		return 0
+	}
+	return *o.Target
+}
+
+func (o *ScalingPolicy) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *ScalingPolicy) GetMaxCapacityPerScale() string {
+	if o == nil || o.MaxCapacityPerScale == nil {
+		return ""
+	}
+	return *o.MaxCapacityPerScale
+}
+
+func (o *ScalingPolicy) GetPredictive() *Predictive {
+	if o == nil {
+		return nil
+	}
+	return o.Predictive
+}
+
+func (o *ScalingPolicy) GetStepAdjustments() []*StepAdjustment {
+	if o == nil {
+		return nil
+	}
+	return o.StepAdjustments
+}
+
+func (o *ScalingStrategy) GetTerminateAtEndOfBillingHour() bool {
+	if o == nil || o.TerminateAtEndOfBillingHour == nil {
+		return false
+	}
+	return *o.TerminateAtEndOfBillingHour
+}
+
+func (o *ScalingStrategy) GetTerminationPolicy() string {
+	if o == nil || o.TerminationPolicy == nil {
+		return ""
+	}
+	return *o.TerminationPolicy
+}
+
+func (o *Scheduling) GetTasks() []*Task {
+	if o == nil {
+		return nil
+	}
+	return o.Tasks
+}
+
+func (o *Signal) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Signal) GetTimeout() int {
+	if o == nil || o.Timeout == nil {
+		return 0
+	}
+	return *o.Timeout
+}
+
+func (o *Snapshots) GetShouldTag() bool {
+	if o == nil || o.ShouldTag == nil {
+		return false
+	}
+	return *o.ShouldTag
+}
+
+func (o *Spot) GetSpotInstanceRequestID() string {
+	if o == nil || o.SpotInstanceRequestID == nil {
+		return ""
+	}
+	return *o.SpotInstanceRequestID
+}
+
+func (o *StatefulDeallocation) GetShouldDeleteImages() bool {
+	if o == nil || o.ShouldDeleteImages == nil {
+		return false
+	}
+	return *o.ShouldDeleteImages
+}
+
+func (o *StatefulDeallocation) GetShouldDeleteNetworkInterfaces() bool {
+	if o == nil || o.ShouldDeleteNetworkInterfaces == nil {
+		return false
+	}
+	return *o.ShouldDeleteNetworkInterfaces
+}
+
+func (o *StatefulDeallocation) GetShouldDeleteVolumes() bool {
+	if o == nil || o.ShouldDeleteVolumes == nil {
+		return false
+	}
+	return *o.ShouldDeleteVolumes
+}
+
+func (o *StatefulDeallocation) GetShouldDeleteSnapshots() bool {
+	if o == nil || o.ShouldDeleteSnapshots == nil {
+		return false
+	}
+	return *o.ShouldDeleteSnapshots
+}
+
+func (o *StatefulInstance) GetStatefulInstanceID() string {
+	if o == nil || o.StatefulInstanceID == nil {
+		return ""
+	}
+	return *o.StatefulInstanceID
+}
+
+func (o *StatefulInstance) GetInstanceID() string {
+	if o == nil || o.InstanceID == nil {
+		return ""
+	}
+	return *o.InstanceID
+}
+
+func (o *StatefulInstance) GetState() string {
+	if o == nil || o.State == nil {
+		return ""
+	}
+	return *o.State
+}
+
+func (o *StatefulInstance) GetPrivateIP() string {
+	if o == nil || o.PrivateIP == nil {
+		return ""
+	}
+	return *o.PrivateIP
+}
+
+func (o *StatefulInstance) GetImageID() string {
+	if o == nil || o.ImageID == nil {
+		return ""
+	}
+	return *o.ImageID
+}
+
+func (o *StatefulInstance) GetDevices() []*Device {
+	if o == nil {
+		return nil
+	}
+	return o.Devices
+}
+
+func (o *StatefulInstance) GetCreatedAt() string {
+	if o == nil || o.CreatedAt == nil {
+		return ""
+	}
+	return *o.CreatedAt
+}
+
+func (o *StatefulInstance) GetLaunchedAt() string {
+	if o == nil || o.LaunchedAt == nil {
+		return ""
+	}
+	return *o.LaunchedAt
+}
+
+func (o *StaticTargetGroup) GetStaticTargetGroupARN() string {
+	if o == nil || o.StaticTargetGroupARN == nil {
+		return ""
+	}
+	return *o.StaticTargetGroupARN
+}
+
+func (o *StaticTargetGroup) GetPercentage() float64 {
+	if o == nil || o.Percentage == nil {
+		return 0
+	}
+	return *o.Percentage
+}
+
+func (o *StatusGroupInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *StatusGroupOutput) GetInstances() []*Instance {
+	if o == nil {
+		return nil
+	}
+	return o.Instances
+}
+
+func (o *StepAdjustment) GetAction() *Action {
+	if o == nil {
+		return nil
+	}
+	return o.Action
+}
+
+func (o *StepAdjustment) GetThreshold() int {
+	if o == nil || o.Threshold == nil {
+		return 0
+	}
+	return *o.Threshold
+}
+
+func (o *StopDeploymentInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *StopDeploymentInput) GetRollID() string {
+	if o == nil || o.RollID == nil {
+		return ""
+	}
+	return *o.RollID
+}
+
+func (o *StopDeploymentInput) GetRoll() *Roll {
+	if o == nil {
+		return nil
+	}
+	return o.Roll
+}
+
+func (o *Strategy) GetRisk() float64 {
+	if o == nil || o.Risk == nil {
+		return 0
+	}
+	return *o.Risk
+}
+
+func (o *Strategy) GetOnDemandCount() int {
+	if o == nil || o.OnDemandCount == nil {
+		return 0
+	}
+	return *o.OnDemandCount
+}
+
+func (o *Strategy) GetDrainingTimeout() int {
+	if o == nil || o.DrainingTimeout == nil {
+		return 0
+	}
+	return *o.DrainingTimeout
+}
+
+func (o *Strategy) GetAvailabilityVsCost() string {
+	if o == nil || o.AvailabilityVsCost == nil {
+		return ""
+	}
+	return *o.AvailabilityVsCost
+}
+
+func (o *Strategy) GetLifetimePeriod() string {
+	if o == nil || o.LifetimePeriod == nil {
+		return ""
+	}
+	return *o.LifetimePeriod
+}
+
+func (o *Strategy) GetUtilizeReservedInstances() bool {
+	if o == nil || o.UtilizeReservedInstances == nil {
+		return false
+	}
+	return *o.UtilizeReservedInstances
+}
+
+func (o *Strategy) GetFallbackToOnDemand() bool {
+	if o == nil || o.FallbackToOnDemand == nil {
+		return false
+	}
+	return *o.FallbackToOnDemand
+}
+
+func (o *Strategy) GetSpinUpTime() int {
+	if o == nil || o.SpinUpTime == nil {
+		return 0
+	}
+	return *o.SpinUpTime
+}
+
+func (o *Strategy) GetSignals() []*Signal {
+	if o == nil {
+		return nil
+	}
+	return o.Signals
+}
+
+func (o *Strategy) GetPersistence() *Persistence {
+	if o == nil {
+		return nil
+	}
+	return o.Persistence
+}
+
+func (o *Strategy) GetRevertToSpot() *RevertToSpot {
+	if o == nil {
+		return nil
+	}
+	return o.RevertToSpot
+}
+
+func (o *Strategy) GetScalingStrategy() *ScalingStrategy {
+	if o == nil {
+		return nil
+	}
+	return o.ScalingStrategy
+}
+
+func (o *Strategy) GetUtilizeCommitments() bool {
+	if o == nil || o.UtilizeCommitments == nil {
+		return false
+	}
+	return *o.UtilizeCommitments
+}
+
+func (o *Strategy) GetMinimumInstanceLifetime() int {
+	if o == nil || o.MinimumInstanceLifetime == nil {
+		return 0
+	}
+	return *o.MinimumInstanceLifetime
+}
+
+func (o *Strategy) GetConsiderODPricing() bool {
+	if o == nil || o.ConsiderODPricing == nil {
+		return false
+	}
+	return *o.ConsiderODPricing
+}
+
+func (o *Strategy) GetImmediateODRecoverThreshold() int {
+	if o == nil || o.ImmediateODRecoverThreshold == nil {
+		return 0
+	}
+	return *o.ImmediateODRecoverThreshold
+}
+
+func (o *Strategy) GetRestrictSingleAz() bool {
+	if o == nil || o.RestrictSingleAz == nil {
+		return false
+	}
+	return *o.RestrictSingleAz
+}
+
+func (o *Strategy) GetMaxReplacementsPercentage() int {
+	if o == nil || o.MaxReplacementsPercentage == nil {
+		return 0
+	}
+	return *o.MaxReplacementsPercentage
+}
+
+func (o *SubEvent) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *SubEvent) GetNewSpots() []*Spot {
+	if o == nil {
+		return nil
+	}
+	return o.NewSpots
+}
+
+func (o *SubEvent) GetNewInstances() []*NewInstance {
+	if o == nil {
+		return nil
+	}
+	return o.NewInstances
+}
+
+func (o *SubEvent) GetTerminatedSpots() []*Spot {
+	if o == nil {
+		return nil
+	}
+	return o.TerminatedSpots
+}
+
+func (o *SubEvent) GetTerminatedInstances() []*TerminatedInstance {
+	if o == nil {
+		return nil
+	}
+	return o.TerminatedInstances
+}
+
+func (o *SubEvent) GetScalingPolicyName() string {
+	if o == nil || o.ScalingPolicyName == nil {
+		return ""
+	}
+	return *o.ScalingPolicyName
+}
+
+func (o *SubEvent) GetValue() int {
+	if o == nil || o.Value == nil {
+		return 0
+	}
+	return *o.Value
+}
+
+func (o *SubEvent) GetUnit() string {
+	if o == nil || o.Unit == nil {
+		return ""
+	}
+	return *o.Unit
+}
+
+func (o *SubEvent) GetThreshold() int {
+	if o == nil || o.Threshold == nil {
+		return 0
+	}
+	return *o.Threshold
+}
+
+func (o *SubEvent) GetInstanceID() string {
+	if o == nil || o.InstanceID == nil {
+		return ""
+	}
+	return *o.InstanceID
+}
+
+func (o *SubEvent) GetInstanceIDs() []*string {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceIDs
+}
+
+func (o *SubEvent) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *SubEvent) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *SubEvent) GetCurrentBatch() int {
+	if o == nil || o.CurrentBatch == nil {
+		return 0
+	}
+	return *o.CurrentBatch
+}
+
+func (o *SubEvent) GetStatus() string {
+	if o == nil || o.Status == nil {
+		return ""
+	}
+	return *o.Status
+}
+
+func (o *SubEvent) GetCreatedAt() string {
+	if o == nil || o.CreatedAt == nil {
+		return ""
+	}
+	return *o.CreatedAt
+}
+
+func (o *SubEvent) GetNumberOfBatches() int {
+	if o == nil || o.NumberOfBatches == nil {
+		return 0
+	}
+	return *o.NumberOfBatches
+}
+
+func (o *SubEvent) GetGracePeriod() int {
+	if o == nil || o.GracePeriod == nil {
+		return 0
+	}
+	return *o.GracePeriod
+}
+
+func (o *SubEvent) GetOldSpotRequestIDs() []*string {
+	if o == nil {
+		return nil
+	}
+	return o.OldSpotRequestIDs
+}
+
+func (o *SubEvent) GetNewSpotRequestIDs() []*string {
+	if o == nil {
+		return nil
+	}
+	return o.NewSpotRequestIDs
+}
+
+func (o *SubEvent) GetOldInstanceIDs() []*string {
+	if o == nil {
+		return nil
+	}
+	return o.OldInstanceIDs
+}
+
+func (o *SubEvent) GetNewInstanceIDs() []*string {
+	if o == nil {
+		return nil
+	}
+	return o.NewInstanceIDs
+}
+
+func (o *SuspendProcesses) GetSuspensions() []*Suspension {
+	if o == nil {
+		return nil
+	}
+	return o.Suspensions
+}
+
+func (o *SuspendProcesses) GetProcesses() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Processes
+}
+
+func (o *Suspension) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Suspension) GetTTLInMinutes() int {
+	if o == nil || o.TTLInMinutes == nil {
+		return 0
+	}
+	return *o.TTLInMinutes
+}
+
+func (o *Suspension) GetExpiresAt() time.Time {
+	if o == nil || o.ExpiresAt == nil {
+		return time.Time{}
+	}
+	return *o.ExpiresAt
+}
+
+func (o *Tag) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *Tag) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *TargetGroupConfig) GetVPCId() string {
+	if o == nil || o.VPCID == nil {
+		return ""
+	}
+	return *o.VPCID
+}
+
+func (o *TargetGroupConfig) GetHealthCheckIntervalSeconds() int {
+	if o == nil || o.HealthCheckIntervalSeconds == nil {
+		return 0
+	}
+	return *o.HealthCheckIntervalSeconds
+}
+
+func (o *TargetGroupConfig) GetHealthCheckPath() string {
+	if o == nil || o.HealthCheckPath == nil {
+		return ""
+	}
+	return *o.HealthCheckPath
+}
+
+func (o *TargetGroupConfig) GetHealthCheckPort() string {
+	if o == nil || o.HealthCheckPort == nil {
+		return ""
+	}
+	return *o.HealthCheckPort
+}
+
+func (o *TargetGroupConfig) GetHealthCheckProtocol() string {
+	if o == nil || o.HealthCheckProtocol == nil {
+		return ""
+	}
+	return *o.HealthCheckProtocol
+}
+
+func (o *TargetGroupConfig) GetHealthCheckTimeoutSeconds() int {
+	if o == nil || o.HealthCheckTimeoutSeconds == nil {
+		return 0
+	}
+	return *o.HealthCheckTimeoutSeconds
+}
+
+func (o *TargetGroupConfig) GetHealthyThresholdCount() int {
+	if o == nil || o.HealthyThresholdCount == nil {
+		return 0
+	}
+	return *o.HealthyThresholdCount
+}
+
+func (o *TargetGroupConfig) GetUnhealthyThresholdCount() int {
+	if o == nil || o.UnhealthyThresholdCount == nil {
+		return 0
+	}
+	return *o.UnhealthyThresholdCount
+}
+
+func (o *TargetGroupConfig) GetPort() int {
+	if o == nil || o.Port == nil {
+		return 0
+	}
+	return *o.Port
+}
+
+func (o *TargetGroupConfig) GetProtocol() string {
+	if o == nil || o.Protocol == nil {
+		return ""
+	}
+	return *o.Protocol
+}
+
+func (o *TargetGroupConfig) GetProtocolVersion() string {
+	if o == nil || o.ProtocolVersion == nil {
+		return ""
+	}
+	return *o.ProtocolVersion
+}
+
+func (o *TargetGroupConfig) GetMatcher() *Matcher {
+	if o == nil {
+		return nil
+	}
+	return o.Matcher
+}
+
+func (o *TargetGroupConfig) GetTags() []*Tag {
+	if o == nil {
+		return nil
+	}
+	return o.Tags
+}
+
+func (o *Task) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *Task) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *Task) GetFrequency() string {
+	if o == nil || o.Frequency == nil {
+		return ""
+	}
+	return *o.Frequency
+}
+
+func (o *Task) GetCronExpression() string {
+	if o == nil || o.CronExpression == nil {
+		return ""
+	}
+	return *o.CronExpression
+}
+
+func (o *Task) GetStartTime() string {
+	if o == nil || o.StartTime == nil {
+		return ""
+	}
+	return *o.StartTime
+}
+
+func (o *Task) GetScaleTargetCapacity() int {
+	if o == nil || o.ScaleTargetCapacity == nil {
+		return 0
+	}
+	return *o.ScaleTargetCapacity
+}
+
+func (o *Task) GetScaleMinCapacity() int {
+	if o == nil || o.ScaleMinCapacity == nil {
+		return 0
+	}
+	return *o.ScaleMinCapacity
+}
+
+func (o *Task) GetScaleMaxCapacity() int {
+	if o == nil || o.ScaleMaxCapacity == nil {
+		return 0
+	}
+	return *o.ScaleMaxCapacity
+}
+
+func (o *Task) GetBatchSizePercentage() int {
+	if o == nil || o.BatchSizePercentage == nil {
+		return 0
+	}
+	return *o.BatchSizePercentage
+}
+
+func (o *Task) GetGracePeriod() int {
+	if o == nil || o.GracePeriod == nil {
+		return 0
+	}
+	return *o.GracePeriod
+}
+
+func (o *Task) GetTargetCapacity() int {
+	if o == nil || o.TargetCapacity == nil {
+		return 0
+	}
+	return *o.TargetCapacity
+}
+
+func (o *Task) GetMinCapacity() int {
+	if o == nil || o.MinCapacity == nil {
+		return 0
+	}
+	return *o.MinCapacity
+}
+
+func (o *Task) GetMaxCapacity() int {
+	if o == nil || o.MaxCapacity == nil {
+		return 0
+	}
+	return *o.MaxCapacity
+}
+
+func (o *Task) GetAdjustment() int {
+	if o == nil || o.Adjustment == nil {
+		return 0
+	}
+	return *o.Adjustment
+}
+
+func (o *Task) GetAdjustmentPercentage() int {
+	if o == nil || o.AdjustmentPercentage == nil {
+		return 0
+	}
+	return *o.AdjustmentPercentage
+}
+
+func (o *UpdateGroupInput) GetGroup() *Group {
+	if o == nil {
+		return nil
+	}
+	return o.Group
+}
+
+func (o *UpdateGroupInput) GetShouldResumeStateful() bool {
+	if o == nil || o.ShouldResumeStateful == nil {
+		return false
+	}
+	return *o.ShouldResumeStateful
+}
+
+func (o *UpdateGroupInput) GetAutoApplyTags() bool {
+	if o == nil || o.AutoApplyTags == nil {
+		return false
+	}
+	return *o.AutoApplyTags
+}
+
+func (o *UpdateGroupOutput) GetGroup() *Group {
+	if o == nil {
+		return nil
+	}
+	return o.Group
+}
+
+func (o *Volumes) GetShouldTag() bool {
+	if o == nil || o.ShouldTag == nil {
+		return false
+	}
+	return *o.ShouldTag
+}
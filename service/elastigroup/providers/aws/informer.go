@@ -0,0 +1,39 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/informer"
+)
+
+// NewGroupInformer returns an informer that lists groups. The list, key and
+// version functions of cfg are set, and its indexers are merged with indexes
+// by name, region and tag. Objects are of type *Group.
+func (s *ServiceOp) NewGroupInformer(cfg informer.Config) *informer.Informer {
+	cfg.List = func(ctx context.Context) ([]interface{}, error) {
+		out, err := s.List(ctx, &ListGroupsInput{})
+		if err != nil {
+			return nil, err
+		}
+		objs := make([]interface{}, len(out.Groups))
+		for i, g := range out.Groups {
+			objs[i] = g
+		}
+		return objs, nil
+	}
+	cfg.Key = func(obj interface{}) string { return obj.(*Group).GetId() }
+	cfg.Version = func(obj interface{}) time.Time { return obj.(*Group).GetUpdatedAt() }
+	cfg.Indexers = informer.Merge(informer.Indexers{
+		informer.IndexName:   func(obj interface{}) []string { return []string{obj.(*Group).GetName()} },
+		informer.IndexRegion: func(obj interface{}) []string { return []string{obj.(*Group).GetRegion()} },
+		informer.IndexTag: func(obj interface{}) []string {
+			tags := obj.(*Group).GetCompute().GetLaunchSpecification().GetTags()
+			return informer.TagIndexValues(len(tags), func(i int) (string, string) {
+				return tags[i].GetKey(), tags[i].GetValue()
+			})
+		},
+	}, cfg.Indexers)
+
+	return informer.New(cfg)
+}
@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+// ErrStatefulInstanceNotFound is returned when a stateful instance is missing
+// from the group's stateful instances.
+var ErrStatefulInstanceNotFound = errors.New("spotinst: stateful instance not found")
+
+// StatefulInstanceTransitionError is returned by the stateful instance
+// "and wait" helpers when a stateful instance fails to reach the requested
+// state.
+type StatefulInstanceTransitionError struct {
+	GroupID            string
+	StatefulInstanceID string
+	Action             string
+
+	// State is the last known state of the stateful instance.
+	State StatefulInstanceState
+
+	// Err is the error returned by the waiter.
+	Err error
+}
+
+func (e *StatefulInstanceTransitionError) Error() string {
+	return fmt.Sprintf("spotinst: failed to %s stateful instance %q of group %q (state: %s)",
+		e.Action, e.StatefulInstanceID, e.GroupID, e.State)
+}
+
+// Unwrap returns the error returned by the waiter.
+func (e *StatefulInstanceTransitionError) Unwrap() error {
+	return e.Err
+}
+
+// PauseStatefulInstanceAndWait pauses a stateful instance and polls
+// ListStatefulInstances until it is PAUSED. If the instance reaches the
+// ERROR state instead, a *StatefulInstanceTransitionError wrapping
+// waiter.ErrFailureState is returned.
+func (s *ServiceOp) PauseStatefulInstanceAndWait(ctx context.Context, input *PauseStatefulInstanceInput, opts ...waiter.Option) (*StatefulInstance, error) {
+	return statefulInstanceTransitionAndWait(ctx, s, input.GroupID, input.StatefulInstanceID, "pause", StatefulInstanceStatePaused,
+		func(ctx context.Context) error {
+			_, err := s.PauseStatefulInstance(ctx, input)
+			return err
+		}, opts)
+}
+
+// ResumeStatefulInstanceAndWait resumes a stateful instance and polls
+// ListStatefulInstances until it is ACTIVE. If the instance reaches the
+// ERROR state instead, a *StatefulInstanceTransitionError wrapping
+// waiter.ErrFailureState is returned.
+func (s *ServiceOp) ResumeStatefulInstanceAndWait(ctx context.Context, input *ResumeStatefulInstanceInput, opts ...waiter.Option) (*StatefulInstance, error) {
+	return statefulInstanceTransitionAndWait(ctx, s, input.GroupID, input.StatefulInstanceID, "resume", StatefulInstanceStateActive,
+		func(ctx context.Context) error {
+			_, err := s.ResumeStatefulInstance(ctx, input)
+			return err
+		}, opts)
+}
+
+// RecycleStatefulInstanceAndWait recycles a stateful instance and polls
+// ListStatefulInstances until it is ACTIVE again. If the instance reaches the
+// ERROR state instead, a *StatefulInstanceTransitionError wrapping
+// waiter.ErrFailureState is returned.
+func (s *ServiceOp) RecycleStatefulInstanceAndWait(ctx context.Context, input *RecycleStatefulInstanceInput, opts ...waiter.Option) (*StatefulInstance, error) {
+	return statefulInstanceTransitionAndWait(ctx, s, input.GroupID, input.StatefulInstanceID, "recycle", StatefulInstanceStateActive,
+		func(ctx context.Context) error {
+			_, err := s.RecycleStatefulInstance(ctx, input)
+			return err
+		}, opts)
+}
+
+func statefulInstanceTransitionAndWait(ctx context.Context, svc Service, groupID, statefulInstanceID *string, action string,
+	target StatefulInstanceState, do func(context.Context) error, opts []waiter.Option) (*StatefulInstance, error) {
+	t := &waiter.Transition{
+		Name: "StatefulInstanceState" + target.String(),
+		Do:   do,
+		Poll: func(ctx context.Context) (interface{}, error) {
+			out, err := svc.ListStatefulInstances(ctx, &ListStatefulInstancesInput{GroupID: groupID})
+			if err != nil {
+				return nil, err
+			}
+			for _, i := range out.StatefulInstances {
+				if i.GetStatefulInstanceID() == spotinst.StringValue(statefulInstanceID) {
+					return i, nil
+				}
+			}
+			return nil, fmt.Errorf("%w: %q", ErrStatefulInstanceNotFound, spotinst.StringValue(statefulInstanceID))
+		},
+		Done: func(output interface{}) bool {
+			return statefulInstanceState(output.(*StatefulInstance)) == target
+		},
+		Failed: func(output interface{}) bool {
+			return statefulInstanceState(output.(*StatefulInstance)) == StatefulInstanceStateError
+		},
+		Reenter: action == "recycle",
+	}
+
+	out, err := t.Run(ctx, opts...)
+	last, _ := out.(*StatefulInstance)
+	if errors.Is(err, waiter.ErrFailureState) {
+		err = &StatefulInstanceTransitionError{
+			GroupID:            spotinst.StringValue(groupID),
+			StatefulInstanceID: spotinst.StringValue(statefulInstanceID),
+			Action:             action,
+			State:              statefulInstanceState(last),
+			Err:                err,
+		}
+	}
+	return last, err
+}
+
+func statefulInstanceState(i *StatefulInstance) StatefulInstanceState {
+	return StatefulInstanceState(strings.ToUpper(i.GetState()))
+}
@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+type fakeStatefulInstances struct {
+	Service
+
+	// states are the states of the stateful instance returned by successive
+	// ListStatefulInstances calls, the last one repeated. An empty state
+	// omits the instance.
+	states []StatefulInstanceState
+
+	n int
+}
+
+func (f *fakeStatefulInstances) ListStatefulInstances(context.Context, *ListStatefulInstancesInput) (*ListStatefulInstancesOutput, error) {
+	state := f.states[f.n]
+	if f.n < len(f.states)-1 {
+		f.n++
+	}
+	out := &ListStatefulInstancesOutput{StatefulInstances: []*StatefulInstance{
+		{StatefulInstanceID: spotinst.String("ssi-other"), State: spotinst.String("ERROR")},
+	}}
+	if state != "" {
+		out.StatefulInstances = append(out.StatefulInstances, &StatefulInstance{
+			StatefulInstanceID: spotinst.String("ssi-1"),
+			State:              spotinst.String(string(state)),
+		})
+	}
+	return out, nil
+}
+
+func TestStatefulInstanceTransitionAndWait(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		recycle bool
+		states  []StatefulInstanceState
+		state   StatefulInstanceState
+		errIs   error
+	}{
+		{
+			name:   "paused",
+			states: []StatefulInstanceState{StatefulInstanceStatePausing, StatefulInstanceStatePaused},
+			state:  StatefulInstanceStatePaused,
+		},
+		{
+			name:   "paused before first poll",
+			states: []StatefulInstanceState{StatefulInstanceStatePaused},
+			state:  StatefulInstanceStatePaused,
+		},
+		{
+			// The first state is read before the recycle is requested.
+			name:    "recycled from active",
+			recycle: true,
+			states: []StatefulInstanceState{
+				StatefulInstanceStateActive,
+				StatefulInstanceStateActive,
+				StatefulInstanceStateRecycling,
+				StatefulInstanceStateActive,
+			},
+			state: StatefulInstanceStateActive,
+		},
+		{
+			name:   "error",
+			states: []StatefulInstanceState{StatefulInstanceStatePausing, StatefulInstanceStateError},
+			state:  StatefulInstanceStateError,
+			errIs:  waiter.ErrFailureState,
+		},
+		{
+			name:   "not found",
+			states: []StatefulInstanceState{StatefulInstanceStatePausing, ""},
+			state:  StatefulInstanceStatePausing,
+			errIs:  ErrStatefulInstanceNotFound,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			action, target := "pause", StatefulInstanceStatePaused
+			if tt.recycle {
+				action, target = "recycle", StatefulInstanceStateActive
+			}
+
+			var done bool
+			svc := &fakeStatefulInstances{states: tt.states}
+			i, err := statefulInstanceTransitionAndWait(context.Background(), svc,
+				spotinst.String("sig-1"), spotinst.String("ssi-1"), action, target,
+				func(context.Context) error {
+					done = true
+					return nil
+				}, []waiter.Option{waiter.WithClock(&fakeClock{now: time.Unix(0, 0)})})
+			if !errors.Is(err, tt.errIs) || (tt.errIs == nil && err != nil) {
+				t.Fatalf("got error %v, want %v", err, tt.errIs)
+			}
+			if !done {
+				t.Error("action not performed")
+			}
+			if got := statefulInstanceState(i); got != tt.state {
+				t.Errorf("got state %s, want %s", got, tt.state)
+			}
+			if tt.recycle && svc.n != len(tt.states)-1 {
+				t.Errorf("done after %d reads, want %d", svc.n+1, len(tt.states))
+			}
+
+			var terr *StatefulInstanceTransitionError
+			if got, want := errors.As(err, &terr), errors.Is(tt.errIs, waiter.ErrFailureState); got != want {
+				t.Errorf("got transition error %v, want %v", got, want)
+			} else if got && (terr.StatefulInstanceID != "ssi-1" || terr.State != tt.state) {
+				t.Errorf("got error %+v", terr)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+// finishMaintenanceTimeout bounds the FinishBeanstalkMaintenance request made
+// after the caller's context is done.
+const finishMaintenanceTimeout = 30 * time.Second
+
+// BeanstalkMaintenanceStatus returns the typed status of a Beanstalk
+// maintenance.
+func (s *ServiceOp) BeanstalkMaintenanceStatus(ctx context.Context, input *BeanstalkMaintenanceInput) (BeanstalkMaintenanceStatus, error) {
+	status, err := s.GetBeanstalkMaintenanceStatus(ctx, input)
+	if err != nil {
+		return "", err
+	}
+	return BeanstalkMaintenanceStatus(strings.ToUpper(spotinst.StringValue(status))), nil
+}
+
+// RunBeanstalkMaintenance starts a Beanstalk maintenance, waits until it is
+// active, runs fn and finishes the maintenance.
+//
+// Once started, the maintenance is always finished, even if waiting fails,
+// fn returns an error or panics, or ctx is canceled. A panic in fn is
+// propagated once the maintenance is finished. If the maintenance can't be
+// finished, the returned error includes the reason along with any error that
+// occurred before.
+func (s *ServiceOp) RunBeanstalkMaintenance(ctx context.Context, groupID string, fn func(ctx context.Context) error, opts ...waiter.Option) error {
+	return runBeanstalkMaintenance(ctx, s, groupID, fn, opts)
+}
+
+func runBeanstalkMaintenance(ctx context.Context, svc Service, groupID string, fn func(ctx context.Context) error, opts []waiter.Option) (err error) {
+	input := &BeanstalkMaintenanceInput{GroupID: spotinst.String(groupID)}
+	if _, err = svc.StartBeanstalkMaintenance(ctx, input); err != nil {
+		return err
+	}
+
+	defer func() {
+		// The caller's context may be done already.
+		finishCtx, cancel := context.WithTimeout(context.Background(), finishMaintenanceTimeout)
+		defer cancel()
+
+		if _, finishErr := svc.FinishBeanstalkMaintenance(finishCtx, input); finishErr != nil {
+			err = errors.Join(err, fmt.Errorf("spotinst: failed to finish beanstalk maintenance of group %q: %w", groupID, finishErr))
+		}
+	}()
+
+	w := &waiter.Waiter{
+		Name: "BeanstalkMaintenanceActive",
+		Poll: func(ctx context.Context) (interface{}, error) {
+			return svc.BeanstalkMaintenanceStatus(ctx, input)
+		},
+		Acceptors: []waiter.Acceptor{
+			{
+				State: waiter.Success,
+				Matcher: waiter.OutputMatcher(func(output interface{}) bool {
+					return output == BeanstalkMaintenanceStatusAwaitUserUpdate
+				}),
+			},
+			{
+				State: waiter.Failure,
+				Matcher: waiter.OutputMatcher(func(output interface{}) bool {
+					return output == BeanstalkMaintenanceStatusEnded
+				}),
+			},
+		},
+		Options: waiter.DefaultOptions(),
+	}
+	w.Options.MinDelay = 10 * time.Second
+	w.Options.MaxDelay = 30 * time.Second
+
+	if _, err = w.Apply(opts...).Wait(ctx); err != nil {
+		return err
+	}
+
+	return fn(ctx)
+}
@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+type fakeMaintenance struct {
+	Service
+
+	startErr  error
+	finishErr error
+	status    BeanstalkMaintenanceStatus
+
+	started  bool
+	finished bool
+}
+
+func (f *fakeMaintenance) StartBeanstalkMaintenance(context.Context, *BeanstalkMaintenanceInput) (*BeanstalkMaintenanceOutput, error) {
+	if f.startErr != nil {
+		return nil, f.startErr
+	}
+	f.started = true
+	return &BeanstalkMaintenanceOutput{}, nil
+}
+
+func (f *fakeMaintenance) FinishBeanstalkMaintenance(context.Context, *BeanstalkMaintenanceInput) (*BeanstalkMaintenanceOutput, error) {
+	f.finished = true
+	return &BeanstalkMaintenanceOutput{}, f.finishErr
+}
+
+func (f *fakeMaintenance) BeanstalkMaintenanceStatus(context.Context, *BeanstalkMaintenanceInput) (BeanstalkMaintenanceStatus, error) {
+	return f.status, nil
+}
+
+func TestRunBeanstalkMaintenance(t *testing.T) {
+	startErr := errors.New("start failed")
+	finishErr := errors.New("finish failed")
+	fnErr := errors.New("update failed")
+	for _, tt := range []struct {
+		name     string
+		svc      *fakeMaintenance
+		fnErr    error
+		ran      bool
+		finished bool
+		errIs    []error
+	}{
+		{
+			name:     "finished",
+			svc:      &fakeMaintenance{status: BeanstalkMaintenanceStatusAwaitUserUpdate},
+			ran:      true,
+			finished: true,
+		},
+		{
+			name:     "work failed",
+			svc:      &fakeMaintenance{status: BeanstalkMaintenanceStatusAwaitUserUpdate},
+			fnErr:    fnErr,
+			ran:      true,
+			finished: true,
+			errIs:    []error{fnErr},
+		},
+		{
+			name:  "start failed",
+			svc:   &fakeMaintenance{startErr: startErr},
+			errIs: []error{startErr},
+		},
+		{
+			name:     "ended while waiting",
+			svc:      &fakeMaintenance{status: BeanstalkMaintenanceStatusEnded},
+			finished: true,
+			errIs:    []error{waiter.ErrFailureState},
+		},
+		{
+			name:     "finish failed",
+			svc:      &fakeMaintenance{status: BeanstalkMaintenanceStatusAwaitUserUpdate, finishErr: finishErr},
+			fnErr:    fnErr,
+			ran:      true,
+			finished: true,
+			errIs:    []error{fnErr, finishErr},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var ran bool
+			err := runBeanstalkMaintenance(context.Background(), tt.svc, "sig-1", func(context.Context) error {
+				ran = true
+				return tt.fnErr
+			}, []waiter.Option{waiter.WithClock(&fakeClock{now: time.Unix(0, 0)})})
+			for _, target := range tt.errIs {
+				if !errors.Is(err, target) {
+					t.Errorf("got error %v, want %v", err, target)
+				}
+			}
+			if len(tt.errIs) == 0 && err != nil {
+				t.Errorf("got error %v", err)
+			}
+			if ran != tt.ran {
+				t.Errorf("got work run %v, want %v", ran, tt.ran)
+			}
+			if tt.svc.finished != tt.finished {
+				t.Errorf("got maintenance finished %v, want %v", tt.svc.finished, tt.finished)
+			}
+		})
+	}
+}
+
+func TestRunBeanstalkMaintenancePanic(t *testing.T) {
+	svc := &fakeMaintenance{status: BeanstalkMaintenanceStatusAwaitUserUpdate}
+	type value struct{ msg string }
+
+	defer func() {
+		r := recover()
+		if v, ok := r.(*value); !ok || v.msg != "boom" {
+			t.Errorf("got panic %v, want the original value", r)
+		}
+		if !svc.finished {
+			t.Error("maintenance not finished")
+		}
+	}()
+
+	runBeanstalkMaintenance(context.Background(), svc, "sig-1", func(context.Context) error {
+		panic(&value{msg: "boom"})
+	}, []waiter.Option{waiter.WithClock(&fakeClock{now: time.Unix(0, 0)})})
+	t.Error("panic not propagated")
+}
@@ -5,7 +5,10 @@ import (
 
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/concurrency"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/informer"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
 )
 
 // Service provides the API operation methods for making requests to endpoints
@@ -16,7 +19,11 @@ type Service interface {
 	Create(context.Context, *CreateGroupInput) (*CreateGroupOutput, error)
 	Read(context.Context, *ReadGroupInput) (*ReadGroupOutput, error)
 	Update(context.Context, *UpdateGroupInput) (*UpdateGroupOutput, error)
+	UpdateIfUnchanged(context.Context, *UpdateGroupInput, *Group) (*UpdateGroupOutput, error)
+	RetryOnConflict(context.Context, string, *concurrency.Retry, func(*Group) (*Group, error)) (*Group, error)
 	Delete(context.Context, *DeleteGroupInput) (*DeleteGroupOutput, error)
+	NewGroupInformer(informer.Config) *informer.Informer
+	Clone(context.Context, string, Service, *CloneRules) (*Group, error)
 	Status(context.Context, *StatusGroupInput) (*StatusGroupOutput, error)
 	Scale(context.Context, *ScaleGroupInput) (*ScaleGroupOutput, error)
 	Detach(context.Context, *DetachGroupInput) (*DetachGroupOutput, error)
@@ -24,6 +31,9 @@ type Service interface {
 	DeploymentStatus(context.Context, *DeploymentStatusInput) (*RollGroupOutput, error)
 	DeploymentStatusECS(context.Context, *DeploymentStatusInput) (*RollGroupOutput, error)
 	StopDeployment(context.Context, *StopDeploymentInput) (*StopDeploymentOutput, error)
+	WaitUntilDeploymentFinished(context.Context, *DeploymentStatusInput, ...waiter.Option) error
+	WaitUntilDeploymentECSFinished(context.Context, *DeploymentStatusInput, ...waiter.Option) error
+	WaitForDeployment(context.Context, string, string, *WaitForDeploymentOptions) (*DeploymentUpdate, error)
 
 	Roll(context.Context, *RollGroupInput) (*RollGroupOutput, error)
 	RollStatus(context.Context, *RollStatusInput) (*RollStatusOutput, error)
@@ -31,11 +41,14 @@ type Service interface {
 
 	GetInstanceHealthiness(context.Context, *GetInstanceHealthinessInput) (*GetInstanceHealthinessOutput, error)
 	GetGroupEvents(context.Context, *GetGroupEventsInput) (*GetGroupEventsOutput, error)
+	StreamGroupEvents(context.Context, *EventStreamOptions) <-chan Event
 
 	ImportBeanstalkEnv(context.Context, *ImportBeanstalkInput) (*ImportBeanstalkOutput, error)
 	StartBeanstalkMaintenance(context.Context, *BeanstalkMaintenanceInput) (*BeanstalkMaintenanceOutput, error)
 	FinishBeanstalkMaintenance(context.Context, *BeanstalkMaintenanceInput) (*BeanstalkMaintenanceOutput, error)
 	GetBeanstalkMaintenanceStatus(context.Context, *BeanstalkMaintenanceInput) (*string, error)
+	BeanstalkMaintenanceStatus(context.Context, *BeanstalkMaintenanceInput) (BeanstalkMaintenanceStatus, error)
+	RunBeanstalkMaintenance(context.Context, string, func(context.Context) error, ...waiter.Option) error
 
 	CreateSuspensions(context.Context, *CreateSuspensionsInput) (*CreateSuspensionsOutput, error)
 	ListSuspensions(context.Context, *ListSuspensionsInput) (*ListSuspensionsOutput, error)
@@ -46,6 +59,9 @@ type Service interface {
 	ResumeStatefulInstance(context.Context, *ResumeStatefulInstanceInput) (*ResumeStatefulInstanceOutput, error)
 	RecycleStatefulInstance(context.Context, *RecycleStatefulInstanceInput) (*RecycleStatefulInstanceOutput, error)
 	DeallocateStatefulInstance(context.Context, *DeallocateStatefulInstanceInput) (*DeallocateStatefulInstanceOutput, error)
+	PauseStatefulInstanceAndWait(context.Context, *PauseStatefulInstanceInput, ...waiter.Option) (*StatefulInstance, error)
+	ResumeStatefulInstanceAndWait(context.Context, *ResumeStatefulInstanceInput, ...waiter.Option) (*StatefulInstance, error)
+	RecycleStatefulInstanceAndWait(context.Context, *RecycleStatefulInstanceInput, ...waiter.Option) (*StatefulInstance, error)
 }
 
 type ServiceOp struct {
@@ -0,0 +1,78 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+// WaitUntilDeploymentFinished polls DeploymentStatus until the deployment
+// reaches the FINISHED status. It returns an error wrapping
+// waiter.ErrFailureState if the deployment is stopped or fails.
+func (s *ServiceOp) WaitUntilDeploymentFinished(ctx context.Context, input *DeploymentStatusInput, opts ...waiter.Option) error {
+	w := &waiter.Waiter{
+		Name: "DeploymentFinished",
+		Poll: func(ctx context.Context) (interface{}, error) {
+			return s.DeploymentStatus(ctx, input)
+		},
+		Acceptors: deploymentAcceptors(),
+		Options:   deploymentWaiterOptions(),
+	}
+	_, err := w.Apply(opts...).Wait(ctx)
+	return err
+}
+
+// WaitUntilDeploymentECSFinished is like WaitUntilDeploymentFinished, but
+// polls DeploymentStatusECS.
+func (s *ServiceOp) WaitUntilDeploymentECSFinished(ctx context.Context, input *DeploymentStatusInput, opts ...waiter.Option) error {
+	w := &waiter.Waiter{
+		Name: "DeploymentECSFinished",
+		Poll: func(ctx context.Context) (interface{}, error) {
+			return s.DeploymentStatusECS(ctx, input)
+		},
+		Acceptors: deploymentAcceptors(),
+		Options:   deploymentWaiterOptions(),
+	}
+	_, err := w.Apply(opts...).Wait(ctx)
+	return err
+}
+
+func deploymentWaiterOptions() waiter.Options {
+	opts := waiter.DefaultOptions()
+	opts.MinDelay = 15 * time.Second
+	opts.MaxDuration = 2 * time.Hour
+	return opts
+}
+
+func deploymentAcceptors() []waiter.Acceptor {
+	return []waiter.Acceptor{
+		{
+			State:   waiter.Success,
+			Matcher: deploymentStatusMatcher(DeploymentStatusFinished),
+		},
+		{
+			State:   waiter.Failure,
+			Matcher: deploymentStatusMatcher(DeploymentStatusStopped, DeploymentStatusFailed),
+		},
+	}
+}
+
+func deploymentStatusMatcher(statuses ...DeploymentStatus) waiter.Matcher {
+	return waiter.OutputMatcher(func(output interface{}) bool {
+		out, ok := output.(*RollGroupOutput)
+		if !ok || len(out.RollGroupStatus) == 0 {
+			return false
+		}
+		status, err := ParseDeploymentStatus(out.RollGroupStatus[0].GetRollStatus())
+		if err != nil {
+			return false
+		}
+		for _, s := range statuses {
+			if status == s {
+				return true
+			}
+		}
+		return false
+	})
+}
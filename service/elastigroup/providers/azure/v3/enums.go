@@ -0,0 +1,122 @@
+package v3
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+)
+
+// A SignalType represents the type of a signal sent by a group's VMs.
+type SignalType string
+
+const (
+	// SignalTypeVMReady signals that a VM is ready to receive traffic.
+	SignalTypeVMReady SignalType = "vmReady"
+
+	// SignalTypeVMReadyToShutdown signals that a VM can be shut down.
+	SignalTypeVMReadyToShutdown SignalType = "vmReadyToShutdown"
+)
+
+var signalTypes = []SignalType{
+	SignalTypeVMReady,
+	SignalTypeVMReadyToShutdown,
+}
+
+// ParseSignalType parses a string into a SignalType. The comparison is case
+// insensitive and an error is returned for unknown values.
+func ParseSignalType(s string) (SignalType, error) {
+	for _, v := range signalTypes {
+		if strings.EqualFold(s, string(v)) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("spotinst: invalid signal type %q", s)
+}
+
+// IsValid reports whether the signal type is a known value.
+func (t SignalType) IsValid() bool {
+	_, err := ParseSignalType(string(t))
+	return err == nil
+}
+
+func (t SignalType) String() string {
+	return string(t)
+}
+
+// A PerformAt represents when a group should revert back to spot VMs after
+// falling back to on-demand.
+type PerformAt string
+
+const (
+	// PerformAtAlways reverts to spot as soon as capacity is available.
+	PerformAtAlways PerformAt = "always"
+
+	// PerformAtNever never reverts to spot.
+	PerformAtNever PerformAt = "never"
+
+	// PerformAtTimeWindow reverts to spot during the optimization windows.
+	PerformAtTimeWindow PerformAt = "timeWindow"
+)
+
+var performAts = []PerformAt{
+	PerformAtAlways,
+	PerformAtNever,
+	PerformAtTimeWindow,
+}
+
+// ParsePerformAt parses a string into a PerformAt. The comparison is case
+// insensitive and an error is returned for unknown values.
+func ParsePerformAt(s string) (PerformAt, error) {
+	for _, v := range performAts {
+		if strings.EqualFold(s, string(v)) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("spotinst: invalid perform at %q", s)
+}
+
+// IsValid reports whether the value is a known value.
+func (p PerformAt) IsValid() bool {
+	_, err := ParsePerformAt(string(p))
+	return err == nil
+}
+
+func (p PerformAt) String() string {
+	return string(p)
+}
+
+// PerformAtEnum returns the PerformAt field as a typed PerformAt, or an
+// empty PerformAt if it isn't set.
+func (o *RevertToSpot) PerformAtEnum() PerformAt {
+	if o == nil || o.PerformAt == nil {
+		return ""
+	}
+	return PerformAt(*o.PerformAt)
+}
+
+// SetPerformAtEnum sets the PerformAt field to v, or clears it if v is
+// empty.
+func (o *RevertToSpot) SetPerformAtEnum(v PerformAt) *RevertToSpot {
+	if v == "" {
+		return o.SetPerformAt(nil)
+	}
+	return o.SetPerformAt(spotinst.String(string(v)))
+}
+
+// TypeEnum returns the Type field as a typed SignalType, or an empty
+// SignalType if it isn't set.
+func (o *Signals) TypeEnum() SignalType {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return SignalType(*o.Type)
+}
+
+// SetTypeEnum sets the Type field to v, or clears it if v is empty.
+func (o *Signals) SetTypeEnum(v SignalType) *Signals {
+	if v == "" {
+		return o.SetType(nil)
+	}
+	return o.SetType(spotinst.String(string(v)))
+}
@@ -0,0 +1,1358 @@
+// Code generated by gettergen. DO NOT EDIT.
+
+package v3
+
+import (
+	"time"
+)
+
+func (o *Action) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *Action) GetAdjustment() string {
+	if o == nil || o.Adjustment == nil {
+		return ""
+	}
+	return *o.Adjustment
+}
+
+func (o *Action) GetMaximum() string {
+	if o == nil || o.Maximum == nil {
+		return ""
+	}
+	return *o.Maximum
+}
+
+func (o *Action) GetMinimum() string {
+	if o == nil || o.Minimum == nil {
+		return ""
+	}
+	return *o.Minimum
+}
+
+func (o *Action) GetTarget() string {
+	if o == nil || o.Target == nil {
+		return ""
+	}
+	return *o.Target
+}
+
+func (o *AdditionalIPConfig) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *AdditionalIPConfig) GetPrivateIPAddressVersion() string {
+	if o == nil || o.PrivateIPAddressVersion == nil {
+		return ""
+	}
+	return *o.PrivateIPAddressVersion
+}
+
+func (o *ApplicationSecurityGroup) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *ApplicationSecurityGroup) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *BootDiagnostics) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *BootDiagnostics) GetStorageUri() string {
+	if o == nil || o.StorageUri == nil {
+		return ""
+	}
+	return *o.StorageUri
+}
+
+func (o *BootDiagnostics) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *Capacity) GetMinimum() int {
+	if o == nil || o.Minimum == nil {
+		return 0
+	}
+	return *o.Minimum
+}
+
+func (o *Capacity) GetMaximum() int {
+	if o == nil || o.Maximum == nil {
+		return 0
+	}
+	return *o.Maximum
+}
+
+func (o *Capacity) GetTarget() int {
+	if o == nil || o.Target == nil {
+		return 0
+	}
+	return *o.Target
+}
+
+func (o *CapacityReservation) GetCapacityReservationGroups() []*CapacityReservationGroups {
+	if o == nil {
+		return nil
+	}
+	return o.CapacityReservationGroups
+}
+
+func (o *CapacityReservation) GetShouldUtilize() bool {
+	if o == nil || o.ShouldUtilize == nil {
+		return false
+	}
+	return *o.ShouldUtilize
+}
+
+func (o *CapacityReservation) GetUtilizationStrategy() string {
+	if o == nil || o.UtilizationStrategy == nil {
+		return ""
+	}
+	return *o.UtilizationStrategy
+}
+
+func (o *CapacityReservationGroups) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *CapacityReservationGroups) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *CapacityReservationGroups) GetShouldPrioritize() bool {
+	if o == nil || o.ShouldPrioritize == nil {
+		return false
+	}
+	return *o.ShouldPrioritize
+}
+
+func (o *Compute) GetVMSizes() *VMSizes {
+	if o == nil {
+		return nil
+	}
+	return o.VMSizes
+}
+
+func (o *Compute) GetOS() string {
+	if o == nil || o.OS == nil {
+		return ""
+	}
+	return *o.OS
+}
+
+func (o *Compute) GetLaunchSpecification() *LaunchSpecification {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecification
+}
+
+func (o *Compute) GetPreferredZones() []string {
+	if o == nil {
+		return nil
+	}
+	return o.PreferredZones
+}
+
+func (o *Compute) GetZones() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Zones
+}
+
+func (o *CreateGroupInput) GetGroup() *Group {
+	if o == nil {
+		return nil
+	}
+	return o.Group
+}
+
+func (o *CreateGroupOutput) GetGroup() *Group {
+	if o == nil {
+		return nil
+	}
+	return o.Group
+}
+
+func (o *CustomImage) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *CustomImage) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *DataDisks) GetLun() int {
+	if o == nil || o.Lun == nil {
+		return 0
+	}
+	return *o.Lun
+}
+
+func (o *DataDisks) GetSizeGB() int {
+	if o == nil || o.SizeGB == nil {
+		return 0
+	}
+	return *o.SizeGB
+}
+
+func (o *DataDisks) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *DeleteGroupInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *Dimensions) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Dimensions) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *Extensions) GetAPIVersion() string {
+	if o == nil || o.APIVersion == nil {
+		return ""
+	}
+	return *o.APIVersion
+}
+
+func (o *Extensions) GetEnableAutomaticUpgrade() bool {
+	if o == nil || o.EnableAutomaticUpgrade == nil {
+		return false
+	}
+	return *o.EnableAutomaticUpgrade
+}
+
+func (o *Extensions) GetMinorVersionAutoUpgrade() bool {
+	if o == nil || o.MinorVersionAutoUpgrade == nil {
+		return false
+	}
+	return *o.MinorVersionAutoUpgrade
+}
+
+func (o *Extensions) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Extensions) GetProtectedSettingsFromKeyVault() *ProtectedSettingsFromKeyVault {
+	if o == nil {
+		return nil
+	}
+	return o.ProtectedSettingsFromKeyVault
+}
+
+func (o *Extensions) GetProtectedSettings() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.ProtectedSettings
+}
+
+func (o *Extensions) GetPublicSettings() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.PublicSettings
+}
+
+func (o *Extensions) GetPublisher() string {
+	if o == nil || o.Publisher == nil {
+		return ""
+	}
+	return *o.Publisher
+}
+
+func (o *Extensions) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *GalleryImage) GetGalleryName() string {
+	if o == nil || o.GalleryName == nil {
+		return ""
+	}
+	return *o.GalleryName
+}
+
+func (o *GalleryImage) GetImageName() string {
+	if o == nil || o.ImageName == nil {
+		return ""
+	}
+	return *o.ImageName
+}
+
+func (o *GalleryImage) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *GalleryImage) GetSpotAccountId() string {
+	if o == nil || o.SpotAccountId == nil {
+		return ""
+	}
+	return *o.SpotAccountId
+}
+
+func (o *GalleryImage) GetVersion() string {
+	if o == nil || o.Version == nil {
+		return ""
+	}
+	return *o.Version
+}
+
+func (o *Group) GetId() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *Group) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Group) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *Group) GetRegion() string {
+	if o == nil || o.Region == nil {
+		return ""
+	}
+	return *o.Region
+}
+
+func (o *Group) GetCapacity() *Capacity {
+	if o == nil {
+		return nil
+	}
+	return o.Capacity
+}
+
+func (o *Group) GetCompute() *Compute {
+	if o == nil {
+		return nil
+	}
+	return o.Compute
+}
+
+func (o *Group) GetStrategy() *Strategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategy
+}
+
+func (o *Group) GetScaling() *Scaling {
+	if o == nil {
+		return nil
+	}
+	return o.Scaling
+}
+
+func (o *Group) GetDescription() string {
+	if o == nil || o.Description == nil {
+		return ""
+	}
+	return *o.Description
+}
+
+func (o *Group) GetHealth() *Health {
+	if o == nil {
+		return nil
+	}
+	return o.Health
+}
+
+func (o *Group) GetScheduling() *Scheduling {
+	if o == nil {
+		return nil
+	}
+	return o.Scheduling
+}
+
+func (o *Group) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *Group) GetUpdatedAt() time.Time {
+	if o == nil || o.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *o.UpdatedAt
+}
+
+func (o *Health) GetAutoHealing() bool {
+	if o == nil || o.AutoHealing == nil {
+		return false
+	}
+	return *o.AutoHealing
+}
+
+func (o *Health) GetGracePeriod() int {
+	if o == nil || o.GracePeriod == nil {
+		return 0
+	}
+	return *o.GracePeriod
+}
+
+func (o *Health) GetHealthCheckTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.HealthCheckTypes
+}
+
+func (o *Health) GetUnhealthyDuration() int {
+	if o == nil || o.UnhealthyDuration == nil {
+		return 0
+	}
+	return *o.UnhealthyDuration
+}
+
+func (o *Image) GetMarketPlaceImage() *MarketPlaceImage {
+	if o == nil {
+		return nil
+	}
+	return o.MarketPlace
+}
+
+func (o *Image) GetCustom() *CustomImage {
+	if o == nil {
+		return nil
+	}
+	return o.Custom
+}
+
+func (o *Image) GetGalleryImage() *GalleryImage {
+	if o == nil {
+		return nil
+	}
+	return o.GalleryImage
+}
+
+func (o *LaunchSpecification) GetImage() *Image {
+	if o == nil {
+		return nil
+	}
+	return o.Image
+}
+
+func (o *LaunchSpecification) GetNetwork() *Network {
+	if o == nil {
+		return nil
+	}
+	return o.Network
+}
+
+func (o *LaunchSpecification) GetLogin() *Login {
+	if o == nil {
+		return nil
+	}
+	return o.Login
+}
+
+func (o *LaunchSpecification) GetCustomData() string {
+	if o == nil || o.CustomData == nil {
+		return ""
+	}
+	return *o.CustomData
+}
+
+func (o *LaunchSpecification) GetManagedServiceIdentities() []*ManagedServiceIdentity {
+	if o == nil {
+		return nil
+	}
+	return o.ManagedServiceIdentities
+}
+
+func (o *LaunchSpecification) GetTags() []*Tags {
+	if o == nil {
+		return nil
+	}
+	return o.Tags
+}
+
+func (o *LaunchSpecification) GetLoadBalancersConfig() *LoadBalancersConfig {
+	if o == nil {
+		return nil
+	}
+	return o.LoadBalancersConfig
+}
+
+func (o *LaunchSpecification) GetShutdownScript() string {
+	if o == nil || o.ShutdownScript == nil {
+		return ""
+	}
+	return *o.ShutdownScript
+}
+
+func (o *LaunchSpecification) GetExtensions() []*Extensions {
+	if o == nil {
+		return nil
+	}
+	return o.Extensions
+}
+
+func (o *LaunchSpecification) GetBootDiagnostics() *BootDiagnostics {
+	if o == nil {
+		return nil
+	}
+	return o.BootDiagnostics
+}
+
+func (o *LaunchSpecification) GetDataDisks() []*DataDisks {
+	if o == nil {
+		return nil
+	}
+	return o.DataDisks
+}
+
+func (o *LaunchSpecification) GetOsDisk() *OsDisk {
+	if o == nil {
+		return nil
+	}
+	return o.OsDisk
+}
+
+func (o *LaunchSpecification) GetProximityPlacementGroups() []*ProximityPlacementGroups {
+	if o == nil {
+		return nil
+	}
+	return o.ProximityPlacementGroups
+}
+
+func (o *LaunchSpecification) GetSecrets() []*Secrets {
+	if o == nil {
+		return nil
+	}
+	return o.Secrets
+}
+
+func (o *LaunchSpecification) GetSecurity() *Security {
+	if o == nil {
+		return nil
+	}
+	return o.Security
+}
+
+func (o *LaunchSpecification) GetUserData() string {
+	if o == nil || o.UserData == nil {
+		return ""
+	}
+	return *o.UserData
+}
+
+func (o *LaunchSpecification) GetVmNamePrefix() string {
+	if o == nil || o.VmNamePrefix == nil {
+		return ""
+	}
+	return *o.VmNamePrefix
+}
+
+func (o *ListGroupsOutput) GetGroups() []*Group {
+	if o == nil {
+		return nil
+	}
+	return o.Groups
+}
+
+func (o *LoadBalancer) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *LoadBalancer) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *LoadBalancer) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *LoadBalancer) GetSKU() string {
+	if o == nil || o.SKU == nil {
+		return ""
+	}
+	return *o.SKU
+}
+
+func (o *LoadBalancer) GetBackendPoolNames() []string {
+	if o == nil {
+		return nil
+	}
+	return o.BackendPoolNames
+}
+
+func (o *LoadBalancersConfig) GetLoadBalancers() []*LoadBalancer {
+	if o == nil {
+		return nil
+	}
+	return o.LoadBalancers
+}
+
+func (o *Login) GetUserName() string {
+	if o == nil || o.UserName == nil {
+		return ""
+	}
+	return *o.UserName
+}
+
+func (o *Login) GetSSHPublicKey() string {
+	if o == nil || o.SSHPublicKey == nil {
+		return ""
+	}
+	return *o.SSHPublicKey
+}
+
+func (o *Login) GetPassword() string {
+	if o == nil || o.Password == nil {
+		return ""
+	}
+	return *o.Password
+}
+
+func (o *ManagedServiceIdentity) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *ManagedServiceIdentity) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *MarketPlaceImage) GetPublisher() string {
+	if o == nil || o.Publisher == nil {
+		return ""
+	}
+	return *o.Publisher
+}
+
+func (o *MarketPlaceImage) GetOffer() string {
+	if o == nil || o.Offer == nil {
+		return ""
+	}
+	return *o.Offer
+}
+
+func (o *MarketPlaceImage) GetSKU() string {
+	if o == nil || o.SKU == nil {
+		return ""
+	}
+	return *o.SKU
+}
+
+func (o *MarketPlaceImage) GetVersion() string {
+	if o == nil || o.Version == nil {
+		return ""
+	}
+	return *o.Version
+}
+
+func (o *Network) GetVirtualNetworkName() string {
+	if o == nil || o.VirtualNetworkName == nil {
+		return ""
+	}
+	return *o.VirtualNetworkName
+}
+
+func (o *Network) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *Network) GetNetworkInterfaces() []*NetworkInterface {
+	if o == nil {
+		return nil
+	}
+	return o.NetworkInterfaces
+}
+
+func (o *NetworkInterface) GetSubnetName() string {
+	if o == nil || o.SubnetName == nil {
+		return ""
+	}
+	return *o.SubnetName
+}
+
+func (o *NetworkInterface) GetAssignPublicIP() bool {
+	if o == nil || o.AssignPublicIP == nil {
+		return false
+	}
+	return *o.AssignPublicIP
+}
+
+func (o *NetworkInterface) GetIsPrimary() bool {
+	if o == nil || o.IsPrimary == nil {
+		return false
+	}
+	return *o.IsPrimary
+}
+
+func (o *NetworkInterface) GetAdditionalIPConfigs() []*AdditionalIPConfig {
+	if o == nil {
+		return nil
+	}
+	return o.AdditionalIPConfigs
+}
+
+func (o *NetworkInterface) GetApplicationSecurityGroups() []*ApplicationSecurityGroup {
+	if o == nil {
+		return nil
+	}
+	return o.ApplicationSecurityGroups
+}
+
+func (o *NetworkInterface) GetEnableIPForwarding() bool {
+	if o == nil || o.EnableIPForwarding == nil {
+		return false
+	}
+	return *o.EnableIPForwarding
+}
+
+func (o *NetworkInterface) GetPrivateIpAddresses() []string {
+	if o == nil {
+		return nil
+	}
+	return o.PrivateIpAddresses
+}
+
+func (o *NetworkInterface) GetPublicIps() []*PublicIps {
+	if o == nil {
+		return nil
+	}
+	return o.PublicIps
+}
+
+func (o *NetworkInterface) GetPublicIpSku() string {
+	if o == nil || o.PublicIpSku == nil {
+		return ""
+	}
+	return *o.PublicIpSku
+}
+
+func (o *NetworkInterface) GetSecurityGroup() *SecurityGroup {
+	if o == nil {
+		return nil
+	}
+	return o.SecurityGroup
+}
+
+func (o *OsDisk) GetSizeGB() int {
+	if o == nil || o.SizeGB == nil {
+		return 0
+	}
+	return *o.SizeGB
+}
+
+func (o *OsDisk) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *ProtectedSettingsFromKeyVault) GetSecretUrl() string {
+	if o == nil || o.SecretUrl == nil {
+		return ""
+	}
+	return *o.SecretUrl
+}
+
+func (o *ProtectedSettingsFromKeyVault) GetSourceVault() string {
+	if o == nil || o.SourceVault == nil {
+		return ""
+	}
+	return *o.SourceVault
+}
+
+func (o *ProximityPlacementGroups) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *ProximityPlacementGroups) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *PublicIps) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *PublicIps) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *ReadGroupInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *ReadGroupOutput) GetGroup() *Group {
+	if o == nil {
+		return nil
+	}
+	return o.Group
+}
+
+func (o *RevertToSpot) GetPerformAt() string {
+	if o == nil || o.PerformAt == nil {
+		return ""
+	}
+	return *o.PerformAt
+}
+
+func (o *Scaling) GetUp() []*ScalingPolicy {
+	if o == nil {
+		return nil
+	}
+	return o.Up
+}
+
+func (o *Scaling) GetDown() []*ScalingPolicy {
+	if o == nil {
+		return nil
+	}
+	return o.Down
+}
+
+func (o *ScalingPolicy) GetPolicyName() string {
+	if o == nil || o.PolicyName == nil {
+		return ""
+	}
+	return *o.PolicyName
+}
+
+func (o *ScalingPolicy) GetMetricName() string {
+	if o == nil || o.MetricName == nil {
+		return ""
+	}
+	return *o.MetricName
+}
+
+func (o *ScalingPolicy) GetNamespace() string {
+	if o == nil || o.Namespace == nil {
+		return ""
+	}
+	return *o.Namespace
+}
+
+func (o *ScalingPolicy) GetStatistic() string {
+	if o == nil || o.Statistic == nil {
+		return ""
+	}
+	return *o.Statistic
+}
+
+func (o *ScalingPolicy) GetUnit() string {
+	if o == nil || o.Unit == nil {
+		return ""
+	}
+	return *o.Unit
+}
+
+func (o *ScalingPolicy) GetThreshold() float64 {
+	if o == nil || o.Threshold == nil {
+		return 0
+	}
+	return *o.Threshold
+}
+
+func (o *ScalingPolicy) GetEvaluationPeriods() int {
+	if o == nil || o.EvaluationPeriods == nil {
+		return 0
+	}
+	return *o.EvaluationPeriods
+}
+
+func (o *ScalingPolicy) GetPeriod() int {
+	if o == nil || o.Period == nil {
+		return 0
+	}
+	return *o.Period
+}
+
+func (o *ScalingPolicy) GetCooldown() int {
+	if o == nil || o.Cooldown == nil {
+		return 0
+	}
+	return *o.Cooldown
+}
+
+func (o *ScalingPolicy) GetOperator() string {
+	if o == nil || o.Operator == nil {
+		return ""
+	}
+	return *o.Operator
+}
+
+func (o *ScalingPolicy) GetDimensions() []*Dimensions {
+	if o == nil {
+		return nil
+	}
+	return o.Dimensions
+}
+
+func (o *ScalingPolicy) GetAction() *Action {
+	if o == nil {
+		return nil
+	}
+	return o.Action
+}
+
+func (o *ScalingPolicy) GetSource() string {
+	if o == nil || o.Source == nil {
+		return ""
+	}
+	return *o.Source
+}
+
+func (o *ScalingPolicy) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *Scheduling) GetTasks() []*Tasks {
+	if o == nil {
+		return nil
+	}
+	return o.Tasks
+}
+
+func (o *Secrets) GetSourceVault() *SourceVault {
+	if o == nil {
+		return nil
+	}
+	return o.SourceVault
+}
+
+func (o *Secrets) GetVaultCertificates() []*VaultCertificates {
+	if o == nil {
+		return nil
+	}
+	return o.VaultCertificates
+}
+
+func (o *Security) GetConfidentialOsDiskEncryption() bool {
+	if o == nil || o.ConfidentialOsDiskEncryption == nil {
+		return false
+	}
+	return *o.ConfidentialOsDiskEncryption
+}
+
+func (o *Security) GetSecureBootEnabled() bool {
+	if o == nil || o.SecureBootEnabled == nil {
+		return false
+	}
+	return *o.SecureBootEnabled
+}
+
+func (o *Security) GetSecurityType() string {
+	if o == nil || o.SecurityType == nil {
+		return ""
+	}
+	return *o.SecurityType
+}
+
+func (o *Security) GetVTpmEnabled() bool {
+	if o == nil || o.VTpmEnabled == nil {
+		return false
+	}
+	return *o.VTpmEnabled
+}
+
+func (o *Security) GetEncryptionAtHost() bool {
+	if o == nil || o.EncryptionAtHost == nil {
+		return false
+	}
+	return *o.EncryptionAtHost
+}
+
+func (o *SecurityGroup) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *SecurityGroup) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *Signals) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *Signals) GetTimeout() int {
+	if o == nil || o.Timeout == nil {
+		return 0
+	}
+	return *o.Timeout
+}
+
+func (o *SourceVault) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *SourceVault) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *SpotSizeAttributes) GetMaxCpu() int {
+	if o == nil || o.MaxCpu == nil {
+		return 0
+	}
+	return *o.MaxCpu
+}
+
+func (o *SpotSizeAttributes) GetMaxMemory() int {
+	if o == nil || o.MaxMemory == nil {
+		return 0
+	}
+	return *o.MaxMemory
+}
+
+func (o *SpotSizeAttributes) GetMaxStorage() int {
+	if o == nil || o.MaxStorage == nil {
+		return 0
+	}
+	return *o.MaxStorage
+}
+
+func (o *SpotSizeAttributes) GetMinCpu() int {
+	if o == nil || o.MinCpu == nil {
+		return 0
+	}
+	return *o.MinCpu
+}
+
+func (o *SpotSizeAttributes) GetMinMemory() int {
+	if o == nil || o.MinMemory == nil {
+		return 0
+	}
+	return *o.MinMemory
+}
+
+func (o *SpotSizeAttributes) GetMinStorage() int {
+	if o == nil || o.MinStorage == nil {
+		return 0
+	}
+	return *o.MinStorage
+}
+
+func (o *Strategy) GetOnDemandCount() int {
+	if o == nil || o.OnDemandCount == nil {
+		return 0
+	}
+	return *o.OnDemandCount
+}
+
+func (o *Strategy) GetDrainingTimeout() int {
+	if o == nil || o.DrainingTimeout == nil {
+		return 0
+	}
+	return *o.DrainingTimeout
+}
+
+func (o *Strategy) GetSpotPercentage() int {
+	if o == nil || o.SpotPercentage == nil {
+		return 0
+	}
+	return *o.SpotPercentage
+}
+
+func (o *Strategy) GetFallbackToOnDemand() bool {
+	if o == nil || o.FallbackToOnDemand == nil {
+		return false
+	}
+	return *o.FallbackToOnDemand
+}
+
+func (o *Strategy) GetAvailabilityVsCost() int {
+	if o == nil || o.AvailabilityVsCost == nil {
+		return 0
+	}
+	return *o.AvailabilityVsCost
+}
+
+func (o *Strategy) GetCapacityReservation() *CapacityReservation {
+	if o == nil {
+		return nil
+	}
+	return o.CapacityReservation
+}
+
+func (o *Strategy) GetOptimizationWindows() []string {
+	if o == nil {
+		return nil
+	}
+	return o.OptimizationWindows
+}
+
+func (o *Strategy) GetRevertToSpot() *RevertToSpot {
+	if o == nil {
+		return nil
+	}
+	return o.RevertToSpot
+}
+
+func (o *Strategy) GetSignals() []*Signals {
+	if o == nil {
+		return nil
+	}
+	return o.Signals
+}
+
+func (o *Tags) GetTagKey() string {
+	if o == nil || o.TagKey == nil {
+		return ""
+	}
+	return *o.TagKey
+}
+
+func (o *Tags) GetTagValue() string {
+	if o == nil || o.TagValue == nil {
+		return ""
+	}
+	return *o.TagValue
+}
+
+func (o *Tasks) GetCronExpression() string {
+	if o == nil || o.CronExpression == nil {
+		return ""
+	}
+	return *o.CronExpression
+}
+
+func (o *Tasks) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *Tasks) GetScaleMaxCapacity() int {
+	if o == nil || o.ScaleMaxCapacity == nil {
+		return 0
+	}
+	return *o.ScaleMaxCapacity
+}
+
+func (o *Tasks) GetScaleMinCapacity() int {
+	if o == nil || o.ScaleMinCapacity == nil {
+		return 0
+	}
+	return *o.ScaleMinCapacity
+}
+
+func (o *Tasks) GetScaleTargetCapacity() int {
+	if o == nil || o.ScaleTargetCapacity == nil {
+		return 0
+	}
+	return *o.ScaleTargetCapacity
+}
+
+func (o *Tasks) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *Tasks) GetAdjustment() int {
+	if o == nil || o.Adjustment == nil {
+		return 0
+	}
+	return *o.Adjustment
+}
+
+func (o *Tasks) GetAdjustmentPercentage() int {
+	if o == nil || o.AdjustmentPercentage == nil {
+		return 0
+	}
+	return *o.AdjustmentPercentage
+}
+
+func (o *Tasks) GetBatchSizePercentage() int {
+	if o == nil || o.BatchSizePercentage == nil {
+		return 0
+	}
+	return *o.BatchSizePercentage
+}
+
+func (o *Tasks) GetGracePeriod() int {
+	if o == nil || o.GracePeriod == nil {
+		return 0
+	}
+	return *o.GracePeriod
+}
+
+func (o *UpdateGroupInput) GetGroup() *Group {
+	if o == nil {
+		return nil
+	}
+	return o.Group
+}
+
+func (o *UpdateGroupOutput) GetGroup() *Group {
+	if o == nil {
+		return nil
+	}
+	return o.Group
+}
+
+func (o *VMSizes) GetOnDemandSizes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.OnDemandSizes
+}
+
+func (o *VMSizes) GetSpotSizes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.SpotSizes
+}
+
+func (o *VMSizes) GetPreferredSpotSizes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.PreferredSpotSizes
+}
+
+func (o *VMSizes) GetSpotSizeAttributes() *SpotSizeAttributes {
+	if o == nil {
+		return nil
+	}
+	return o.SpotSizeAttributes
+}
+
+func (o *VMSizes) GetExcludedVmSizes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ExcludedVmSizes
+}
+
+func (o *VaultCertificates) GetCertificateStore() string {
+	if o == nil || o.CertificateStore == nil {
+		return ""
+	}
+	return *o.CertificateStore
+}
+
+func (o *VaultCertificates) GetCertificateUrl() string {
+	if o == nil || o.CertificateUrl == nil {
+		return ""
+	}
+	return *o.CertificateUrl
+}
@@ -0,0 +1,1218 @@
+// Code generated by gettergen. DO NOT EDIT.
+
+package gcp
+
+import (
+	"time"
+)
+
+func (o *AccessConfig) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *AccessConfig) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *Action) GetAdjustment() int {
+	if o == nil || o.Adjustment == nil {
+		return 0
+	}
+	return *o.Adjustment
+}
+
+func (o *Action) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *AliasIPRange) GetIPCIDRRange() string {
+	if o == nil || o.IPCIDRRange == nil {
+		return ""
+	}
+	return *o.IPCIDRRange
+}
+
+func (o *AliasIPRange) GetSubnetworkRangeName() string {
+	if o == nil || o.SubnetworkRangeName == nil {
+		return ""
+	}
+	return *o.SubnetworkRangeName
+}
+
+func (o *AutoScale) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *AutoScale) GetIsAutoConfig() bool {
+	if o == nil || o.IsAutoConfig == nil {
+		return false
+	}
+	return *o.IsAutoConfig
+}
+
+func (o *AutoScale) GetCooldown() int {
+	if o == nil || o.Cooldown == nil {
+		return 0
+	}
+	return *o.Cooldown
+}
+
+func (o *AutoScale) GetHeadroom() *AutoScaleHeadroom {
+	if o == nil {
+		return nil
+	}
+	return o.Headroom
+}
+
+func (o *AutoScale) GetDown() *AutoScaleDown {
+	if o == nil {
+		return nil
+	}
+	return o.Down
+}
+
+func (o *AutoScaleDown) GetEvaluationPeriods() int {
+	if o == nil || o.EvaluationPeriods == nil {
+		return 0
+	}
+	return *o.EvaluationPeriods
+}
+
+func (o *AutoScaleGKE) GetLabels() []*AutoScaleLabel {
+	if o == nil {
+		return nil
+	}
+	return o.Labels
+}
+
+func (o *AutoScaleHeadroom) GetCPUPerUnit() int {
+	if o == nil || o.CPUPerUnit == nil {
+		return 0
+	}
+	return *o.CPUPerUnit
+}
+
+func (o *AutoScaleHeadroom) GetMemoryPerUnit() int {
+	if o == nil || o.MemoryPerUnit == nil {
+		return 0
+	}
+	return *o.MemoryPerUnit
+}
+
+func (o *AutoScaleHeadroom) GetNumOfUnits() int {
+	if o == nil || o.NumOfUnits == nil {
+		return 0
+	}
+	return *o.NumOfUnits
+}
+
+func (o *AutoScaleLabel) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *AutoScaleLabel) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *BackendBalancing) GetBackendBalancingMode() string {
+	if o == nil || o.BackendBalancingMode == nil {
+		return ""
+	}
+	return *o.BackendBalancingMode
+}
+
+func (o *BackendBalancing) GetMaxRatePerInstance() int {
+	if o == nil || o.MaxRatePerInstance == nil {
+		return 0
+	}
+	return *o.MaxRatePerInstance
+}
+
+func (o *BackendService) GetBackendServiceName() string {
+	if o == nil || o.BackendServiceName == nil {
+		return ""
+	}
+	return *o.BackendServiceName
+}
+
+func (o *BackendService) GetLocationType() string {
+	if o == nil || o.LocationType == nil {
+		return ""
+	}
+	return *o.LocationType
+}
+
+func (o *BackendService) GetScheme() string {
+	if o == nil || o.Scheme == nil {
+		return ""
+	}
+	return *o.Scheme
+}
+
+func (o *BackendService) GetNamedPorts() *NamedPorts {
+	if o == nil {
+		return nil
+	}
+	return o.NamedPorts
+}
+
+func (o *BackendService) GetBackendBalancing() *BackendBalancing {
+	if o == nil {
+		return nil
+	}
+	return o.BackendBalancing
+}
+
+func (o *BackendServiceConfig) GetBackendServices() []*BackendService {
+	if o == nil {
+		return nil
+	}
+	return o.BackendServices
+}
+
+func (o *Capacity) GetMaximum() int {
+	if o == nil || o.Maximum == nil {
+		return 0
+	}
+	return *o.Maximum
+}
+
+func (o *Capacity) GetMinimum() int {
+	if o == nil || o.Minimum == nil {
+		return 0
+	}
+	return *o.Minimum
+}
+
+func (o *Capacity) GetTarget() int {
+	if o == nil || o.Target == nil {
+		return 0
+	}
+	return *o.Target
+}
+
+func (o *Compute) GetAvailabilityZones() []string {
+	if o == nil {
+		return nil
+	}
+	return o.AvailabilityZones
+}
+
+func (o *Compute) GetGPU() *GPU {
+	if o == nil {
+		return nil
+	}
+	return o.GPU
+}
+
+func (o *Compute) GetHealth() *Health {
+	if o == nil {
+		return nil
+	}
+	return o.Health
+}
+
+func (o *Compute) GetInstanceTypes() *InstanceTypes {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceTypes
+}
+
+func (o *Compute) GetLaunchConfiguration() *LaunchSpecification {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecification
+}
+
+func (o *Compute) GetSubnets() []*Subnet {
+	if o == nil {
+		return nil
+	}
+	return o.Subnets
+}
+
+func (o *Compute) GetPreferredAvailabilityZones() []string {
+	if o == nil {
+		return nil
+	}
+	return o.PreferredAvailabilityZones
+}
+
+func (o *CreateGroupInput) GetGroup() *Group {
+	if o == nil {
+		return nil
+	}
+	return o.Group
+}
+
+func (o *CreateGroupOutput) GetGroup() *Group {
+	if o == nil {
+		return nil
+	}
+	return o.Group
+}
+
+func (o *CustomInstance) GetVCPU() int {
+	if o == nil || o.VCPU == nil {
+		return 0
+	}
+	return *o.VCPU
+}
+
+func (o *CustomInstance) GetMemoryGiB() int {
+	if o == nil || o.MemoryGiB == nil {
+		return 0
+	}
+	return *o.MemoryGiB
+}
+
+func (o *DeleteGroupInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *Dimension) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Dimension) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *Disk) GetAutoDelete() bool {
+	if o == nil || o.AutoDelete == nil {
+		return false
+	}
+	return *o.AutoDelete
+}
+
+func (o *Disk) GetBoot() bool {
+	if o == nil || o.Boot == nil {
+		return false
+	}
+	return *o.Boot
+}
+
+func (o *Disk) GetDeviceName() string {
+	if o == nil || o.DeviceName == nil {
+		return ""
+	}
+	return *o.DeviceName
+}
+
+func (o *Disk) GetInitializeParams() *InitializeParams {
+	if o == nil {
+		return nil
+	}
+	return o.InitializeParams
+}
+
+func (o *Disk) GetInterface() string {
+	if o == nil || o.Interface == nil {
+		return ""
+	}
+	return *o.Interface
+}
+
+func (o *Disk) GetMode() string {
+	if o == nil || o.Mode == nil {
+		return ""
+	}
+	return *o.Mode
+}
+
+func (o *Disk) GetSource() string {
+	if o == nil || o.Source == nil {
+		return ""
+	}
+	return *o.Source
+}
+
+func (o *Disk) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *DockerSwarmIntegration) GetMasterHost() string {
+	if o == nil || o.MasterHost == nil {
+		return ""
+	}
+	return *o.MasterHost
+}
+
+func (o *DockerSwarmIntegration) GetMasterPort() int {
+	if o == nil || o.MasterPort == nil {
+		return 0
+	}
+	return *o.MasterPort
+}
+
+func (o *GKEIntegration) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *GKEIntegration) GetClusterZoneName() string {
+	if o == nil || o.ClusterZoneName == nil {
+		return ""
+	}
+	return *o.ClusterZoneName
+}
+
+func (o *GKEIntegration) GetAutoUpdate() bool {
+	if o == nil || o.AutoUpdate == nil {
+		return false
+	}
+	return *o.AutoUpdate
+}
+
+func (o *GKEIntegration) GetAutoScale() *AutoScaleGKE {
+	if o == nil {
+		return nil
+	}
+	return o.AutoScale
+}
+
+func (o *GKEIntegration) GetLocation() string {
+	if o == nil || o.Location == nil {
+		return ""
+	}
+	return *o.Location
+}
+
+func (o *GPU) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *GPU) GetCount() int {
+	if o == nil || o.Count == nil {
+		return 0
+	}
+	return *o.Count
+}
+
+func (o *Group) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *Group) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Group) GetDescription() string {
+	if o == nil || o.Description == nil {
+		return ""
+	}
+	return *o.Description
+}
+
+func (o *Group) GetNodeImage() string {
+	if o == nil || o.NodeImage == nil {
+		return ""
+	}
+	return *o.NodeImage
+}
+
+func (o *Group) GetCapacity() *Capacity {
+	if o == nil {
+		return nil
+	}
+	return o.Capacity
+}
+
+func (o *Group) GetCompute() *Compute {
+	if o == nil {
+		return nil
+	}
+	return o.Compute
+}
+
+func (o *Group) GetScaling() *Scaling {
+	if o == nil {
+		return nil
+	}
+	return o.Scaling
+}
+
+func (o *Group) GetScheduling() *Scheduling {
+	if o == nil {
+		return nil
+	}
+	return o.Scheduling
+}
+
+func (o *Group) GetStrategy() *Strategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategy
+}
+
+func (o *Group) GetIntegration() *Integration {
+	if o == nil {
+		return nil
+	}
+	return o.Integration
+}
+
+func (o *Group) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *Group) GetUpdatedAt() time.Time {
+	if o == nil || o.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *o.UpdatedAt
+}
+
+func (o *Health) GetAutoHealing() bool {
+	if o == nil || o.AutoHealing == nil {
+		return false
+	}
+	return *o.AutoHealing
+}
+
+func (o *Health) GetGracePeriod() int {
+	if o == nil || o.GracePeriod == nil {
+		return 0
+	}
+	return *o.GracePeriod
+}
+
+func (o *Health) GetHealthCheckType() string {
+	if o == nil || o.HealthCheckType == nil {
+		return ""
+	}
+	return *o.HealthCheckType
+}
+
+func (o *Health) GetUnhealthyDuration() int {
+	if o == nil || o.UnhealthyDuration == nil {
+		return 0
+	}
+	return *o.UnhealthyDuration
+}
+
+func (o *ImportGKEClusterInput) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *ImportGKEClusterInput) GetClusterZoneName() string {
+	if o == nil || o.ClusterZoneName == nil {
+		return ""
+	}
+	return *o.ClusterZoneName
+}
+
+func (o *ImportGKEClusterInput) GetDryRun() bool {
+	if o == nil || o.DryRun == nil {
+		return false
+	}
+	return *o.DryRun
+}
+
+func (o *ImportGKEClusterInput) GetGroup() *ImportGKEGroup {
+	if o == nil {
+		return nil
+	}
+	return o.Group
+}
+
+func (o *ImportGKEClusterOutput) GetGroup() *Group {
+	if o == nil {
+		return nil
+	}
+	return o.Group
+}
+
+func (o *ImportGKEGroup) GetAvailabilityZones() []string {
+	if o == nil {
+		return nil
+	}
+	return o.AvailabilityZones
+}
+
+func (o *ImportGKEGroup) GetCapacity() *CapacityGKE {
+	if o == nil {
+		return nil
+	}
+	return o.Capacity
+}
+
+func (o *ImportGKEGroup) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *ImportGKEGroup) GetInstanceTypes() *InstanceTypesGKE {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceTypes
+}
+
+func (o *ImportGKEGroup) GetPreemptiblePercentage() int {
+	if o == nil || o.PreemptiblePercentage == nil {
+		return 0
+	}
+	return *o.PreemptiblePercentage
+}
+
+func (o *ImportGKEGroup) GetNodeImage() string {
+	if o == nil || o.NodeImage == nil {
+		return ""
+	}
+	return *o.NodeImage
+}
+
+func (o *InitializeParams) GetDiskSizeGB() int {
+	if o == nil || o.DiskSizeGB == nil {
+		return 0
+	}
+	return *o.DiskSizeGB
+}
+
+func (o *InitializeParams) GetDiskType() string {
+	if o == nil || o.DiskType == nil {
+		return ""
+	}
+	return *o.DiskType
+}
+
+func (o *InitializeParams) GetSourceImage() string {
+	if o == nil || o.SourceImage == nil {
+		return ""
+	}
+	return *o.SourceImage
+}
+
+func (o *Instance) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *Instance) GetInstanceName() string {
+	if o == nil || o.InstanceName == nil {
+		return ""
+	}
+	return *o.InstanceName
+}
+
+func (o *Instance) GetLifeCycle() string {
+	if o == nil || o.LifeCycle == nil {
+		return ""
+	}
+	return *o.LifeCycle
+}
+
+func (o *Instance) GetMachineType() string {
+	if o == nil || o.MachineType == nil {
+		return ""
+	}
+	return *o.MachineType
+}
+
+func (o *Instance) GetPrivateIP() string {
+	if o == nil || o.PrivateIP == nil {
+		return ""
+	}
+	return *o.PrivateIP
+}
+
+func (o *Instance) GetPublicIP() string {
+	if o == nil || o.PublicIP == nil {
+		return ""
+	}
+	return *o.PublicIP
+}
+
+func (o *Instance) GetStatusName() string {
+	if o == nil || o.StatusName == nil {
+		return ""
+	}
+	return *o.StatusName
+}
+
+func (o *Instance) GetUpdatedAt() time.Time {
+	if o == nil || o.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *o.UpdatedAt
+}
+
+func (o *Instance) GetZone() string {
+	if o == nil || o.Zone == nil {
+		return ""
+	}
+	return *o.Zone
+}
+
+func (o *InstanceTypes) GetOnDemand() string {
+	if o == nil || o.OnDemand == nil {
+		return ""
+	}
+	return *o.OnDemand
+}
+
+func (o *InstanceTypes) GetPreemptible() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Preemptible
+}
+
+func (o *InstanceTypes) GetCustom() []*CustomInstance {
+	if o == nil {
+		return nil
+	}
+	return o.Custom
+}
+
+func (o *InstanceTypesGKE) GetOnDemand() string {
+	if o == nil || o.OnDemand == nil {
+		return ""
+	}
+	return *o.OnDemand
+}
+
+func (o *InstanceTypesGKE) GetPreemptible() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Preemptible
+}
+
+func (o *Integration) GetGKE() *GKEIntegration {
+	if o == nil {
+		return nil
+	}
+	return o.GKE
+}
+
+func (o *Integration) GetDockerSwarm() *DockerSwarmIntegration {
+	if o == nil {
+		return nil
+	}
+	return o.DockerSwarm
+}
+
+func (o *Label) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *Label) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *LaunchSpecification) GetBackendServiceConfig() *BackendServiceConfig {
+	if o == nil {
+		return nil
+	}
+	return o.BackendServiceConfig
+}
+
+func (o *LaunchSpecification) GetDisks() []*Disk {
+	if o == nil {
+		return nil
+	}
+	return o.Disks
+}
+
+func (o *LaunchSpecification) GetLabels() []*Label {
+	if o == nil {
+		return nil
+	}
+	return o.Labels
+}
+
+func (o *LaunchSpecification) GetIPForwarding() bool {
+	if o == nil || o.IPForwarding == nil {
+		return false
+	}
+	return *o.IPForwarding
+}
+
+func (o *LaunchSpecification) GetNetworkInterfaces() []*NetworkInterface {
+	if o == nil {
+		return nil
+	}
+	return o.NetworkInterfaces
+}
+
+func (o *LaunchSpecification) GetMetadata() []*Metadata {
+	if o == nil {
+		return nil
+	}
+	return o.Metadata
+}
+
+func (o *LaunchSpecification) GetServiceAccount() string {
+	if o == nil || o.ServiceAccount == nil {
+		return ""
+	}
+	return *o.ServiceAccount
+}
+
+func (o *LaunchSpecification) GetStartupScript() string {
+	if o == nil || o.StartupScript == nil {
+		return ""
+	}
+	return *o.StartupScript
+}
+
+func (o *LaunchSpecification) GetShutdownScript() string {
+	if o == nil || o.ShutdownScript == nil {
+		return ""
+	}
+	return *o.ShutdownScript
+}
+
+func (o *LaunchSpecification) GetTags() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Tags
+}
+
+func (o *LaunchSpecification) GetInstanceNamePrefix() string {
+	if o == nil || o.InstanceNamePrefix == nil {
+		return ""
+	}
+	return *o.InstanceNamePrefix
+}
+
+func (o *LaunchSpecification) GetMinCpuPlatform() string {
+	if o == nil || o.MinCpuPlatform == nil {
+		return ""
+	}
+	return *o.MinCpuPlatform
+}
+
+func (o *LaunchSpecification) GetShieldedInstanceConfig() *ShieldedInstanceConfig {
+	if o == nil {
+		return nil
+	}
+	return o.ShieldedInstanceConfig
+}
+
+func (o *ListGroupsOutput) GetGroups() []*Group {
+	if o == nil {
+		return nil
+	}
+	return o.Groups
+}
+
+func (o *Metadata) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *Metadata) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *NamedPorts) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *NamedPorts) GetPorts() []int {
+	if o == nil {
+		return nil
+	}
+	return o.Ports
+}
+
+func (o *NetworkInterface) GetAccessConfigs() []*AccessConfig {
+	if o == nil {
+		return nil
+	}
+	return o.AccessConfigs
+}
+
+func (o *NetworkInterface) GetAliasIPRanges() []*AliasIPRange {
+	if o == nil {
+		return nil
+	}
+	return o.AliasIPRanges
+}
+
+func (o *NetworkInterface) GetNetwork() string {
+	if o == nil || o.Network == nil {
+		return ""
+	}
+	return *o.Network
+}
+
+func (o *NetworkInterface) GetProjectId() string {
+	if o == nil || o.ProjectID == nil {
+		return ""
+	}
+	return *o.ProjectID
+}
+
+func (o *ReadGroupInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *ReadGroupOutput) GetGroup() *Group {
+	if o == nil {
+		return nil
+	}
+	return o.Group
+}
+
+func (o *RevertToPreemptible) GetPerformAt() string {
+	if o == nil || o.PerformAt == nil {
+		return ""
+	}
+	return *o.PerformAt
+}
+
+func (o *Scaling) GetUp() []*ScalingPolicy {
+	if o == nil {
+		return nil
+	}
+	return o.Up
+}
+
+func (o *Scaling) GetDown() []*ScalingPolicy {
+	if o == nil {
+		return nil
+	}
+	return o.Down
+}
+
+func (o *ScalingPolicy) GetAction() *Action {
+	if o == nil {
+		return nil
+	}
+	return o.Action
+}
+
+func (o *ScalingPolicy) GetCooldown() int {
+	if o == nil || o.Cooldown == nil {
+		return 0
+	}
+	return *o.Cooldown
+}
+
+func (o *ScalingPolicy) GetDimensions() []*Dimension {
+	if o == nil {
+		return nil
+	}
+	return o.Dimensions
+}
+
+func (o *ScalingPolicy) GetEvaluationPeriods() int {
+	if o == nil || o.EvaluationPeriods == nil {
+		return 0
+	}
+	return *o.EvaluationPeriods
+}
+
+func (o *ScalingPolicy) GetMetricName() string {
+	if o == nil || o.MetricName == nil {
+		return ""
+	}
+	return *o.MetricName
+}
+
+func (o *ScalingPolicy) GetNamespace() string {
+	if o == nil || o.Namespace == nil {
+		return ""
+	}
+	return *o.Namespace
+}
+
+func (o *ScalingPolicy) GetOperator() string {
+	if o == nil || o.Operator == nil {
+		return ""
+	}
+	return *o.Operator
+}
+
+func (o *ScalingPolicy) GetPeriod() int {
+	if o == nil || o.Period == nil {
+		return 0
+	}
+	return *o.Period
+}
+
+func (o *ScalingPolicy) GetPolicyName() string {
+	if o == nil || o.PolicyName == nil {
+		return ""
+	}
+	return *o.PolicyName
+}
+
+func (o *ScalingPolicy) GetSource() string {
+	if o == nil || o.Source == nil {
+		return ""
+	}
+	return *o.Source
+}
+
+func (o *ScalingPolicy) GetStatistic() string {
+	if o == nil || o.Statistic == nil {
+		return ""
+	}
+	return *o.Statistic
+}
+
+func (o *ScalingPolicy) GetThreshold() float64 {
+	if o == nil || o.Threshold == nil {
+		return 0
+	}
+	return *o.Threshold
+}
+
+func (o *ScalingPolicy) GetUnit() string {
+	if o == nil || o.Unit == nil {
+		return ""
+	}
+	return *o.Unit
+}
+
+func (o *Scheduling) GetTasks() []*Task {
+	if o == nil {
+		return nil
+	}
+	return o.Tasks
+}
+
+func (o *ShieldedInstanceConfig) GetEnableSecureBoot() bool {
+	if o == nil || o.EnableSecureBoot == nil {
+		return false
+	}
+	return *o.EnableSecureBoot
+}
+
+func (o *ShieldedInstanceConfig) GetEnableIntegrityMonitoring() bool {
+	if o == nil || o.EnableIntegrityMonitoring == nil {
+		return false
+	}
+	return *o.EnableIntegrityMonitoring
+}
+
+func (o *StatusGroupInput) GetGroupID() string {
+	if o == nil || o.GroupID == nil {
+		return ""
+	}
+	return *o.GroupID
+}
+
+func (o *StatusGroupOutput) GetInstances() []*Instance {
+	if o == nil {
+		return nil
+	}
+	return o.Instances
+}
+
+func (o *Strategy) GetDrainingTimeout() int {
+	if o == nil || o.DrainingTimeout == nil {
+		return 0
+	}
+	return *o.DrainingTimeout
+}
+
+func (o *Strategy) GetFallbackToOnDemand() bool {
+	if o == nil || o.FallbackToOnDemand == nil {
+		return false
+	}
+	return *o.FallbackToOnDemand
+}
+
+func (o *Strategy) GetPreemptiblePercentage() int {
+	if o == nil || o.PreemptiblePercentage == nil {
+		return 0
+	}
+	return *o.PreemptiblePercentage
+}
+
+func (o *Strategy) GetOnDemandCount() int {
+	if o == nil || o.OnDemandCount == nil {
+		return 0
+	}
+	return *o.OnDemandCount
+}
+
+func (o *Strategy) GetProvisioningModel() string {
+	if o == nil || o.ProvisioningModel == nil {
+		return ""
+	}
+	return *o.ProvisioningModel
+}
+
+func (o *Strategy) GetRevertToPreemptible() *RevertToPreemptible {
+	if o == nil {
+		return nil
+	}
+	return o.RevertToPreemptible
+}
+
+func (o *Strategy) GetOptimizationWindows() []string {
+	if o == nil {
+		return nil
+	}
+	return o.OptimizationWindows
+}
+
+func (o *Strategy) GetShouldUtilizeCommitments() bool {
+	if o == nil || o.ShouldUtilizeCommitments == nil {
+		return false
+	}
+	return *o.ShouldUtilizeCommitments
+}
+
+func (o *Subnet) GetRegion() string {
+	if o == nil || o.Region == nil {
+		return ""
+	}
+	return *o.Region
+}
+
+func (o *Subnet) GetSubnetNames() []string {
+	if o == nil {
+		return nil
+	}
+	return o.SubnetNames
+}
+
+func (o *Tag) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *Tag) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *Task) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *Task) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *Task) GetCronExpression() string {
+	if o == nil || o.CronExpression == nil {
+		return ""
+	}
+	return *o.CronExpression
+}
+
+func (o *Task) GetTargetCapacity() int {
+	if o == nil || o.TargetCapacity == nil {
+		return 0
+	}
+	return *o.TargetCapacity
+}
+
+func (o *Task) GetMinCapacity() int {
+	if o == nil || o.MinCapacity == nil {
+		return 0
+	}
+	return *o.MinCapacity
+}
+
+func (o *Task) GetMaxCapacity() int {
+	if o == nil || o.MaxCapacity == nil {
+		return 0
+	}
+	return *o.MaxCapacity
+}
+
+func (o *UpdateGroupInput) GetGroup() *Group {
+	if o == nil {
+		return nil
+	}
+	return o.Group
+}
+
+func (o *UpdateGroupOutput) GetGroup() *Group {
+	if o == nil {
+		return nil
+	}
+	return o.Group
+}
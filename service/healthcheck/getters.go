@@ -0,0 +1,150 @@
+// Code generated by gettergen. DO NOT EDIT.
+
+package healthcheck
+
+func (o *Check) GetProtocol() string {
+	if o == nil || o.Protocol == nil {
+		return ""
+	}
+	return *o.Protocol
+}
+
+func (o *Check) GetEndpoint() string {
+	if o == nil || o.Endpoint == nil {
+		return ""
+	}
+	return *o.Endpoint
+}
+
+func (o *Check) GetPort() int {
+	if o == nil || o.Port == nil {
+		return 0
+	}
+	return *o.Port
+}
+
+func (o *Check) GetInterval() int {
+	if o == nil || o.Interval == nil {
+		return 0
+	}
+	return *o.Interval
+}
+
+func (o *Check) GetTimeout() int {
+	if o == nil || o.Timeout == nil {
+		return 0
+	}
+	return *o.Timeout
+}
+
+func (o *Check) GetHealthy() int {
+	if o == nil || o.Healthy == nil {
+		return 0
+	}
+	return *o.Healthy
+}
+
+func (o *Check) GetUnhealthy() int {
+	if o == nil || o.Unhealthy == nil {
+		return 0
+	}
+	return *o.Unhealthy
+}
+
+func (o *CreateHealthCheckInput) GetHealthCheck() *HealthCheck {
+	if o == nil {
+		return nil
+	}
+	return o.HealthCheck
+}
+
+func (o *CreateHealthCheckOutput) GetHealthCheck() *HealthCheck {
+	if o == nil {
+		return nil
+	}
+	return o.HealthCheck
+}
+
+func (o *DeleteHealthCheckInput) GetHealthCheckID() string {
+	if o == nil || o.HealthCheckID == nil {
+		return ""
+	}
+	return *o.HealthCheckID
+}
+
+func (o *HealthCheck) GetId() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *HealthCheck) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *HealthCheck) GetResourceId() string {
+	if o == nil || o.ResourceID == nil {
+		return ""
+	}
+	return *o.ResourceID
+}
+
+func (o *HealthCheck) GetCheck() *Check {
+	if o == nil {
+		return nil
+	}
+	return o.Check
+}
+
+func (o *HealthCheck) GetProxyAddr() string {
+	if o == nil || o.ProxyAddr == nil {
+		return ""
+	}
+	return *o.ProxyAddr
+}
+
+func (o *HealthCheck) GetProxyPort() int {
+	if o == nil || o.ProxyPort == nil {
+		return 0
+	}
+	return *o.ProxyPort
+}
+
+func (o *ListHealthChecksOutput) GetHealthChecks() []*HealthCheck {
+	if o == nil {
+		return nil
+	}
+	return o.HealthChecks
+}
+
+func (o *ReadHealthCheckInput) GetHealthCheckID() string {
+	if o == nil || o.HealthCheckID == nil {
+		return ""
+	}
+	return *o.HealthCheckID
+}
+
+func (o *ReadHealthCheckOutput) GetHealthCheck() *HealthCheck {
+	if o == nil {
+		return nil
+	}
+	return o.HealthCheck
+}
+
+func (o *UpdateHealthCheckInput) GetHealthCheck() *HealthCheck {
+	if o == nil {
+		return nil
+	}
+	return o.HealthCheck
+}
+
+func (o *UpdateHealthCheckOutput) GetHealthCheck() *HealthCheck {
+	if o == nil {
+		return nil
+	}
+	return o.HealthCheck
+}
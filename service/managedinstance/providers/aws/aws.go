@@ -447,8 +447,20 @@ type StatusManagedInstanceOutput struct {
 type CostsManagedInstanceInput struct {
 	ManagedInstanceID *string `json:"managedInstanceId,omitempty"`
 	AggregationPeriod *string `json:"aggregationPeriod,omitempty"`
-	FromDate          *string `json:"fromDate,omitempty"`
-	ToDate            *string `json:"toDate,omitempty"`
+	FromDate          *string `json:"fromDate,omitempty"` // see WithFromDate
+	ToDate            *string `json:"toDate,omitempty"`   // see WithToDate
+}
+
+// WithFromDate sets FromDate to the calendar date of t, formatted as yyyy-mm-dd.
+func (i *CostsManagedInstanceInput) WithFromDate(t time.Time) *CostsManagedInstanceInput {
+	i.FromDate = spotinst.String(spotinst.FormatDate(t))
+	return i
+}
+
+// WithToDate sets ToDate to the calendar date of t, formatted as yyyy-mm-dd.
+func (i *CostsManagedInstanceInput) WithToDate(t time.Time) *CostsManagedInstanceInput {
+	i.ToDate = spotinst.String(spotinst.FormatDate(t))
+	return i
 }
 
 type CostsManagedInstanceOutput struct {
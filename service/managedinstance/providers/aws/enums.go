@@ -0,0 +1,189 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+)
+
+// A Status represents the status of a managed instance.
+type Status string
+
+const (
+	// StatusActive represents a running managed instance.
+	StatusActive Status = "ACTIVE"
+
+	// StatusPausing represents a managed instance being paused.
+	StatusPausing Status = "PAUSING"
+
+	// StatusPaused represents a paused managed instance.
+	StatusPaused Status = "PAUSED"
+
+	// StatusResuming represents a managed instance being resumed.
+	StatusResuming Status = "RESUMING"
+
+	// StatusRecycling represents a managed instance being recycled.
+	StatusRecycling Status = "RECYCLING"
+
+	// StatusError represents a managed instance in error.
+	StatusError Status = "ERROR"
+)
+
+var statuses = []Status{
+	StatusActive,
+	StatusPausing,
+	StatusPaused,
+	StatusResuming,
+	StatusRecycling,
+	StatusError,
+}
+
+// ParseStatus parses a string into a Status. The comparison is case
+// insensitive and an error is returned for unknown values.
+func ParseStatus(s string) (Status, error) {
+	for _, v := range statuses {
+		if strings.EqualFold(s, string(v)) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("spotinst: invalid managed instance status %q", s)
+}
+
+// IsValid reports whether the status is a known value.
+func (s Status) IsValid() bool {
+	_, err := ParseStatus(string(s))
+	return err == nil
+}
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// A LifeCycle represents the lifecycle a managed instance should run on.
+type LifeCycle string
+
+const (
+	// LifeCycleSpot runs the managed instance on spot capacity.
+	LifeCycleSpot LifeCycle = "spot"
+
+	// LifeCycleOnDemand runs the managed instance on on-demand capacity.
+	LifeCycleOnDemand LifeCycle = "on_demand"
+)
+
+var lifeCycles = []LifeCycle{
+	LifeCycleSpot,
+	LifeCycleOnDemand,
+}
+
+// ParseLifeCycle parses a string into a LifeCycle. The comparison is case
+// insensitive and an error is returned for unknown values.
+func ParseLifeCycle(s string) (LifeCycle, error) {
+	for _, v := range lifeCycles {
+		if strings.EqualFold(s, string(v)) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("spotinst: invalid lifecycle %q", s)
+}
+
+// IsValid reports whether the lifecycle is a known value.
+func (l LifeCycle) IsValid() bool {
+	_, err := ParseLifeCycle(string(l))
+	return err == nil
+}
+
+func (l LifeCycle) String() string {
+	return string(l)
+}
+
+// An Orientation represents how markets are chosen for a managed instance.
+type Orientation string
+
+const (
+	// OrientationBalanced balances between availability and cost.
+	OrientationBalanced Orientation = "balanced"
+
+	// OrientationCostOriented prefers cheaper markets.
+	OrientationCostOriented Orientation = "costOriented"
+
+	// OrientationAvailabilityOriented prefers markets with the lowest
+	// interruption rate.
+	OrientationAvailabilityOriented Orientation = "availabilityOriented"
+
+	// OrientationCheapest always chooses the cheapest market.
+	OrientationCheapest Orientation = "cheapest"
+)
+
+var orientations = []Orientation{
+	OrientationBalanced,
+	OrientationCostOriented,
+	OrientationAvailabilityOriented,
+	OrientationCheapest,
+}
+
+// ParseOrientation parses a string into an Orientation. The comparison is
+// case insensitive and an error is returned for unknown values.
+func ParseOrientation(s string) (Orientation, error) {
+	for _, v := range orientations {
+		if strings.EqualFold(s, string(v)) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("spotinst: invalid orientation %q", s)
+}
+
+// IsValid reports whether the orientation is a known value.
+func (o Orientation) IsValid() bool {
+	_, err := ParseOrientation(string(o))
+	return err == nil
+}
+
+func (o Orientation) String() string {
+	return string(o)
+}
+
+// LifeCycleEnum returns the LifeCycle field as a typed LifeCycle, or an
+// empty LifeCycle if it isn't set.
+func (o *Strategy) LifeCycleEnum() LifeCycle {
+	if o == nil || o.LifeCycle == nil {
+		return ""
+	}
+	return LifeCycle(*o.LifeCycle)
+}
+
+// SetLifeCycleEnum sets the LifeCycle field to v, or clears it if v is
+// empty.
+func (o *Strategy) SetLifeCycleEnum(v LifeCycle) *Strategy {
+	if v == "" {
+		return o.SetLifeCycle(nil)
+	}
+	return o.SetLifeCycle(spotinst.String(string(v)))
+}
+
+// OrientationEnum returns the Orientation field as a typed Orientation, or
+// an empty Orientation if it isn't set.
+func (o *Strategy) OrientationEnum() Orientation {
+	if o == nil || o.Orientation == nil {
+		return ""
+	}
+	return Orientation(*o.Orientation)
+}
+
+// SetOrientationEnum sets the Orientation field to v, or clears it if v is
+// empty.
+func (o *Strategy) SetOrientationEnum(v Orientation) *Strategy {
+	if v == "" {
+		return o.SetOrientation(nil)
+	}
+	return o.SetOrientation(spotinst.String(string(v)))
+}
+
+// StatusEnum returns the Status field as a typed Status, or an empty Status
+// if it isn't set.
+func (o *StatusManagedInstanceOutput) StatusEnum() Status {
+	if o == nil || o.Status == nil {
+		return ""
+	}
+	return Status(*o.Status)
+}
@@ -0,0 +1,76 @@
+package aws
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type enumCase struct {
+	in, want string
+	ok       bool
+}
+
+func TestParseEnums(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		parse func(string) (string, bool, error)
+		cases []enumCase
+	}{
+		{"Status", func(s string) (string, bool, error) {
+			v, err := ParseStatus(s)
+			return string(v), v.IsValid(), err
+		}, []enumCase{
+			{"ERROR", "ERROR", true},
+			{"error", "ERROR", true},
+			{"bogus", "", false},
+			{"", "", false},
+		}},
+		{"LifeCycle", func(s string) (string, bool, error) {
+			v, err := ParseLifeCycle(s)
+			return string(v), v.IsValid(), err
+		}, []enumCase{
+			{"on_demand", "on_demand", true},
+			{"ON_DEMAND", "on_demand", true},
+			{"bogus", "", false},
+			{"", "", false},
+		}},
+		{"Orientation", func(s string) (string, bool, error) {
+			v, err := ParseOrientation(s)
+			return string(v), v.IsValid(), err
+		}, []enumCase{
+			{"cheapest", "cheapest", true},
+			{"CHEAPEST", "cheapest", true},
+			{"bogus", "", false},
+			{"", "", false},
+		}},
+	} {
+		for _, c := range tt.cases {
+			got, valid, err := tt.parse(c.in)
+			if (err == nil) != c.ok || got != c.want || valid != c.ok {
+				t.Errorf("Parse%s(%q) = %q (valid %v), %v; want %q, ok %v", tt.name, c.in, got, valid, err, c.want, c.ok)
+			}
+		}
+	}
+}
+
+func TestEnumAccessors(t *testing.T) {
+	var o *Strategy
+	if got := o.OrientationEnum(); got != "" {
+		t.Errorf("got %q from nil Strategy, want empty", got)
+	}
+
+	o = new(Strategy).SetOrientationEnum(OrientationCheapest)
+	if got := o.OrientationEnum(); got != OrientationCheapest {
+		t.Errorf("got %q, want %q", got, OrientationCheapest)
+	}
+
+	// An empty value clears the field.
+	o.SetOrientationEnum("")
+	b, err := json.Marshal(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), `{"orientation":null}`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
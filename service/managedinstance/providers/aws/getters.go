@@ -0,0 +1,1127 @@
+// Code generated by gettergen. DO NOT EDIT.
+
+package aws
+
+import (
+	"time"
+)
+
+func (o *AMIBackup) GetShouldDeleteImages() bool {
+	if o == nil || o.ShouldDeleteImages == nil {
+		return false
+	}
+	return *o.ShouldDeleteImages
+}
+
+func (o *AMIs) GetShouldTag() bool {
+	if o == nil || o.ShouldTag == nil {
+		return false
+	}
+	return *o.ShouldTag
+}
+
+func (o *BlockDeviceMapping) GetDeviceName() string {
+	if o == nil || o.DeviceName == nil {
+		return ""
+	}
+	return *o.DeviceName
+}
+
+func (o *BlockDeviceMapping) GetEBS() *EBS {
+	if o == nil {
+		return nil
+	}
+	return o.EBS
+}
+
+func (o *Compute) GetProduct() string {
+	if o == nil || o.Product == nil {
+		return ""
+	}
+	return *o.Product
+}
+
+func (o *Compute) GetElasticIP() string {
+	if o == nil || o.ElasticIP == nil {
+		return ""
+	}
+	return *o.ElasticIP
+}
+
+func (o *Compute) GetPrivateIP() string {
+	if o == nil || o.PrivateIP == nil {
+		return ""
+	}
+	return *o.PrivateIP
+}
+
+func (o *Compute) GetSubnetIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.SubnetIDs
+}
+
+func (o *Compute) GetVpcId() string {
+	if o == nil || o.VpcID == nil {
+		return ""
+	}
+	return *o.VpcID
+}
+
+func (o *Compute) GetLaunchSpecification() *LaunchSpecification {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecification
+}
+
+func (o *Costs) GetActual() float32 {
+	if o == nil || o.Actual == nil {
+		return 0
+	}
+	return *o.Actual
+}
+
+func (o *Costs) GetPotential() float32 {
+	if o == nil || o.Potential == nil {
+		return 0
+	}
+	return *o.Potential
+}
+
+func (o *CostsManagedInstanceInput) GetManagedInstanceID() string {
+	if o == nil || o.ManagedInstanceID == nil {
+		return ""
+	}
+	return *o.ManagedInstanceID
+}
+
+func (o *CostsManagedInstanceInput) GetAggregationPeriod() string {
+	if o == nil || o.AggregationPeriod == nil {
+		return ""
+	}
+	return *o.AggregationPeriod
+}
+
+func (o *CostsManagedInstanceInput) GetFromDate() string {
+	if o == nil || o.FromDate == nil {
+		return ""
+	}
+	return *o.FromDate
+}
+
+func (o *CostsManagedInstanceInput) GetToDate() string {
+	if o == nil || o.ToDate == nil {
+		return ""
+	}
+	return *o.ToDate
+}
+
+func (o *CostsManagedInstanceOutput) GetCosts() *Costs {
+	if o == nil {
+		return nil
+	}
+	return o.Costs
+}
+
+func (o *CostsManagedInstanceOutput) GetRunning() *UnitValue {
+	if o == nil {
+		return nil
+	}
+	return o.Running
+}
+
+func (o *CostsManagedInstanceOutput) GetSavings() *UnitValue {
+	if o == nil {
+		return nil
+	}
+	return o.Savings
+}
+
+func (o *CreateManagedInstanceInput) GetManagedInstance() *ManagedInstance {
+	if o == nil {
+		return nil
+	}
+	return o.ManagedInstance
+}
+
+func (o *CreateManagedInstanceOutput) GetManagedInstance() *ManagedInstance {
+	if o == nil {
+		return nil
+	}
+	return o.ManagedInstance
+}
+
+func (o *CreditSpecification) GetCPUCredits() string {
+	if o == nil || o.CPUCredits == nil {
+		return ""
+	}
+	return *o.CPUCredits
+}
+
+func (o *DeallocationConfig) GetShouldDeleteImages() bool {
+	if o == nil || o.ShouldDeleteImages == nil {
+		return false
+	}
+	return *o.ShouldDeleteImages
+}
+
+func (o *DeallocationConfig) GetShouldDeleteNetworkInterfaces() bool {
+	if o == nil || o.ShouldDeleteNetworkInterfaces == nil {
+		return false
+	}
+	return *o.ShouldDeleteNetworkInterfaces
+}
+
+func (o *DeallocationConfig) GetShouldDeleteSnapshots() bool {
+	if o == nil || o.ShouldDeleteSnapshots == nil {
+		return false
+	}
+	return *o.ShouldDeleteSnapshots
+}
+
+func (o *DeallocationConfig) GetShouldDeleteVolumes() bool {
+	if o == nil || o.ShouldDeleteVolumes == nil {
+		return false
+	}
+	return *o.ShouldDeleteVolumes
+}
+
+func (o *DeallocationConfig) GetShouldTerminateInstance() bool {
+	if o == nil || o.ShouldTerminateInstance == nil {
+		return false
+	}
+	return *o.ShouldTerminateInstance
+}
+
+func (o *DeleteManagedInstanceInput) GetManagedInstanceID() string {
+	if o == nil || o.ManagedInstanceID == nil {
+		return ""
+	}
+	return *o.ManagedInstanceID
+}
+
+func (o *DeleteManagedInstanceInput) GetAMIBackup() *AMIBackup {
+	if o == nil {
+		return nil
+	}
+	return o.AMIBackup
+}
+
+func (o *DeleteManagedInstanceInput) GetDeallocationConfig() *DeallocationConfig {
+	if o == nil {
+		return nil
+	}
+	return o.DeallocationConfig
+}
+
+func (o *Domain) GetHostedZoneId() string {
+	if o == nil || o.HostedZoneID == nil {
+		return ""
+	}
+	return *o.HostedZoneID
+}
+
+func (o *Domain) GetSpotinstAccountId() string {
+	if o == nil || o.SpotinstAccountID == nil {
+		return ""
+	}
+	return *o.SpotinstAccountID
+}
+
+func (o *Domain) GetRecordSetType() string {
+	if o == nil || o.RecordSetType == nil {
+		return ""
+	}
+	return *o.RecordSetType
+}
+
+func (o *Domain) GetRecordSets() []*RecordSet {
+	if o == nil {
+		return nil
+	}
+	return o.RecordSets
+}
+
+func (o *EBS) GetDeleteOnTermination() bool {
+	if o == nil || o.DeleteOnTermination == nil {
+		return false
+	}
+	return *o.DeleteOnTermination
+}
+
+func (o *EBS) GetVolumeType() string {
+	if o == nil || o.VolumeType == nil {
+		return ""
+	}
+	return *o.VolumeType
+}
+
+func (o *EBS) GetIOPS() int {
+	if o == nil || o.IOPS == nil {
+		return 0
+	}
+	return *o.IOPS
+}
+
+func (o *EBS) GetVolumeSize() int {
+	if o == nil || o.VolumeSize == nil {
+		return 0
+	}
+	return *o.VolumeSize
+}
+
+func (o *EBS) GetThroughput() int {
+	if o == nil || o.Throughput == nil {
+		return 0
+	}
+	return *o.Throughput
+}
+
+func (o *EBS) GetEncrypted() bool {
+	if o == nil || o.Encrypted == nil {
+		return false
+	}
+	return *o.Encrypted
+}
+
+func (o *EBS) GetKmsKeyId() string {
+	if o == nil || o.KmsKeyId == nil {
+		return ""
+	}
+	return *o.KmsKeyId
+}
+
+func (o *EBS) GetSnapshotId() string {
+	if o == nil || o.SnapshotID == nil {
+		return ""
+	}
+	return *o.SnapshotID
+}
+
+func (o *ENIs) GetShouldTag() bool {
+	if o == nil || o.ShouldTag == nil {
+		return false
+	}
+	return *o.ShouldTag
+}
+
+func (o *HealthCheck) GetHealthCheckType() string {
+	if o == nil || o.HealthCheckType == nil {
+		return ""
+	}
+	return *o.HealthCheckType
+}
+
+func (o *HealthCheck) GetGracePeriod() int {
+	if o == nil || o.GracePeriod == nil {
+		return 0
+	}
+	return *o.GracePeriod
+}
+
+func (o *HealthCheck) GetUnhealthyDuration() int {
+	if o == nil || o.UnhealthyDuration == nil {
+		return 0
+	}
+	return *o.UnhealthyDuration
+}
+
+func (o *HealthCheck) GetAutoHealing() bool {
+	if o == nil || o.AutoHealing == nil {
+		return false
+	}
+	return *o.AutoHealing
+}
+
+func (o *IAMInstanceProfile) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *IAMInstanceProfile) GetArn() string {
+	if o == nil || o.Arn == nil {
+		return ""
+	}
+	return *o.Arn
+}
+
+func (o *InstanceTypes) GetPreferredType() string {
+	if o == nil || o.PreferredType == nil {
+		return ""
+	}
+	return *o.PreferredType
+}
+
+func (o *InstanceTypes) GetInstanceTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Types
+}
+
+func (o *InstanceTypes) GetPreferredTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.PreferredTypes
+}
+
+func (o *InstanceTypes) GetResourceRequirements() *ResourceRequirements {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceRequirements
+}
+
+func (o *Integration) GetLoadBalancersConfig() *LoadBalancersConfig {
+	if o == nil {
+		return nil
+	}
+	return o.LoadBalancersConfig
+}
+
+func (o *Integration) GetRoute53() *Route53Integration {
+	if o == nil {
+		return nil
+	}
+	return o.Route53
+}
+
+func (o *LaunchSpecification) GetSecurityGroupIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.SecurityGroupIDs
+}
+
+func (o *LaunchSpecification) GetImageId() string {
+	if o == nil || o.ImageID == nil {
+		return ""
+	}
+	return *o.ImageID
+}
+
+func (o *LaunchSpecification) GetKeyPair() string {
+	if o == nil || o.KeyPair == nil {
+		return ""
+	}
+	return *o.KeyPair
+}
+
+func (o *LaunchSpecification) GetUserData() string {
+	if o == nil || o.UserData == nil {
+		return ""
+	}
+	return *o.UserData
+}
+
+func (o *LaunchSpecification) GetShutdownScript() string {
+	if o == nil || o.ShutdownScript == nil {
+		return ""
+	}
+	return *o.ShutdownScript
+}
+
+func (o *LaunchSpecification) GetTenancy() string {
+	if o == nil || o.Tenancy == nil {
+		return ""
+	}
+	return *o.Tenancy
+}
+
+func (o *LaunchSpecification) GetMonitoring() bool {
+	if o == nil || o.Monitoring == nil {
+		return false
+	}
+	return *o.Monitoring
+}
+
+func (o *LaunchSpecification) GetEBSOptimized() bool {
+	if o == nil || o.EBSOptimized == nil {
+		return false
+	}
+	return *o.EBSOptimized
+}
+
+func (o *LaunchSpecification) GetInstanceTypes() *InstanceTypes {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceTypes
+}
+
+func (o *LaunchSpecification) GetCreditSpecification() *CreditSpecification {
+	if o == nil {
+		return nil
+	}
+	return o.CreditSpecification
+}
+
+func (o *LaunchSpecification) GetIAMInstanceProfile() *IAMInstanceProfile {
+	if o == nil {
+		return nil
+	}
+	return o.IAMInstanceProfile
+}
+
+func (o *LaunchSpecification) GetNetworkInterfaces() []*NetworkInterface {
+	if o == nil {
+		return nil
+	}
+	return o.NetworkInterfaces
+}
+
+func (o *LaunchSpecification) GetTags() []*Tag {
+	if o == nil {
+		return nil
+	}
+	return o.Tags
+}
+
+func (o *LaunchSpecification) GetBlockDeviceMappings() []*BlockDeviceMapping {
+	if o == nil {
+		return nil
+	}
+	return o.BlockDeviceMappings
+}
+
+func (o *LaunchSpecification) GetResourceTagSpecification() *ResourceTagSpecification {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceTagSpecification
+}
+
+func (o *LaunchSpecification) GetMetadataOptions() *MetadataOptions {
+	if o == nil {
+		return nil
+	}
+	return o.MetadataOptions
+}
+
+func (o *ListManagedInstancesOutput) GetManagedInstances() []*ManagedInstance {
+	if o == nil {
+		return nil
+	}
+	return o.ManagedInstances
+}
+
+func (o *LoadBalancer) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *LoadBalancer) GetArn() string {
+	if o == nil || o.Arn == nil {
+		return ""
+	}
+	return *o.Arn
+}
+
+func (o *LoadBalancer) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *LoadBalancersConfig) GetLoadBalancers() []*LoadBalancer {
+	if o == nil {
+		return nil
+	}
+	return o.LoadBalancers
+}
+
+func (o *ManagedInstance) GetId() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *ManagedInstance) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *ManagedInstance) GetDescription() string {
+	if o == nil || o.Description == nil {
+		return ""
+	}
+	return *o.Description
+}
+
+func (o *ManagedInstance) GetRegion() string {
+	if o == nil || o.Region == nil {
+		return ""
+	}
+	return *o.Region
+}
+
+func (o *ManagedInstance) GetStrategy() *Strategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategy
+}
+
+func (o *ManagedInstance) GetCompute() *Compute {
+	if o == nil {
+		return nil
+	}
+	return o.Compute
+}
+
+func (o *ManagedInstance) GetPersistence() *Persistence {
+	if o == nil {
+		return nil
+	}
+	return o.Persistence
+}
+
+func (o *ManagedInstance) GetHealthCheck() *HealthCheck {
+	if o == nil {
+		return nil
+	}
+	return o.HealthCheck
+}
+
+func (o *ManagedInstance) GetScheduling() *Scheduling {
+	if o == nil {
+		return nil
+	}
+	return o.Scheduling
+}
+
+func (o *ManagedInstance) GetIntegration() *Integration {
+	if o == nil {
+		return nil
+	}
+	return o.Integration
+}
+
+func (o *ManagedInstance) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *ManagedInstance) GetUpdatedAt() time.Time {
+	if o == nil || o.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *o.UpdatedAt
+}
+
+func (o *MetadataOptions) GetHttpPutResponseHopLimit() int {
+	if o == nil || o.HttpPutResponseHopLimit == nil {
+		return 0
+	}
+	return *o.HttpPutResponseHopLimit
+}
+
+func (o *MetadataOptions) GetHttpTokens() string {
+	if o == nil || o.HttpTokens == nil {
+		return ""
+	}
+	return *o.HttpTokens
+}
+
+func (o *MetadataOptions) GetInstanceMetadataTags() string {
+	if o == nil || o.InstanceMetadataTags == nil {
+		return ""
+	}
+	return *o.InstanceMetadataTags
+}
+
+func (o *NetworkInterface) GetId() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *NetworkInterface) GetDeviceIndex() int {
+	if o == nil || o.DeviceIndex == nil {
+		return 0
+	}
+	return *o.DeviceIndex
+}
+
+func (o *NetworkInterface) GetAssociatePublicIPAddress() bool {
+	if o == nil || o.AssociatePublicIPAddress == nil {
+		return false
+	}
+	return *o.AssociatePublicIPAddress
+}
+
+func (o *NetworkInterface) GetAssociateIPV6Address() bool {
+	if o == nil || o.AssociateIPV6Address == nil {
+		return false
+	}
+	return *o.AssociateIPV6Address
+}
+
+func (o *PauseManagedInstanceInput) GetManagedInstanceID() string {
+	if o == nil || o.ManagedInstanceID == nil {
+		return ""
+	}
+	return *o.ManagedInstanceID
+}
+
+func (o *Persistence) GetShouldPersistBlockDevices() bool {
+	if o == nil || o.PersistBlockDevices == nil {
+		return false
+	}
+	return *o.PersistBlockDevices
+}
+
+func (o *Persistence) GetShouldPersistRootDevice() bool {
+	if o == nil || o.PersistRootDevice == nil {
+		return false
+	}
+	return *o.PersistRootDevice
+}
+
+func (o *Persistence) GetPersistPrivateIP() bool {
+	if o == nil || o.PersistPrivateIP == nil {
+		return false
+	}
+	return *o.PersistPrivateIP
+}
+
+func (o *Persistence) GetBlockDevicesMode() string {
+	if o == nil || o.BlockDevicesMode == nil {
+		return ""
+	}
+	return *o.BlockDevicesMode
+}
+
+func (o *ReadManagedInstanceInput) GetManagedInstanceID() string {
+	if o == nil || o.ManagedInstanceID == nil {
+		return ""
+	}
+	return *o.ManagedInstanceID
+}
+
+func (o *ReadManagedInstanceOutput) GetManagedInstance() *ManagedInstance {
+	if o == nil {
+		return nil
+	}
+	return o.ManagedInstance
+}
+
+func (o *RecordSet) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *RecordSet) GetUsePublicIP() bool {
+	if o == nil || o.UsePublicIP == nil {
+		return false
+	}
+	return *o.UsePublicIP
+}
+
+func (o *RecordSet) GetUsePublicDNS() bool {
+	if o == nil || o.UsePublicDNS == nil {
+		return false
+	}
+	return *o.UsePublicDNS
+}
+
+func (o *RecycleManagedInstanceInput) GetManagedInstanceID() string {
+	if o == nil || o.ManagedInstanceID == nil {
+		return ""
+	}
+	return *o.ManagedInstanceID
+}
+
+func (o *RequiredGpu) GetMaximum() int {
+	if o == nil || o.Maximum == nil {
+		return 0
+	}
+	return *o.Maximum
+}
+
+func (o *RequiredGpu) GetMinimum() int {
+	if o == nil || o.Minimum == nil {
+		return 0
+	}
+	return *o.Minimum
+}
+
+func (o *RequiredMemory) GetMaximum() int {
+	if o == nil || o.Maximum == nil {
+		return 0
+	}
+	return *o.Maximum
+}
+
+func (o *RequiredMemory) GetMinimum() int {
+	if o == nil || o.Minimum == nil {
+		return 0
+	}
+	return *o.Minimum
+}
+
+func (o *RequiredVCpu) GetMaximum() int {
+	if o == nil || o.Maximum == nil {
+		return 0
+	}
+	return *o.Maximum
+}
+
+func (o *RequiredVCpu) GetMinimum() int {
+	if o == nil || o.Minimum == nil {
+		return 0
+	}
+	return *o.Minimum
+}
+
+func (o *ResourceRequirements) GetExcludedInstanceFamilies() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ExcludedInstanceFamilies
+}
+
+func (o *ResourceRequirements) GetExcludedInstanceGenerations() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ExcludedInstanceGenerations
+}
+
+func (o *ResourceRequirements) GetExcludedInstanceTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ExcludedInstanceTypes
+}
+
+func (o *ResourceRequirements) GetRequiredGpu() *RequiredGpu {
+	if o == nil {
+		return nil
+	}
+	return o.RequiredGpu
+}
+
+func (o *ResourceRequirements) GetRequiredMemory() *RequiredMemory {
+	if o == nil {
+		return nil
+	}
+	return o.RequiredMemory
+}
+
+func (o *ResourceRequirements) GetRequiredVCpu() *RequiredVCpu {
+	if o == nil {
+		return nil
+	}
+	return o.RequiredVCpu
+}
+
+func (o *ResourceTagSpecification) GetVolumes() *Volumes {
+	if o == nil {
+		return nil
+	}
+	return o.Volumes
+}
+
+func (o *ResourceTagSpecification) GetSnapshots() *Snapshots {
+	if o == nil {
+		return nil
+	}
+	return o.Snapshots
+}
+
+func (o *ResourceTagSpecification) GetENIs() *ENIs {
+	if o == nil {
+		return nil
+	}
+	return o.ENIs
+}
+
+func (o *ResourceTagSpecification) GetAMIs() *AMIs {
+	if o == nil {
+		return nil
+	}
+	return o.AMIs
+}
+
+func (o *ResumeManagedInstanceInput) GetManagedInstanceID() string {
+	if o == nil || o.ManagedInstanceID == nil {
+		return ""
+	}
+	return *o.ManagedInstanceID
+}
+
+func (o *RevertToSpot) GetPerformAt() string {
+	if o == nil || o.PerformAt == nil {
+		return ""
+	}
+	return *o.PerformAt
+}
+
+func (o *Route53Integration) GetDomains() []*Domain {
+	if o == nil {
+		return nil
+	}
+	return o.Domains
+}
+
+func (o *Scheduling) GetTasks() []*Task {
+	if o == nil {
+		return nil
+	}
+	return o.Tasks
+}
+
+func (o *Snapshots) GetShouldTag() bool {
+	if o == nil || o.ShouldTag == nil {
+		return false
+	}
+	return *o.ShouldTag
+}
+
+func (o *StatusManagedInstanceInput) GetManagedInstanceID() string {
+	if o == nil || o.ManagedInstanceID == nil {
+		return ""
+	}
+	return *o.ManagedInstanceID
+}
+
+func (o *StatusManagedInstanceOutput) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *StatusManagedInstanceOutput) GetImageID() string {
+	if o == nil || o.ImageID == nil {
+		return ""
+	}
+	return *o.ImageID
+}
+
+func (o *StatusManagedInstanceOutput) GetInstanceID() string {
+	if o == nil || o.InstanceID == nil {
+		return ""
+	}
+	return *o.InstanceID
+}
+
+func (o *StatusManagedInstanceOutput) GetInstanceType() string {
+	if o == nil || o.InstanceType == nil {
+		return ""
+	}
+	return *o.InstanceType
+}
+
+func (o *StatusManagedInstanceOutput) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *StatusManagedInstanceOutput) GetPrivateIP() string {
+	if o == nil || o.PrivateIP == nil {
+		return ""
+	}
+	return *o.PrivateIP
+}
+
+func (o *StatusManagedInstanceOutput) GetPublicIP() string {
+	if o == nil || o.PublicIP == nil {
+		return ""
+	}
+	return *o.PublicIP
+}
+
+func (o *StatusManagedInstanceOutput) GetStatus() string {
+	if o == nil || o.Status == nil {
+		return ""
+	}
+	return *o.Status
+}
+
+func (o *StatusManagedInstanceOutput) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *StatusManagedInstanceOutput) GetLaunchedAt() time.Time {
+	if o == nil || o.LaunchedAt == nil {
+		return time.Time{}
+	}
+	return *o.LaunchedAt
+}
+
+func (o *StatusManagedInstanceOutput) GetIPv6Address() string {
+	if o == nil || o.IPv6Address == nil {
+		return ""
+	}
+	return *o.IPv6Address
+}
+
+func (o *Strategy) GetLifeCycle() string {
+	if o == nil || o.LifeCycle == nil {
+		return ""
+	}
+	return *o.LifeCycle
+}
+
+func (o *Strategy) GetOrientation() string {
+	if o == nil || o.Orientation == nil {
+		return ""
+	}
+	return *o.Orientation
+}
+
+func (o *Strategy) GetDrainingTimeout() int {
+	if o == nil || o.DrainingTimeout == nil {
+		return 0
+	}
+	return *o.DrainingTimeout
+}
+
+func (o *Strategy) GetFallbackToOnDemand() bool {
+	if o == nil || o.FallbackToOnDemand == nil {
+		return false
+	}
+	return *o.FallbackToOnDemand
+}
+
+func (o *Strategy) GetUtilizeReservedInstances() bool {
+	if o == nil || o.UtilizeReservedInstances == nil {
+		return false
+	}
+	return *o.UtilizeReservedInstances
+}
+
+func (o *Strategy) GetOptimizationWindows() []string {
+	if o == nil {
+		return nil
+	}
+	return o.OptimizationWindows
+}
+
+func (o *Strategy) GetRevertToSpot() *RevertToSpot {
+	if o == nil {
+		return nil
+	}
+	return o.RevertToSpot
+}
+
+func (o *Strategy) GetMinimumInstanceLifetime() int {
+	if o == nil || o.MinimumInstanceLifetime == nil {
+		return 0
+	}
+	return *o.MinimumInstanceLifetime
+}
+
+func (o *Tag) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *Tag) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *Task) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *Task) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *Task) GetFrequency() string {
+	if o == nil || o.Frequency == nil {
+		return ""
+	}
+	return *o.Frequency
+}
+
+func (o *Task) GetCronExpression() string {
+	if o == nil || o.CronExpression == nil {
+		return ""
+	}
+	return *o.CronExpression
+}
+
+func (o *Task) GetStartTime() string {
+	if o == nil || o.StartTime == nil {
+		return ""
+	}
+	return *o.StartTime
+}
+
+func (o *UnitValue) GetUnit() string {
+	if o == nil || o.Unit == nil {
+		return ""
+	}
+	return *o.Unit
+}
+
+func (o *UnitValue) GetValue() float32 {
+	if o == nil || o.Value == nil {
+		return 0
+	}
+	return *o.Value
+}
+
+func (o *UpdateManagedInstanceInput) GetManagedInstance() *ManagedInstance {
+	if o == nil {
+		return nil
+	}
+	return o.ManagedInstance
+}
+
+func (o *UpdateManagedInstanceInput) GetAutoApplyTags() bool {
+	if o == nil || o.AutoApplyTags == nil {
+		return false
+	}
+	return *o.AutoApplyTags
+}
+
+func (o *UpdateManagedInstanceOutput) GetManagedInstance() *ManagedInstance {
+	if o == nil {
+		return nil
+	}
+	return o.ManagedInstance
+}
+
+func (o *Volumes) GetShouldTag() bool {
+	if o == nil || o.ShouldTag == nil {
+		return false
+	}
+	return *o.ShouldTag
+}
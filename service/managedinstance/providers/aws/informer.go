@@ -0,0 +1,40 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/informer"
+)
+
+// NewManagedInstanceInformer returns an informer that lists managed
+// instances. The list, key and version functions of cfg are set, and its
+// indexers are merged with indexes by name, region and tag. Objects are of
+// type *ManagedInstance.
+func (s *ServiceOp) NewManagedInstanceInformer(cfg informer.Config) *informer.Informer {
+	cfg.List = func(ctx context.Context) ([]interface{}, error) {
+		out, err := s.List(ctx, &ListManagedInstancesInput{})
+		if err != nil {
+			return nil, err
+		}
+		objs := make([]interface{}, len(out.ManagedInstances))
+		for i, mi := range out.ManagedInstances {
+			objs[i] = mi
+		}
+		return objs, nil
+	}
+	cfg.Key = func(obj interface{}) string { return obj.(*ManagedInstance).GetId() }
+	cfg.Version = func(obj interface{}) time.Time { return obj.(*ManagedInstance).GetUpdatedAt() }
+	cfg.Indexers = informer.Merge(informer.Indexers{
+		informer.IndexName:   func(obj interface{}) []string { return []string{obj.(*ManagedInstance).GetName()} },
+		informer.IndexRegion: func(obj interface{}) []string { return []string{obj.(*ManagedInstance).GetRegion()} },
+		informer.IndexTag: func(obj interface{}) []string {
+			tags := obj.(*ManagedInstance).GetCompute().GetLaunchSpecification().GetTags()
+			return informer.TagIndexValues(len(tags), func(i int) (string, string) {
+				return tags[i].GetKey(), tags[i].GetValue()
+			})
+		},
+	}, cfg.Indexers)
+
+	return informer.New(cfg)
+}
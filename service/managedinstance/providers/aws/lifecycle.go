@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+// StateTransitionError is returned by PauseAndWait, ResumeAndWait and
+// RecycleAndWait when a managed instance fails to reach the requested state.
+type StateTransitionError struct {
+	ManagedInstanceID string
+	Action            string
+
+	// Status is the last known status of the managed instance.
+	Status Status
+
+	// Err is the error returned by the waiter.
+	Err error
+}
+
+func (e *StateTransitionError) Error() string {
+	return fmt.Sprintf("spotinst: failed to %s managed instance %q (status: %s)", e.Action, e.ManagedInstanceID, e.Status)
+}
+
+// Unwrap returns the error returned by the waiter.
+func (e *StateTransitionError) Unwrap() error {
+	return e.Err
+}
+
+// PauseAndWait pauses a managed instance and polls Status until it is
+// PAUSED. If the instance reaches the ERROR status instead, a
+// *StateTransitionError wrapping waiter.ErrFailureState is returned.
+func (s *ServiceOp) PauseAndWait(ctx context.Context, input *PauseManagedInstanceInput, opts ...waiter.Option) (*StatusManagedInstanceOutput, error) {
+	return transitionAndWait(ctx, s, input.ManagedInstanceID, "pause", StatusPaused, func(ctx context.Context) error {
+		_, err := s.Pause(ctx, input)
+		return err
+	}, opts)
+}
+
+// ResumeAndWait resumes a managed instance and polls Status until it is
+// ACTIVE. If the instance reaches the ERROR status instead, a
+// *StateTransitionError wrapping waiter.ErrFailureState is returned.
+func (s *ServiceOp) ResumeAndWait(ctx context.Context, input *ResumeManagedInstanceInput, opts ...waiter.Option) (*StatusManagedInstanceOutput, error) {
+	return transitionAndWait(ctx, s, input.ManagedInstanceID, "resume", StatusActive, func(ctx context.Context) error {
+		_, err := s.Resume(ctx, input)
+		return err
+	}, opts)
+}
+
+// RecycleAndWait recycles a managed instance and polls Status until it is
+// ACTIVE again. If the instance reaches the ERROR status instead, a
+// *StateTransitionError wrapping waiter.ErrFailureState is returned.
+func (s *ServiceOp) RecycleAndWait(ctx context.Context, input *RecycleManagedInstanceInput, opts ...waiter.Option) (*StatusManagedInstanceOutput, error) {
+	return transitionAndWait(ctx, s, input.ManagedInstanceID, "recycle", StatusActive, func(ctx context.Context) error {
+		_, err := s.Recycle(ctx, input)
+		return err
+	}, opts)
+}
+
+func transitionAndWait(ctx context.Context, svc Service, id *string, action string, target Status,
+	do func(context.Context) error, opts []waiter.Option) (*StatusManagedInstanceOutput, error) {
+	t := &waiter.Transition{
+		Name: "ManagedInstanceStatus" + target.String(),
+		Do:   do,
+		Poll: func(ctx context.Context) (interface{}, error) {
+			return svc.Status(ctx, &StatusManagedInstanceInput{ManagedInstanceID: id})
+		},
+		Done: func(output interface{}) bool {
+			return managedInstanceStatus(output.(*StatusManagedInstanceOutput)) == target
+		},
+		Failed: func(output interface{}) bool {
+			return managedInstanceStatus(output.(*StatusManagedInstanceOutput)) == StatusError
+		},
+		Reenter: action == "recycle",
+	}
+
+	out, err := t.Run(ctx, opts...)
+	last, _ := out.(*StatusManagedInstanceOutput)
+	if errors.Is(err, waiter.ErrFailureState) {
+		err = &StateTransitionError{
+			ManagedInstanceID: spotinst.StringValue(id),
+			Action:            action,
+			Status:            managedInstanceStatus(last),
+			Err:               err,
+		}
+	}
+	return last, err
+}
+
+func managedInstanceStatus(out *StatusManagedInstanceOutput) Status {
+	return Status(strings.ToUpper(out.GetStatus()))
+}
@@ -5,7 +5,9 @@ import (
 
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/informer"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
 )
 
 // Service provides the API operation methods for making requests to endpoints
@@ -18,10 +20,15 @@ type Service interface {
 	Update(context.Context, *UpdateManagedInstanceInput) (*UpdateManagedInstanceOutput, error)
 	Delete(context.Context, *DeleteManagedInstanceInput) (*DeleteManagedInstanceOutput, error)
 	Status(context.Context, *StatusManagedInstanceInput) (*StatusManagedInstanceOutput, error)
+	WaitUntilManagedInstanceStatus(context.Context, *StatusManagedInstanceInput, Status, ...waiter.Option) error
 	Costs(context.Context, *CostsManagedInstanceInput) (*CostsManagedInstanceOutput, error)
 	Pause(context.Context, *PauseManagedInstanceInput) (*PauseManagedInstanceOutput, error)
 	Resume(context.Context, *ResumeManagedInstanceInput) (*ResumeManagedInstanceOutput, error)
 	Recycle(context.Context, *RecycleManagedInstanceInput) (*RecycleManagedInstanceOutput, error)
+	PauseAndWait(context.Context, *PauseManagedInstanceInput, ...waiter.Option) (*StatusManagedInstanceOutput, error)
+	ResumeAndWait(context.Context, *ResumeManagedInstanceInput, ...waiter.Option) (*StatusManagedInstanceOutput, error)
+	RecycleAndWait(context.Context, *RecycleManagedInstanceInput, ...waiter.Option) (*StatusManagedInstanceOutput, error)
+	NewManagedInstanceInformer(informer.Config) *informer.Informer
 }
 
 type ServiceOp struct {
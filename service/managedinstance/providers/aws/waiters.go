@@ -0,0 +1,36 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+// WaitUntilManagedInstanceStatus polls Status until the managed instance
+// reaches the given status. It returns an error wrapping
+// waiter.ErrFailureState if the instance reaches the ERROR status instead.
+func (s *ServiceOp) WaitUntilManagedInstanceStatus(ctx context.Context, input *StatusManagedInstanceInput, status Status, opts ...waiter.Option) error {
+	w := &waiter.Waiter{
+		Name: "ManagedInstanceStatus" + status.String(),
+		Poll: func(ctx context.Context) (interface{}, error) {
+			return s.Status(ctx, input)
+		},
+		Acceptors: []waiter.Acceptor{
+			{
+				State:   waiter.Success,
+				Matcher: waiter.PathMatcher("Status", status.String()),
+			},
+			{
+				State:   waiter.Failure,
+				Matcher: waiter.PathMatcher("Status", StatusError.String()),
+			},
+		},
+		Options: waiter.DefaultOptions(),
+	}
+	w.Options.MinDelay = 10 * time.Second
+	w.Options.MaxDelay = 30 * time.Second
+
+	_, err := w.Apply(opts...).Wait(ctx)
+	return err
+}
@@ -0,0 +1,290 @@
+// Code generated by gettergen. DO NOT EDIT.
+
+package mcs
+
+func (o *ClusterCost) GetNamespaces() []*Namespace {
+	if o == nil {
+		return nil
+	}
+	return o.Namespaces
+}
+
+func (o *ClusterCost) GetDeployments() []*Deployment {
+	if o == nil {
+		return nil
+	}
+	return o.Deployments
+}
+
+func (o *ClusterCost) GetTotalCost() float64 {
+	if o == nil || o.TotalCost == nil {
+		return 0
+	}
+	return *o.TotalCost
+}
+
+func (o *ClusterCost) GetTotalComputeCost() float64 {
+	if o == nil || o.TotalComputeCost == nil {
+		return 0
+	}
+	return *o.TotalComputeCost
+}
+
+func (o *ClusterCost) GetTotalEBSCost() float64 {
+	if o == nil || o.TotalEBSCost == nil {
+		return 0
+	}
+	return *o.TotalEBSCost
+}
+
+func (o *ClusterCost) GetTotalStorageCost() float64 {
+	if o == nil || o.TotalStorageCost == nil {
+		return 0
+	}
+	return *o.TotalStorageCost
+}
+
+func (o *ClusterCost) GetUnusedStorageCost() float64 {
+	if o == nil || o.UnusedStorageCost == nil {
+		return 0
+	}
+	return *o.UnusedStorageCost
+}
+
+func (o *ClusterCost) GetStandAlonePodsCost() float64 {
+	if o == nil || o.StandAlonePodsCost == nil {
+		return 0
+	}
+	return *o.StandAlonePodsCost
+}
+
+func (o *ClusterCost) GetHeadroomCost() float64 {
+	if o == nil || o.HeadroomCost == nil {
+		return 0
+	}
+	return *o.HeadroomCost
+}
+
+func (o *ClusterCost) GetIdleCost() float64 {
+	if o == nil || o.IdleCost == nil {
+		return 0
+	}
+	return *o.IdleCost
+}
+
+func (o *ClusterCostInput) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *ClusterCostInput) GetToDate() string {
+	if o == nil || o.ToDate == nil {
+		return ""
+	}
+	return *o.ToDate
+}
+
+func (o *ClusterCostInput) GetFromDate() string {
+	if o == nil || o.FromDate == nil {
+		return ""
+	}
+	return *o.FromDate
+}
+
+func (o *ClusterCostOutput) GetClusterCosts() []*ClusterCost {
+	if o == nil {
+		return nil
+	}
+	return o.ClusterCosts
+}
+
+func (o *Deployment) GetDeploymentName() string {
+	if o == nil || o.DeploymentName == nil {
+		return ""
+	}
+	return *o.DeploymentName
+}
+
+func (o *Deployment) GetNamespace() string {
+	if o == nil || o.Namespace == nil {
+		return ""
+	}
+	return *o.Namespace
+}
+
+func (o *Deployment) GetCost() float64 {
+	if o == nil || o.Cost == nil {
+		return 0
+	}
+	return *o.Cost
+}
+
+func (o *Deployment) GetComputeCost() float64 {
+	if o == nil || o.ComputeCost == nil {
+		return 0
+	}
+	return *o.ComputeCost
+}
+
+func (o *Deployment) GetStorageCost() float64 {
+	if o == nil || o.StorageCost == nil {
+		return 0
+	}
+	return *o.StorageCost
+}
+
+func (o *Deployment) GetLabels() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.Labels
+}
+
+func (o *Deployment) GetAnnotations() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.Annotations
+}
+
+func (o *Namespace) GetNamespace() string {
+	if o == nil || o.Namespace == nil {
+		return ""
+	}
+	return *o.Namespace
+}
+
+func (o *Namespace) GetCost() float64 {
+	if o == nil || o.Cost == nil {
+		return 0
+	}
+	return *o.Cost
+}
+
+func (o *Namespace) GetComputeCost() float64 {
+	if o == nil || o.ComputeCost == nil {
+		return 0
+	}
+	return *o.ComputeCost
+}
+
+func (o *Namespace) GetEBSCost() float64 {
+	if o == nil || o.EBSCost == nil {
+		return 0
+	}
+	return *o.EBSCost
+}
+
+func (o *Namespace) GetStorageCost() float64 {
+	if o == nil || o.StorageCost == nil {
+		return 0
+	}
+	return *o.StorageCost
+}
+
+func (o *Namespace) GetDeployments() []*Resource {
+	if o == nil {
+		return nil
+	}
+	return o.Deployments
+}
+
+func (o *Namespace) GetStatefulSets() []*Resource {
+	if o == nil {
+		return nil
+	}
+	return o.StatefulSets
+}
+
+func (o *Namespace) GetDaemonSets() []*Resource {
+	if o == nil {
+		return nil
+	}
+	return o.DaemonSets
+}
+
+func (o *Namespace) GetJobs() []*Resource {
+	if o == nil {
+		return nil
+	}
+	return o.Jobs
+}
+
+func (o *Namespace) GetStandAlonePodsCost() *Resource {
+	if o == nil {
+		return nil
+	}
+	return o.StandAlonePodsCost
+}
+
+func (o *Namespace) GetLabels() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.Labels
+}
+
+func (o *Namespace) GetAnnotations() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.Annotations
+}
+
+func (o *Resource) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Resource) GetNamespace() string {
+	if o == nil || o.Namespace == nil {
+		return ""
+	}
+	return *o.Namespace
+}
+
+func (o *Resource) GetCost() float64 {
+	if o == nil || o.Cost == nil {
+		return 0
+	}
+	return *o.Cost
+}
+
+func (o *Resource) GetComputeCost() float64 {
+	if o == nil || o.ComputeCost == nil {
+		return 0
+	}
+	return *o.ComputeCost
+}
+
+func (o *Resource) GetEBSCost() float64 {
+	if o == nil || o.EBSCost == nil {
+		return 0
+	}
+	return *o.EBSCost
+}
+
+func (o *Resource) GetStorageCost() float64 {
+	if o == nil || o.StorageCost == nil {
+		return 0
+	}
+	return *o.StorageCost
+}
+
+func (o *Resource) GetLabels() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.Labels
+}
+
+func (o *Resource) GetAnnotations() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.Annotations
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
@@ -13,8 +14,20 @@ import (
 
 type ClusterCostInput struct {
 	ClusterID *string `json:"clusterId,omitempty"`
-	ToDate    *string `json:"toDate,omitempty"`
-	FromDate  *string `json:"fromDate,omitempty"`
+	ToDate    *string `json:"toDate,omitempty"`   // see WithToDate
+	FromDate  *string `json:"fromDate,omitempty"` // see WithFromDate
+}
+
+// WithFromDate sets FromDate to the calendar date of t, formatted as yyyy-mm-dd.
+func (i *ClusterCostInput) WithFromDate(t time.Time) *ClusterCostInput {
+	i.FromDate = spotinst.String(spotinst.FormatDate(t))
+	return i
+}
+
+// WithToDate sets ToDate to the calendar date of t, formatted as yyyy-mm-dd.
+func (i *ClusterCostInput) WithToDate(t time.Time) *ClusterCostInput {
+	i.ToDate = spotinst.String(spotinst.FormatDate(t))
+	return i
 }
 
 type ClusterCostOutput struct {
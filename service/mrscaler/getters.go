@@ -0,0 +1,920 @@
+// Code generated by gettergen. DO NOT EDIT.
+
+package mrscaler
+
+func (o *Action) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *Action) GetAdjustment() string {
+	if o == nil || o.Adjustment == nil {
+		return ""
+	}
+	return *o.Adjustment
+}
+
+func (o *Action) GetMinTargetCapacity() string {
+	if o == nil || o.MinTargetCapacity == nil {
+		return ""
+	}
+	return *o.MinTargetCapacity
+}
+
+func (o *Action) GetMaxTargetCapacity() string {
+	if o == nil || o.MaxTargetCapacity == nil {
+		return ""
+	}
+	return *o.MaxTargetCapacity
+}
+
+func (o *Action) GetTarget() string {
+	if o == nil || o.Target == nil {
+		return ""
+	}
+	return *o.Target
+}
+
+func (o *Action) GetMinimum() string {
+	if o == nil || o.Minimum == nil {
+		return ""
+	}
+	return *o.Minimum
+}
+
+func (o *Action) GetMaximum() string {
+	if o == nil || o.Maximum == nil {
+		return ""
+	}
+	return *o.Maximum
+}
+
+func (o *Application) GetArgs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Args
+}
+
+func (o *Application) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Application) GetVersion() string {
+	if o == nil || o.Version == nil {
+		return ""
+	}
+	return *o.Version
+}
+
+func (o *AvailabilityZone) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *AvailabilityZone) GetSubnetId() string {
+	if o == nil || o.SubnetID == nil {
+		return ""
+	}
+	return *o.SubnetID
+}
+
+func (o *BlockDeviceConfig) GetVolumesPerInstance() int {
+	if o == nil || o.VolumesPerInstance == nil {
+		return 0
+	}
+	return *o.VolumesPerInstance
+}
+
+func (o *BlockDeviceConfig) GetVolumeSpecification() *VolumeSpecification {
+	if o == nil {
+		return nil
+	}
+	return o.VolumeSpecification
+}
+
+func (o *BootstrapActions) GetFile() *S3File {
+	if o == nil {
+		return nil
+	}
+	return o.File
+}
+
+func (o *Cloning) GetOriginClusterId() string {
+	if o == nil || o.OriginClusterID == nil {
+		return ""
+	}
+	return *o.OriginClusterID
+}
+
+func (o *Cloning) GetRetries() int {
+	if o == nil || o.Retries == nil {
+		return 0
+	}
+	return *o.Retries
+}
+
+func (o *Cluster) GetLogURI() string {
+	if o == nil || o.LogURI == nil {
+		return ""
+	}
+	return *o.LogURI
+}
+
+func (o *Cluster) GetAdditionalInfo() string {
+	if o == nil || o.AdditionalInfo == nil {
+		return ""
+	}
+	return *o.AdditionalInfo
+}
+
+func (o *Cluster) GetJobFlowRole() string {
+	if o == nil || o.JobFlowRole == nil {
+		return ""
+	}
+	return *o.JobFlowRole
+}
+
+func (o *Cluster) GetSecurityConfiguration() string {
+	if o == nil || o.SecurityConfiguration == nil {
+		return ""
+	}
+	return *o.SecurityConfiguration
+}
+
+func (o *Cluster) GetServiceRole() string {
+	if o == nil || o.ServiceRole == nil {
+		return ""
+	}
+	return *o.ServiceRole
+}
+
+func (o *Cluster) GetVisibleToAllUsers() bool {
+	if o == nil || o.VisibleToAllUsers == nil {
+		return false
+	}
+	return *o.VisibleToAllUsers
+}
+
+func (o *Cluster) GetTerminationProtected() bool {
+	if o == nil || o.TerminationProtected == nil {
+		return false
+	}
+	return *o.TerminationProtected
+}
+
+func (o *Cluster) GetKeepJobFlowAliveWhenNoSteps() bool {
+	if o == nil || o.KeepJobFlowAliveWhenNoSteps == nil {
+		return false
+	}
+	return *o.KeepJobFlowAliveWhenNoSteps
+}
+
+func (o *Compute) GetAvailabilityZones() []*AvailabilityZone {
+	if o == nil {
+		return nil
+	}
+	return o.AvailabilityZones
+}
+
+func (o *Compute) GetTags() []*Tag {
+	if o == nil {
+		return nil
+	}
+	return o.Tags
+}
+
+func (o *Compute) GetInstanceGroups() *InstanceGroups {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceGroups
+}
+
+func (o *Compute) GetConfigurations() *Configurations {
+	if o == nil {
+		return nil
+	}
+	return o.Configurations
+}
+
+func (o *Compute) GetEBSRootVolumeSize() int {
+	if o == nil || o.EBSRootVolumeSize == nil {
+		return 0
+	}
+	return *o.EBSRootVolumeSize
+}
+
+func (o *Compute) GetManagedPrimarySecurityGroup() string {
+	if o == nil || o.ManagedPrimarySecurityGroup == nil {
+		return ""
+	}
+	return *o.ManagedPrimarySecurityGroup
+}
+
+func (o *Compute) GetManagedReplicaSecurityGroup() string {
+	if o == nil || o.ManagedReplicaSecurityGroup == nil {
+		return ""
+	}
+	return *o.ManagedReplicaSecurityGroup
+}
+
+func (o *Compute) GetServiceAccessSecurityGroup() string {
+	if o == nil || o.ServiceAccessSecurityGroup == nil {
+		return ""
+	}
+	return *o.ServiceAccessSecurityGroup
+}
+
+func (o *Compute) GetAdditionalPrimarySecurityGroups() []string {
+	if o == nil {
+		return nil
+	}
+	return o.AdditionalPrimarySecurityGroups
+}
+
+func (o *Compute) GetAdditionalReplicaSecurityGroups() []string {
+	if o == nil {
+		return nil
+	}
+	return o.AdditionalReplicaSecurityGroups
+}
+
+func (o *Compute) GetCustomAMIID() string {
+	if o == nil || o.CustomAMIID == nil {
+		return ""
+	}
+	return *o.CustomAMIID
+}
+
+func (o *Compute) GetRepoUpgradeOnBoot() string {
+	if o == nil || o.RepoUpgradeOnBoot == nil {
+		return ""
+	}
+	return *o.RepoUpgradeOnBoot
+}
+
+func (o *Compute) GetEC2KeyName() string {
+	if o == nil || o.EC2KeyName == nil {
+		return ""
+	}
+	return *o.EC2KeyName
+}
+
+func (o *Compute) GetApplications() []*Application {
+	if o == nil {
+		return nil
+	}
+	return o.Applications
+}
+
+func (o *Compute) GetBootstrapActions() *BootstrapActions {
+	if o == nil {
+		return nil
+	}
+	return o.BootstrapActions
+}
+
+func (o *Compute) GetSteps() *Steps {
+	if o == nil {
+		return nil
+	}
+	return o.Steps
+}
+
+func (o *Compute) GetInstanceWeights() []*InstanceWeight {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceWeights
+}
+
+func (o *Configurations) GetFile() *S3File {
+	if o == nil {
+		return nil
+	}
+	return o.File
+}
+
+func (o *CreateNew) GetReleaseLabel() string {
+	if o == nil || o.ReleaseLabel == nil {
+		return ""
+	}
+	return *o.ReleaseLabel
+}
+
+func (o *CreateNew) GetRetries() int {
+	if o == nil || o.Retries == nil {
+		return 0
+	}
+	return *o.Retries
+}
+
+func (o *CreateScalerInput) GetScaler() *Scaler {
+	if o == nil {
+		return nil
+	}
+	return o.Scaler
+}
+
+func (o *CreateScalerOutput) GetScaler() *Scaler {
+	if o == nil {
+		return nil
+	}
+	return o.Scaler
+}
+
+func (o *DeleteScalerInput) GetScalerID() string {
+	if o == nil || o.ScalerID == nil {
+		return ""
+	}
+	return *o.ScalerID
+}
+
+func (o *Dimension) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Dimension) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *EBSConfiguration) GetOptimized() bool {
+	if o == nil || o.Optimized == nil {
+		return false
+	}
+	return *o.Optimized
+}
+
+func (o *EBSConfiguration) GetBlockDeviceConfigs() []*BlockDeviceConfig {
+	if o == nil {
+		return nil
+	}
+	return o.BlockDeviceConfigs
+}
+
+func (o *InstanceGroup) GetInstanceTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceTypes
+}
+
+func (o *InstanceGroup) GetTarget() int {
+	if o == nil || o.Target == nil {
+		return 0
+	}
+	return *o.Target
+}
+
+func (o *InstanceGroup) GetCapacity() *InstanceGroupCapacity {
+	if o == nil {
+		return nil
+	}
+	return o.Capacity
+}
+
+func (o *InstanceGroup) GetLifeCycle() string {
+	if o == nil || o.LifeCycle == nil {
+		return ""
+	}
+	return *o.LifeCycle
+}
+
+func (o *InstanceGroup) GetEBSConfiguration() *EBSConfiguration {
+	if o == nil {
+		return nil
+	}
+	return o.EBSConfiguration
+}
+
+func (o *InstanceGroupCapacity) GetTarget() int {
+	if o == nil || o.Target == nil {
+		return 0
+	}
+	return *o.Target
+}
+
+func (o *InstanceGroupCapacity) GetMinimum() int {
+	if o == nil || o.Minimum == nil {
+		return 0
+	}
+	return *o.Minimum
+}
+
+func (o *InstanceGroupCapacity) GetMaximum() int {
+	if o == nil || o.Maximum == nil {
+		return 0
+	}
+	return *o.Maximum
+}
+
+func (o *InstanceGroupCapacity) GetUnit() string {
+	if o == nil || o.Unit == nil {
+		return ""
+	}
+	return *o.Unit
+}
+
+func (o *InstanceGroups) GetMasterGroup() *InstanceGroup {
+	if o == nil {
+		return nil
+	}
+	return o.MasterGroup
+}
+
+func (o *InstanceGroups) GetCoreGroup() *InstanceGroup {
+	if o == nil {
+		return nil
+	}
+	return o.CoreGroup
+}
+
+func (o *InstanceGroups) GetTaskGroup() *InstanceGroup {
+	if o == nil {
+		return nil
+	}
+	return o.TaskGroup
+}
+
+func (o *InstanceWeight) GetInstanceType() string {
+	if o == nil || o.InstanceType == nil {
+		return ""
+	}
+	return *o.InstanceType
+}
+
+func (o *InstanceWeight) GetWeightedCapacity() int {
+	if o == nil || o.WeightedCapacity == nil {
+		return 0
+	}
+	return *o.WeightedCapacity
+}
+
+func (o *ListScalersOutput) GetScalers() []*Scaler {
+	if o == nil {
+		return nil
+	}
+	return o.Scalers
+}
+
+func (o *ProvisioningTimeout) GetTimeout() int {
+	if o == nil || o.Timeout == nil {
+		return 0
+	}
+	return *o.Timeout
+}
+
+func (o *ProvisioningTimeout) GetTimeoutAction() string {
+	if o == nil || o.TimeoutAction == nil {
+		return ""
+	}
+	return *o.TimeoutAction
+}
+
+func (o *ReadScalerInput) GetScalerID() string {
+	if o == nil || o.ScalerID == nil {
+		return ""
+	}
+	return *o.ScalerID
+}
+
+func (o *ReadScalerOutput) GetScaler() *Scaler {
+	if o == nil {
+		return nil
+	}
+	return o.Scaler
+}
+
+func (o *S3File) GetBucket() string {
+	if o == nil || o.Bucket == nil {
+		return ""
+	}
+	return *o.Bucket
+}
+
+func (o *S3File) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *Scaler) GetId() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *Scaler) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Scaler) GetDescription() string {
+	if o == nil || o.Description == nil {
+		return ""
+	}
+	return *o.Description
+}
+
+func (o *Scaler) GetRegion() string {
+	if o == nil || o.Region == nil {
+		return ""
+	}
+	return *o.Region
+}
+
+func (o *Scaler) GetStrategy() *Strategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategy
+}
+
+func (o *Scaler) GetCompute() *Compute {
+	if o == nil {
+		return nil
+	}
+	return o.Compute
+}
+
+func (o *Scaler) GetCluster() *Cluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *Scaler) GetScaling() *Scaling {
+	if o == nil {
+		return nil
+	}
+	return o.Scaling
+}
+
+func (o *Scaler) GetCoreScaling() *Scaling {
+	if o == nil {
+		return nil
+	}
+	return o.CoreScaling
+}
+
+func (o *Scaler) GetScheduling() *Scheduling {
+	if o == nil {
+		return nil
+	}
+	return o.Scheduling
+}
+
+func (o *Scaler) GetTerminationPolicies() []*TerminationPolicy {
+	if o == nil {
+		return nil
+	}
+	return o.TerminationPolicies
+}
+
+func (o *ScalerCluster) GetScalerClusterId() string {
+	if o == nil || o.ScalerClusterId == nil {
+		return ""
+	}
+	return *o.ScalerClusterId
+}
+
+func (o *ScalerClusterStatusInput) GetScalerID() string {
+	if o == nil || o.ScalerID == nil {
+		return ""
+	}
+	return *o.ScalerID
+}
+
+func (o *ScalerClusterStatusOutput) GetScalerClusterId() string {
+	if o == nil || o.ScalerClusterId == nil {
+		return ""
+	}
+	return *o.ScalerClusterId
+}
+
+func (o *Scaling) GetUp() []*ScalingPolicy {
+	if o == nil {
+		return nil
+	}
+	return o.Up
+}
+
+func (o *Scaling) GetDown() []*ScalingPolicy {
+	if o == nil {
+		return nil
+	}
+	return o.Down
+}
+
+func (o *ScalingPolicy) GetPolicyName() string {
+	if o == nil || o.PolicyName == nil {
+		return ""
+	}
+	return *o.PolicyName
+}
+
+func (o *ScalingPolicy) GetNamespace() string {
+	if o == nil || o.Namespace == nil {
+		return ""
+	}
+	return *o.Namespace
+}
+
+func (o *ScalingPolicy) GetMetricName() string {
+	if o == nil || o.MetricName == nil {
+		return ""
+	}
+	return *o.MetricName
+}
+
+func (o *ScalingPolicy) GetDimensions() []*Dimension {
+	if o == nil {
+		return nil
+	}
+	return o.Dimensions
+}
+
+func (o *ScalingPolicy) GetStatistic() string {
+	if o == nil || o.Statistic == nil {
+		return ""
+	}
+	return *o.Statistic
+}
+
+func (o *ScalingPolicy) GetUnit() string {
+	if o == nil || o.Unit == nil {
+		return ""
+	}
+	return *o.Unit
+}
+
+func (o *ScalingPolicy) GetThreshold() float64 {
+	if o == nil || o.Threshold == nil {
+		return 0
+	}
+	return *o.Threshold
+}
+
+func (o *ScalingPolicy) GetPeriod() int {
+	if o == nil || o.Period == nil {
+		return 0
+	}
+	return *o.Period
+}
+
+func (o *ScalingPolicy) GetEvaluationPeriods() int {
+	if o == nil || o.EvaluationPeriods == nil {
+		return 0
+	}
+	return *o.EvaluationPeriods
+}
+
+func (o *ScalingPolicy) GetCooldown() int {
+	if o == nil || o.Cooldown == nil {
+		return 0
+	}
+	return *o.Cooldown
+}
+
+func (o *ScalingPolicy) GetAction() *Action {
+	if o == nil {
+		return nil
+	}
+	return o.Action
+}
+
+func (o *ScalingPolicy) GetOperator() string {
+	if o == nil || o.Operator == nil {
+		return ""
+	}
+	return *o.Operator
+}
+
+func (o *Scheduling) GetTasks() []*Task {
+	if o == nil {
+		return nil
+	}
+	return o.Tasks
+}
+
+func (o *Statement) GetNamespace() string {
+	if o == nil || o.Namespace == nil {
+		return ""
+	}
+	return *o.Namespace
+}
+
+func (o *Statement) GetMetricName() string {
+	if o == nil || o.MetricName == nil {
+		return ""
+	}
+	return *o.MetricName
+}
+
+func (o *Statement) GetStatistic() string {
+	if o == nil || o.Statistic == nil {
+		return ""
+	}
+	return *o.Statistic
+}
+
+func (o *Statement) GetUnit() string {
+	if o == nil || o.Unit == nil {
+		return ""
+	}
+	return *o.Unit
+}
+
+func (o *Statement) GetThreshold() float64 {
+	if o == nil || o.Threshold == nil {
+		return 0
+	}
+	return *o.Threshold
+}
+
+func (o *Statement) GetPeriod() int {
+	if o == nil || o.Period == nil {
+		return 0
+	}
+	return *o.Period
+}
+
+func (o *Statement) GetEvaluationPeriods() int {
+	if o == nil || o.EvaluationPeriods == nil {
+		return 0
+	}
+	return *o.EvaluationPeriods
+}
+
+func (o *Statement) GetOperator() string {
+	if o == nil || o.Operator == nil {
+		return ""
+	}
+	return *o.Operator
+}
+
+func (o *Steps) GetFile() *S3File {
+	if o == nil {
+		return nil
+	}
+	return o.File
+}
+
+func (o *Strategy) GetCloning() *Cloning {
+	if o == nil {
+		return nil
+	}
+	return o.Cloning
+}
+
+func (o *Strategy) GetWrapping() *Wrapping {
+	if o == nil {
+		return nil
+	}
+	return o.Wrapping
+}
+
+func (o *Strategy) GetCreateNew() *CreateNew {
+	if o == nil {
+		return nil
+	}
+	return o.CreateNew
+}
+
+func (o *Strategy) GetProvisioningTimeout() *ProvisioningTimeout {
+	if o == nil {
+		return nil
+	}
+	return o.ProvisioningTimeout
+}
+
+func (o *Tag) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *Tag) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *Task) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *Task) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *Task) GetInstanceGroupType() string {
+	if o == nil || o.InstanceGroupType == nil {
+		return ""
+	}
+	return *o.InstanceGroupType
+}
+
+func (o *Task) GetCronExpression() string {
+	if o == nil || o.CronExpression == nil {
+		return ""
+	}
+	return *o.CronExpression
+}
+
+func (o *Task) GetTargetCapacity() int {
+	if o == nil || o.TargetCapacity == nil {
+		return 0
+	}
+	return *o.TargetCapacity
+}
+
+func (o *Task) GetMinCapacity() int {
+	if o == nil || o.MinCapacity == nil {
+		return 0
+	}
+	return *o.MinCapacity
+}
+
+func (o *Task) GetMaxCapacity() int {
+	if o == nil || o.MaxCapacity == nil {
+		return 0
+	}
+	return *o.MaxCapacity
+}
+
+func (o *TerminationPolicy) GetStatements() []*Statement {
+	if o == nil {
+		return nil
+	}
+	return o.Statements
+}
+
+func (o *UpdateScalerInput) GetScaler() *Scaler {
+	if o == nil {
+		return nil
+	}
+	return o.Scaler
+}
+
+func (o *UpdateScalerOutput) GetScaler() *Scaler {
+	if o == nil {
+		return nil
+	}
+	return o.Scaler
+}
+
+func (o *VolumeSpecification) GetVolumeType() string {
+	if o == nil || o.VolumeType == nil {
+		return ""
+	}
+	return *o.VolumeType
+}
+
+func (o *VolumeSpecification) GetSizeInGB() int {
+	if o == nil || o.SizeInGB == nil {
+		return 0
+	}
+	return *o.SizeInGB
+}
+
+func (o *VolumeSpecification) GetIOPS() int {
+	if o == nil || o.IOPS == nil {
+		return 0
+	}
+	return *o.IOPS
+}
+
+func (o *Wrapping) GetSourceClusterId() string {
+	if o == nil || o.SourceClusterID == nil {
+		return ""
+	}
+	return *o.SourceClusterID
+}
@@ -0,0 +1,192 @@
+// Code generated by gettergen. DO NOT EDIT.
+
+package notificationcenter
+
+func (o *ComputePolicyConfig) GetEvents() []*Events {
+	if o == nil {
+		return nil
+	}
+	return o.Events
+}
+
+func (o *ComputePolicyConfig) GetShouldIncludeAllResources() bool {
+	if o == nil || o.ShouldIncludeAllResources == nil {
+		return false
+	}
+	return *o.ShouldIncludeAllResources
+}
+
+func (o *ComputePolicyConfig) GetResourceIds() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceIds
+}
+
+func (o *ComputePolicyConfig) GetDynamicRules() []*DynamicRules {
+	if o == nil {
+		return nil
+	}
+	return o.DynamicRules
+}
+
+func (o *CreateNotificationCenterPolicyOutput) GetNotificationCenter() *NotificationCenter {
+	if o == nil {
+		return nil
+	}
+	return o.NotificationCenter
+}
+
+func (o *DeleteNotificationCenterPolicyInput) GetPolicyId() string {
+	if o == nil || o.PolicyId == nil {
+		return ""
+	}
+	return *o.PolicyId
+}
+
+func (o *DynamicRules) GetFilterConditions() []*FilterConditions {
+	if o == nil {
+		return nil
+	}
+	return o.FilterConditions
+}
+
+func (o *Events) GetEvent() string {
+	if o == nil || o.Event == nil {
+		return ""
+	}
+	return *o.Event
+}
+
+func (o *Events) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *FilterConditions) GetIdentifier() string {
+	if o == nil || o.Identifier == nil {
+		return ""
+	}
+	return *o.Identifier
+}
+
+func (o *FilterConditions) GetOperator() string {
+	if o == nil || o.Operator == nil {
+		return ""
+	}
+	return *o.Operator
+}
+
+func (o *FilterConditions) GetExpression() string {
+	if o == nil || o.Expression == nil {
+		return ""
+	}
+	return *o.Expression
+}
+
+func (o *ListNotificationCenterPolicyOutput) GetNotificationCenter() []*NotificationCenter {
+	if o == nil {
+		return nil
+	}
+	return o.NotificationCenter
+}
+
+func (o *NotificationCenter) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *NotificationCenter) GetDescription() string {
+	if o == nil || o.Description == nil {
+		return ""
+	}
+	return *o.Description
+}
+
+func (o *NotificationCenter) GetPrivacyLevel() string {
+	if o == nil || o.PrivacyLevel == nil {
+		return ""
+	}
+	return *o.PrivacyLevel
+}
+
+func (o *NotificationCenter) GetIsActive() bool {
+	if o == nil || o.IsActive == nil {
+		return false
+	}
+	return *o.IsActive
+}
+
+func (o *NotificationCenter) GetRegisteredUsers() []*RegisteredUsers {
+	if o == nil {
+		return nil
+	}
+	return o.RegisteredUsers
+}
+
+func (o *NotificationCenter) GetSubscriptions() []*Subscriptions {
+	if o == nil {
+		return nil
+	}
+	return o.Subscriptions
+}
+
+func (o *NotificationCenter) GetComputePolicyConfig() *ComputePolicyConfig {
+	if o == nil {
+		return nil
+	}
+	return o.ComputePolicyConfig
+}
+
+func (o *NotificationCenter) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *ReadNotificationCenterPolicyInput) GetPolicyId() string {
+	if o == nil || o.PolicyId == nil {
+		return ""
+	}
+	return *o.PolicyId
+}
+
+func (o *ReadNotificationCenterPolicyOutput) GetNotificationCenter() *NotificationCenter {
+	if o == nil {
+		return nil
+	}
+	return o.NotificationCenter
+}
+
+func (o *RegisteredUsers) GetUserEmail() string {
+	if o == nil || o.UserEmail == nil {
+		return ""
+	}
+	return *o.UserEmail
+}
+
+func (o *RegisteredUsers) GetSubscriptionTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.SubscriptionTypes
+}
+
+func (o *Subscriptions) GetEndpoint() string {
+	if o == nil || o.Endpoint == nil {
+		return ""
+	}
+	return *o.Endpoint
+}
+
+func (o *Subscriptions) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
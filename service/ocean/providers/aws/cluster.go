@@ -503,25 +503,49 @@ type UpdateRollOutput struct {
 
 type GetLogEventsInput struct {
 	ClusterID  *string `json:"clusterId,omitempty"`
-	FromDate   *string `json:"fromDate,omitempty"`
-	ToDate     *string `json:"toDate,omitempty"`
+	FromDate   *string `json:"fromDate,omitempty"` // see WithFromDate
+	ToDate     *string `json:"toDate,omitempty"`   // see WithToDate
 	ResourceID *string `json:"resourceId,omitempty"`
 	Severity   *string `json:"severity,omitempty"`
 	Limit      *int    `json:"limit,omitempty"`
 }
 
+// WithFromDate sets FromDate to t, formatted as epoch milliseconds.
+func (i *GetLogEventsInput) WithFromDate(t time.Time) *GetLogEventsInput {
+	i.FromDate = spotinst.String(spotinst.FormatEpochMillis(t))
+	return i
+}
+
+// WithToDate sets ToDate to t, formatted as epoch milliseconds.
+func (i *GetLogEventsInput) WithToDate(t time.Time) *GetLogEventsInput {
+	i.ToDate = spotinst.String(spotinst.FormatEpochMillis(t))
+	return i
+}
+
 type GetLogEventsOutput struct {
 	Events []*LogEvent `json:"events,omitempty"`
 }
 
 type ClusterAggregatedCostInput struct {
 	OceanId   *string           `json:"oceanId,omitempty"`
-	StartTime *string           `json:"startTime,omitempty"`
-	EndTime   *string           `json:"endTime,omitempty"`
+	StartTime *string           `json:"startTime,omitempty"` // see WithStartTime
+	EndTime   *string           `json:"endTime,omitempty"`   // see WithEndTime
 	GroupBy   *string           `json:"groupBy,omitempty"`
 	Filter    *AggregatedFilter `json:"filter,omitempty"`
 }
 
+// WithStartTime sets StartTime to t, formatted as epoch milliseconds.
+func (i *ClusterAggregatedCostInput) WithStartTime(t time.Time) *ClusterAggregatedCostInput {
+	i.StartTime = spotinst.String(spotinst.FormatEpochMillis(t))
+	return i
+}
+
+// WithEndTime sets EndTime to t, formatted as epoch milliseconds.
+func (i *ClusterAggregatedCostInput) WithEndTime(t time.Time) *ClusterAggregatedCostInput {
+	i.EndTime = spotinst.String(spotinst.FormatEpochMillis(t))
+	return i
+}
+
 type AggregatedFilter struct {
 	Scope      *string     `json:"scope,omitempty"`
 	Conditions *Conditions `json:"conditions,omitempty"`
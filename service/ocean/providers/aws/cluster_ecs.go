@@ -322,6 +322,16 @@ type ECSRollClusterStatus struct {
 	UpdatedAt    *string      `json:"updatedAt,omitempty"`
 }
 
+// CreatedAtTime parses CreatedAt. It returns the zero time if CreatedAt is nil.
+func (o *ECSRollClusterStatus) CreatedAtTime() (time.Time, error) {
+	return spotinst.TimeFromString(o.CreatedAt)
+}
+
+// UpdatedAtTime parses UpdatedAt. It returns the zero time if UpdatedAt is nil.
+func (o *ECSRollClusterStatus) UpdatedAtTime() (time.Time, error) {
+	return spotinst.TimeFromString(o.UpdatedAt)
+}
+
 type ECSProgress struct {
 	Unit  *string `json:"unit,omitempty"`
 	Value *int    `json:"value,omitempty"`
@@ -0,0 +1,165 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/concurrency"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/httpcache"
+)
+
+// UpdateClusterIfUnchanged is like UpdateCluster, but first re-reads the
+// cluster and returns a *concurrency.ConflictError, without updating it, if
+// it changed since previous was read.
+func (s *ServiceOp) UpdateClusterIfUnchanged(ctx context.Context, input *UpdateClusterInput, previous *Cluster) (*UpdateClusterOutput, error) {
+	return updateClusterIfUnchanged(ctx, s, input, previous)
+}
+
+func updateClusterIfUnchanged(ctx context.Context, svc Service, input *UpdateClusterInput, previous *Cluster) (*UpdateClusterOutput, error) {
+	version, err := concurrency.Version(previous)
+	if err != nil {
+		return nil, err
+	}
+	return updateClusterIfVersion(ctx, svc, input, version)
+}
+
+// updateClusterIfVersion re-reads the cluster and updates it if its version is
+// still version.
+func updateClusterIfVersion(ctx context.Context, svc Service, input *UpdateClusterInput, version string) (*UpdateClusterOutput, error) {
+	id := spotinst.StringValue(input.Cluster.ID)
+	out, err := svc.ReadCluster(httpcache.WithBypass(ctx), &ReadClusterInput{ClusterID: spotinst.String(id)})
+	if err != nil {
+		return nil, err
+	}
+	if err := concurrency.Check(id, version, out.Cluster); err != nil {
+		return nil, err
+	}
+	return svc.UpdateCluster(ctx, input)
+}
+
+// RetryClusterOnConflict reads the cluster, calls mutate with it and updates
+// the cluster with the returned desired state if it is unchanged since the
+// read, until the update succeeds or fails with an error other than a
+// conflict. mutate may return nil to leave the cluster as-is, modify and
+// return current, whose read-only fields are then cleared, or return an
+// error matching concurrency.ErrConflict to start over with a fresh read. It
+// returns the updated cluster.
+func (s *ServiceOp) RetryClusterOnConflict(ctx context.Context, clusterID string, retry *concurrency.Retry, mutate func(current *Cluster) (*Cluster, error)) (*Cluster, error) {
+	return retryClusterOnConflict(ctx, s, clusterID, retry, mutate)
+}
+
+func retryClusterOnConflict(ctx context.Context, svc Service, clusterID string, retry *concurrency.Retry, mutate func(current *Cluster) (*Cluster, error)) (*Cluster, error) {
+	var updated *Cluster
+	err := concurrency.RetryOnConflict(ctx, retry, func(ctx context.Context) error {
+		out, err := svc.ReadCluster(httpcache.WithBypass(ctx), &ReadClusterInput{ClusterID: spotinst.String(clusterID)})
+		if err != nil {
+			return err
+		}
+
+		// mutate may modify the cluster in place.
+		version, err := concurrency.Version(out.Cluster)
+		if err != nil {
+			return err
+		}
+
+		desired, err := mutate(out.Cluster)
+		if err != nil || desired == nil {
+			updated = out.Cluster
+			return err
+		}
+		ClearClusterReadOnly(desired)
+		desired.ID = spotinst.String(clusterID)
+
+		res, err := updateClusterIfVersion(ctx, svc, &UpdateClusterInput{Cluster: desired}, version)
+		if err != nil {
+			return err
+		}
+		updated = res.Cluster
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// UpdateLaunchSpecIfUnchanged is like UpdateLaunchSpec, but first re-reads
+// the launch spec and returns a *concurrency.ConflictError, without updating
+// it, if it changed since previous was read.
+func (s *ServiceOp) UpdateLaunchSpecIfUnchanged(ctx context.Context, input *UpdateLaunchSpecInput, previous *LaunchSpec) (*UpdateLaunchSpecOutput, error) {
+	return updateLaunchSpecIfUnchanged(ctx, s, input, previous)
+}
+
+func updateLaunchSpecIfUnchanged(ctx context.Context, svc Service, input *UpdateLaunchSpecInput, previous *LaunchSpec) (*UpdateLaunchSpecOutput, error) {
+	version, err := concurrency.Version(previous)
+	if err != nil {
+		return nil, err
+	}
+	return updateLaunchSpecIfVersion(ctx, svc, input, version)
+}
+
+// updateLaunchSpecIfVersion re-reads the launch spec and updates it if its version is
+// still version.
+func updateLaunchSpecIfVersion(ctx context.Context, svc Service, input *UpdateLaunchSpecInput, version string) (*UpdateLaunchSpecOutput, error) {
+	id := spotinst.StringValue(input.LaunchSpec.ID)
+	out, err := svc.ReadLaunchSpec(httpcache.WithBypass(ctx), &ReadLaunchSpecInput{LaunchSpecID: spotinst.String(id)})
+	if err != nil {
+		return nil, err
+	}
+	if err := concurrency.Check(id, version, out.LaunchSpec); err != nil {
+		return nil, err
+	}
+	return svc.UpdateLaunchSpec(ctx, input)
+}
+
+// RetryLaunchSpecOnConflict is like RetryClusterOnConflict, but updates a
+// launch spec.
+func (s *ServiceOp) RetryLaunchSpecOnConflict(ctx context.Context, launchSpecID string, retry *concurrency.Retry, mutate func(current *LaunchSpec) (*LaunchSpec, error)) (*LaunchSpec, error) {
+	return retryLaunchSpecOnConflict(ctx, s, launchSpecID, retry, mutate)
+}
+
+func retryLaunchSpecOnConflict(ctx context.Context, svc Service, launchSpecID string, retry *concurrency.Retry, mutate func(current *LaunchSpec) (*LaunchSpec, error)) (*LaunchSpec, error) {
+	var updated *LaunchSpec
+	err := concurrency.RetryOnConflict(ctx, retry, func(ctx context.Context) error {
+		out, err := svc.ReadLaunchSpec(httpcache.WithBypass(ctx), &ReadLaunchSpecInput{LaunchSpecID: spotinst.String(launchSpecID)})
+		if err != nil {
+			return err
+		}
+
+		// mutate may modify the launch spec in place.
+		version, err := concurrency.Version(out.LaunchSpec)
+		if err != nil {
+			return err
+		}
+
+		desired, err := mutate(out.LaunchSpec)
+		if err != nil || desired == nil {
+			updated = out.LaunchSpec
+			return err
+		}
+		ClearLaunchSpecReadOnly(desired)
+		desired.ID = spotinst.String(launchSpecID)
+
+		res, err := updateLaunchSpecIfVersion(ctx, svc, &UpdateLaunchSpecInput{LaunchSpec: desired}, version)
+		if err != nil {
+			return err
+		}
+		updated = res.LaunchSpec
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// ClearClusterReadOnly clears the read-only fields of a cluster, which are
+// set by the API and rejected when updating it.
+func ClearClusterReadOnly(cluster *Cluster) {
+	cluster.ID, cluster.CreatedAt, cluster.UpdatedAt = nil, nil, nil
+}
+
+// ClearLaunchSpecReadOnly clears the read-only fields of a launch spec.
+func ClearLaunchSpecReadOnly(ls *LaunchSpec) {
+	ls.ID, ls.CreatedAt, ls.UpdatedAt = nil, nil, nil
+}
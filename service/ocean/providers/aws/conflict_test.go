@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/concurrency"
+)
+
+type fakeClusters struct {
+	Service
+
+	// updatedAt are the update times of the cluster returned by successive
+	// ReadCluster calls, the last one repeated.
+	updatedAt []int64
+
+	n       int
+	reads   int
+	updates []*Cluster
+}
+
+func (f *fakeClusters) ReadCluster(context.Context, *ReadClusterInput) (*ReadClusterOutput, error) {
+	f.reads++
+	updated := time.Unix(f.updatedAt[f.n], 0).UTC()
+	if f.n < len(f.updatedAt)-1 {
+		f.n++
+	}
+	return &ReadClusterOutput{Cluster: &Cluster{
+		ID:        spotinst.String("o-1"),
+		Name:      spotinst.String("prod"),
+		UpdatedAt: &updated,
+	}}, nil
+}
+
+func (f *fakeClusters) UpdateCluster(_ context.Context, input *UpdateClusterInput) (*UpdateClusterOutput, error) {
+	f.updates = append(f.updates, input.Cluster)
+	return &UpdateClusterOutput{Cluster: input.Cluster}, nil
+}
+
+func TestRetryClusterOnConflictChangedBeforeUpdate(t *testing.T) {
+	// The cluster changes between the read and the update of the first
+	// attempt.
+	svc := &fakeClusters{updatedAt: []int64{100, 200}}
+
+	var calls int
+	cluster, err := retryClusterOnConflict(context.Background(), svc, "o-1", &concurrency.Retry{Delay: time.Millisecond}, func(current *Cluster) (*Cluster, error) {
+		calls++
+		current.SetName(spotinst.String("production"))
+		return current, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 || svc.reads != 4 || len(svc.updates) != 1 {
+		t.Fatalf("got %d mutations, %d reads and %d updates, want 2, 4 and 1", calls, svc.reads, len(svc.updates))
+	}
+	if cluster.GetName() != "production" || cluster.GetId() != "o-1" || cluster.UpdatedAt != nil {
+		t.Errorf("got cluster %+v", cluster)
+	}
+}
@@ -0,0 +1,227 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+)
+
+// An AvailabilityVsCost represents the orientation of a cluster, i.e. how
+// Ocean trades off availability against cost when choosing markets.
+type AvailabilityVsCost string
+
+const (
+	// AvailabilityVsCostBalanced balances between availability and cost.
+	AvailabilityVsCostBalanced AvailabilityVsCost = "balanced"
+
+	// AvailabilityVsCostCostOriented prefers cheaper markets.
+	AvailabilityVsCostCostOriented AvailabilityVsCost = "costOriented"
+
+	// AvailabilityVsCostCheapest always chooses the cheapest markets.
+	AvailabilityVsCostCheapest AvailabilityVsCost = "cheapest"
+)
+
+var availabilityVsCosts = []AvailabilityVsCost{
+	AvailabilityVsCostBalanced,
+	AvailabilityVsCostCostOriented,
+	AvailabilityVsCostCheapest,
+}
+
+// ParseAvailabilityVsCost parses a string into an AvailabilityVsCost. The
+// comparison is case insensitive and an error is returned for unknown values.
+func ParseAvailabilityVsCost(s string) (AvailabilityVsCost, error) {
+	for _, v := range availabilityVsCosts {
+		if strings.EqualFold(s, string(v)) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("spotinst: invalid availability vs. cost %q", s)
+}
+
+// IsValid reports whether the orientation is a known value.
+func (a AvailabilityVsCost) IsValid() bool {
+	_, err := ParseAvailabilityVsCost(string(a))
+	return err == nil
+}
+
+func (a AvailabilityVsCost) String() string {
+	return string(a)
+}
+
+// A LogSeverity represents the severity of a cluster log event.
+type LogSeverity string
+
+const (
+	// LogSeverityAll matches log events of any severity. It is only
+	// meaningful as a filter when getting log events.
+	LogSeverityAll LogSeverity = "ALL"
+
+	// LogSeverityInfo represents an informational log event.
+	LogSeverityInfo LogSeverity = "INFO"
+
+	// LogSeverityWarn represents a warning log event.
+	LogSeverityWarn LogSeverity = "WARN"
+
+	// LogSeverityError represents an error log event.
+	LogSeverityError LogSeverity = "ERROR"
+)
+
+var logSeverities = []LogSeverity{
+	LogSeverityAll,
+	LogSeverityInfo,
+	LogSeverityWarn,
+	LogSeverityError,
+}
+
+// ParseLogSeverity parses a string into a LogSeverity. The comparison is case
+// insensitive and an error is returned for unknown values.
+func ParseLogSeverity(s string) (LogSeverity, error) {
+	for _, v := range logSeverities {
+		if strings.EqualFold(s, string(v)) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("spotinst: invalid log severity %q", s)
+}
+
+// IsValid reports whether the severity is a known value.
+func (s LogSeverity) IsValid() bool {
+	_, err := ParseLogSeverity(string(s))
+	return err == nil
+}
+
+func (s LogSeverity) String() string {
+	return string(s)
+}
+
+// A RollState represents the status of a cluster roll.
+type RollState string
+
+const (
+	// RollStatePending represents a roll that has not started yet.
+	RollStatePending RollState = "PENDING"
+
+	// RollStateInProgress represents a running roll.
+	RollStateInProgress RollState = "IN_PROGRESS"
+
+	// RollStateCompleted represents a successfully completed roll.
+	RollStateCompleted RollState = "COMPLETED"
+
+	// RollStateStopped represents a roll stopped by the user.
+	RollStateStopped RollState = "STOPPED"
+
+	// RollStateFailed represents a failed roll.
+	RollStateFailed RollState = "FAILED"
+)
+
+var rollStates = []RollState{
+	RollStatePending,
+	RollStateInProgress,
+	RollStateCompleted,
+	RollStateStopped,
+	RollStateFailed,
+}
+
+// ParseRollState parses a string into a RollState. The comparison is case
+// insensitive and an error is returned for unknown values.
+func ParseRollState(s string) (RollState, error) {
+	for _, v := range rollStates {
+		if strings.EqualFold(s, string(v)) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("spotinst: invalid roll state %q", s)
+}
+
+// IsValid reports whether the roll state is a known value.
+func (s RollState) IsValid() bool {
+	_, err := ParseRollState(string(s))
+	return err == nil
+}
+
+// IsTerminal reports whether the roll state is final, i.e. the roll will not
+// make any further progress.
+func (s RollState) IsTerminal() bool {
+	switch s {
+	case RollStateCompleted, RollStateStopped, RollStateFailed:
+		return true
+	}
+	return false
+}
+
+func (s RollState) String() string {
+	return string(s)
+}
+
+// AvailabilityVsCostEnum returns the AvailabilityVsCost field as a typed
+// AvailabilityVsCost, or an empty AvailabilityVsCost if it isn't set.
+func (o *ClusterOrientation) AvailabilityVsCostEnum() AvailabilityVsCost {
+	if o == nil || o.AvailabilityVsCost == nil {
+		return ""
+	}
+	return AvailabilityVsCost(*o.AvailabilityVsCost)
+}
+
+// SetAvailabilityVsCostEnum sets the AvailabilityVsCost field to v, or
+// clears it if v is empty.
+func (o *ClusterOrientation) SetAvailabilityVsCostEnum(v AvailabilityVsCost) *ClusterOrientation {
+	if v == "" {
+		return o.SetAvailabilityVsCost(nil)
+	}
+	return o.SetAvailabilityVsCost(spotinst.String(string(v)))
+}
+
+// StatusEnum returns the Status field as a typed RollState, or an empty
+// RollState if it isn't set.
+func (o *RollSpec) StatusEnum() RollState {
+	if o == nil || o.Status == nil {
+		return ""
+	}
+	return RollState(*o.Status)
+}
+
+// SetStatusEnum sets the Status field to v, or clears it if v is empty.
+func (o *RollSpec) SetStatusEnum(v RollState) *RollSpec {
+	if v == "" {
+		return o.SetStatus(nil)
+	}
+	return o.SetStatus(spotinst.String(string(v)))
+}
+
+// StatusEnum returns the Status field as a typed RollState, or an empty
+// RollState if it isn't set.
+func (o *RollStatus) StatusEnum() RollState {
+	if o == nil || o.Status == nil {
+		return ""
+	}
+	return RollState(*o.Status)
+}
+
+// SeverityEnum returns the Severity field as a typed LogSeverity, or an
+// empty LogSeverity if it isn't set.
+func (o *LogEvent) SeverityEnum() LogSeverity {
+	if o == nil || o.Severity == nil {
+		return ""
+	}
+	return LogSeverity(*o.Severity)
+}
+
+// SeverityEnum returns the Severity field as a typed LogSeverity, or an
+// empty LogSeverity if it isn't set.
+func (o *GetLogEventsInput) SeverityEnum() LogSeverity {
+	if o == nil || o.Severity == nil {
+		return ""
+	}
+	return LogSeverity(*o.Severity)
+}
+
+// SetSeverityEnum sets the Severity field to v, or clears it if v is empty.
+func (o *GetLogEventsInput) SetSeverityEnum(v LogSeverity) *GetLogEventsInput {
+	if v == "" {
+		o.Severity = nil
+	} else {
+		o.Severity = spotinst.String(string(v))
+	}
+	return o
+}
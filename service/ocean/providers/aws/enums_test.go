@@ -0,0 +1,76 @@
+package aws
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type enumCase struct {
+	in, want string
+	ok       bool
+}
+
+func TestParseEnums(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		parse func(string) (string, bool, error)
+		cases []enumCase
+	}{
+		{"AvailabilityVsCost", func(s string) (string, bool, error) {
+			v, err := ParseAvailabilityVsCost(s)
+			return string(v), v.IsValid(), err
+		}, []enumCase{
+			{"cheapest", "cheapest", true},
+			{"CHEAPEST", "cheapest", true},
+			{"bogus", "", false},
+			{"", "", false},
+		}},
+		{"LogSeverity", func(s string) (string, bool, error) {
+			v, err := ParseLogSeverity(s)
+			return string(v), v.IsValid(), err
+		}, []enumCase{
+			{"ERROR", "ERROR", true},
+			{"error", "ERROR", true},
+			{"bogus", "", false},
+			{"", "", false},
+		}},
+		{"RollState", func(s string) (string, bool, error) {
+			v, err := ParseRollState(s)
+			return string(v), v.IsValid(), err
+		}, []enumCase{
+			{"FAILED", "FAILED", true},
+			{"failed", "FAILED", true},
+			{"bogus", "", false},
+			{"", "", false},
+		}},
+	} {
+		for _, c := range tt.cases {
+			got, valid, err := tt.parse(c.in)
+			if (err == nil) != c.ok || got != c.want || valid != c.ok {
+				t.Errorf("Parse%s(%q) = %q (valid %v), %v; want %q, ok %v", tt.name, c.in, got, valid, err, c.want, c.ok)
+			}
+		}
+	}
+}
+
+func TestEnumAccessors(t *testing.T) {
+	var o *ClusterOrientation
+	if got := o.AvailabilityVsCostEnum(); got != "" {
+		t.Errorf("got %q from nil ClusterOrientation, want empty", got)
+	}
+
+	o = new(ClusterOrientation).SetAvailabilityVsCostEnum(AvailabilityVsCostCheapest)
+	if got := o.AvailabilityVsCostEnum(); got != AvailabilityVsCostCheapest {
+		t.Errorf("got %q, want %q", got, AvailabilityVsCostCheapest)
+	}
+
+	// An empty value clears the field.
+	o.SetAvailabilityVsCostEnum("")
+	b, err := json.Marshal(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), `{"availabilityVsCost":null}`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
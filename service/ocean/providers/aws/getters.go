@@ -0,0 +1,4935 @@
+// Code generated by gettergen. DO NOT EDIT.
+
+package aws
+
+import (
+	"time"
+)
+
+func (o *AggregatedClusterCost) GetResult() *Result {
+	if o == nil {
+		return nil
+	}
+	return o.Result
+}
+
+func (o *AggregatedCompute) GetHeadroom() *Headroom {
+	if o == nil {
+		return nil
+	}
+	return o.Headroom
+}
+
+func (o *AggregatedCompute) GetTotal() float64 {
+	if o == nil || o.Total == nil {
+		return 0
+	}
+	return *o.Total
+}
+
+func (o *AggregatedCompute) GetWorkloads() *Workloads {
+	if o == nil {
+		return nil
+	}
+	return o.Workloads
+}
+
+func (o *AggregatedCostResource) GetCompute() *AggregatedCompute {
+	if o == nil {
+		return nil
+	}
+	return o.Compute
+}
+
+func (o *AggregatedCostResource) GetStorage() *AggregatedStorage {
+	if o == nil {
+		return nil
+	}
+	return o.Storage
+}
+
+func (o *AggregatedCostResource) GetMetaData() *MetaData {
+	if o == nil {
+		return nil
+	}
+	return o.MetaData
+}
+
+func (o *AggregatedCostResource) GetTotal() float64 {
+	if o == nil || o.Total == nil {
+		return 0
+	}
+	return *o.Total
+}
+
+func (o *AggregatedFilter) GetScope() string {
+	if o == nil || o.Scope == nil {
+		return ""
+	}
+	return *o.Scope
+}
+
+func (o *AggregatedFilter) GetConditions() *Conditions {
+	if o == nil {
+		return nil
+	}
+	return o.Conditions
+}
+
+func (o *AggregatedStorage) GetBlock() *Block {
+	if o == nil {
+		return nil
+	}
+	return o.Block
+}
+
+func (o *AggregatedStorage) GetFile() *File {
+	if o == nil {
+		return nil
+	}
+	return o.File
+}
+
+func (o *AggregatedStorage) GetTotal() float64 {
+	if o == nil || o.Total == nil {
+		return 0
+	}
+	return *o.Total
+}
+
+func (o *AggressiveScaleDown) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *AggressiveScaleDownVNG) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *AllMatch) GetAllMatches() []*AllMatchInner {
+	if o == nil {
+		return nil
+	}
+	return o.AllMatches
+}
+
+func (o *AllMatchInner) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *AllMatchInner) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *AllMatchInner) GetOperator() string {
+	if o == nil || o.Operator == nil {
+		return ""
+	}
+	return *o.Operator
+}
+
+func (o *AllMatchInner) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *AmiAutoUpdate) GetApplyRoll() bool {
+	if o == nil || o.ApplyRoll == nil {
+		return false
+	}
+	return *o.ApplyRoll
+}
+
+func (o *AmiAutoUpdate) GetClusterRoll() *AmiAutoUpdateClusterRoll {
+	if o == nil {
+		return nil
+	}
+	return o.AmiAutoUpdateClusterRoll
+}
+
+func (o *AmiAutoUpdate) GetMinorVersion() bool {
+	if o == nil || o.MinorVersion == nil {
+		return false
+	}
+	return *o.MinorVersion
+}
+
+func (o *AmiAutoUpdate) GetPatch() bool {
+	if o == nil || o.Patch == nil {
+		return false
+	}
+	return *o.Patch
+}
+
+func (o *AmiAutoUpdateClusterRoll) GetBatchMinHealthyPercentage() int {
+	if o == nil || o.BatchMinHealthyPercentage == nil {
+		return 0
+	}
+	return *o.BatchMinHealthyPercentage
+}
+
+func (o *AmiAutoUpdateClusterRoll) GetBatchSizePercentage() int {
+	if o == nil || o.BatchSizePercentage == nil {
+		return 0
+	}
+	return *o.BatchSizePercentage
+}
+
+func (o *AmiAutoUpdateClusterRoll) GetComment() string {
+	if o == nil || o.Comment == nil {
+		return ""
+	}
+	return *o.Comment
+}
+
+func (o *AmiAutoUpdateClusterRoll) GetRespectPdb() bool {
+	if o == nil || o.RespectPdb == nil {
+		return false
+	}
+	return *o.RespectPdb
+}
+
+func (o *AttachLoadbalancerInput) GetLoadBalancers() []*LoadBalancers {
+	if o == nil {
+		return nil
+	}
+	return o.LoadBalancers
+}
+
+func (o *AttachLoadbalancerInput) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *Attribute) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *Attribute) GetOperator() string {
+	if o == nil || o.Operator == nil {
+		return ""
+	}
+	return *o.Operator
+}
+
+func (o *Attribute) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *Attribute) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *AutoScale) GetHeadrooms() []*AutoScaleHeadroom {
+	if o == nil {
+		return nil
+	}
+	return o.Headrooms
+}
+
+func (o *AutoScale) GetAutoHeadroomPercentage() int {
+	if o == nil || o.AutoHeadroomPercentage == nil {
+		return 0
+	}
+	return *o.AutoHeadroomPercentage
+}
+
+func (o *AutoScale) GetAutoScalerDownVNG() *AutoScalerDownVNG {
+	if o == nil {
+		return nil
+	}
+	return o.Down
+}
+
+func (o *AutoScaleHeadroom) GetCPUPerUnit() int {
+	if o == nil || o.CPUPerUnit == nil {
+		return 0
+	}
+	return *o.CPUPerUnit
+}
+
+func (o *AutoScaleHeadroom) GetGPUPerUnit() int {
+	if o == nil || o.GPUPerUnit == nil {
+		return 0
+	}
+	return *o.GPUPerUnit
+}
+
+func (o *AutoScaleHeadroom) GetMemoryPerUnit() int {
+	if o == nil || o.MemoryPerUnit == nil {
+		return 0
+	}
+	return *o.MemoryPerUnit
+}
+
+func (o *AutoScaleHeadroom) GetNumOfUnits() int {
+	if o == nil || o.NumOfUnits == nil {
+		return 0
+	}
+	return *o.NumOfUnits
+}
+
+func (o *AutoScaler) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *AutoScaler) GetIsAutoConfig() bool {
+	if o == nil || o.IsAutoConfig == nil {
+		return false
+	}
+	return *o.IsAutoConfig
+}
+
+func (o *AutoScaler) GetCooldown() int {
+	if o == nil || o.Cooldown == nil {
+		return 0
+	}
+	return *o.Cooldown
+}
+
+func (o *AutoScaler) GetAutoHeadroomPercentage() int {
+	if o == nil || o.AutoHeadroomPercentage == nil {
+		return 0
+	}
+	return *o.AutoHeadroomPercentage
+}
+
+func (o *AutoScaler) GetHeadroom() *AutoScalerHeadroom {
+	if o == nil {
+		return nil
+	}
+	return o.Headroom
+}
+
+func (o *AutoScaler) GetResourceLimits() *AutoScalerResourceLimits {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceLimits
+}
+
+func (o *AutoScaler) GetDown() *AutoScalerDown {
+	if o == nil {
+		return nil
+	}
+	return o.Down
+}
+
+func (o *AutoScaler) GetEnableAutomaticAndManualHeadroom() bool {
+	if o == nil || o.EnableAutomaticAndManualHeadroom == nil {
+		return false
+	}
+	return *o.EnableAutomaticAndManualHeadroom
+}
+
+func (o *AutoScaler) GetExtendedResourceDefinitions() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ExtendedResourceDefinitions
+}
+
+func (o *AutoScalerDown) GetEvaluationPeriods() int {
+	if o == nil || o.EvaluationPeriods == nil {
+		return 0
+	}
+	return *o.EvaluationPeriods
+}
+
+func (o *AutoScalerDown) GetMaxScaleDownPercentage() float64 {
+	if o == nil || o.MaxScaleDownPercentage == nil {
+		return 0
+	}
+	return *o.MaxScaleDownPercentage
+}
+
+func (o *AutoScalerDown) GetAggressiveScaleDown() *AggressiveScaleDown {
+	if o == nil {
+		return nil
+	}
+	return o.AggressiveScaleDown
+}
+
+func (o *AutoScalerDownVNG) GetMaxScaleDownPercentage() float64 {
+	if o == nil || o.MaxScaleDownPercentage == nil {
+		return 0
+	}
+	return *o.MaxScaleDownPercentage
+}
+
+func (o *AutoScalerDownVNG) GetAggressiveScaleDownVNG() *AggressiveScaleDownVNG {
+	if o == nil {
+		return nil
+	}
+	return o.AggressiveScaleDown
+}
+
+func (o *AutoScalerHeadroom) GetCPUPerUnit() int {
+	if o == nil || o.CPUPerUnit == nil {
+		return 0
+	}
+	return *o.CPUPerUnit
+}
+
+func (o *AutoScalerHeadroom) GetGPUPerUnit() int {
+	if o == nil || o.GPUPerUnit == nil {
+		return 0
+	}
+	return *o.GPUPerUnit
+}
+
+func (o *AutoScalerHeadroom) GetMemoryPerUnit() int {
+	if o == nil || o.MemoryPerUnit == nil {
+		return 0
+	}
+	return *o.MemoryPerUnit
+}
+
+func (o *AutoScalerHeadroom) GetNumOfUnits() int {
+	if o == nil || o.NumOfUnits == nil {
+		return 0
+	}
+	return *o.NumOfUnits
+}
+
+func (o *AutoScalerResourceLimits) GetMaxVCPU() int {
+	if o == nil || o.MaxVCPU == nil {
+		return 0
+	}
+	return *o.MaxVCPU
+}
+
+func (o *AutoScalerResourceLimits) GetMaxMemoryGiB() int {
+	if o == nil || o.MaxMemoryGiB == nil {
+		return 0
+	}
+	return *o.MaxMemoryGiB
+}
+
+func (o *Block) GetEbsPv() *EbsPv {
+	if o == nil {
+		return nil
+	}
+	return o.EbsPv
+}
+
+func (o *Block) GetNonPv() *NonPv {
+	if o == nil {
+		return nil
+	}
+	return o.NonPv
+}
+
+func (o *Block) GetTotal() float64 {
+	if o == nil || o.Total == nil {
+		return 0
+	}
+	return *o.Total
+}
+
+func (o *BlockDeviceMapping) GetDeviceName() string {
+	if o == nil || o.DeviceName == nil {
+		return ""
+	}
+	return *o.DeviceName
+}
+
+func (o *BlockDeviceMapping) GetNoDevice() string {
+	if o == nil || o.NoDevice == nil {
+		return ""
+	}
+	return *o.NoDevice
+}
+
+func (o *BlockDeviceMapping) GetVirtualName() string {
+	if o == nil || o.VirtualName == nil {
+		return ""
+	}
+	return *o.VirtualName
+}
+
+func (o *BlockDeviceMapping) GetEBS() *EBS {
+	if o == nil {
+		return nil
+	}
+	return o.EBS
+}
+
+func (o *Capacity) GetMinimum() int {
+	if o == nil || o.Minimum == nil {
+		return 0
+	}
+	return *o.Minimum
+}
+
+func (o *Capacity) GetMaximum() int {
+	if o == nil || o.Maximum == nil {
+		return 0
+	}
+	return *o.Maximum
+}
+
+func (o *Capacity) GetTarget() int {
+	if o == nil || o.Target == nil {
+		return 0
+	}
+	return *o.Target
+}
+
+func (o *Cluster) GetId() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *Cluster) GetControllerClusterId() string {
+	if o == nil || o.ControllerClusterID == nil {
+		return ""
+	}
+	return *o.ControllerClusterID
+}
+
+func (o *Cluster) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Cluster) GetRegion() string {
+	if o == nil || o.Region == nil {
+		return ""
+	}
+	return *o.Region
+}
+
+func (o *Cluster) GetStrategy() *Strategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategy
+}
+
+func (o *Cluster) GetCapacity() *Capacity {
+	if o == nil {
+		return nil
+	}
+	return o.Capacity
+}
+
+func (o *Cluster) GetCompute() *Compute {
+	if o == nil {
+		return nil
+	}
+	return o.Compute
+}
+
+func (o *Cluster) GetScheduling() *Scheduling {
+	if o == nil {
+		return nil
+	}
+	return o.Scheduling
+}
+
+func (o *Cluster) GetAutoScaler() *AutoScaler {
+	if o == nil {
+		return nil
+	}
+	return o.AutoScaler
+}
+
+func (o *Cluster) GetLogging() *Logging {
+	if o == nil {
+		return nil
+	}
+	return o.Logging
+}
+
+func (o *Cluster) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *Cluster) GetUpdatedAt() time.Time {
+	if o == nil || o.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *o.UpdatedAt
+}
+
+func (o *ClusterAggregatedCostInput) GetOceanId() string {
+	if o == nil || o.OceanId == nil {
+		return ""
+	}
+	return *o.OceanId
+}
+
+func (o *ClusterAggregatedCostInput) GetStartTime() string {
+	if o == nil || o.StartTime == nil {
+		return ""
+	}
+	return *o.StartTime
+}
+
+func (o *ClusterAggregatedCostInput) GetEndTime() string {
+	if o == nil || o.EndTime == nil {
+		return ""
+	}
+	return *o.EndTime
+}
+
+func (o *ClusterAggregatedCostInput) GetGroupBy() string {
+	if o == nil || o.GroupBy == nil {
+		return ""
+	}
+	return *o.GroupBy
+}
+
+func (o *ClusterAggregatedCostInput) GetFilter() *AggregatedFilter {
+	if o == nil {
+		return nil
+	}
+	return o.Filter
+}
+
+func (o *ClusterAggregatedCostOutput) GetAggregatedClusterCosts() []*AggregatedClusterCost {
+	if o == nil {
+		return nil
+	}
+	return o.AggregatedClusterCosts
+}
+
+func (o *ClusterBlockDeviceMappings) GetDeviceName() string {
+	if o == nil || o.DeviceName == nil {
+		return ""
+	}
+	return *o.DeviceName
+}
+
+func (o *ClusterBlockDeviceMappings) GetEBS() *ClusterEBS {
+	if o == nil {
+		return nil
+	}
+	return o.EBS
+}
+
+func (o *ClusterDynamicIops) GetBaseSize() int {
+	if o == nil || o.BaseSize == nil {
+		return 0
+	}
+	return *o.BaseSize
+}
+
+func (o *ClusterDynamicIops) GetSizePerResourceUnit() int {
+	if o == nil || o.SizePerResourceUnit == nil {
+		return 0
+	}
+	return *o.SizePerResourceUnit
+}
+
+func (o *ClusterDynamicIops) GetResource() string {
+	if o == nil || o.Resource == nil {
+		return ""
+	}
+	return *o.Resource
+}
+
+func (o *ClusterDynamicVolumeSize) GetBaseSize() int {
+	if o == nil || o.BaseSize == nil {
+		return 0
+	}
+	return *o.BaseSize
+}
+
+func (o *ClusterDynamicVolumeSize) GetSizePerResourceUnit() int {
+	if o == nil || o.SizePerResourceUnit == nil {
+		return 0
+	}
+	return *o.SizePerResourceUnit
+}
+
+func (o *ClusterDynamicVolumeSize) GetResource() string {
+	if o == nil || o.Resource == nil {
+		return ""
+	}
+	return *o.Resource
+}
+
+func (o *ClusterEBS) GetDeleteOnTermination() bool {
+	if o == nil || o.DeleteOnTermination == nil {
+		return false
+	}
+	return *o.DeleteOnTermination
+}
+
+func (o *ClusterEBS) GetEncrypted() bool {
+	if o == nil || o.Encrypted == nil {
+		return false
+	}
+	return *o.Encrypted
+}
+
+func (o *ClusterEBS) GetKMSKeyId() string {
+	if o == nil || o.KMSKeyID == nil {
+		return ""
+	}
+	return *o.KMSKeyID
+}
+
+func (o *ClusterEBS) GetSnapshotId() string {
+	if o == nil || o.SnapshotID == nil {
+		return ""
+	}
+	return *o.SnapshotID
+}
+
+func (o *ClusterEBS) GetVolumeType() string {
+	if o == nil || o.VolumeType == nil {
+		return ""
+	}
+	return *o.VolumeType
+}
+
+func (o *ClusterEBS) GetIOPS() int {
+	if o == nil || o.IOPS == nil {
+		return 0
+	}
+	return *o.IOPS
+}
+
+func (o *ClusterEBS) GetVolumeSize() int {
+	if o == nil || o.VolumeSize == nil {
+		return 0
+	}
+	return *o.VolumeSize
+}
+
+func (o *ClusterEBS) GetThroughput() int {
+	if o == nil || o.Throughput == nil {
+		return 0
+	}
+	return *o.Throughput
+}
+
+func (o *ClusterEBS) GetDynamicVolumeSize() *ClusterDynamicVolumeSize {
+	if o == nil {
+		return nil
+	}
+	return o.DynamicVolumeSize
+}
+
+func (o *ClusterEBS) GetDynamicIops() *ClusterDynamicIops {
+	if o == nil {
+		return nil
+	}
+	return o.DynamicIops
+}
+
+func (o *ClusterNodes) GetInstanceId() string {
+	if o == nil || o.InstanceId == nil {
+		return ""
+	}
+	return *o.InstanceId
+}
+
+func (o *ClusterNodes) GetInstanceType() string {
+	if o == nil || o.InstanceType == nil {
+		return ""
+	}
+	return *o.InstanceType
+}
+
+func (o *ClusterNodes) GetAvailabilityZone() string {
+	if o == nil || o.AvailabilityZone == nil {
+		return ""
+	}
+	return *o.AvailabilityZone
+}
+
+func (o *ClusterNodes) GetLaunchSpecId() string {
+	if o == nil || o.LaunchSpecId == nil {
+		return ""
+	}
+	return *o.LaunchSpecId
+}
+
+func (o *ClusterNodes) GetLaunchSpecName() string {
+	if o == nil || o.LaunchSpecName == nil {
+		return ""
+	}
+	return *o.LaunchSpecName
+}
+
+func (o *ClusterNodes) GetLifeCycle() string {
+	if o == nil || o.LifeCycle == nil {
+		return ""
+	}
+	return *o.LifeCycle
+}
+
+func (o *ClusterNodes) GetPublicIp() string {
+	if o == nil || o.PublicIp == nil {
+		return ""
+	}
+	return *o.PublicIp
+}
+
+func (o *ClusterNodes) GetNodeName() string {
+	if o == nil || o.NodeName == nil {
+		return ""
+	}
+	return *o.NodeName
+}
+
+func (o *ClusterNodes) GetRegistrationStatus() string {
+	if o == nil || o.RegistrationStatus == nil {
+		return ""
+	}
+	return *o.RegistrationStatus
+}
+
+func (o *ClusterNodes) GetWorkloadRequestedMilliCpu() int {
+	if o == nil || o.WorkloadRequestedMilliCpu == nil {
+		return 0
+	}
+	return *o.WorkloadRequestedMilliCpu
+}
+
+func (o *ClusterNodes) GetWorkloadRequestedMemoryInMiB() float64 {
+	if o == nil || o.WorkloadRequestedMemoryInMiB == nil {
+		return 0
+	}
+	return *o.WorkloadRequestedMemoryInMiB
+}
+
+func (o *ClusterNodes) GetWorkloadRequestedGpu() int {
+	if o == nil || o.WorkloadRequestedGpu == nil {
+		return 0
+	}
+	return *o.WorkloadRequestedGpu
+}
+
+func (o *ClusterNodes) GetHeadroomRequestedMilliCpu() int {
+	if o == nil || o.HeadroomRequestedMilliCpu == nil {
+		return 0
+	}
+	return *o.HeadroomRequestedMilliCpu
+}
+
+func (o *ClusterNodes) GetHeadroomRequestedMemoryInMiB() int {
+	if o == nil || o.HeadroomRequestedMemoryInMiB == nil {
+		return 0
+	}
+	return *o.HeadroomRequestedMemoryInMiB
+}
+
+func (o *ClusterNodes) GetHeadroomRequestedGpu() int {
+	if o == nil || o.HeadroomRequestedGpu == nil {
+		return 0
+	}
+	return *o.HeadroomRequestedGpu
+}
+
+func (o *ClusterNodes) GetAllocatableMilliCpu() int {
+	if o == nil || o.AllocatableMilliCpu == nil {
+		return 0
+	}
+	return *o.AllocatableMilliCpu
+}
+
+func (o *ClusterNodes) GetAllocatableMemoryInMiB() float64 {
+	if o == nil || o.AllocatableMemoryInMiB == nil {
+		return 0
+	}
+	return *o.AllocatableMemoryInMiB
+}
+
+func (o *ClusterNodes) GetAllocatableGpu() int {
+	if o == nil || o.AllocatableGpu == nil {
+		return 0
+	}
+	return *o.AllocatableGpu
+}
+
+func (o *ClusterNodes) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *ClusterOrientation) GetAvailabilityVsCost() string {
+	if o == nil || o.AvailabilityVsCost == nil {
+		return ""
+	}
+	return *o.AvailabilityVsCost
+}
+
+func (o *Compute) GetInstanceTypes() *InstanceTypes {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceTypes
+}
+
+func (o *Compute) GetLaunchSpecification() *LaunchSpecification {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecification
+}
+
+func (o *Compute) GetSubnetIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.SubnetIDs
+}
+
+func (o *Conditions) GetAnyMatch() []*AllMatch {
+	if o == nil {
+		return nil
+	}
+	return o.AnyMatch
+}
+
+func (o *ContainerResourceSuggestion) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *ContainerResourceSuggestion) GetSuggestedCPU() float64 {
+	if o == nil || o.SuggestedCPU == nil {
+		return 0
+	}
+	return *o.SuggestedCPU
+}
+
+func (o *ContainerResourceSuggestion) GetRequestedCPU() float64 {
+	if o == nil || o.RequestedCPU == nil {
+		return 0
+	}
+	return *o.RequestedCPU
+}
+
+func (o *ContainerResourceSuggestion) GetSuggestedMemory() float64 {
+	if o == nil || o.SuggestedMemory == nil {
+		return 0
+	}
+	return *o.SuggestedMemory
+}
+
+func (o *ContainerResourceSuggestion) GetRequestedMemory() float64 {
+	if o == nil || o.RequestedMemory == nil {
+		return 0
+	}
+	return *o.RequestedMemory
+}
+
+func (o *CreateClusterInput) GetCluster() *Cluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *CreateClusterOutput) GetCluster() *Cluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *CreateECSClusterInput) GetCluster() *ECSCluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *CreateECSClusterOutput) GetCluster() *ECSCluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *CreateECSLaunchSpecInput) GetLaunchSpec() *ECSLaunchSpec {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpec
+}
+
+func (o *CreateECSLaunchSpecOutput) GetLaunchSpec() *ECSLaunchSpec {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpec
+}
+
+func (o *CreateExtendedResourceDefinitionInput) GetExtendedResourceDefinition() *ExtendedResourceDefinition {
+	if o == nil {
+		return nil
+	}
+	return o.ExtendedResourceDefinition
+}
+
+func (o *CreateExtendedResourceDefinitionOutput) GetExtendedResourceDefinition() *ExtendedResourceDefinition {
+	if o == nil {
+		return nil
+	}
+	return o.ExtendedResourceDefinition
+}
+
+func (o *CreateLaunchSpecInput) GetLaunchSpec() *LaunchSpec {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpec
+}
+
+func (o *CreateLaunchSpecInput) GetInitialNodes() int {
+	if o == nil || o.InitialNodes == nil {
+		return 0
+	}
+	return *o.InitialNodes
+}
+
+func (o *CreateLaunchSpecOutput) GetLaunchSpec() *LaunchSpec {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpec
+}
+
+func (o *CreateRollInput) GetRoll() *RollSpec {
+	if o == nil {
+		return nil
+	}
+	return o.Roll
+}
+
+func (o *CreateRollOutput) GetRoll() *RollStatus {
+	if o == nil {
+		return nil
+	}
+	return o.Roll
+}
+
+func (o *DeleteClusterInput) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *DeleteECSClusterInput) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *DeleteECSLaunchSpecInput) GetLaunchSpecID() string {
+	if o == nil || o.LaunchSpecID == nil {
+		return ""
+	}
+	return *o.LaunchSpecID
+}
+
+func (o *DeleteExtendedResourceDefinitionInput) GetExtendedResourceDefinitionID() string {
+	if o == nil || o.ExtendedResourceDefinitionID == nil {
+		return ""
+	}
+	return *o.ExtendedResourceDefinitionID
+}
+
+func (o *DeleteLaunchSpecInput) GetLaunchSpecID() string {
+	if o == nil || o.LaunchSpecID == nil {
+		return ""
+	}
+	return *o.LaunchSpecID
+}
+
+func (o *DeleteLaunchSpecInput) GetForceDelete() bool {
+	if o == nil || o.ForceDelete == nil {
+		return false
+	}
+	return *o.ForceDelete
+}
+
+func (o *DeleteLaunchSpecInput) GetDeleteNodes() bool {
+	if o == nil || o.DeleteNodes == nil {
+		return false
+	}
+	return *o.DeleteNodes
+}
+
+func (o *DetachClusterInstancesInput) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *DetachClusterInstancesInput) GetInstanceIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceIDs
+}
+
+func (o *DetachClusterInstancesInput) GetShouldDecrementTargetCapacity() bool {
+	if o == nil || o.ShouldDecrementTargetCapacity == nil {
+		return false
+	}
+	return *o.ShouldDecrementTargetCapacity
+}
+
+func (o *DetachClusterInstancesInput) GetShouldTerminateInstances() bool {
+	if o == nil || o.ShouldTerminateInstances == nil {
+		return false
+	}
+	return *o.ShouldTerminateInstances
+}
+
+func (o *DetachLoadbalancerInput) GetLoadBalancers() []*LoadBalancers {
+	if o == nil {
+		return nil
+	}
+	return o.LoadBalancers
+}
+
+func (o *DetachLoadbalancerInput) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *DetailedCosts) GetAggregations() map[string]Property {
+	if o == nil {
+		return nil
+	}
+	return o.Aggregations
+}
+
+func (o *DetailedCosts) GetGroupedBy() string {
+	if o == nil || o.GroupedBy == nil {
+		return ""
+	}
+	return *o.GroupedBy
+}
+
+func (o *DynamicVolumeSize) GetBaseSize() int {
+	if o == nil || o.BaseSize == nil {
+		return 0
+	}
+	return *o.BaseSize
+}
+
+func (o *DynamicVolumeSize) GetSizePerResourceUnit() int {
+	if o == nil || o.SizePerResourceUnit == nil {
+		return 0
+	}
+	return *o.SizePerResourceUnit
+}
+
+func (o *DynamicVolumeSize) GetResource() string {
+	if o == nil || o.Resource == nil {
+		return ""
+	}
+	return *o.Resource
+}
+
+func (o *EBS) GetDeleteOnTermination() bool {
+	if o == nil || o.DeleteOnTermination == nil {
+		return false
+	}
+	return *o.DeleteOnTermination
+}
+
+func (o *EBS) GetEncrypted() bool {
+	if o == nil || o.Encrypted == nil {
+		return false
+	}
+	return *o.Encrypted
+}
+
+func (o *EBS) GetKMSKeyId() string {
+	if o == nil || o.KMSKeyID == nil {
+		return ""
+	}
+	return *o.KMSKeyID
+}
+
+func (o *EBS) GetSnapshotId() string {
+	if o == nil || o.SnapshotID == nil {
+		return ""
+	}
+	return *o.SnapshotID
+}
+
+func (o *EBS) GetVolumeType() string {
+	if o == nil || o.VolumeType == nil {
+		return ""
+	}
+	return *o.VolumeType
+}
+
+func (o *EBS) GetIOPS() int {
+	if o == nil || o.IOPS == nil {
+		return 0
+	}
+	return *o.IOPS
+}
+
+func (o *EBS) GetVolumeSize() int {
+	if o == nil || o.VolumeSize == nil {
+		return 0
+	}
+	return *o.VolumeSize
+}
+
+func (o *EBS) GetThroughput() int {
+	if o == nil || o.Throughput == nil {
+		return 0
+	}
+	return *o.Throughput
+}
+
+func (o *EBS) GetDynamicVolumeSize() *DynamicVolumeSize {
+	if o == nil {
+		return nil
+	}
+	return o.DynamicVolumeSize
+}
+
+func (o *EBS) GetDynamicIops() *VNGDynamicIops {
+	if o == nil {
+		return nil
+	}
+	return o.DynamicIops
+}
+
+func (o *ECSAttribute) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *ECSAttribute) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *ECSAutoScale) GetHeadrooms() []*ECSAutoScaleHeadroom {
+	if o == nil {
+		return nil
+	}
+	return o.Headrooms
+}
+
+func (o *ECSAutoScaleHeadroom) GetCPUPerUnit() int {
+	if o == nil || o.CPUPerUnit == nil {
+		return 0
+	}
+	return *o.CPUPerUnit
+}
+
+func (o *ECSAutoScaleHeadroom) GetMemoryPerUnit() int {
+	if o == nil || o.MemoryPerUnit == nil {
+		return 0
+	}
+	return *o.MemoryPerUnit
+}
+
+func (o *ECSAutoScaleHeadroom) GetNumOfUnits() int {
+	if o == nil || o.NumOfUnits == nil {
+		return 0
+	}
+	return *o.NumOfUnits
+}
+
+func (o *ECSAutoScaler) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *ECSAutoScaler) GetIsAutoConfig() bool {
+	if o == nil || o.IsAutoConfig == nil {
+		return false
+	}
+	return *o.IsAutoConfig
+}
+
+func (o *ECSAutoScaler) GetCooldown() int {
+	if o == nil || o.Cooldown == nil {
+		return 0
+	}
+	return *o.Cooldown
+}
+
+func (o *ECSAutoScaler) GetHeadroom() *ECSAutoScalerHeadroom {
+	if o == nil {
+		return nil
+	}
+	return o.Headroom
+}
+
+func (o *ECSAutoScaler) GetResourceLimits() *ECSAutoScalerResourceLimits {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceLimits
+}
+
+func (o *ECSAutoScaler) GetDown() *ECSAutoScalerDown {
+	if o == nil {
+		return nil
+	}
+	return o.Down
+}
+
+func (o *ECSAutoScaler) GetAutoHeadroomPercentage() int {
+	if o == nil || o.AutoHeadroomPercentage == nil {
+		return 0
+	}
+	return *o.AutoHeadroomPercentage
+}
+
+func (o *ECSAutoScaler) GetShouldScaleDownNonServiceTasks() bool {
+	if o == nil || o.ShouldScaleDownNonServiceTasks == nil {
+		return false
+	}
+	return *o.ShouldScaleDownNonServiceTasks
+}
+
+func (o *ECSAutoScaler) GetEnableAutomaticAndManualHeadroom() bool {
+	if o == nil || o.EnableAutomaticAndManualHeadroom == nil {
+		return false
+	}
+	return *o.EnableAutomaticAndManualHeadroom
+}
+
+func (o *ECSAutoScalerDown) GetMaxScaleDownPercentage() float64 {
+	if o == nil || o.MaxScaleDownPercentage == nil {
+		return 0
+	}
+	return *o.MaxScaleDownPercentage
+}
+
+func (o *ECSAutoScalerHeadroom) GetCPUPerUnit() int {
+	if o == nil || o.CPUPerUnit == nil {
+		return 0
+	}
+	return *o.CPUPerUnit
+}
+
+func (o *ECSAutoScalerHeadroom) GetMemoryPerUnit() int {
+	if o == nil || o.MemoryPerUnit == nil {
+		return 0
+	}
+	return *o.MemoryPerUnit
+}
+
+func (o *ECSAutoScalerHeadroom) GetNumOfUnits() int {
+	if o == nil || o.NumOfUnits == nil {
+		return 0
+	}
+	return *o.NumOfUnits
+}
+
+func (o *ECSAutoScalerResourceLimits) GetMaxVCPU() int {
+	if o == nil || o.MaxVCPU == nil {
+		return 0
+	}
+	return *o.MaxVCPU
+}
+
+func (o *ECSAutoScalerResourceLimits) GetMaxMemoryGiB() int {
+	if o == nil || o.MaxMemoryGiB == nil {
+		return 0
+	}
+	return *o.MaxMemoryGiB
+}
+
+func (o *ECSBlockDeviceMapping) GetDeviceName() string {
+	if o == nil || o.DeviceName == nil {
+		return ""
+	}
+	return *o.DeviceName
+}
+
+func (o *ECSBlockDeviceMapping) GetNoDevice() string {
+	if o == nil || o.NoDevice == nil {
+		return ""
+	}
+	return *o.NoDevice
+}
+
+func (o *ECSBlockDeviceMapping) GetVirtualName() string {
+	if o == nil || o.VirtualName == nil {
+		return ""
+	}
+	return *o.VirtualName
+}
+
+func (o *ECSBlockDeviceMapping) GetEBS() *ECSEBS {
+	if o == nil {
+		return nil
+	}
+	return o.EBS
+}
+
+func (o *ECSCapacity) GetMinimum() int {
+	if o == nil || o.Minimum == nil {
+		return 0
+	}
+	return *o.Minimum
+}
+
+func (o *ECSCapacity) GetMaximum() int {
+	if o == nil || o.Maximum == nil {
+		return 0
+	}
+	return *o.Maximum
+}
+
+func (o *ECSCapacity) GetTarget() int {
+	if o == nil || o.Target == nil {
+		return 0
+	}
+	return *o.Target
+}
+
+func (o *ECSCluster) GetId() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *ECSCluster) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *ECSCluster) GetClusterName() string {
+	if o == nil || o.ClusterName == nil {
+		return ""
+	}
+	return *o.ClusterName
+}
+
+func (o *ECSCluster) GetRegion() string {
+	if o == nil || o.Region == nil {
+		return ""
+	}
+	return *o.Region
+}
+
+func (o *ECSCluster) GetCapacity() *ECSCapacity {
+	if o == nil {
+		return nil
+	}
+	return o.Capacity
+}
+
+func (o *ECSCluster) GetCompute() *ECSCompute {
+	if o == nil {
+		return nil
+	}
+	return o.Compute
+}
+
+func (o *ECSCluster) GetAutoScaler() *ECSAutoScaler {
+	if o == nil {
+		return nil
+	}
+	return o.AutoScaler
+}
+
+func (o *ECSCluster) GetECSStrategy() *ECSStrategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategy
+}
+
+func (o *ECSCluster) GetScheduling() *ECSScheduling {
+	if o == nil {
+		return nil
+	}
+	return o.Scheduling
+}
+
+func (o *ECSCluster) GetLogging() *ECSLogging {
+	if o == nil {
+		return nil
+	}
+	return o.Logging
+}
+
+func (o *ECSCluster) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *ECSCluster) GetUpdatedAt() time.Time {
+	if o == nil || o.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *o.UpdatedAt
+}
+
+func (o *ECSClusterOrientation) GetECSAvailabilityVsCost() string {
+	if o == nil || o.AvailabilityVsCost == nil {
+		return ""
+	}
+	return *o.AvailabilityVsCost
+}
+
+func (o *ECSCompute) GetInstanceTypes() *ECSInstanceTypes {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceTypes
+}
+
+func (o *ECSCompute) GetLaunchSpecification() *ECSLaunchSpecification {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecification
+}
+
+func (o *ECSCompute) GetOptimizeImages() *ECSOptimizeImages {
+	if o == nil {
+		return nil
+	}
+	return o.OptimizeImages
+}
+
+func (o *ECSCompute) GetSubnetIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.SubnetIDs
+}
+
+func (o *ECSDynamicVolumeSize) GetBaseSize() int {
+	if o == nil || o.BaseSize == nil {
+		return 0
+	}
+	return *o.BaseSize
+}
+
+func (o *ECSDynamicVolumeSize) GetSizePerResourceUnit() int {
+	if o == nil || o.SizePerResourceUnit == nil {
+		return 0
+	}
+	return *o.SizePerResourceUnit
+}
+
+func (o *ECSDynamicVolumeSize) GetResource() string {
+	if o == nil || o.Resource == nil {
+		return ""
+	}
+	return *o.Resource
+}
+
+func (o *ECSEBS) GetDeleteOnTermination() bool {
+	if o == nil || o.DeleteOnTermination == nil {
+		return false
+	}
+	return *o.DeleteOnTermination
+}
+
+func (o *ECSEBS) GetEncrypted() bool {
+	if o == nil || o.Encrypted == nil {
+		return false
+	}
+	return *o.Encrypted
+}
+
+func (o *ECSEBS) GetKMSKeyId() string {
+	if o == nil || o.KMSKeyID == nil {
+		return ""
+	}
+	return *o.KMSKeyID
+}
+
+func (o *ECSEBS) GetSnapshotId() string {
+	if o == nil || o.SnapshotID == nil {
+		return ""
+	}
+	return *o.SnapshotID
+}
+
+func (o *ECSEBS) GetVolumeType() string {
+	if o == nil || o.VolumeType == nil {
+		return ""
+	}
+	return *o.VolumeType
+}
+
+func (o *ECSEBS) GetIOPS() int {
+	if o == nil || o.IOPS == nil {
+		return 0
+	}
+	return *o.IOPS
+}
+
+func (o *ECSEBS) GetVolumeSize() int {
+	if o == nil || o.VolumeSize == nil {
+		return 0
+	}
+	return *o.VolumeSize
+}
+
+func (o *ECSEBS) GetThroughput() int {
+	if o == nil || o.Throughput == nil {
+		return 0
+	}
+	return *o.Throughput
+}
+
+func (o *ECSEBS) GetDynamicVolumeSize() *ECSDynamicVolumeSize {
+	if o == nil {
+		return nil
+	}
+	return o.DynamicVolumeSize
+}
+
+func (o *ECSExport) GetS3() *ECSS3 {
+	if o == nil {
+		return nil
+	}
+	return o.S3
+}
+
+func (o *ECSFilters) GetArchitectures() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Architectures
+}
+
+func (o *ECSFilters) GetCategories() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Categories
+}
+
+func (o *ECSFilters) GetDiskTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.DiskTypes
+}
+
+func (o *ECSFilters) GetExcludeFamilies() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ExcludeFamilies
+}
+
+func (o *ECSFilters) GetExcludeMetal() bool {
+	if o == nil || o.ExcludeMetal == nil {
+		return false
+	}
+	return *o.ExcludeMetal
+}
+
+func (o *ECSFilters) GetHypervisor() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Hypervisor
+}
+
+func (o *ECSFilters) GetIncludeFamilies() []string {
+	if o == nil {
+		return nil
+	}
+	return o.IncludeFamilies
+}
+
+func (o *ECSFilters) GetIsEnaSupported() bool {
+	if o == nil || o.IsEnaSupported == nil {
+		return false
+	}
+	return *o.IsEnaSupported
+}
+
+func (o *ECSFilters) GetMaxGpu() int {
+	if o == nil || o.MaxGpu == nil {
+		return 0
+	}
+	return *o.MaxGpu
+}
+
+func (o *ECSFilters) GetMaxMemoryGiB() float64 {
+	if o == nil || o.MaxMemoryGiB == nil {
+		return 0
+	}
+	return *o.MaxMemoryGiB
+}
+
+func (o *ECSFilters) GetMaxNetworkPerformance() int {
+	if o == nil || o.MaxNetworkPerformance == nil {
+		return 0
+	}
+	return *o.MaxNetworkPerformance
+}
+
+func (o *ECSFilters) GetMaxVcpu() int {
+	if o == nil || o.MaxVcpu == nil {
+		return 0
+	}
+	return *o.MaxVcpu
+}
+
+func (o *ECSFilters) GetMinEnis() int {
+	if o == nil || o.MinEnis == nil {
+		return 0
+	}
+	return *o.MinEnis
+}
+
+func (o *ECSFilters) GetMinGpu() int {
+	if o == nil || o.MinGpu == nil {
+		return 0
+	}
+	return *o.MinGpu
+}
+
+func (o *ECSFilters) GetMinMemoryGiB() float64 {
+	if o == nil || o.MinMemoryGiB == nil {
+		return 0
+	}
+	return *o.MinMemoryGiB
+}
+
+func (o *ECSFilters) GetMinNetworkPerformance() int {
+	if o == nil || o.MinNetworkPerformance == nil {
+		return 0
+	}
+	return *o.MinNetworkPerformance
+}
+
+func (o *ECSFilters) GetMinVcpu() int {
+	if o == nil || o.MinVcpu == nil {
+		return 0
+	}
+	return *o.MinVcpu
+}
+
+func (o *ECSFilters) GetRootDeviceTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.RootDeviceTypes
+}
+
+func (o *ECSFilters) GetVirtualizationTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.VirtualizationTypes
+}
+
+func (o *ECSIAMInstanceProfile) GetArn() string {
+	if o == nil || o.ARN == nil {
+		return ""
+	}
+	return *o.ARN
+}
+
+func (o *ECSIAMInstanceProfile) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *ECSImages) GetImageId() string {
+	if o == nil || o.ImageId == nil {
+		return ""
+	}
+	return *o.ImageId
+}
+
+func (o *ECSInstanceMetadataOptions) GetHTTPTokens() string {
+	if o == nil || o.HTTPTokens == nil {
+		return ""
+	}
+	return *o.HTTPTokens
+}
+
+func (o *ECSInstanceMetadataOptions) GetHTTPPutResponseHopLimit() int {
+	if o == nil || o.HTTPPutResponseHopLimit == nil {
+		return 0
+	}
+	return *o.HTTPPutResponseHopLimit
+}
+
+func (o *ECSInstanceTypes) GetWhitelist() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Whitelist
+}
+
+func (o *ECSInstanceTypes) GetBlacklist() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Blacklist
+}
+
+func (o *ECSInstanceTypes) GetFilters() *ECSFilters {
+	if o == nil {
+		return nil
+	}
+	return o.Filters
+}
+
+func (o *ECSLaunchSpec) GetId() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *ECSLaunchSpec) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *ECSLaunchSpec) GetOceanId() string {
+	if o == nil || o.OceanID == nil {
+		return ""
+	}
+	return *o.OceanID
+}
+
+func (o *ECSLaunchSpec) GetImageId() string {
+	if o == nil || o.ImageID == nil {
+		return ""
+	}
+	return *o.ImageID
+}
+
+func (o *ECSLaunchSpec) GetUserData() string {
+	if o == nil || o.UserData == nil {
+		return ""
+	}
+	return *o.UserData
+}
+
+func (o *ECSLaunchSpec) GetSecurityGroupIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.SecurityGroupIDs
+}
+
+func (o *ECSLaunchSpec) GetAutoScale() *ECSAutoScale {
+	if o == nil {
+		return nil
+	}
+	return o.AutoScale
+}
+
+func (o *ECSLaunchSpec) GetIAMInstanceProfile() *ECSIAMInstanceProfile {
+	if o == nil {
+		return nil
+	}
+	return o.IAMInstanceProfile
+}
+
+func (o *ECSLaunchSpec) GetAttributes() []*ECSAttribute {
+	if o == nil {
+		return nil
+	}
+	return o.Attributes
+}
+
+func (o *ECSLaunchSpec) GetBlockDeviceMappings() []*ECSBlockDeviceMapping {
+	if o == nil {
+		return nil
+	}
+	return o.BlockDeviceMappings
+}
+
+func (o *ECSLaunchSpec) GetTags() []*Tag {
+	if o == nil {
+		return nil
+	}
+	return o.Tags
+}
+
+func (o *ECSLaunchSpec) GetInstanceTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceTypes
+}
+
+func (o *ECSLaunchSpec) GetPreferredSpotTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.PreferredSpotTypes
+}
+
+func (o *ECSLaunchSpec) GetStrategy() *ECSLaunchSpecStrategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategy
+}
+
+func (o *ECSLaunchSpec) GetRestrictScaleDown() bool {
+	if o == nil || o.RestrictScaleDown == nil {
+		return false
+	}
+	return *o.RestrictScaleDown
+}
+
+func (o *ECSLaunchSpec) GetSubnetIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.SubnetIDs
+}
+
+func (o *ECSLaunchSpec) GetScheduling() *ECSLaunchSpecScheduling {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecScheduling
+}
+
+func (o *ECSLaunchSpec) GetECSLaunchspecInstanceMetadataOptions() *ECSLaunchspecInstanceMetadataOptions {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceMetadataOptions
+}
+
+func (o *ECSLaunchSpec) GetECSImages() []*ECSImages {
+	if o == nil {
+		return nil
+	}
+	return o.Images
+}
+
+func (o *ECSLaunchSpec) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *ECSLaunchSpec) GetUpdatedAt() time.Time {
+	if o == nil || o.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *o.UpdatedAt
+}
+
+func (o *ECSLaunchSpecScheduling) GetTasks() []*ECSLaunchSpecTask {
+	if o == nil {
+		return nil
+	}
+	return o.Tasks
+}
+
+func (o *ECSLaunchSpecStrategy) GetSpotPercentage() int {
+	if o == nil || o.SpotPercentage == nil {
+		return 0
+	}
+	return *o.SpotPercentage
+}
+
+func (o *ECSLaunchSpecTask) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *ECSLaunchSpecTask) GetCronExpression() string {
+	if o == nil || o.CronExpression == nil {
+		return ""
+	}
+	return *o.CronExpression
+}
+
+func (o *ECSLaunchSpecTask) GetTaskType() string {
+	if o == nil || o.TaskType == nil {
+		return ""
+	}
+	return *o.TaskType
+}
+
+func (o *ECSLaunchSpecTask) GetTaskConfig() *ECSTaskConfig {
+	if o == nil {
+		return nil
+	}
+	return o.Config
+}
+
+func (o *ECSLaunchSpecTaskHeadroom) GetCPUPerUnit() int {
+	if o == nil || o.CPUPerUnit == nil {
+		return 0
+	}
+	return *o.CPUPerUnit
+}
+
+func (o *ECSLaunchSpecTaskHeadroom) GetMemoryPerUnit() int {
+	if o == nil || o.MemoryPerUnit == nil {
+		return 0
+	}
+	return *o.MemoryPerUnit
+}
+
+func (o *ECSLaunchSpecTaskHeadroom) GetNumOfUnits() int {
+	if o == nil || o.NumOfUnits == nil {
+		return 0
+	}
+	return *o.NumOfUnits
+}
+
+func (o *ECSLaunchSpecification) GetAssociatePublicIPAddress() bool {
+	if o == nil || o.AssociatePublicIPAddress == nil {
+		return false
+	}
+	return *o.AssociatePublicIPAddress
+}
+
+func (o *ECSLaunchSpecification) GetSecurityGroupIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.SecurityGroupIDs
+}
+
+func (o *ECSLaunchSpecification) GetImageId() string {
+	if o == nil || o.ImageID == nil {
+		return ""
+	}
+	return *o.ImageID
+}
+
+func (o *ECSLaunchSpecification) GetKeyPair() string {
+	if o == nil || o.KeyPair == nil {
+		return ""
+	}
+	return *o.KeyPair
+}
+
+func (o *ECSLaunchSpecification) GetUserData() string {
+	if o == nil || o.UserData == nil {
+		return ""
+	}
+	return *o.UserData
+}
+
+func (o *ECSLaunchSpecification) GetIAMInstanceProfile() *ECSIAMInstanceProfile {
+	if o == nil {
+		return nil
+	}
+	return o.IAMInstanceProfile
+}
+
+func (o *ECSLaunchSpecification) GetTags() []*Tag {
+	if o == nil {
+		return nil
+	}
+	return o.Tags
+}
+
+func (o *ECSLaunchSpecification) GetMonitoring() bool {
+	if o == nil || o.Monitoring == nil {
+		return false
+	}
+	return *o.Monitoring
+}
+
+func (o *ECSLaunchSpecification) GetEBSOptimized() bool {
+	if o == nil || o.EBSOptimized == nil {
+		return false
+	}
+	return *o.EBSOptimized
+}
+
+func (o *ECSLaunchSpecification) GetBlockDeviceMappings() []*ECSBlockDeviceMapping {
+	if o == nil {
+		return nil
+	}
+	return o.BlockDeviceMappings
+}
+
+func (o *ECSLaunchSpecification) GetInstanceMetadataOptions() *ECSInstanceMetadataOptions {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceMetadataOptions
+}
+
+func (o *ECSLaunchSpecification) GetUseAsTemplateOnly() bool {
+	if o == nil || o.UseAsTemplateOnly == nil {
+		return false
+	}
+	return *o.UseAsTemplateOnly
+}
+
+func (o *ECSLaunchspecInstanceMetadataOptions) GetHTTPTokens() string {
+	if o == nil || o.HTTPTokens == nil {
+		return ""
+	}
+	return *o.HTTPTokens
+}
+
+func (o *ECSLaunchspecInstanceMetadataOptions) GetHTTPPutResponseHopLimit() int {
+	if o == nil || o.HTTPPutResponseHopLimit == nil {
+		return 0
+	}
+	return *o.HTTPPutResponseHopLimit
+}
+
+func (o *ECSLogging) GetExport() *ECSExport {
+	if o == nil {
+		return nil
+	}
+	return o.Export
+}
+
+func (o *ECSOptimizeImages) GetPerformAt() string {
+	if o == nil || o.PerformAt == nil {
+		return ""
+	}
+	return *o.PerformAt
+}
+
+func (o *ECSOptimizeImages) GetTimeWindows() []string {
+	if o == nil {
+		return nil
+	}
+	return o.TimeWindows
+}
+
+func (o *ECSOptimizeImages) GetShouldOptimizeECSAMI() bool {
+	if o == nil || o.ShouldOptimizeECSAMI == nil {
+		return false
+	}
+	return *o.ShouldOptimizeECSAMI
+}
+
+func (o *ECSProgress) GetUnit() string {
+	if o == nil || o.Unit == nil {
+		return ""
+	}
+	return *o.Unit
+}
+
+func (o *ECSProgress) GetValue() int {
+	if o == nil || o.Value == nil {
+		return 0
+	}
+	return *o.Value
+}
+
+func (o *ECSRoll) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *ECSRoll) GetComment() string {
+	if o == nil || o.Comment == nil {
+		return ""
+	}
+	return *o.Comment
+}
+
+func (o *ECSRoll) GetBatchSizePercentage() int {
+	if o == nil || o.BatchSizePercentage == nil {
+		return 0
+	}
+	return *o.BatchSizePercentage
+}
+
+func (o *ECSRoll) GetBatchMinHealthyPercentage() int {
+	if o == nil || o.BatchMinHealthyPercentage == nil {
+		return 0
+	}
+	return *o.BatchMinHealthyPercentage
+}
+
+func (o *ECSRoll) GetLaunchSpecIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecIDs
+}
+
+func (o *ECSRoll) GetInstanceIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceIDs
+}
+
+func (o *ECSRollClusterInput) GetRoll() *ECSRoll {
+	if o == nil {
+		return nil
+	}
+	return o.Roll
+}
+
+func (o *ECSRollClusterOutput) GetRollClusterStatus() *ECSRollClusterStatus {
+	if o == nil {
+		return nil
+	}
+	return o.RollClusterStatus
+}
+
+func (o *ECSRollClusterStatus) GetOceanID() string {
+	if o == nil || o.OceanID == nil {
+		return ""
+	}
+	return *o.OceanID
+}
+
+func (o *ECSRollClusterStatus) GetRollID() string {
+	if o == nil || o.RollID == nil {
+		return ""
+	}
+	return *o.RollID
+}
+
+func (o *ECSRollClusterStatus) GetRollStatus() string {
+	if o == nil || o.RollStatus == nil {
+		return ""
+	}
+	return *o.RollStatus
+}
+
+func (o *ECSRollClusterStatus) GetProgress() *ECSProgress {
+	if o == nil {
+		return nil
+	}
+	return o.Progress
+}
+
+func (o *ECSRollClusterStatus) GetCurrentBatch() int {
+	if o == nil || o.CurrentBatch == nil {
+		return 0
+	}
+	return *o.CurrentBatch
+}
+
+func (o *ECSRollClusterStatus) GetNumOfBatches() int {
+	if o == nil || o.NumOfBatches == nil {
+		return 0
+	}
+	return *o.NumOfBatches
+}
+
+func (o *ECSRollClusterStatus) GetCreatedAt() string {
+	if o == nil || o.CreatedAt == nil {
+		return ""
+	}
+	return *o.CreatedAt
+}
+
+func (o *ECSRollClusterStatus) GetUpdatedAt() string {
+	if o == nil || o.UpdatedAt == nil {
+		return ""
+	}
+	return *o.UpdatedAt
+}
+
+func (o *ECSS3) GetId() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *ECSScheduling) GetTasks() []*ECSTask {
+	if o == nil {
+		return nil
+	}
+	return o.Tasks
+}
+
+func (o *ECSScheduling) GetShutdownHours() *ECSShutdownHours {
+	if o == nil {
+		return nil
+	}
+	return o.ShutdownHours
+}
+
+func (o *ECSShutdownHours) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *ECSShutdownHours) GetTimeWindows() []string {
+	if o == nil {
+		return nil
+	}
+	return o.TimeWindows
+}
+
+func (o *ECSStrategy) GetDrainingTimeout() int {
+	if o == nil || o.DrainingTimeout == nil {
+		return 0
+	}
+	return *o.DrainingTimeout
+}
+
+func (o *ECSStrategy) GetUtilizeReservedInstances() bool {
+	if o == nil || o.UtilizeReservedInstances == nil {
+		return false
+	}
+	return *o.UtilizeReservedInstances
+}
+
+func (o *ECSStrategy) GetUtilizeCommitments() bool {
+	if o == nil || o.UtilizeCommitments == nil {
+		return false
+	}
+	return *o.UtilizeCommitments
+}
+
+func (o *ECSStrategy) GetSpotPercentage() int {
+	if o == nil || o.SpotPercentage == nil {
+		return 0
+	}
+	return *o.SpotPercentage
+}
+
+func (o *ECSStrategy) GetECSClusterOrientation() *ECSClusterOrientation {
+	if o == nil {
+		return nil
+	}
+	return o.ClusterOrientation
+}
+
+func (o *ECSStrategy) GetFallbackToOnDemand() bool {
+	if o == nil || o.FallbackToOnDemand == nil {
+		return false
+	}
+	return *o.FallbackToOnDemand
+}
+
+func (o *ECSTask) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *ECSTask) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *ECSTask) GetCronExpression() string {
+	if o == nil || o.CronExpression == nil {
+		return ""
+	}
+	return *o.CronExpression
+}
+
+func (o *ECSTaskConfig) GetHeadrooms() []*ECSLaunchSpecTaskHeadroom {
+	if o == nil {
+		return nil
+	}
+	return o.TaskHeadrooms
+}
+
+func (o *EbsPv) GetTotal() float64 {
+	if o == nil || o.Total == nil {
+		return 0
+	}
+	return *o.Total
+}
+
+func (o *EfsPv) GetTotal() float64 {
+	if o == nil || o.Total == nil {
+		return 0
+	}
+	return *o.Total
+}
+
+func (o *ElasticIPPool) GetTagSelector() *TagSelector {
+	if o == nil {
+		return nil
+	}
+	return o.TagSelector
+}
+
+func (o *EphemeralStorage) GetDeviceName() string {
+	if o == nil || o.DeviceName == nil {
+		return ""
+	}
+	return *o.DeviceName
+}
+
+func (o *Export) GetS3() *S3 {
+	if o == nil {
+		return nil
+	}
+	return o.S3
+}
+
+func (o *ExtendedResourceDefinition) GetId() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *ExtendedResourceDefinition) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *ExtendedResourceDefinition) GetMapping() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.Mapping
+}
+
+func (o *File) GetEfsPv() *EfsPv {
+	if o == nil {
+		return nil
+	}
+	return o.EfsPv
+}
+
+func (o *File) GetTotal() float64 {
+	if o == nil || o.Total == nil {
+		return 0
+	}
+	return *o.Total
+}
+
+func (o *Filter) GetAttribute() *Attribute {
+	if o == nil {
+		return nil
+	}
+	return o.Attribute
+}
+
+func (o *Filter) GetNamespaces() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Namespaces
+}
+
+func (o *Filters) GetArchitectures() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Architectures
+}
+
+func (o *Filters) GetCategories() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Categories
+}
+
+func (o *Filters) GetDiskTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.DiskTypes
+}
+
+func (o *Filters) GetExcludeFamilies() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ExcludeFamilies
+}
+
+func (o *Filters) GetExcludeMetal() bool {
+	if o == nil || o.ExcludeMetal == nil {
+		return false
+	}
+	return *o.ExcludeMetal
+}
+
+func (o *Filters) GetHypervisor() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Hypervisor
+}
+
+func (o *Filters) GetIncludeFamilies() []string {
+	if o == nil {
+		return nil
+	}
+	return o.IncludeFamilies
+}
+
+func (o *Filters) GetIsEnaSupported() bool {
+	if o == nil || o.IsEnaSupported == nil {
+		return false
+	}
+	return *o.IsEnaSupported
+}
+
+func (o *Filters) GetMaxGpu() int {
+	if o == nil || o.MaxGpu == nil {
+		return 0
+	}
+	return *o.MaxGpu
+}
+
+func (o *Filters) GetMaxMemoryGiB() float64 {
+	if o == nil || o.MaxMemoryGiB == nil {
+		return 0
+	}
+	return *o.MaxMemoryGiB
+}
+
+func (o *Filters) GetMaxNetworkPerformance() int {
+	if o == nil || o.MaxNetworkPerformance == nil {
+		return 0
+	}
+	return *o.MaxNetworkPerformance
+}
+
+func (o *Filters) GetMaxVcpu() int {
+	if o == nil || o.MaxVcpu == nil {
+		return 0
+	}
+	return *o.MaxVcpu
+}
+
+func (o *Filters) GetMinEnis() int {
+	if o == nil || o.MinEnis == nil {
+		return 0
+	}
+	return *o.MinEnis
+}
+
+func (o *Filters) GetMinGpu() int {
+	if o == nil || o.MinGpu == nil {
+		return 0
+	}
+	return *o.MinGpu
+}
+
+func (o *Filters) GetMinMemoryGiB() float64 {
+	if o == nil || o.MinMemoryGiB == nil {
+		return 0
+	}
+	return *o.MinMemoryGiB
+}
+
+func (o *Filters) GetMinNetworkPerformance() int {
+	if o == nil || o.MinNetworkPerformance == nil {
+		return 0
+	}
+	return *o.MinNetworkPerformance
+}
+
+func (o *Filters) GetMinVcpu() int {
+	if o == nil || o.MinVcpu == nil {
+		return 0
+	}
+	return *o.MinVcpu
+}
+
+func (o *Filters) GetRootDeviceTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.RootDeviceTypes
+}
+
+func (o *Filters) GetVirtualizationTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.VirtualizationTypes
+}
+
+func (o *GetLogEventsInput) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *GetLogEventsInput) GetFromDate() string {
+	if o == nil || o.FromDate == nil {
+		return ""
+	}
+	return *o.FromDate
+}
+
+func (o *GetLogEventsInput) GetToDate() string {
+	if o == nil || o.ToDate == nil {
+		return ""
+	}
+	return *o.ToDate
+}
+
+func (o *GetLogEventsInput) GetResourceID() string {
+	if o == nil || o.ResourceID == nil {
+		return ""
+	}
+	return *o.ResourceID
+}
+
+func (o *GetLogEventsInput) GetSeverity() string {
+	if o == nil || o.Severity == nil {
+		return ""
+	}
+	return *o.Severity
+}
+
+func (o *GetLogEventsInput) GetLimit() int {
+	if o == nil || o.Limit == nil {
+		return 0
+	}
+	return *o.Limit
+}
+
+func (o *GetLogEventsOutput) GetEvents() []*LogEvent {
+	if o == nil {
+		return nil
+	}
+	return o.Events
+}
+
+func (o *Headroom) GetTotal() float64 {
+	if o == nil || o.Total == nil {
+		return 0
+	}
+	return *o.Total
+}
+
+func (o *IAMInstanceProfile) GetArn() string {
+	if o == nil || o.ARN == nil {
+		return ""
+	}
+	return *o.ARN
+}
+
+func (o *IAMInstanceProfile) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Images) GetImageId() string {
+	if o == nil || o.ImageId == nil {
+		return ""
+	}
+	return *o.ImageId
+}
+
+func (o *Instance) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *Instance) GetSpotRequestID() string {
+	if o == nil || o.SpotRequestID == nil {
+		return ""
+	}
+	return *o.SpotRequestID
+}
+
+func (o *Instance) GetInstanceType() string {
+	if o == nil || o.InstanceType == nil {
+		return ""
+	}
+	return *o.InstanceType
+}
+
+func (o *Instance) GetStatus() string {
+	if o == nil || o.Status == nil {
+		return ""
+	}
+	return *o.Status
+}
+
+func (o *Instance) GetProduct() string {
+	if o == nil || o.Product == nil {
+		return ""
+	}
+	return *o.Product
+}
+
+func (o *Instance) GetAvailabilityZone() string {
+	if o == nil || o.AvailabilityZone == nil {
+		return ""
+	}
+	return *o.AvailabilityZone
+}
+
+func (o *Instance) GetPrivateIP() string {
+	if o == nil || o.PrivateIP == nil {
+		return ""
+	}
+	return *o.PrivateIP
+}
+
+func (o *Instance) GetPublicIP() string {
+	if o == nil || o.PublicIP == nil {
+		return ""
+	}
+	return *o.PublicIP
+}
+
+func (o *Instance) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *InstanceDetails) GetInstanceId() string {
+	if o == nil || o.InstanceId == nil {
+		return ""
+	}
+	return *o.InstanceId
+}
+
+func (o *InstanceDetails) GetK8sNodeName() string {
+	if o == nil || o.K8sNodeName == nil {
+		return ""
+	}
+	return *o.K8sNodeName
+}
+
+func (o *InstanceDetails) GetAsgName() string {
+	if o == nil || o.AsgName == nil {
+		return ""
+	}
+	return *o.AsgName
+}
+
+func (o *InstanceDetails) GetState() string {
+	if o == nil || o.State == nil {
+		return ""
+	}
+	return *o.State
+}
+
+func (o *InstanceDetails) GetRunningPods() int64 {
+	if o == nil || o.RunningPods == nil {
+		return 0
+	}
+	return *o.RunningPods
+}
+
+func (o *InstanceDetails) GetPodDetails() []*PodDetails {
+	if o == nil {
+		return nil
+	}
+	return o.PodDetails
+}
+
+func (o *InstanceMetadataOptions) GetHTTPTokens() string {
+	if o == nil || o.HTTPTokens == nil {
+		return ""
+	}
+	return *o.HTTPTokens
+}
+
+func (o *InstanceMetadataOptions) GetHTTPPutResponseHopLimit() int {
+	if o == nil || o.HTTPPutResponseHopLimit == nil {
+		return 0
+	}
+	return *o.HTTPPutResponseHopLimit
+}
+
+func (o *InstanceStorePolicy) GetInstanceStorePolicyType() string {
+	if o == nil || o.InstanceStorePolicyType == nil {
+		return ""
+	}
+	return *o.InstanceStorePolicyType
+}
+
+func (o *InstanceTypes) GetWhitelist() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Whitelist
+}
+
+func (o *InstanceTypes) GetBlacklist() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Blacklist
+}
+
+func (o *InstanceTypes) GetFilters() *Filters {
+	if o == nil {
+		return nil
+	}
+	return o.Filters
+}
+
+func (o *InstanceTypesFilters) GetCategories() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Categories
+}
+
+func (o *InstanceTypesFilters) GetDiskTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.DiskTypes
+}
+
+func (o *InstanceTypesFilters) GetExcludeFamilies() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ExcludeFamilies
+}
+
+func (o *InstanceTypesFilters) GetExcludeMetal() bool {
+	if o == nil || o.ExcludeMetal == nil {
+		return false
+	}
+	return *o.ExcludeMetal
+}
+
+func (o *InstanceTypesFilters) GetHypervisor() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Hypervisor
+}
+
+func (o *InstanceTypesFilters) GetIncludeFamilies() []string {
+	if o == nil {
+		return nil
+	}
+	return o.IncludeFamilies
+}
+
+func (o *InstanceTypesFilters) GetIsEnaSupported() bool {
+	if o == nil || o.IsEnaSupported == nil {
+		return false
+	}
+	return *o.IsEnaSupported
+}
+
+func (o *InstanceTypesFilters) GetMaxGpu() int {
+	if o == nil || o.MaxGpu == nil {
+		return 0
+	}
+	return *o.MaxGpu
+}
+
+func (o *InstanceTypesFilters) GetMaxMemoryGiB() float64 {
+	if o == nil || o.MaxMemoryGiB == nil {
+		return 0
+	}
+	return *o.MaxMemoryGiB
+}
+
+func (o *InstanceTypesFilters) GetMaxNetworkPerformance() int {
+	if o == nil || o.MaxNetworkPerformance == nil {
+		return 0
+	}
+	return *o.MaxNetworkPerformance
+}
+
+func (o *InstanceTypesFilters) GetMaxVcpu() int {
+	if o == nil || o.MaxVcpu == nil {
+		return 0
+	}
+	return *o.MaxVcpu
+}
+
+func (o *InstanceTypesFilters) GetMinEnis() int {
+	if o == nil || o.MinEnis == nil {
+		return 0
+	}
+	return *o.MinEnis
+}
+
+func (o *InstanceTypesFilters) GetMinGpu() int {
+	if o == nil || o.MinGpu == nil {
+		return 0
+	}
+	return *o.MinGpu
+}
+
+func (o *InstanceTypesFilters) GetMinMemoryGiB() float64 {
+	if o == nil || o.MinMemoryGiB == nil {
+		return 0
+	}
+	return *o.MinMemoryGiB
+}
+
+func (o *InstanceTypesFilters) GetMinNetworkPerformance() int {
+	if o == nil || o.MinNetworkPerformance == nil {
+		return 0
+	}
+	return *o.MinNetworkPerformance
+}
+
+func (o *InstanceTypesFilters) GetMinVcpu() int {
+	if o == nil || o.MinVcpu == nil {
+		return 0
+	}
+	return *o.MinVcpu
+}
+
+func (o *InstanceTypesFilters) GetRootDeviceTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.RootDeviceTypes
+}
+
+func (o *InstanceTypesFilters) GetVirtualizationTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.VirtualizationTypes
+}
+
+func (o *Label) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *Label) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *LaunchSpec) GetId() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *LaunchSpec) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *LaunchSpec) GetOceanId() string {
+	if o == nil || o.OceanID == nil {
+		return ""
+	}
+	return *o.OceanID
+}
+
+func (o *LaunchSpec) GetImageId() string {
+	if o == nil || o.ImageID == nil {
+		return ""
+	}
+	return *o.ImageID
+}
+
+func (o *LaunchSpec) GetUserData() string {
+	if o == nil || o.UserData == nil {
+		return ""
+	}
+	return *o.UserData
+}
+
+func (o *LaunchSpec) GetRootVolumeSize() int {
+	if o == nil || o.RootVolumeSize == nil {
+		return 0
+	}
+	return *o.RootVolumeSize
+}
+
+func (o *LaunchSpec) GetSecurityGroupIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.SecurityGroupIDs
+}
+
+func (o *LaunchSpec) GetSubnetIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.SubnetIDs
+}
+
+func (o *LaunchSpec) GetInstanceTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceTypes
+}
+
+func (o *LaunchSpec) GetPreferredSpotTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.PreferredSpotTypes
+}
+
+func (o *LaunchSpec) GetStrategy() *LaunchSpecStrategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategy
+}
+
+func (o *LaunchSpec) GetResourceLimits() *ResourceLimits {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceLimits
+}
+
+func (o *LaunchSpec) GetIAMInstanceProfile() *IAMInstanceProfile {
+	if o == nil {
+		return nil
+	}
+	return o.IAMInstanceProfile
+}
+
+func (o *LaunchSpec) GetAutoScale() *AutoScale {
+	if o == nil {
+		return nil
+	}
+	return o.AutoScale
+}
+
+func (o *LaunchSpec) GetElasticIPPool() *ElasticIPPool {
+	if o == nil {
+		return nil
+	}
+	return o.ElasticIPPool
+}
+
+func (o *LaunchSpec) GetBlockDeviceMappings() []*BlockDeviceMapping {
+	if o == nil {
+		return nil
+	}
+	return o.BlockDeviceMappings
+}
+
+func (o *LaunchSpec) GetEphemeralStorage() *EphemeralStorage {
+	if o == nil {
+		return nil
+	}
+	return o.EphemeralStorage
+}
+
+func (o *LaunchSpec) GetLabels() []*Label {
+	if o == nil {
+		return nil
+	}
+	return o.Labels
+}
+
+func (o *LaunchSpec) GetVNGLoadBalancer() []*VNGLoadBalancer {
+	if o == nil {
+		return nil
+	}
+	return o.LoadBalancers
+}
+
+func (o *LaunchSpec) GetTaints() []*Taint {
+	if o == nil {
+		return nil
+	}
+	return o.Taints
+}
+
+func (o *LaunchSpec) GetTags() []*Tag {
+	if o == nil {
+		return nil
+	}
+	return o.Tags
+}
+
+func (o *LaunchSpec) GetAssociatePublicIPAddress() bool {
+	if o == nil || o.AssociatePublicIPAddress == nil {
+		return false
+	}
+	return *o.AssociatePublicIPAddress
+}
+
+func (o *LaunchSpec) GetRestrictScaleDown() bool {
+	if o == nil || o.RestrictScaleDown == nil {
+		return false
+	}
+	return *o.RestrictScaleDown
+}
+
+func (o *LaunchSpec) GetScheduling() *LaunchSpecScheduling {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecScheduling
+}
+
+func (o *LaunchSpec) GetLaunchspecInstanceMetadataOptions() *LaunchspecInstanceMetadataOptions {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceMetadataOptions
+}
+
+func (o *LaunchSpec) GetImages() []*Images {
+	if o == nil {
+		return nil
+	}
+	return o.Images
+}
+
+func (o *LaunchSpec) GetInstanceTypesFilters() *InstanceTypesFilters {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceTypesFilters
+}
+
+func (o *LaunchSpec) GetPreferredOnDemandTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.PreferredOnDemandTypes
+}
+
+func (o *LaunchSpec) GetReservedENIs() int {
+	if o == nil || o.ReservedENIs == nil {
+		return 0
+	}
+	return *o.ReservedENIs
+}
+
+func (o *LaunchSpec) GetInstanceStorePolicy() *InstanceStorePolicy {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceStorePolicy
+}
+
+func (o *LaunchSpec) GetStartupTaints() []*StartupTaints {
+	if o == nil {
+		return nil
+	}
+	return o.StartupTaints
+}
+
+func (o *LaunchSpec) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *LaunchSpec) GetUpdatedAt() time.Time {
+	if o == nil || o.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *o.UpdatedAt
+}
+
+func (o *LaunchSpecOptimizationWindow) GetCronExpression() string {
+	if o == nil || o.CronExpression == nil {
+		return ""
+	}
+	return *o.CronExpression
+}
+
+func (o *LaunchSpecOptimizationWindow) GetDuration() string {
+	if o == nil || o.Duration == nil {
+		return ""
+	}
+	return *o.Duration
+}
+
+func (o *LaunchSpecOptimizationWindow) GetEffects() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Effects
+}
+
+func (o *LaunchSpecOptimizationWindows) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *LaunchSpecOptimizationWindows) GetWindows() []*LaunchSpecOptimizationWindow {
+	if o == nil {
+		return nil
+	}
+	return o.Windows
+}
+
+func (o *LaunchSpecOrientation) GetAvailabilityVsCost() string {
+	if o == nil || o.AvailabilityVsCost == nil {
+		return ""
+	}
+	return *o.AvailabilityVsCost
+}
+
+func (o *LaunchSpecScheduling) GetTasks() []*LaunchSpecTask {
+	if o == nil {
+		return nil
+	}
+	return o.Tasks
+}
+
+func (o *LaunchSpecScheduling) GetShutdownHours() *LaunchSpecShutdownHours {
+	if o == nil {
+		return nil
+	}
+	return o.ShutdownHours
+}
+
+func (o *LaunchSpecScheduling) GetOptimizationWindows() *LaunchSpecOptimizationWindows {
+	if o == nil {
+		return nil
+	}
+	return o.OptimizationWindows
+}
+
+func (o *LaunchSpecShutdownHours) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *LaunchSpecShutdownHours) GetTimeWindows() []string {
+	if o == nil {
+		return nil
+	}
+	return o.TimeWindows
+}
+
+func (o *LaunchSpecStrategy) GetSpotPercentage() int {
+	if o == nil || o.SpotPercentage == nil {
+		return 0
+	}
+	return *o.SpotPercentage
+}
+
+func (o *LaunchSpecStrategy) GetDrainingTimeout() int {
+	if o == nil || o.DrainingTimeout == nil {
+		return 0
+	}
+	return *o.DrainingTimeout
+}
+
+func (o *LaunchSpecStrategy) GetUtilizeCommitments() bool {
+	if o == nil || o.UtilizeCommitments == nil {
+		return false
+	}
+	return *o.UtilizeCommitments
+}
+
+func (o *LaunchSpecStrategy) GetUtilizeReservedInstances() bool {
+	if o == nil || o.UtilizeReservedInstances == nil {
+		return false
+	}
+	return *o.UtilizeReservedInstances
+}
+
+func (o *LaunchSpecStrategy) GetOrientation() *LaunchSpecOrientation {
+	if o == nil {
+		return nil
+	}
+	return o.Orientation
+}
+
+func (o *LaunchSpecTask) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *LaunchSpecTask) GetCronExpression() string {
+	if o == nil || o.CronExpression == nil {
+		return ""
+	}
+	return *o.CronExpression
+}
+
+func (o *LaunchSpecTask) GetTaskType() string {
+	if o == nil || o.TaskType == nil {
+		return ""
+	}
+	return *o.TaskType
+}
+
+func (o *LaunchSpecTask) GetTaskConfig() *TaskConfig {
+	if o == nil {
+		return nil
+	}
+	return o.Config
+}
+
+func (o *LaunchSpecTaskHeadroom) GetCPUPerUnit() int {
+	if o == nil || o.CPUPerUnit == nil {
+		return 0
+	}
+	return *o.CPUPerUnit
+}
+
+func (o *LaunchSpecTaskHeadroom) GetGPUPerUnit() int {
+	if o == nil || o.GPUPerUnit == nil {
+		return 0
+	}
+	return *o.GPUPerUnit
+}
+
+func (o *LaunchSpecTaskHeadroom) GetMemoryPerUnit() int {
+	if o == nil || o.MemoryPerUnit == nil {
+		return 0
+	}
+	return *o.MemoryPerUnit
+}
+
+func (o *LaunchSpecTaskHeadroom) GetNumOfUnits() int {
+	if o == nil || o.NumOfUnits == nil {
+		return 0
+	}
+	return *o.NumOfUnits
+}
+
+func (o *LaunchSpecification) GetAssociatePublicIPAddress() bool {
+	if o == nil || o.AssociatePublicIPAddress == nil {
+		return false
+	}
+	return *o.AssociatePublicIPAddress
+}
+
+func (o *LaunchSpecification) GetAssociateIPv6Address() bool {
+	if o == nil || o.AssociateIPv6Address == nil {
+		return false
+	}
+	return *o.AssociateIPv6Address
+}
+
+func (o *LaunchSpecification) GetSecurityGroupIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.SecurityGroupIDs
+}
+
+func (o *LaunchSpecification) GetImageId() string {
+	if o == nil || o.ImageID == nil {
+		return ""
+	}
+	return *o.ImageID
+}
+
+func (o *LaunchSpecification) GetKeyPair() string {
+	if o == nil || o.KeyPair == nil {
+		return ""
+	}
+	return *o.KeyPair
+}
+
+func (o *LaunchSpecification) GetUserData() string {
+	if o == nil || o.UserData == nil {
+		return ""
+	}
+	return *o.UserData
+}
+
+func (o *LaunchSpecification) GetIAMInstanceProfile() *IAMInstanceProfile {
+	if o == nil {
+		return nil
+	}
+	return o.IAMInstanceProfile
+}
+
+func (o *LaunchSpecification) GetTags() []*Tag {
+	if o == nil {
+		return nil
+	}
+	return o.Tags
+}
+
+func (o *LaunchSpecification) GetLoadBalancers() []*LoadBalancer {
+	if o == nil {
+		return nil
+	}
+	return o.LoadBalancers
+}
+
+func (o *LaunchSpecification) GetRootVolumeSize() int {
+	if o == nil || o.RootVolumeSize == nil {
+		return 0
+	}
+	return *o.RootVolumeSize
+}
+
+func (o *LaunchSpecification) GetHealthCheckUnhealthyDurationBeforeReplacement() int {
+	if o == nil || o.HealthCheckUnhealthyDurationBeforeReplacement == nil {
+		return 0
+	}
+	return *o.HealthCheckUnhealthyDurationBeforeReplacement
+}
+
+func (o *LaunchSpecification) GetMonitoring() bool {
+	if o == nil || o.Monitoring == nil {
+		return false
+	}
+	return *o.Monitoring
+}
+
+func (o *LaunchSpecification) GetEBSOptimized() bool {
+	if o == nil || o.EBSOptimized == nil {
+		return false
+	}
+	return *o.EBSOptimized
+}
+
+func (o *LaunchSpecification) GetUseAsTemplateOnly() bool {
+	if o == nil || o.UseAsTemplateOnly == nil {
+		return false
+	}
+	return *o.UseAsTemplateOnly
+}
+
+func (o *LaunchSpecification) GetInstanceMetadataOptions() *InstanceMetadataOptions {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceMetadataOptions
+}
+
+func (o *LaunchSpecification) GetClusterBlockDeviceMappings() []*ClusterBlockDeviceMappings {
+	if o == nil {
+		return nil
+	}
+	return o.BlockDeviceMappings
+}
+
+func (o *LaunchSpecification) GetLaunchSpecScheduling() *LaunchSpecScheduling {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecScheduling
+}
+
+func (o *LaunchSpecification) GetResourceTagSpecification() *ResourceTagSpecification {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceTagSpecification
+}
+
+func (o *LaunchSpecification) GetReservedENIs() int {
+	if o == nil || o.ReservedENIs == nil {
+		return 0
+	}
+	return *o.ReservedENIs
+}
+
+func (o *LaunchSpecification) GetInstanceStorePolicy() *InstanceStorePolicy {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceStorePolicy
+}
+
+func (o *LaunchSpecification) GetStartupTaints() []*StartupTaints {
+	if o == nil {
+		return nil
+	}
+	return o.StartupTaints
+}
+
+func (o *LaunchSpecification) GetPrimaryIPv6() bool {
+	if o == nil || o.PrimaryIPv6 == nil {
+		return false
+	}
+	return *o.PrimaryIPv6
+}
+
+func (o *LaunchspecInstanceMetadataOptions) GetHTTPTokens() string {
+	if o == nil || o.HTTPTokens == nil {
+		return ""
+	}
+	return *o.HTTPTokens
+}
+
+func (o *LaunchspecInstanceMetadataOptions) GetHTTPPutResponseHopLimit() int {
+	if o == nil || o.HTTPPutResponseHopLimit == nil {
+		return 0
+	}
+	return *o.HTTPPutResponseHopLimit
+}
+
+func (o *ListClusterInstancesInput) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *ListClusterInstancesOutput) GetInstances() []*Instance {
+	if o == nil {
+		return nil
+	}
+	return o.Instances
+}
+
+func (o *ListClustersOutput) GetClusters() []*Cluster {
+	if o == nil {
+		return nil
+	}
+	return o.Clusters
+}
+
+func (o *ListECSClustersOutput) GetClusters() []*ECSCluster {
+	if o == nil {
+		return nil
+	}
+	return o.Clusters
+}
+
+func (o *ListECSLaunchSpecsInput) GetOceanID() string {
+	if o == nil || o.OceanID == nil {
+		return ""
+	}
+	return *o.OceanID
+}
+
+func (o *ListECSLaunchSpecsOutput) GetLaunchSpecs() []*ECSLaunchSpec {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecs
+}
+
+func (o *ListExtendedResourceDefinitionsOutput) GetExtendedResourceDefinitions() []*ExtendedResourceDefinition {
+	if o == nil {
+		return nil
+	}
+	return o.ExtendedResourceDefinitions
+}
+
+func (o *ListLaunchSpecsInput) GetOceanID() string {
+	if o == nil || o.OceanID == nil {
+		return ""
+	}
+	return *o.OceanID
+}
+
+func (o *ListLaunchSpecsOutput) GetLaunchSpecs() []*LaunchSpec {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecs
+}
+
+func (o *ListOceanResourceSuggestionsInput) GetOceanID() string {
+	if o == nil || o.OceanID == nil {
+		return ""
+	}
+	return *o.OceanID
+}
+
+func (o *ListOceanResourceSuggestionsInput) GetFilter() *Filter {
+	if o == nil {
+		return nil
+	}
+	return o.Filter
+}
+
+func (o *ListOceanResourceSuggestionsOutput) GetSuggestions() []*ResourceSuggestion {
+	if o == nil {
+		return nil
+	}
+	return o.Suggestions
+}
+
+func (o *ListResourceSuggestionsInput) GetOceanID() string {
+	if o == nil || o.OceanID == nil {
+		return ""
+	}
+	return *o.OceanID
+}
+
+func (o *ListResourceSuggestionsInput) GetNamespace() string {
+	if o == nil || o.Namespace == nil {
+		return ""
+	}
+	return *o.Namespace
+}
+
+func (o *ListResourceSuggestionsOutput) GetSuggestions() []*ResourceSuggestion {
+	if o == nil {
+		return nil
+	}
+	return o.Suggestions
+}
+
+func (o *ListRollsInput) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *ListRollsOutput) GetRolls() []*RollStatus {
+	if o == nil {
+		return nil
+	}
+	return o.Rolls
+}
+
+func (o *LoadBalancer) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *LoadBalancer) GetArn() string {
+	if o == nil || o.Arn == nil {
+		return ""
+	}
+	return *o.Arn
+}
+
+func (o *LoadBalancer) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *LoadBalancers) GetArn() string {
+	if o == nil || o.Arn == nil {
+		return ""
+	}
+	return *o.Arn
+}
+
+func (o *LoadBalancers) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *LoadBalancers) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *LogEvent) GetMessage() string {
+	if o == nil || o.Message == nil {
+		return ""
+	}
+	return *o.Message
+}
+
+func (o *LogEvent) GetSeverity() string {
+	if o == nil || o.Severity == nil {
+		return ""
+	}
+	return *o.Severity
+}
+
+func (o *LogEvent) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *Logging) GetExport() *Export {
+	if o == nil {
+		return nil
+	}
+	return o.Export
+}
+
+func (o *MetaData) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *MetaData) GetNamespace() string {
+	if o == nil || o.Namespace == nil {
+		return ""
+	}
+	return *o.Namespace
+}
+
+func (o *MetaData) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *MetaData) GetCustomType() string {
+	if o == nil || o.CustomType == nil {
+		return ""
+	}
+	return *o.CustomType
+}
+
+func (o *Migration) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *Migration) GetState() string {
+	if o == nil || o.State == nil {
+		return ""
+	}
+	return *o.State
+}
+
+func (o *Migration) GetCreatedAt() string {
+	if o == nil || o.CreatedAt == nil {
+		return ""
+	}
+	return *o.CreatedAt
+}
+
+func (o *Migration) GetCompletedAt() string {
+	if o == nil || o.CompletedAt == nil {
+		return ""
+	}
+	return *o.CompletedAt
+}
+
+func (o *MigrationConfig) GetShouldTerminateDrainedNodes() bool {
+	if o == nil || o.ShouldTerminateDrainedNodes == nil {
+		return false
+	}
+	return *o.ShouldTerminateDrainedNodes
+}
+
+func (o *MigrationConfig) GetShouldEvictStandAlonePods() bool {
+	if o == nil || o.ShouldEvictStandAlonePods == nil {
+		return false
+	}
+	return *o.ShouldEvictStandAlonePods
+}
+
+func (o *MigrationStatus) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *MigrationStatus) GetOceanId() string {
+	if o == nil || o.OceanId == nil {
+		return ""
+	}
+	return *o.OceanId
+}
+
+func (o *MigrationStatus) GetStatus() string {
+	if o == nil || o.Status == nil {
+		return ""
+	}
+	return *o.Status
+}
+
+func (o *MigrationStatus) GetNewInstances() []*InstanceDetails {
+	if o == nil {
+		return nil
+	}
+	return o.NewInstances
+}
+
+func (o *MigrationStatus) GetOldInstances() []*InstanceDetails {
+	if o == nil {
+		return nil
+	}
+	return o.OldInstances
+}
+
+func (o *MigrationStatus) GetUnscheduledPodIds() string {
+	if o == nil || o.UnscheduledPodIds == nil {
+		return ""
+	}
+	return *o.UnscheduledPodIds
+}
+
+func (o *MigrationStatus) GetNewUnscheduledPodIds() string {
+	if o == nil || o.NewUnscheduledPodIds == nil {
+		return ""
+	}
+	return *o.NewUnscheduledPodIds
+}
+
+func (o *MigrationStatus) GetMigrationConfig() *MigrationConfig {
+	if o == nil {
+		return nil
+	}
+	return o.MigrationConfig
+}
+
+func (o *MigrationStatus) GetCreatedAt() string {
+	if o == nil || o.CreatedAt == nil {
+		return ""
+	}
+	return *o.CreatedAt
+}
+
+func (o *MigrationStatus) GetErroredAt() string {
+	if o == nil || o.ErroredAt == nil {
+		return ""
+	}
+	return *o.ErroredAt
+}
+
+func (o *MigrationStatus) GetStoppedAt() string {
+	if o == nil || o.StoppedAt == nil {
+		return ""
+	}
+	return *o.StoppedAt
+}
+
+func (o *MigrationStatus) GetCompletedAt() string {
+	if o == nil || o.CompletedAt == nil {
+		return ""
+	}
+	return *o.CompletedAt
+}
+
+func (o *NonPv) GetTotal() float64 {
+	if o == nil || o.Total == nil {
+		return 0
+	}
+	return *o.Total
+}
+
+func (o *OptimizationWindow) GetCronExpression() string {
+	if o == nil || o.CronExpression == nil {
+		return ""
+	}
+	return *o.CronExpression
+}
+
+func (o *OptimizationWindow) GetDuration() string {
+	if o == nil || o.Duration == nil {
+		return ""
+	}
+	return *o.Duration
+}
+
+func (o *OptimizationWindow) GetEffects() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Effects
+}
+
+func (o *OptimizationWindows) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *OptimizationWindows) GetWindows() []*OptimizationWindow {
+	if o == nil {
+		return nil
+	}
+	return o.Windows
+}
+
+func (o *Parameter) GetAmiAutoUpdate() *AmiAutoUpdate {
+	if o == nil {
+		return nil
+	}
+	return o.AmiAutoUpdate
+}
+
+func (o *Parameter) GetClusterRoll() *ParameterClusterRoll {
+	if o == nil {
+		return nil
+	}
+	return o.ClusterRoll
+}
+
+func (o *ParameterClusterRoll) GetBatchMinHealthyPercentage() int {
+	if o == nil || o.BatchMinHealthyPercentage == nil {
+		return 0
+	}
+	return *o.BatchMinHealthyPercentage
+}
+
+func (o *ParameterClusterRoll) GetBatchSizePercentage() int {
+	if o == nil || o.BatchSizePercentage == nil {
+		return 0
+	}
+	return *o.BatchSizePercentage
+}
+
+func (o *ParameterClusterRoll) GetComment() string {
+	if o == nil || o.Comment == nil {
+		return ""
+	}
+	return *o.Comment
+}
+
+func (o *ParameterClusterRoll) GetRespectPdb() bool {
+	if o == nil || o.RespectPdb == nil {
+		return false
+	}
+	return *o.RespectPdb
+}
+
+func (o *PodDetails) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *PodDetails) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *PodDetails) GetKind() string {
+	if o == nil || o.Kind == nil {
+		return ""
+	}
+	return *o.Kind
+}
+
+func (o *Progress) GetUnit() string {
+	if o == nil || o.Unit == nil {
+		return ""
+	}
+	return *o.Unit
+}
+
+func (o *Progress) GetValue() float64 {
+	if o == nil || o.Value == nil {
+		return 0
+	}
+	return *o.Value
+}
+
+func (o *Property) GetResources() []AggregatedCostResource {
+	if o == nil {
+		return nil
+	}
+	return o.Resources
+}
+
+func (o *Property) GetSummary() *Summary {
+	if o == nil {
+		return nil
+	}
+	return o.Summary
+}
+
+func (o *ReadClusterInput) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *ReadClusterNodeInput) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *ReadClusterNodeInput) GetLaunchSpecId() string {
+	if o == nil || o.LaunchSpecId == nil {
+		return ""
+	}
+	return *o.LaunchSpecId
+}
+
+func (o *ReadClusterNodeInput) GetInstanceId() string {
+	if o == nil || o.InstanceId == nil {
+		return ""
+	}
+	return *o.InstanceId
+}
+
+func (o *ReadClusterNodeOutput) GetClusterNode() []*ClusterNodes {
+	if o == nil {
+		return nil
+	}
+	return o.ClusterNode
+}
+
+func (o *ReadClusterOutput) GetCluster() *Cluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *ReadECSClusterInput) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *ReadECSClusterOutput) GetCluster() *ECSCluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *ReadECSLaunchSpecInput) GetLaunchSpecID() string {
+	if o == nil || o.LaunchSpecID == nil {
+		return ""
+	}
+	return *o.LaunchSpecID
+}
+
+func (o *ReadECSLaunchSpecOutput) GetLaunchSpec() *ECSLaunchSpec {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpec
+}
+
+func (o *ReadExtendedResourceDefinitionInput) GetExtendedResourceDefinitionID() string {
+	if o == nil || o.ExtendedResourceDefinitionID == nil {
+		return ""
+	}
+	return *o.ExtendedResourceDefinitionID
+}
+
+func (o *ReadExtendedResourceDefinitionOutput) GetExtendedResourceDefinition() *ExtendedResourceDefinition {
+	if o == nil {
+		return nil
+	}
+	return o.ExtendedResourceDefinition
+}
+
+func (o *ReadLaunchSpecInput) GetLaunchSpecID() string {
+	if o == nil || o.LaunchSpecID == nil {
+		return ""
+	}
+	return *o.LaunchSpecID
+}
+
+func (o *ReadLaunchSpecOutput) GetLaunchSpec() *LaunchSpec {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpec
+}
+
+func (o *ReadMigrationInput) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *ReadMigrationOutput) GetMigration() []*Migration {
+	if o == nil {
+		return nil
+	}
+	return o.Migration
+}
+
+func (o *ReadMigrationStatusInput) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *ReadMigrationStatusInput) GetMigrationID() string {
+	if o == nil || o.MigrationID == nil {
+		return ""
+	}
+	return *o.MigrationID
+}
+
+func (o *ReadMigrationStatusOutput) GetMigrationStatus() []*MigrationStatus {
+	if o == nil {
+		return nil
+	}
+	return o.MigrationStatus
+}
+
+func (o *ReadRollInput) GetRollID() string {
+	if o == nil || o.RollID == nil {
+		return ""
+	}
+	return *o.RollID
+}
+
+func (o *ReadRollInput) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *ReadRollOutput) GetRoll() *RollStatus {
+	if o == nil {
+		return nil
+	}
+	return o.Roll
+}
+
+func (o *ResourceLimits) GetMaxInstanceCount() int {
+	if o == nil || o.MaxInstanceCount == nil {
+		return 0
+	}
+	return *o.MaxInstanceCount
+}
+
+func (o *ResourceLimits) GetMinInstanceCount() int {
+	if o == nil || o.MinInstanceCount == nil {
+		return 0
+	}
+	return *o.MinInstanceCount
+}
+
+func (o *ResourceSuggestion) GetResourceName() string {
+	if o == nil || o.ResourceName == nil {
+		return ""
+	}
+	return *o.ResourceName
+}
+
+func (o *ResourceSuggestion) GetResourceType() string {
+	if o == nil || o.ResourceType == nil {
+		return ""
+	}
+	return *o.ResourceType
+}
+
+func (o *ResourceSuggestion) GetDeploymentName() string {
+	if o == nil || o.DeploymentName == nil {
+		return ""
+	}
+	return *o.DeploymentName
+}
+
+func (o *ResourceSuggestion) GetNamespace() string {
+	if o == nil || o.Namespace == nil {
+		return ""
+	}
+	return *o.Namespace
+}
+
+func (o *ResourceSuggestion) GetSuggestedCPU() float64 {
+	if o == nil || o.SuggestedCPU == nil {
+		return 0
+	}
+	return *o.SuggestedCPU
+}
+
+func (o *ResourceSuggestion) GetRequestedCPU() float64 {
+	if o == nil || o.RequestedCPU == nil {
+		return 0
+	}
+	return *o.RequestedCPU
+}
+
+func (o *ResourceSuggestion) GetSuggestedMemory() float64 {
+	if o == nil || o.SuggestedMemory == nil {
+		return 0
+	}
+	return *o.SuggestedMemory
+}
+
+func (o *ResourceSuggestion) GetRequestedMemory() float64 {
+	if o == nil || o.RequestedMemory == nil {
+		return 0
+	}
+	return *o.RequestedMemory
+}
+
+func (o *ResourceSuggestion) GetContainers() []*ContainerResourceSuggestion {
+	if o == nil {
+		return nil
+	}
+	return o.Containers
+}
+
+func (o *ResourceTagSpecification) GetVolumes() *Volumes {
+	if o == nil {
+		return nil
+	}
+	return o.Volumes
+}
+
+func (o *Result) GetTotalForDuration() *TotalForDuration {
+	if o == nil {
+		return nil
+	}
+	return o.TotalForDuration
+}
+
+func (o *Roll) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *Roll) GetComment() string {
+	if o == nil || o.Comment == nil {
+		return ""
+	}
+	return *o.Comment
+}
+
+func (o *Roll) GetBatchSizePercentage() int {
+	if o == nil || o.BatchSizePercentage == nil {
+		return 0
+	}
+	return *o.BatchSizePercentage
+}
+
+func (o *Roll) GetDisableLaunchSpecAutoscaling() bool {
+	if o == nil || o.DisableLaunchSpecAutoscaling == nil {
+		return false
+	}
+	return *o.DisableLaunchSpecAutoscaling
+}
+
+func (o *Roll) GetLaunchSpecIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecIDs
+}
+
+func (o *Roll) GetInstanceIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceIDs
+}
+
+func (o *RollClusterInput) GetRoll() *Roll {
+	if o == nil {
+		return nil
+	}
+	return o.Roll
+}
+
+func (o *RollClusterOutput) GetRollClusterStatus() *RollClusterStatus {
+	if o == nil {
+		return nil
+	}
+	return o.RollClusterStatus
+}
+
+func (o *RollClusterStatus) GetOceanID() string {
+	if o == nil || o.OceanID == nil {
+		return ""
+	}
+	return *o.OceanID
+}
+
+func (o *RollClusterStatus) GetRollID() string {
+	if o == nil || o.RollID == nil {
+		return ""
+	}
+	return *o.RollID
+}
+
+func (o *RollClusterStatus) GetRollStatus() string {
+	if o == nil || o.RollStatus == nil {
+		return ""
+	}
+	return *o.RollStatus
+}
+
+func (o *RollClusterStatus) GetProgress() *Progress {
+	if o == nil {
+		return nil
+	}
+	return o.Progress
+}
+
+func (o *RollClusterStatus) GetCurrentBatch() int {
+	if o == nil || o.CurrentBatch == nil {
+		return 0
+	}
+	return *o.CurrentBatch
+}
+
+func (o *RollClusterStatus) GetNumOfBatches() int {
+	if o == nil || o.NumOfBatches == nil {
+		return 0
+	}
+	return *o.NumOfBatches
+}
+
+func (o *RollClusterStatus) GetCreatedAt() string {
+	if o == nil || o.CreatedAt == nil {
+		return ""
+	}
+	return *o.CreatedAt
+}
+
+func (o *RollClusterStatus) GetUpdatedAt() string {
+	if o == nil || o.UpdatedAt == nil {
+		return ""
+	}
+	return *o.UpdatedAt
+}
+
+func (o *RollSpec) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *RollSpec) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *RollSpec) GetComment() string {
+	if o == nil || o.Comment == nil {
+		return ""
+	}
+	return *o.Comment
+}
+
+func (o *RollSpec) GetStatus() string {
+	if o == nil || o.Status == nil {
+		return ""
+	}
+	return *o.Status
+}
+
+func (o *RollSpec) GetBatchSizePercentage() int {
+	if o == nil || o.BatchSizePercentage == nil {
+		return 0
+	}
+	return *o.BatchSizePercentage
+}
+
+func (o *RollSpec) GetBatchMinHealthyPercentage() int {
+	if o == nil || o.BatchMinHealthyPercentage == nil {
+		return 0
+	}
+	return *o.BatchMinHealthyPercentage
+}
+
+func (o *RollSpec) GetRespectPDB() bool {
+	if o == nil || o.RespectPDB == nil {
+		return false
+	}
+	return *o.RespectPDB
+}
+
+func (o *RollSpec) GetDisableLaunchSpecAutoScaling() bool {
+	if o == nil || o.DisableLaunchSpecAutoScaling == nil {
+		return false
+	}
+	return *o.DisableLaunchSpecAutoScaling
+}
+
+func (o *RollSpec) GetLaunchSpecIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecIDs
+}
+
+func (o *RollSpec) GetInstanceIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceIDs
+}
+
+func (o *RollStatus) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *RollStatus) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *RollStatus) GetComment() string {
+	if o == nil || o.Comment == nil {
+		return ""
+	}
+	return *o.Comment
+}
+
+func (o *RollStatus) GetStatus() string {
+	if o == nil || o.Status == nil {
+		return ""
+	}
+	return *o.Status
+}
+
+func (o *RollStatus) GetProgress() *Progress {
+	if o == nil {
+		return nil
+	}
+	return o.Progress
+}
+
+func (o *RollStatus) GetCurrentBatch() int {
+	if o == nil || o.CurrentBatch == nil {
+		return 0
+	}
+	return *o.CurrentBatch
+}
+
+func (o *RollStatus) GetNumOfBatches() int {
+	if o == nil || o.NumOfBatches == nil {
+		return 0
+	}
+	return *o.NumOfBatches
+}
+
+func (o *RollStatus) GetLaunchSpecIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecIDs
+}
+
+func (o *RollStatus) GetInstanceIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceIDs
+}
+
+func (o *RollStatus) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *RollStatus) GetUpdatedAt() time.Time {
+	if o == nil || o.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *o.UpdatedAt
+}
+
+func (o *S3) GetId() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *Scheduling) GetShutdownHours() *ShutdownHours {
+	if o == nil {
+		return nil
+	}
+	return o.ShutdownHours
+}
+
+func (o *Scheduling) GetTasks() []*Task {
+	if o == nil {
+		return nil
+	}
+	return o.Tasks
+}
+
+func (o *Scheduling) GetOptimizationWindows() *OptimizationWindows {
+	if o == nil {
+		return nil
+	}
+	return o.OptimizationWindows
+}
+
+func (o *ShutdownHours) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *ShutdownHours) GetTimeWindows() []string {
+	if o == nil {
+		return nil
+	}
+	return o.TimeWindows
+}
+
+func (o *StartupTaints) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *StartupTaints) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *StartupTaints) GetEffect() string {
+	if o == nil || o.Effect == nil {
+		return ""
+	}
+	return *o.Effect
+}
+
+func (o *Strategy) GetSpotPercentage() float64 {
+	if o == nil || o.SpotPercentage == nil {
+		return 0
+	}
+	return *o.SpotPercentage
+}
+
+func (o *Strategy) GetUtilizeReservedInstances() bool {
+	if o == nil || o.UtilizeReservedInstances == nil {
+		return false
+	}
+	return *o.UtilizeReservedInstances
+}
+
+func (o *Strategy) GetFallbackToOnDemand() bool {
+	if o == nil || o.FallbackToOnDemand == nil {
+		return false
+	}
+	return *o.FallbackToOnDemand
+}
+
+func (o *Strategy) GetDrainingTimeout() int {
+	if o == nil || o.DrainingTimeout == nil {
+		return 0
+	}
+	return *o.DrainingTimeout
+}
+
+func (o *Strategy) GetGracePeriod() int {
+	if o == nil || o.GracePeriod == nil {
+		return 0
+	}
+	return *o.GracePeriod
+}
+
+func (o *Strategy) GetMaxReplacementLimitPercentage() int {
+	if o == nil || o.MaxReplacementLimitPercentage == nil {
+		return 0
+	}
+	return *o.MaxReplacementLimitPercentage
+}
+
+func (o *Strategy) GetUtilizeCommitments() bool {
+	if o == nil || o.UtilizeCommitments == nil {
+		return false
+	}
+	return *o.UtilizeCommitments
+}
+
+func (o *Strategy) GetClusterOrientation() *ClusterOrientation {
+	if o == nil {
+		return nil
+	}
+	return o.ClusterOrientation
+}
+
+func (o *Strategy) GetSpreadNodesBy() string {
+	if o == nil || o.SpreadNodesBy == nil {
+		return ""
+	}
+	return *o.SpreadNodesBy
+}
+
+func (o *Summary) GetCompute() *AggregatedCompute {
+	if o == nil {
+		return nil
+	}
+	return o.Compute
+}
+
+func (o *Summary) GetStorage() *AggregatedStorage {
+	if o == nil {
+		return nil
+	}
+	return o.Storage
+}
+
+func (o *Summary) GetTotal() float64 {
+	if o == nil || o.Total == nil {
+		return 0
+	}
+	return *o.Total
+}
+
+func (o *Tag) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *Tag) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *TagSelector) GetTagKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *TagSelector) GetTagValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *Taint) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *Taint) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *Taint) GetEffect() string {
+	if o == nil || o.Effect == nil {
+		return ""
+	}
+	return *o.Effect
+}
+
+func (o *Task) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *Task) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *Task) GetCronExpression() string {
+	if o == nil || o.CronExpression == nil {
+		return ""
+	}
+	return *o.CronExpression
+}
+
+func (o *Task) GetParameter() *Parameter {
+	if o == nil {
+		return nil
+	}
+	return o.Parameter
+}
+
+func (o *TaskConfig) GetHeadrooms() []*LaunchSpecTaskHeadroom {
+	if o == nil {
+		return nil
+	}
+	return o.TaskHeadrooms
+}
+
+func (o *TotalForDuration) GetSummary() *Summary {
+	if o == nil {
+		return nil
+	}
+	return o.Summary
+}
+
+func (o *TotalForDuration) GetStartTime() string {
+	if o == nil || o.StartTime == nil {
+		return ""
+	}
+	return *o.StartTime
+}
+
+func (o *TotalForDuration) GetEndTime() string {
+	if o == nil || o.EndTime == nil {
+		return ""
+	}
+	return *o.EndTime
+}
+
+func (o *TotalForDuration) GetDetailedCosts() *DetailedCosts {
+	if o == nil {
+		return nil
+	}
+	return o.DetailedCosts
+}
+
+func (o *UpdateClusterInput) GetCluster() *Cluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *UpdateClusterOutput) GetCluster() *Cluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *UpdateECSClusterInput) GetCluster() *ECSCluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *UpdateECSClusterOutput) GetCluster() *ECSCluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *UpdateECSLaunchSpecInput) GetLaunchSpec() *ECSLaunchSpec {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpec
+}
+
+func (o *UpdateECSLaunchSpecOutput) GetLaunchSpec() *ECSLaunchSpec {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpec
+}
+
+func (o *UpdateExtendedResourceDefinitionInput) GetExtendedResourceDefinition() *ExtendedResourceDefinition {
+	if o == nil {
+		return nil
+	}
+	return o.ExtendedResourceDefinition
+}
+
+func (o *UpdateExtendedResourceDefinitionOutput) GetExtendedResourceDefinition() *ExtendedResourceDefinition {
+	if o == nil {
+		return nil
+	}
+	return o.ExtendedResourceDefinition
+}
+
+func (o *UpdateLaunchSpecInput) GetLaunchSpec() *LaunchSpec {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpec
+}
+
+func (o *UpdateLaunchSpecOutput) GetLaunchSpec() *LaunchSpec {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpec
+}
+
+func (o *UpdateRollInput) GetRoll() *RollSpec {
+	if o == nil {
+		return nil
+	}
+	return o.Roll
+}
+
+func (o *UpdateRollOutput) GetRoll() *RollStatus {
+	if o == nil {
+		return nil
+	}
+	return o.Roll
+}
+
+func (o *VNGDynamicIops) GetBaseSize() int {
+	if o == nil || o.BaseSize == nil {
+		return 0
+	}
+	return *o.BaseSize
+}
+
+func (o *VNGDynamicIops) GetSizePerResourceUnit() int {
+	if o == nil || o.SizePerResourceUnit == nil {
+		return 0
+	}
+	return *o.SizePerResourceUnit
+}
+
+func (o *VNGDynamicIops) GetResource() string {
+	if o == nil || o.Resource == nil {
+		return ""
+	}
+	return *o.Resource
+}
+
+func (o *VNGLoadBalancer) GetArn() string {
+	if o == nil || o.Arn == nil {
+		return ""
+	}
+	return *o.Arn
+}
+
+func (o *VNGLoadBalancer) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *VNGLoadBalancer) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *Volumes) GetShouldTag() bool {
+	if o == nil || o.ShouldTag == nil {
+		return false
+	}
+	return *o.ShouldTag
+}
+
+func (o *Workloads) GetTotal() float64 {
+	if o == nil || o.Total == nil {
+		return 0
+	}
+	return *o.Total
+}
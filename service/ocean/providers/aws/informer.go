@@ -0,0 +1,78 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/informer"
+)
+
+// NewClusterInformer returns an informer that lists clusters. The list, key
+// and version functions of cfg are set, and its indexers are merged with
+// indexes by name, region, controller cluster ID and tag. Objects are of
+// type *Cluster.
+func (s *ServiceOp) NewClusterInformer(cfg informer.Config) *informer.Informer {
+	cfg.List = func(ctx context.Context) ([]interface{}, error) {
+		out, err := s.ListClusters(ctx, &ListClustersInput{})
+		if err != nil {
+			return nil, err
+		}
+		objs := make([]interface{}, len(out.Clusters))
+		for i, c := range out.Clusters {
+			objs[i] = c
+		}
+		return objs, nil
+	}
+	cfg.Key = func(obj interface{}) string { return obj.(*Cluster).GetId() }
+	cfg.Version = func(obj interface{}) time.Time { return obj.(*Cluster).GetUpdatedAt() }
+	cfg.Indexers = informer.Merge(informer.Indexers{
+		informer.IndexName:      func(obj interface{}) []string { return []string{obj.(*Cluster).GetName()} },
+		informer.IndexRegion:    func(obj interface{}) []string { return []string{obj.(*Cluster).GetRegion()} },
+		informer.IndexClusterID: func(obj interface{}) []string { return []string{obj.(*Cluster).GetControllerClusterId()} },
+		informer.IndexTag: func(obj interface{}) []string {
+			tags := obj.(*Cluster).GetCompute().GetLaunchSpecification().GetTags()
+			return informer.TagIndexValues(len(tags), func(i int) (string, string) {
+				return tags[i].GetKey(), tags[i].GetValue()
+			})
+		},
+	}, cfg.Indexers)
+
+	return informer.New(cfg)
+}
+
+// NewLaunchSpecInformer returns an informer that lists the launch specs of a
+// cluster, or of all clusters if oceanID is empty. The list, key and version
+// functions of cfg are set, and its indexers are merged with indexes by
+// name, Ocean cluster ID and tag. Objects are of type *LaunchSpec.
+func (s *ServiceOp) NewLaunchSpecInformer(oceanID string, cfg informer.Config) *informer.Informer {
+	cfg.List = func(ctx context.Context) ([]interface{}, error) {
+		input := &ListLaunchSpecsInput{}
+		if oceanID != "" {
+			input.OceanID = spotinst.String(oceanID)
+		}
+		out, err := s.ListLaunchSpecs(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		objs := make([]interface{}, len(out.LaunchSpecs))
+		for i, ls := range out.LaunchSpecs {
+			objs[i] = ls
+		}
+		return objs, nil
+	}
+	cfg.Key = func(obj interface{}) string { return obj.(*LaunchSpec).GetId() }
+	cfg.Version = func(obj interface{}) time.Time { return obj.(*LaunchSpec).GetUpdatedAt() }
+	cfg.Indexers = informer.Merge(informer.Indexers{
+		informer.IndexName:      func(obj interface{}) []string { return []string{obj.(*LaunchSpec).GetName()} },
+		informer.IndexClusterID: func(obj interface{}) []string { return []string{obj.(*LaunchSpec).GetOceanId()} },
+		informer.IndexTag: func(obj interface{}) []string {
+			tags := obj.(*LaunchSpec).GetTags()
+			return informer.TagIndexValues(len(tags), func(i int) (string, string) {
+				return tags[i].GetKey(), tags[i].GetValue()
+			})
+		},
+	}, cfg.Indexers)
+
+	return informer.New(cfg)
+}
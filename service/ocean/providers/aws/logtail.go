@@ -0,0 +1,361 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+const (
+	// DefaultTailInterval is the default delay between TailLogEvents polls.
+	DefaultTailInterval = 10 * time.Second
+
+	// DefaultTailLookback is the default length of the TailLogEvents window.
+	DefaultTailLookback = 5 * time.Minute
+
+	// DefaultExportPageSize is the default number of log events requested
+	// per page by ExportLogEvents.
+	DefaultExportPageSize = 1000
+)
+
+// TailLogEventsOptions configure TailLogEvents.
+type TailLogEventsOptions struct {
+	// Since is the start of the first window. Defaults to Lookback ago.
+	Since time.Time
+
+	// Interval is the delay between polls. Defaults to DefaultTailInterval.
+	Interval time.Duration
+
+	// Lookback is the length of each window after the first, which should
+	// cover the delay before events become visible. Windows overlap and
+	// duplicate events are dropped. Defaults to DefaultTailLookback.
+	Lookback time.Duration
+
+	// Severity and ResourceID filter the events.
+	Severity   LogSeverity
+	ResourceID string
+
+	// OnError, if set, is called when a poll fails. Tailing continues with
+	// the next poll.
+	OnError func(error)
+
+	// Clock measures time and sleeps between polls. Defaults to
+	// waiter.SystemClock.
+	Clock waiter.Clock
+}
+
+// TailLogEvents polls GetLogEvents with a moving window and sends new events
+// on the returned channel, oldest first. The channel is closed when ctx is
+// done.
+func (s *ServiceOp) TailLogEvents(ctx context.Context, clusterID string, opts *TailLogEventsOptions) <-chan LogEvent {
+	return tailLogEvents(ctx, s, clusterID, opts)
+}
+
+func tailLogEvents(ctx context.Context, svc Service, clusterID string, opts *TailLogEventsOptions) <-chan LogEvent {
+	o := TailLogEventsOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Interval <= 0 {
+		o.Interval = DefaultTailInterval
+	}
+	if o.Lookback <= 0 {
+		o.Lookback = DefaultTailLookback
+	}
+	if o.Clock == nil {
+		o.Clock = waiter.SystemClock
+	}
+
+	ch := make(chan LogEvent)
+	go func() {
+		defer close(ch)
+
+		t := &logTail{
+			svc:       svc,
+			clusterID: clusterID,
+			opts:      &o,
+			from:      o.Since,
+			seen:      make(map[string]time.Time),
+		}
+		if t.from.IsZero() {
+			t.from = o.Clock.Now().Add(-o.Lookback)
+		}
+
+		for {
+			events, err := t.poll(ctx, o.Clock.Now())
+			if err != nil && ctx.Err() == nil && o.OnError != nil {
+				o.OnError(err)
+			}
+			for _, e := range events {
+				select {
+				case ch <- *e:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-o.Clock.After(o.Interval):
+			}
+		}
+	}()
+
+	return ch
+}
+
+type logTail struct {
+	svc       Service
+	clusterID string
+	opts      *TailLogEventsOptions
+	from      time.Time
+
+	// seen holds the keys of the events sent, and their creation times so
+	// that keys falling out of the window can be pruned.
+	seen map[string]time.Time
+}
+
+// poll returns the events in the current window that weren't seen before,
+// and moves the window forward.
+func (t *logTail) poll(ctx context.Context, now time.Time) ([]*LogEvent, error) {
+	input := &GetLogEventsInput{ClusterID: spotinst.String(t.clusterID)}
+	input.WithFromDate(t.from).WithToDate(now)
+	applyLogEventFilters(input, t.opts.Severity, t.opts.ResourceID)
+
+	out, err := t.svc.GetLogEvents(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []*LogEvent
+	for _, e := range filterLogEvents(out.Events, t.opts.Severity) {
+		key := logEventKey(e)
+		if _, ok := t.seen[key]; ok {
+			continue // windows overlap by design
+		}
+		t.seen[key] = e.GetCreatedAt()
+		events = append(events, e)
+	}
+	sortLogEvents(events)
+
+	if from := now.Add(-t.opts.Lookback); from.After(t.from) {
+		t.from = from
+	}
+	for key, created := range t.seen {
+		if created.Before(t.from) {
+			delete(t.seen, key)
+		}
+	}
+
+	return events, nil
+}
+
+// ErrLogEventsTruncated is returned by ExportLogEvents when more than a page
+// of log events share the same millisecond.
+var ErrLogEventsTruncated = errors.New("spotinst: log events truncated")
+
+// A LogEventsRange is an inclusive range of log event creation times.
+type LogEventsRange struct {
+	From time.Time
+	To   time.Time
+}
+
+func (r LogEventsRange) String() string {
+	return r.From.UTC().Format(time.RFC3339Nano) + "/" + r.To.UTC().Format(time.RFC3339Nano)
+}
+
+// LogEventsTruncatedError is returned by ExportLogEvents when some windows
+// hold more than a page of log events that can't be split further. It wraps
+// ErrLogEventsTruncated.
+type LogEventsTruncatedError struct {
+	// Ranges are the windows whose events were only partially exported.
+	Ranges []LogEventsRange
+}
+
+func (e *LogEventsTruncatedError) Error() string {
+	ranges := make([]string, len(e.Ranges))
+	for i, r := range e.Ranges {
+		ranges[i] = r.String()
+	}
+	return fmt.Sprintf("%v in %d window(s): %s", ErrLogEventsTruncated, len(e.Ranges), strings.Join(ranges, ", "))
+}
+
+// Unwrap returns ErrLogEventsTruncated.
+func (e *LogEventsTruncatedError) Unwrap() error {
+	return ErrLogEventsTruncated
+}
+
+// ExportLogEventsOptions configure ExportLogEvents.
+type ExportLogEventsOptions struct {
+	// From and To bound the exported history. To defaults to now.
+	From time.Time
+	To   time.Time
+
+	// Severity and ResourceID filter the events.
+	Severity   LogSeverity
+	ResourceID string
+
+	// MaxEvents bounds the number of exported events. Zero means no limit.
+	MaxEvents int
+
+	// PageSize is the number of events requested per page. Defaults to
+	// DefaultExportPageSize.
+	PageSize int
+}
+
+// ExportLogEvents writes the log events of a cluster between opts.From and
+// opts.To to w as JSON Lines, oldest first, and returns the number of events
+// written.
+//
+// The API doesn't tell which events a full page leaves out, so instead of
+// continuing from the last event of a full page, its window is split in two
+// halves that are exported in turn. If more than a page of events share the
+// same millisecond, the events that fit are written, the export continues
+// with the remaining windows and a *LogEventsTruncatedError listing the
+// affected windows is returned.
+func (s *ServiceOp) ExportLogEvents(ctx context.Context, clusterID string, w io.Writer, opts *ExportLogEventsOptions) (int, error) {
+	return exportLogEvents(ctx, s, clusterID, w, opts)
+}
+
+func exportLogEvents(ctx context.Context, svc Service, clusterID string, w io.Writer, opts *ExportLogEventsOptions) (int, error) {
+	o := ExportLogEventsOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.To.IsZero() {
+		o.To = time.Now()
+	}
+	if o.PageSize <= 0 {
+		o.PageSize = DefaultExportPageSize
+	}
+
+	x := &logExport{
+		svc:       svc,
+		clusterID: clusterID,
+		opts:      &o,
+		enc:       json.NewEncoder(w),
+		seen:      make(map[string]bool),
+	}
+	err := x.window(ctx, o.From.Truncate(time.Millisecond), o.To.Truncate(time.Millisecond))
+	if errors.Is(err, errMaxEvents) {
+		err = nil
+	}
+	if err == nil && len(x.truncated) > 0 {
+		err = &LogEventsTruncatedError{Ranges: x.truncated}
+	}
+	return x.n, err
+}
+
+// errMaxEvents stops an export once ExportLogEventsOptions.MaxEvents events
+// are written.
+var errMaxEvents = errors.New("max events exported")
+
+type logExport struct {
+	svc       Service
+	clusterID string
+	opts      *ExportLogEventsOptions
+	enc       *json.Encoder
+
+	// seen holds the keys of the events written. Windows share their
+	// boundaries, which are inclusive.
+	seen map[string]bool
+	n    int
+
+	// truncated holds the windows that held more than a page of events.
+	truncated []LogEventsRange
+}
+
+// window writes the events between from and to, splitting the window if it
+// holds more than a page of events.
+func (x *logExport) window(ctx context.Context, from, to time.Time) error {
+	input := &GetLogEventsInput{
+		ClusterID: spotinst.String(x.clusterID),
+		Limit:     spotinst.Int(x.opts.PageSize),
+	}
+	input.WithFromDate(from).WithToDate(to)
+	applyLogEventFilters(input, x.opts.Severity, x.opts.ResourceID)
+
+	out, err := x.svc.GetLogEvents(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	if len(out.Events) >= x.opts.PageSize {
+		if d := to.Sub(from); d > time.Millisecond {
+			mid := from.Add(d / 2).Truncate(time.Millisecond)
+			if err := x.window(ctx, from, mid); err != nil {
+				return err
+			}
+			return x.window(ctx, mid, to)
+		}
+		// Windows are exported in order and share their boundaries, so a
+		// crowded boundary extends the previous range.
+		if n := len(x.truncated); n > 0 && !x.truncated[n-1].To.Before(from) {
+			x.truncated[n-1].To = to
+		} else {
+			x.truncated = append(x.truncated, LogEventsRange{From: from, To: to})
+		}
+	}
+
+	events := filterLogEvents(out.Events, x.opts.Severity)
+	sortLogEvents(events)
+	for _, e := range events {
+		key := logEventKey(e)
+		if x.seen[key] {
+			continue
+		}
+		x.seen[key] = true
+
+		if err := x.enc.Encode(e); err != nil {
+			return err
+		}
+		if x.n++; x.opts.MaxEvents > 0 && x.n >= x.opts.MaxEvents {
+			return errMaxEvents
+		}
+	}
+
+	return nil
+}
+
+func applyLogEventFilters(input *GetLogEventsInput, severity LogSeverity, resourceID string) {
+	if severity != "" && severity != LogSeverityAll {
+		input.Severity = spotinst.String(severity.String())
+	}
+	if resourceID != "" {
+		input.ResourceID = spotinst.String(resourceID)
+	}
+}
+
+// filterLogEvents drops events not matching severity, in case the API
+// ignores the filter.
+func filterLogEvents(events []*LogEvent, severity LogSeverity) []*LogEvent {
+	if severity == "" || severity == LogSeverityAll {
+		return events
+	}
+	var out []*LogEvent
+	for _, e := range events {
+		if strings.EqualFold(e.GetSeverity(), severity.String()) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+func sortLogEvents(events []*LogEvent) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].GetCreatedAt().Before(events[j].GetCreatedAt())
+	})
+}
+
+func logEventKey(e *LogEvent) string {
+	return e.GetCreatedAt().String() + "|" + e.GetSeverity() + "|" + e.GetMessage()
+}
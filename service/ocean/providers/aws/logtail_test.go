@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+)
+
+// fakeLogs serves log events between the requested dates, newest first, and
+// truncates them to the requested limit.
+type fakeLogs struct {
+	Service
+
+	events []*LogEvent
+	calls  int
+}
+
+func (f *fakeLogs) GetLogEvents(_ context.Context, input *GetLogEventsInput) (*GetLogEventsOutput, error) {
+	f.calls++
+	from, err := strconv.ParseInt(input.GetFromDate(), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	to, err := strconv.ParseInt(input.GetToDate(), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*LogEvent
+	for _, e := range f.events {
+		if ms := e.GetCreatedAt().UnixMilli(); ms >= from && ms <= to {
+			out = append(out, e)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].GetCreatedAt().After(out[j].GetCreatedAt())
+	})
+	if limit := input.GetLimit(); limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return &GetLogEventsOutput{Events: out}, nil
+}
+
+func logEventsAt(start time.Time, step time.Duration, n int) []*LogEvent {
+	events := make([]*LogEvent, n)
+	for i := range events {
+		created := start.Add(time.Duration(i) * step)
+		events[i] = &LogEvent{
+			Message:   spotinst.String(fmt.Sprintf("event %d", i)),
+			Severity:  spotinst.String("INFO"),
+			CreatedAt: &created,
+		}
+	}
+	return events
+}
+
+func decodeMessages(t *testing.T, b []byte) []string {
+	t.Helper()
+	var msgs []string
+	dec := json.NewDecoder(bytes.NewReader(b))
+	for dec.More() {
+		var e LogEvent
+		if err := dec.Decode(&e); err != nil {
+			t.Fatal(err)
+		}
+		msgs = append(msgs, e.GetMessage())
+	}
+	return msgs
+}
+
+func TestExportLogEvents(t *testing.T) {
+	start := time.Unix(1000, 0)
+	svc := &fakeLogs{events: logEventsAt(start, time.Second, 25)}
+
+	var buf bytes.Buffer
+	n, err := exportLogEvents(context.Background(), svc, "o-1", &buf, &ExportLogEventsOptions{
+		From:     start,
+		To:       start.Add(time.Minute),
+		PageSize: 4,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 25 {
+		t.Errorf("got %d events, want 25", n)
+	}
+	msgs := decodeMessages(t, buf.Bytes())
+	if len(msgs) != 25 {
+		t.Fatalf("got %d events written, want 25", len(msgs))
+	}
+	for i, msg := range msgs {
+		if want := fmt.Sprintf("event %d", i); msg != want {
+			t.Fatalf("got event %q at %d, want %q", msg, i, want)
+		}
+	}
+}
+
+func TestExportLogEventsMaxEvents(t *testing.T) {
+	start := time.Unix(1000, 0)
+	svc := &fakeLogs{events: logEventsAt(start, time.Second, 10)}
+
+	var buf bytes.Buffer
+	n, err := exportLogEvents(context.Background(), svc, "o-1", &buf, &ExportLogEventsOptions{
+		From:      start,
+		To:        start.Add(time.Minute),
+		MaxEvents: 3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msgs := decodeMessages(t, buf.Bytes()); n != 3 || len(msgs) != 3 || msgs[0] != "event 0" {
+		t.Errorf("got %d events %v", n, msgs)
+	}
+	if svc.calls != 1 {
+		t.Errorf("got %d calls, want 1", svc.calls)
+	}
+}
+
+func TestExportLogEventsTruncated(t *testing.T) {
+	start := time.Unix(1000, 0)
+	crowded := start.Add(30 * time.Second)
+	svc := &fakeLogs{}
+	svc.events = append(svc.events, logEventsAt(start, 0, 5)...)
+	svc.events = append(svc.events, logEventsAt(start.Add(time.Second), time.Second, 2)...)
+	svc.events = append(svc.events, logEventsAt(crowded, 0, 4)...)
+	svc.events = append(svc.events, logEventsAt(crowded.Add(time.Second), time.Second, 2)...)
+
+	var buf bytes.Buffer
+	n, err := exportLogEvents(context.Background(), svc, "o-1", &buf, &ExportLogEventsOptions{
+		From:     start,
+		To:       start.Add(time.Minute),
+		PageSize: 3,
+	})
+	if !errors.Is(err, ErrLogEventsTruncated) {
+		t.Fatalf("got error %v, want %v", err, ErrLogEventsTruncated)
+	}
+
+	// The export goes on after each truncated window.
+	if n != 10 {
+		t.Errorf("got %d events, want 10", n)
+	}
+	var terr *LogEventsTruncatedError
+	if !errors.As(err, &terr) || len(terr.Ranges) != 2 {
+		t.Fatalf("got error %v, want 2 truncated ranges", err)
+	}
+	for i, at := range []time.Time{start, crowded} {
+		if r := terr.Ranges[i]; r.From.After(at) || r.To.Before(at) || r.To.Sub(r.From) > 2*time.Millisecond {
+			t.Errorf("got range %s, want one around %s", r, at.UTC().Format(time.RFC3339))
+		}
+	}
+}
+
+func TestTailLogEvents(t *testing.T) {
+	start := time.Unix(1000, 0)
+	svc := &fakeLogs{events: logEventsAt(start.Add(5*time.Second), 10*time.Second, 4)}
+
+	// Events become visible as the clock passes their creation time.
+	clock := &fakeClock{now: start}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := tailLogEvents(ctx, svc, "o-1", &TailLogEventsOptions{
+		Since:    start,
+		Interval: 10 * time.Second,
+		Lookback: time.Minute,
+		Clock:    clock,
+	})
+
+	var msgs []string
+	for e := range events {
+		msgs = append(msgs, e.GetMessage())
+		if len(msgs) == 4 {
+			cancel()
+		}
+	}
+	for i, msg := range msgs {
+		if want := fmt.Sprintf("event %d", i); msg != want {
+			t.Errorf("got event %q at %d, want %q", msg, i, want)
+		}
+	}
+	if len(msgs) != 4 {
+		t.Errorf("got %d events, want 4", len(msgs))
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/spotinst/util/uritemplates"
 	"io/ioutil"
 	"net/http"
+	"time"
 )
 
 type MigrationStatus struct {
@@ -40,6 +41,27 @@ type MigrationStatus struct {
 	// This may be used to include null fields in Patch requests.
 	nullFields []string
 }
+
+// CreatedAtTime parses CreatedAt. It returns the zero time if CreatedAt is nil.
+func (o *MigrationStatus) CreatedAtTime() (time.Time, error) {
+	return spotinst.TimeFromString(o.CreatedAt)
+}
+
+// ErroredAtTime parses ErroredAt. It returns the zero time if ErroredAt is nil.
+func (o *MigrationStatus) ErroredAtTime() (time.Time, error) {
+	return spotinst.TimeFromString(o.ErroredAt)
+}
+
+// StoppedAtTime parses StoppedAt. It returns the zero time if StoppedAt is nil.
+func (o *MigrationStatus) StoppedAtTime() (time.Time, error) {
+	return spotinst.TimeFromString(o.StoppedAt)
+}
+
+// CompletedAtTime parses CompletedAt. It returns the zero time if CompletedAt is nil.
+func (o *MigrationStatus) CompletedAtTime() (time.Time, error) {
+	return spotinst.TimeFromString(o.CompletedAt)
+}
+
 type MigrationConfig struct {
 	ShouldTerminateDrainedNodes *bool `json:"shouldTerminateDrainedNodes,omitempty"`
 	ShouldEvictStandAlonePods   *bool `json:"shouldEvictStandAlonePods,omitempty"`
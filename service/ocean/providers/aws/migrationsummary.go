@@ -8,6 +8,7 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/spotinst/util/uritemplates"
 	"io/ioutil"
 	"net/http"
+	"time"
 )
 
 type Migration struct {
@@ -32,6 +33,17 @@ type Migration struct {
 	// This may be used to include null fields in Patch requests.
 	nullFields []string
 }
+
+// CreatedAtTime parses CreatedAt. It returns the zero time if CreatedAt is nil.
+func (o *Migration) CreatedAtTime() (time.Time, error) {
+	return spotinst.TimeFromString(o.CreatedAt)
+}
+
+// CompletedAtTime parses CompletedAt. It returns the zero time if CompletedAt is nil.
+func (o *Migration) CompletedAtTime() (time.Time, error) {
+	return spotinst.TimeFromString(o.CompletedAt)
+}
+
 type ReadMigrationInput struct {
 	ClusterID *string `json:"clusterId,omitempty"`
 }
@@ -0,0 +1,289 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+// ErrNoMigrations is returned by TrackMigration when no migration ID is given
+// and the cluster has no migrations with a creation time.
+var ErrNoMigrations = errors.New("spotinst: cluster has no migrations")
+
+// A MigrationProgress describes the progress of a migration.
+type MigrationProgress struct {
+	ClusterID   string
+	MigrationID string
+
+	// Status is the status reported by the API.
+	Status string
+
+	OldInstances      int
+	NewInstances      int
+	InstancesDrained  int
+	InstancesLaunched int
+
+	// PodsRescheduled is the number of pods that left the old instances and
+	// are no longer unscheduled. Pods get new IDs when rescheduled, so it's
+	// derived from pod counts rather than matched by ID.
+	PodsRescheduled int
+
+	// UnscheduledPods holds the IDs of the pods that are still unscheduled.
+	UnscheduledPods []string
+
+	Elapsed time.Duration
+}
+
+// A MigrationSummary describes the outcome of a migration.
+type MigrationSummary struct {
+	MigrationProgress
+
+	CreatedAt   time.Time
+	CompletedAt time.Time
+	ErroredAt   time.Time
+	StoppedAt   time.Time
+
+	// LeftBehind holds the old instances that weren't drained when the
+	// migration errored or was stopped.
+	LeftBehind []*InstanceDetails
+}
+
+// Completed reports whether the migration completed.
+func (s *MigrationSummary) Completed() bool {
+	return !s.CompletedAt.IsZero()
+}
+
+// A MigrationTracker tracks a migration started by TrackMigration.
+type MigrationTracker struct {
+	progress chan *MigrationProgress
+	done     chan struct{}
+	summary  *MigrationSummary
+	err      error
+}
+
+// Progress returns a channel on which the progress of the migration is sent
+// after each poll. It's closed when tracking ends. Progress that isn't
+// received before the next poll is replaced by the newer one.
+func (t *MigrationTracker) Progress() <-chan *MigrationProgress {
+	return t.progress
+}
+
+// Wait waits for tracking to end and returns the summary of the migration.
+// A migration that errors or is stopped is reported as an error wrapping
+// waiter.ErrFailureState along with the summary.
+func (t *MigrationTracker) Wait() (*MigrationSummary, error) {
+	<-t.done
+	return t.summary, t.err
+}
+
+func (t *MigrationTracker) send(p *MigrationProgress) {
+	select {
+	case t.progress <- p:
+	default:
+		// Drop the unread progress; Progress is only sent from one
+		// goroutine, so the buffer is free afterwards.
+		select {
+		case <-t.progress:
+		default:
+		}
+		t.progress <- p
+	}
+}
+
+// TrackMigration polls MigrationStatus in the background until the
+// migration completes, errors or is stopped. If input.MigrationID is nil,
+// the cluster's most recent migration is tracked.
+func (s *ServiceOp) TrackMigration(ctx context.Context, input *ReadMigrationStatusInput, opts ...waiter.Option) *MigrationTracker {
+	return trackMigration(ctx, s, input, opts)
+}
+
+func trackMigration(ctx context.Context, svc Service, input *ReadMigrationStatusInput, opts []waiter.Option) *MigrationTracker {
+	tracker := &MigrationTracker{
+		progress: make(chan *MigrationProgress, 1),
+		done:     make(chan struct{}),
+	}
+	go func() {
+		defer close(tracker.done)
+		defer close(tracker.progress)
+		tracker.summary, tracker.err = pollMigration(ctx, svc, input, tracker.send, opts)
+	}()
+	return tracker
+}
+
+func pollMigration(ctx context.Context, svc Service, input *ReadMigrationStatusInput,
+	send func(*MigrationProgress), opts []waiter.Option) (*MigrationSummary, error) {
+	migrationID := spotinst.StringValue(input.MigrationID)
+	if migrationID == "" {
+		var err error
+		if migrationID, err = latestMigrationID(ctx, svc, input.ClusterID); err != nil {
+			return nil, err
+		}
+	}
+
+	t := &migrationObserver{
+		summary: &MigrationSummary{
+			MigrationProgress: MigrationProgress{
+				ClusterID:   spotinst.StringValue(input.ClusterID),
+				MigrationID: migrationID,
+			},
+		},
+	}
+	statusInput := &ReadMigrationStatusInput{
+		ClusterID:   input.ClusterID,
+		MigrationID: spotinst.String(migrationID),
+	}
+
+	w := &waiter.Waiter{
+		Name: "MigrationCompleted",
+		Poll: func(ctx context.Context) (interface{}, error) {
+			out, err := svc.MigrationStatus(ctx, statusInput)
+			if err != nil {
+				return nil, err
+			}
+			if len(out.MigrationStatus) > 0 {
+				t.observe(out.MigrationStatus[0])
+			}
+			return out, nil
+		},
+		Acceptors: []waiter.Acceptor{
+			{
+				State: waiter.Success,
+				Matcher: migrationStatusMatcher(func(m *MigrationStatus) bool {
+					return m.CompletedAt != nil
+				}),
+			},
+			{
+				State: waiter.Failure,
+				Matcher: migrationStatusMatcher(func(m *MigrationStatus) bool {
+					return m.ErroredAt != nil || m.StoppedAt != nil
+				}),
+			},
+		},
+		Options: waiter.DefaultOptions(),
+	}
+	w.Options.MinDelay = 15 * time.Second
+	w.Options.MaxDuration = 2 * time.Hour
+	w.Apply(opts...)
+
+	userProgress := w.Options.Progress
+	w.Apply(waiter.WithProgress(func(a waiter.Attempt) {
+		t.summary.Elapsed = a.Elapsed
+		if a.Err == nil {
+			p := t.summary.MigrationProgress
+			p.UnscheduledPods = append([]string(nil), p.UnscheduledPods...)
+			send(&p)
+		}
+		if userProgress != nil {
+			userProgress(a)
+		}
+	}))
+
+	_, err := w.Wait(ctx)
+	return t.summary, err
+}
+
+// latestMigrationID returns the ID of the cluster's most recent migration.
+// Migrations without a valid creation time are skipped.
+func latestMigrationID(ctx context.Context, svc Service, clusterID *string) (string, error) {
+	out, err := svc.ListMigrations(ctx, &ReadMigrationInput{ClusterID: clusterID})
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		latest  *Migration
+		created time.Time
+	)
+	for _, m := range out.Migration {
+		t, err := m.CreatedAtTime()
+		if err != nil || t.IsZero() {
+			continue
+		}
+		if latest == nil || t.After(created) {
+			latest, created = m, t
+		}
+	}
+	if latest == nil {
+		return "", ErrNoMigrations
+	}
+
+	return latest.GetID(), nil
+}
+
+type migrationObserver struct {
+	summary *MigrationSummary
+
+	// podsBefore is the largest number of pods seen on the old instances.
+	podsBefore int64
+}
+
+func (t *migrationObserver) observe(m *MigrationStatus) {
+	s := t.summary
+	s.Status = m.GetStatus()
+	s.OldInstances = len(m.OldInstances)
+	s.NewInstances = len(m.NewInstances)
+	s.CreatedAt, _ = m.CreatedAtTime()
+	s.CompletedAt, _ = m.CompletedAtTime()
+	s.ErroredAt, _ = m.ErroredAtTime()
+	s.StoppedAt, _ = m.StoppedAtTime()
+
+	var podsNow int64
+	s.InstancesDrained = 0
+	s.LeftBehind = nil
+	for _, i := range m.OldInstances {
+		podsNow += instancePods(i)
+		if instanceDrained(i) {
+			s.InstancesDrained++
+		} else {
+			s.LeftBehind = append(s.LeftBehind, i)
+		}
+	}
+	if podsNow > t.podsBefore {
+		t.podsBefore = podsNow
+	}
+	if s.CompletedAt.IsZero() && s.ErroredAt.IsZero() && s.StoppedAt.IsZero() {
+		s.LeftBehind = nil // only reported once the migration has ended
+	}
+
+	s.InstancesLaunched = 0
+	for _, i := range m.NewInstances {
+		if i.GetInstanceId() != "" {
+			s.InstancesLaunched++
+		}
+	}
+
+	s.UnscheduledPods = splitPodIDs(m.GetUnscheduledPodIds())
+	s.PodsRescheduled = int(t.podsBefore-podsNow) - len(s.UnscheduledPods)
+	if s.PodsRescheduled < 0 {
+		s.PodsRescheduled = 0
+	}
+}
+
+// instancePods returns the number of pods running on an instance.
+func instancePods(i *InstanceDetails) int64 {
+	if i.RunningPods != nil {
+		return i.GetRunningPods()
+	}
+	return int64(len(i.PodDetails))
+}
+
+// instanceDrained reports whether no pods are left on an instance.
+func instanceDrained(i *InstanceDetails) bool {
+	return instancePods(i) == 0
+}
+
+// splitPodIDs splits a list of pod IDs separated by commas or whitespace,
+// optionally enclosed in brackets.
+func splitPodIDs(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		switch r {
+		case ',', ' ', '\t', '\n', '[', ']', '"':
+			return true
+		}
+		return false
+	})
+}
@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+type fakeMigrations struct {
+	Service
+
+	migrations []*Migration
+
+	// statuses are returned by successive MigrationStatus calls, the last
+	// one repeated.
+	statuses []*MigrationStatus
+
+	n           int
+	migrationID string
+}
+
+func (f *fakeMigrations) ListMigrations(context.Context, *ReadMigrationInput) (*ReadMigrationOutput, error) {
+	return &ReadMigrationOutput{Migration: f.migrations}, nil
+}
+
+func (f *fakeMigrations) MigrationStatus(_ context.Context, input *ReadMigrationStatusInput) (*ReadMigrationStatusOutput, error) {
+	f.migrationID = spotinst.StringValue(input.MigrationID)
+	status := f.statuses[f.n]
+	if f.n < len(f.statuses)-1 {
+		f.n++
+	}
+	return &ReadMigrationStatusOutput{MigrationStatus: []*MigrationStatus{status}}, nil
+}
+
+func instances(pods ...int64) []*InstanceDetails {
+	out := make([]*InstanceDetails, len(pods))
+	for i, n := range pods {
+		out[i] = &InstanceDetails{InstanceId: spotinst.String("i-" + string(rune('a'+i))), RunningPods: spotinst.Int64(n)}
+	}
+	return out
+}
+
+func at(sec int64) *string {
+	return spotinst.String(spotinst.FormatTimestamp(time.Unix(sec, 0)))
+}
+
+func TestTrackMigration(t *testing.T) {
+	svc := &fakeMigrations{
+		migrations: []*Migration{
+			{ID: spotinst.String("m-old"), CreatedAt: at(100)},
+			{ID: spotinst.String("m-unknown")},
+			{ID: spotinst.String("m-new"), CreatedAt: at(200)},
+		},
+		statuses: []*MigrationStatus{
+			{Status: spotinst.String("running"), OldInstances: instances(3, 2), UnscheduledPodIds: spotinst.String("[p1, p2]")},
+			{Status: spotinst.String("running"), OldInstances: instances(0, 2), NewInstances: instances(0), UnscheduledPodIds: spotinst.String("p1")},
+			{Status: spotinst.String("completed"), OldInstances: instances(0, 0), NewInstances: instances(3, 2), CompletedAt: at(300)},
+		},
+	}
+
+	tracker := trackMigration(context.Background(), svc, &ReadMigrationStatusInput{
+		ClusterID: spotinst.String("o-1"),
+	}, []waiter.Option{waiter.WithClock(&fakeClock{now: time.Unix(0, 0)})})
+
+	var drained []int
+	for p := range tracker.Progress() {
+		drained = append(drained, p.InstancesDrained)
+	}
+	summary, err := tracker.Wait()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if svc.migrationID != "m-new" {
+		t.Errorf("tracked migration %q, want m-new", svc.migrationID)
+	}
+	// Progress may be replaced when not received in time, but the last one
+	// is always delivered.
+	if len(drained) == 0 || drained[len(drained)-1] != 2 {
+		t.Errorf("got drained instances %v, want last 2", drained)
+	}
+	if !summary.Completed() || !summary.CompletedAt.Equal(time.Unix(300, 0)) {
+		t.Errorf("got completed at %s", summary.CompletedAt)
+	}
+	if summary.PodsRescheduled != 5 || summary.InstancesLaunched != 2 || len(summary.UnscheduledPods) != 0 {
+		t.Errorf("got summary %+v", summary.MigrationProgress)
+	}
+}
+
+func TestTrackMigrationStopped(t *testing.T) {
+	svc := &fakeMigrations{statuses: []*MigrationStatus{
+		{Status: spotinst.String("running"), OldInstances: instances(3, 2)},
+		{Status: spotinst.String("stopped"), OldInstances: instances(0, 2), UnscheduledPodIds: spotinst.String("p1,p2"), StoppedAt: at(300)},
+	}}
+
+	tracker := trackMigration(context.Background(), svc, &ReadMigrationStatusInput{
+		ClusterID:   spotinst.String("o-1"),
+		MigrationID: spotinst.String("m-1"),
+	}, []waiter.Option{waiter.WithClock(&fakeClock{now: time.Unix(0, 0)})})
+
+	summary, err := tracker.Wait()
+	if !errors.Is(err, waiter.ErrFailureState) {
+		t.Fatalf("got error %v, want %v", err, waiter.ErrFailureState)
+	}
+	if _, ok := <-tracker.Progress(); ok {
+		// Unread progress may be buffered, but the channel must be closed.
+		if _, ok := <-tracker.Progress(); ok {
+			t.Error("progress not closed")
+		}
+	}
+	if len(summary.LeftBehind) != 1 || summary.LeftBehind[0].GetInstanceId() != "i-b" {
+		t.Errorf("got left behind %v", summary.LeftBehind)
+	}
+	if want := []string{"p1", "p2"}; !reflect.DeepEqual(summary.UnscheduledPods, want) {
+		t.Errorf("got unscheduled pods %v, want %v", summary.UnscheduledPods, want)
+	}
+}
+
+func TestTrackMigrationNoMigrations(t *testing.T) {
+	svc := &fakeMigrations{migrations: []*Migration{{ID: spotinst.String("m-unknown")}}}
+
+	tracker := trackMigration(context.Background(), svc, &ReadMigrationStatusInput{
+		ClusterID: spotinst.String("o-1"),
+	}, nil)
+	if _, err := tracker.Wait(); !errors.Is(err, ErrNoMigrations) {
+		t.Errorf("got error %v, want %v", err, ErrNoMigrations)
+	}
+}
@@ -0,0 +1,342 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+var (
+	// ErrRollStalled is returned by RunRoll when the roll makes no progress
+	// for longer than RunRollOptions.StallTimeout.
+	ErrRollStalled = errors.New("spotinst: roll stalled")
+
+	// ErrRollLogErrors is returned by RunRoll when error log events appear
+	// during the roll and RunRollOptions.StopOnLogErrors is set.
+	ErrRollLogErrors = errors.New("spotinst: error log events during roll")
+)
+
+// stopRollTimeout bounds the UpdateRoll request made to stop a roll.
+const stopRollTimeout = 30 * time.Second
+
+// RunRollOptions configure RunRoll.
+type RunRollOptions struct {
+	// StallTimeout stops the roll when neither its progress nor its current
+	// batch change for this long. Zero disables stall detection.
+	StallTimeout time.Duration
+
+	// StopOnLogErrors stops the roll when log events with the ERROR severity
+	// appear during the roll.
+	StopOnLogErrors bool
+
+	// OnUpdate, if set, is called after each poll with the report so far.
+	OnUpdate func(*RollReport)
+
+	// WaiterOptions configure polling, e.g. waiter.WithMinDelay.
+	WaiterOptions []waiter.Option
+}
+
+// A RollReport describes the execution of a roll.
+type RollReport struct {
+	RollID    string
+	ClusterID string
+
+	// Status is the last known status of the roll.
+	Status   RollState
+	Progress *Progress
+
+	StartedAt  time.Time
+	FinishedAt time.Time
+
+	// Batches holds the observed timing of each batch.
+	Batches []*RollBatch
+
+	// Events holds the cluster log events collected during the roll.
+	Events []*LogEvent
+}
+
+// A RollBatch describes the observed timing of a single roll batch. Times
+// are accurate to the polling interval.
+type RollBatch struct {
+	Number     int
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+// Duration returns the duration of the batch, or zero if it hasn't finished.
+func (b *RollBatch) Duration() time.Duration {
+	if b.FinishedAt.IsZero() {
+		return 0
+	}
+	return b.FinishedAt.Sub(b.StartedAt)
+}
+
+// RollStoppedError is returned by RunRoll when it stopped the roll.
+type RollStoppedError struct {
+	ClusterID string
+	RollID    string
+
+	// Reason is the error that caused the roll to be stopped.
+	Reason error
+}
+
+func (e *RollStoppedError) Error() string {
+	return fmt.Sprintf("spotinst: stopped roll %q of cluster %q: %v", e.RollID, e.ClusterID, e.Reason)
+}
+
+// Unwrap returns the reason the roll was stopped.
+func (e *RollStoppedError) Unwrap() error {
+	return e.Reason
+}
+
+// RunRoll creates a roll and polls it until it reaches a terminal status,
+// collecting the cluster's log events along the way. The roll is stopped if
+// it stalls or, if configured, error log events appear, in which case a
+// *RollStoppedError is returned. A roll that is stopped by someone else or
+// fails is reported as an error wrapping waiter.ErrFailureState, and is never
+// stopped again. Polls that fail with a retryable error (429, 5xx or a
+// network error) are retried.
+//
+// The returned report is non-nil once the roll has been created, even if an
+// error is returned.
+func (s *ServiceOp) RunRoll(ctx context.Context, input *CreateRollInput, opts *RunRollOptions) (*RollReport, error) {
+	return runRoll(ctx, s, input, opts)
+}
+
+func runRoll(ctx context.Context, svc Service, input *CreateRollInput, opts *RunRollOptions) (*RollReport, error) {
+	if opts == nil {
+		opts = &RunRollOptions{}
+	}
+	if input == nil || input.Roll == nil {
+		return nil, errors.New("spotinst: roll is required")
+	}
+
+	w := &waiter.Waiter{
+		Name:    "RollCompleted",
+		Options: waiter.DefaultOptions(),
+	}
+	w.Options.MinDelay = 15 * time.Second
+	w.Options.MaxDelay = 30 * time.Second
+	w.Options.MaxDuration = 2 * time.Hour
+	w.Apply(opts.WaiterOptions...)
+
+	clock := w.Options.Clock
+	if clock == nil {
+		clock = waiter.SystemClock
+	}
+
+	clusterID := spotinst.StringValue(input.Roll.ClusterID)
+	out, err := svc.CreateRoll(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &rollRunner{
+		svc:   svc,
+		opts:  opts,
+		clock: clock,
+		report: &RollReport{
+			RollID:    out.Roll.GetID(),
+			ClusterID: clusterID,
+			Status:    RollState(strings.ToUpper(out.Roll.GetStatus())),
+			StartedAt: clock.Now(),
+		},
+		seen: make(map[string]bool),
+	}
+	r.lastChange = r.report.StartedAt
+	r.logsFrom = r.report.StartedAt
+	if created := out.Roll.GetCreatedAt(); !created.IsZero() {
+		r.report.StartedAt = created
+		r.logsFrom = created
+	}
+
+	w.Poll = r.poll
+	w.Acceptors = []waiter.Acceptor{
+		{
+			State: waiter.Success,
+			Matcher: rollReportMatcher(func(report *RollReport) bool {
+				return report.Status == RollStateCompleted
+			}),
+		},
+		{
+			State: waiter.Failure,
+			Matcher: rollReportMatcher(func(report *RollReport) bool {
+				return report.Status == RollStateStopped || report.Status == RollStateFailed
+			}),
+		},
+		{
+			State: waiter.Failure,
+			Matcher: rollReportMatcher(func(*RollReport) bool {
+				return r.stopReason != nil
+			}),
+		},
+		{
+			// The roll keeps running on the server, so transient poll
+			// errors don't end the wait.
+			State:   waiter.Retry,
+			Matcher: waiter.RetryableErrorMatcher,
+		},
+	}
+
+	_, err = w.Wait(ctx)
+	r.finish()
+	if err == nil || r.stopReason == nil {
+		return r.report, err
+	}
+	if r.report.Status.IsTerminal() {
+		// The roll failed or was stopped already; there's nothing to stop.
+		return r.report, errors.Join(err, r.stopReason)
+	}
+
+	// The caller's context may be done already.
+	stopCtx, cancel := context.WithTimeout(context.Background(), stopRollTimeout)
+	defer cancel()
+
+	stopped, stopErr := svc.UpdateRoll(stopCtx, &UpdateRollInput{
+		Roll: &RollSpec{
+			ID:        spotinst.String(r.report.RollID),
+			ClusterID: spotinst.String(r.report.ClusterID),
+			Status:    spotinst.String(RollStateStopped.String()),
+		},
+	})
+	if stopErr != nil {
+		return r.report, errors.Join(r.stopReason, fmt.Errorf("spotinst: failed to stop roll: %w", stopErr))
+	}
+	r.report.Status = RollStateStopped
+	if status := stopped.Roll.GetStatus(); status != "" {
+		r.report.Status = RollState(strings.ToUpper(status))
+	}
+
+	return r.report, &RollStoppedError{
+		ClusterID: r.report.ClusterID,
+		RollID:    r.report.RollID,
+		Reason:    r.stopReason,
+	}
+}
+
+type rollRunner struct {
+	svc    Service
+	opts   *RunRollOptions
+	clock  waiter.Clock
+	report *RollReport
+
+	// Stall detection.
+	lastProgress float64
+	lastBatch    int
+	lastChange   time.Time
+
+	// Log collection.
+	logsFrom time.Time
+	seen     map[string]bool
+
+	stopReason error
+}
+
+func (r *rollRunner) poll(ctx context.Context) (interface{}, error) {
+	out, err := r.svc.ReadRoll(ctx, &ReadRollInput{
+		ClusterID: spotinst.String(r.report.ClusterID),
+		RollID:    spotinst.String(r.report.RollID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	now := r.clock.Now()
+
+	roll := out.Roll
+	r.report.Status = RollState(strings.ToUpper(roll.GetStatus()))
+	r.report.Progress = roll.Progress
+	r.observeBatch(roll.GetCurrentBatch(), now)
+
+	if err := r.collectLogs(ctx, now); err != nil {
+		return nil, err
+	}
+
+	progress := roll.Progress.GetValue()
+	if progress != r.lastProgress || roll.GetCurrentBatch() != r.lastBatch {
+		r.lastProgress = progress
+		r.lastBatch = roll.GetCurrentBatch()
+		r.lastChange = now
+	}
+	if !r.report.Status.IsTerminal() {
+		if r.opts.StallTimeout > 0 && now.Sub(r.lastChange) > r.opts.StallTimeout {
+			r.stopReason = fmt.Errorf("%w: no progress since %s", ErrRollStalled, r.lastChange.Format(time.RFC3339))
+		}
+	}
+
+	if r.opts.OnUpdate != nil {
+		r.opts.OnUpdate(r.report)
+	}
+
+	return r.report, nil
+}
+
+// observeBatch records batch transitions. Batches are numbered from 1.
+func (r *rollRunner) observeBatch(current int, now time.Time) {
+	if current <= 0 {
+		return
+	}
+	n := len(r.report.Batches)
+	if n > 0 && r.report.Batches[n-1].Number == current {
+		return
+	}
+	if n > 0 && r.report.Batches[n-1].FinishedAt.IsZero() {
+		r.report.Batches[n-1].FinishedAt = now
+	}
+	r.report.Batches = append(r.report.Batches, &RollBatch{
+		Number:    current,
+		StartedAt: now,
+	})
+}
+
+// collectLogs appends the log events between the end of the previous window
+// and now to the report.
+func (r *rollRunner) collectLogs(ctx context.Context, now time.Time) error {
+	input := &GetLogEventsInput{
+		ClusterID: spotinst.String(r.report.ClusterID),
+	}
+	input.WithFromDate(r.logsFrom).WithToDate(now)
+
+	out, err := r.svc.GetLogEvents(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range out.Events {
+		key := logEventKey(e)
+		if r.seen[key] {
+			continue // windows overlap by design
+		}
+		r.seen[key] = true
+		r.report.Events = append(r.report.Events, e)
+
+		if created := e.GetCreatedAt(); created.After(r.logsFrom) {
+			r.logsFrom = created
+		}
+		if r.opts.StopOnLogErrors && r.stopReason == nil &&
+			strings.EqualFold(e.GetSeverity(), LogSeverityError.String()) {
+			r.stopReason = fmt.Errorf("%w: %s", ErrRollLogErrors, e.GetMessage())
+		}
+	}
+
+	return nil
+}
+
+func (r *rollRunner) finish() {
+	now := r.clock.Now()
+	r.report.FinishedAt = now
+	if n := len(r.report.Batches); n > 0 && r.report.Batches[n-1].FinishedAt.IsZero() && r.report.Status.IsTerminal() {
+		r.report.Batches[n-1].FinishedAt = now
+	}
+}
+
+func rollReportMatcher(fn func(*RollReport) bool) waiter.Matcher {
+	return waiter.OutputMatcher(func(output interface{}) bool {
+		report, ok := output.(*RollReport)
+		return ok && fn(report)
+	})
+}
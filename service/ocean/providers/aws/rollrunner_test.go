@@ -0,0 +1,262 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+// fakeClock advances instantly when slept on.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.now = c.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+type rollPoll struct {
+	status   RollState
+	batch    int
+	progress float64
+	events   []*LogEvent
+}
+
+type fakeRolls struct {
+	Service
+
+	// polls are returned by successive ReadRoll calls, the last one
+	// repeated, along with the log events read right after.
+	polls   []rollPoll
+	stopErr error
+
+	// pollErr, if set, is returned by the first ReadRoll call.
+	pollErr error
+
+	n       int
+	stopped bool
+}
+
+func (f *fakeRolls) CreateRoll(_ context.Context, input *CreateRollInput) (*CreateRollOutput, error) {
+	return &CreateRollOutput{Roll: &RollStatus{
+		ID:        spotinst.String("scr-1"),
+		ClusterID: input.Roll.ClusterID,
+		Status:    spotinst.String("PENDING"),
+	}}, nil
+}
+
+func (f *fakeRolls) ReadRoll(context.Context, *ReadRollInput) (*ReadRollOutput, error) {
+	if err := f.pollErr; err != nil {
+		f.pollErr = nil
+		return nil, err
+	}
+	p := f.polls[f.n]
+	return &ReadRollOutput{Roll: &RollStatus{
+		ID:           spotinst.String("scr-1"),
+		Status:       spotinst.String(string(p.status)),
+		CurrentBatch: spotinst.Int(p.batch),
+		Progress:     &Progress{Value: spotinst.Float64(p.progress)},
+	}}, nil
+}
+
+func (f *fakeRolls) GetLogEvents(context.Context, *GetLogEventsInput) (*GetLogEventsOutput, error) {
+	events := f.polls[f.n].events
+	if f.n < len(f.polls)-1 {
+		f.n++
+	}
+	return &GetLogEventsOutput{Events: events}, nil
+}
+
+func (f *fakeRolls) UpdateRoll(_ context.Context, input *UpdateRollInput) (*UpdateRollOutput, error) {
+	if input.Roll.GetStatus() != "STOPPED" {
+		return nil, errors.New("unexpected update")
+	}
+	f.stopped = true
+	if f.stopErr != nil {
+		return nil, f.stopErr
+	}
+	return &UpdateRollOutput{Roll: &RollStatus{Status: spotinst.String("STOPPED")}}, nil
+}
+
+func logEvent(severity LogSeverity, msg string) *LogEvent {
+	created := time.Unix(10, 0)
+	return &LogEvent{
+		Severity:  spotinst.String(string(severity)),
+		Message:   spotinst.String(msg),
+		CreatedAt: &created,
+	}
+}
+
+func TestRunRoll(t *testing.T) {
+	stopErr := errors.New("stop failed")
+	failure := logEvent(LogSeverityError, "failed to launch instance")
+	for _, tt := range []struct {
+		name    string
+		svc     *fakeRolls
+		opts    RunRollOptions
+		status  RollState
+		batches int
+		stopped bool
+		errIs   []error
+	}{
+		{
+			name: "completed",
+			svc: &fakeRolls{polls: []rollPoll{
+				{status: RollStateInProgress, batch: 1},
+				{status: RollStateInProgress, batch: 2, progress: 50},
+				{status: RollStateCompleted, batch: 2, progress: 100},
+			}},
+			opts:    RunRollOptions{StallTimeout: time.Hour, StopOnLogErrors: true},
+			status:  RollStateCompleted,
+			batches: 2,
+		},
+		{
+			name: "transient poll error",
+			svc: &fakeRolls{polls: []rollPoll{
+				{status: RollStateInProgress, batch: 1},
+				{status: RollStateCompleted, batch: 1, progress: 100},
+			}, pollErr: client.Errors{{Response: &http.Response{StatusCode: http.StatusTooManyRequests}}}},
+			status:  RollStateCompleted,
+			batches: 1,
+		},
+		{
+			name: "stalled",
+			svc: &fakeRolls{polls: []rollPoll{
+				{status: RollStateInProgress, batch: 1, progress: 10},
+			}},
+			opts:    RunRollOptions{StallTimeout: time.Minute},
+			status:  RollStateStopped,
+			batches: 1,
+			stopped: true,
+			errIs:   []error{ErrRollStalled},
+		},
+		{
+			name: "log errors",
+			svc: &fakeRolls{polls: []rollPoll{
+				{status: RollStateInProgress, batch: 1},
+				{status: RollStateInProgress, batch: 1, progress: 10, events: []*LogEvent{failure}},
+			}},
+			opts:    RunRollOptions{StopOnLogErrors: true},
+			status:  RollStateStopped,
+			batches: 1,
+			stopped: true,
+			errIs:   []error{ErrRollLogErrors},
+		},
+		{
+			name: "log errors ignored",
+			svc: &fakeRolls{polls: []rollPoll{
+				{status: RollStateInProgress, batch: 1, events: []*LogEvent{failure}},
+				{status: RollStateCompleted, batch: 1, progress: 100},
+			}},
+			status:  RollStateCompleted,
+			batches: 1,
+		},
+		{
+			name: "failed with log errors",
+			svc: &fakeRolls{polls: []rollPoll{
+				{status: RollStateInProgress, batch: 1},
+				{status: RollStateFailed, batch: 1, events: []*LogEvent{failure}},
+			}},
+			opts:    RunRollOptions{StopOnLogErrors: true},
+			status:  RollStateFailed,
+			batches: 1,
+			errIs:   []error{waiter.ErrFailureState, ErrRollLogErrors},
+		},
+		{
+			name: "stop fails",
+			svc: &fakeRolls{polls: []rollPoll{
+				{status: RollStateInProgress, batch: 1},
+			}, stopErr: stopErr},
+			opts:    RunRollOptions{StallTimeout: time.Minute},
+			status:  RollStateInProgress,
+			batches: 1,
+			stopped: true,
+			errIs:   []error{ErrRollStalled, stopErr},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var updates int
+			opts := tt.opts
+			opts.OnUpdate = func(*RollReport) { updates++ }
+			opts.WaiterOptions = []waiter.Option{
+				waiter.WithClock(&fakeClock{now: time.Unix(0, 0)}),
+				waiter.WithJitter(0),
+			}
+
+			report, err := runRoll(context.Background(), tt.svc, &CreateRollInput{
+				Roll: &RollSpec{ClusterID: spotinst.String("o-1")},
+			}, &opts)
+			for _, target := range tt.errIs {
+				if !errors.Is(err, target) {
+					t.Errorf("got error %v, want %v", err, target)
+				}
+			}
+			if len(tt.errIs) == 0 && err != nil {
+				t.Fatalf("got error %v", err)
+			}
+
+			var stopped *RollStoppedError
+			if got := errors.As(err, &stopped); got != (tt.stopped && tt.svc.stopErr == nil) {
+				t.Errorf("got stopped error %v, want %v", got, tt.stopped)
+			}
+			if tt.svc.stopped != tt.stopped {
+				t.Errorf("got UpdateRoll called %v, want %v", tt.svc.stopped, tt.stopped)
+			}
+			if report == nil || report.Status != tt.status {
+				t.Fatalf("got report %+v, want status %s", report, tt.status)
+			}
+			if report.RollID != "scr-1" || report.ClusterID != "o-1" {
+				t.Errorf("got roll %q of cluster %q", report.RollID, report.ClusterID)
+			}
+			if len(report.Batches) != tt.batches {
+				t.Errorf("got %d batches, want %d", len(report.Batches), tt.batches)
+			}
+			if updates == 0 {
+				t.Error("OnUpdate not called")
+			}
+		})
+	}
+}
+
+func TestRunRollBatches(t *testing.T) {
+	svc := &fakeRolls{polls: []rollPoll{
+		{status: RollStateInProgress, batch: 1},
+		{status: RollStateInProgress, batch: 1, progress: 25},
+		{status: RollStateInProgress, batch: 2, progress: 50},
+		{status: RollStateCompleted, batch: 2, progress: 100},
+	}}
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	report, err := runRoll(context.Background(), svc, &CreateRollInput{
+		Roll: &RollSpec{ClusterID: spotinst.String("o-1")},
+	}, &RunRollOptions{WaiterOptions: []waiter.Option{
+		waiter.WithClock(clock),
+		waiter.WithJitter(0),
+		waiter.WithMinDelay(time.Minute),
+		waiter.WithMaxDelay(time.Minute),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Batches) != 2 {
+		t.Fatalf("got %d batches, want 2", len(report.Batches))
+	}
+	for i, want := range []time.Duration{2 * time.Minute, time.Minute} {
+		if got := report.Batches[i].Duration(); got != want {
+			t.Errorf("batch %d took %s, want %s", i+1, got, want)
+		}
+	}
+	if !report.FinishedAt.Equal(clock.now) {
+		t.Errorf("got finished at %s, want %s", report.FinishedAt, clock.now)
+	}
+}
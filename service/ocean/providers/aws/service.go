@@ -2,10 +2,14 @@ package aws
 
 import (
 	"context"
+	"io"
 
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/concurrency"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/informer"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
 )
 
 // Service provides the API operation methods for making requests to endpoints
@@ -22,23 +26,33 @@ type serviceKubernetes interface {
 	CreateCluster(context.Context, *CreateClusterInput) (*CreateClusterOutput, error)
 	ReadCluster(context.Context, *ReadClusterInput) (*ReadClusterOutput, error)
 	UpdateCluster(context.Context, *UpdateClusterInput) (*UpdateClusterOutput, error)
+	UpdateClusterIfUnchanged(context.Context, *UpdateClusterInput, *Cluster) (*UpdateClusterOutput, error)
+	RetryClusterOnConflict(context.Context, string, *concurrency.Retry, func(*Cluster) (*Cluster, error)) (*Cluster, error)
 	DeleteCluster(context.Context, *DeleteClusterInput) (*DeleteClusterOutput, error)
+	NewClusterInformer(informer.Config) *informer.Informer
 
 	ListLaunchSpecs(context.Context, *ListLaunchSpecsInput) (*ListLaunchSpecsOutput, error)
 	CreateLaunchSpec(context.Context, *CreateLaunchSpecInput) (*CreateLaunchSpecOutput, error)
 	ReadLaunchSpec(context.Context, *ReadLaunchSpecInput) (*ReadLaunchSpecOutput, error)
 	UpdateLaunchSpec(context.Context, *UpdateLaunchSpecInput) (*UpdateLaunchSpecOutput, error)
+	UpdateLaunchSpecIfUnchanged(context.Context, *UpdateLaunchSpecInput, *LaunchSpec) (*UpdateLaunchSpecOutput, error)
+	RetryLaunchSpecOnConflict(context.Context, string, *concurrency.Retry, func(*LaunchSpec) (*LaunchSpec, error)) (*LaunchSpec, error)
 	DeleteLaunchSpec(context.Context, *DeleteLaunchSpecInput) (*DeleteLaunchSpecOutput, error)
+	NewLaunchSpecInformer(string, informer.Config) *informer.Informer
 
 	ListClusterInstances(context.Context, *ListClusterInstancesInput) (*ListClusterInstancesOutput, error)
 	DetachClusterInstances(context.Context, *DetachClusterInstancesInput) (*DetachClusterInstancesOutput, error)
 
 	GetLogEvents(context.Context, *GetLogEventsInput) (*GetLogEventsOutput, error)
+	TailLogEvents(context.Context, string, *TailLogEventsOptions) <-chan LogEvent
+	ExportLogEvents(context.Context, string, io.Writer, *ExportLogEventsOptions) (int, error)
 
 	ListRolls(context.Context, *ListRollsInput) (*ListRollsOutput, error)
 	CreateRoll(context.Context, *CreateRollInput) (*CreateRollOutput, error)
 	ReadRoll(context.Context, *ReadRollInput) (*ReadRollOutput, error)
 	UpdateRoll(context.Context, *UpdateRollInput) (*UpdateRollOutput, error)
+	WaitUntilRollCompleted(context.Context, *ReadRollInput, ...waiter.Option) error
+	RunRoll(context.Context, *CreateRollInput, *RunRollOptions) (*RollReport, error)
 
 	// Deprecated: Roll is obsolete, exists for backward compatibility only,
 	// and should not be used. Please use CreateRoll instead.
@@ -51,6 +65,9 @@ type serviceKubernetes interface {
 	ListMigrations(context.Context, *ReadMigrationInput) (*ReadMigrationOutput, error)
 
 	MigrationStatus(context.Context, *ReadMigrationStatusInput) (*ReadMigrationStatusOutput, error)
+	WaitUntilMigrationCompleted(context.Context, *ReadMigrationStatusInput, ...waiter.Option) error
+	TrackMigration(context.Context, *ReadMigrationStatusInput, ...waiter.Option) *MigrationTracker
+
 	AttachLoadBalancer(context.Context, *AttachLoadbalancerInput) (*AttachLoadbalancerOutput, error)
 	DetachLoadBalancer(context.Context, *DetachLoadbalancerInput) (*DetachLoadbalancerOutput, error)
 }
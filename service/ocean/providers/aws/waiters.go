@@ -0,0 +1,94 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+// WaitUntilRollCompleted polls ReadRoll until the roll reaches the COMPLETED
+// status. It returns an error wrapping waiter.ErrFailureState if the roll is
+// stopped or fails.
+func (s *ServiceOp) WaitUntilRollCompleted(ctx context.Context, input *ReadRollInput, opts ...waiter.Option) error {
+	w := &waiter.Waiter{
+		Name: "RollCompleted",
+		Poll: func(ctx context.Context) (interface{}, error) {
+			return s.ReadRoll(ctx, input)
+		},
+		Acceptors: []waiter.Acceptor{
+			{
+				State:   waiter.Success,
+				Matcher: rollStateMatcher(RollStateCompleted),
+			},
+			{
+				State:   waiter.Failure,
+				Matcher: rollStateMatcher(RollStateStopped, RollStateFailed),
+			},
+		},
+		Options: waiter.DefaultOptions(),
+	}
+	w.Options.MinDelay = 15 * time.Second
+	w.Options.MaxDuration = 2 * time.Hour
+
+	_, err := w.Apply(opts...).Wait(ctx)
+	return err
+}
+
+func rollStateMatcher(states ...RollState) waiter.Matcher {
+	return waiter.OutputMatcher(func(output interface{}) bool {
+		out, ok := output.(*ReadRollOutput)
+		if !ok {
+			return false
+		}
+		state, err := ParseRollState(out.Roll.GetStatus())
+		if err != nil {
+			return false
+		}
+		for _, s := range states {
+			if state == s {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// WaitUntilMigrationCompleted polls MigrationStatus until the migration
+// completes. It returns an error wrapping waiter.ErrFailureState if the
+// migration errors or is stopped.
+func (s *ServiceOp) WaitUntilMigrationCompleted(ctx context.Context, input *ReadMigrationStatusInput, opts ...waiter.Option) error {
+	w := &waiter.Waiter{
+		Name: "MigrationCompleted",
+		Poll: func(ctx context.Context) (interface{}, error) {
+			return s.MigrationStatus(ctx, input)
+		},
+		Acceptors: []waiter.Acceptor{
+			{
+				State: waiter.Success,
+				Matcher: migrationStatusMatcher(func(m *MigrationStatus) bool {
+					return m.CompletedAt != nil
+				}),
+			},
+			{
+				State: waiter.Failure,
+				Matcher: migrationStatusMatcher(func(m *MigrationStatus) bool {
+					return m.ErroredAt != nil || m.StoppedAt != nil
+				}),
+			},
+		},
+		Options: waiter.DefaultOptions(),
+	}
+	w.Options.MinDelay = 15 * time.Second
+	w.Options.MaxDuration = 2 * time.Hour
+
+	_, err := w.Apply(opts...).Wait(ctx)
+	return err
+}
+
+func migrationStatusMatcher(fn func(*MigrationStatus) bool) waiter.Matcher {
+	return waiter.OutputMatcher(func(output interface{}) bool {
+		out, ok := output.(*ReadMigrationStatusOutput)
+		return ok && len(out.MigrationStatus) > 0 && out.MigrationStatus[0] != nil && fn(out.MigrationStatus[0])
+	})
+}
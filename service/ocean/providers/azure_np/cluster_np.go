@@ -197,6 +197,16 @@ type RollStatus struct {
 	UpdatedAt                 *string   `json:"updatedAt,omitempty"`
 }
 
+// CreatedAtTime parses CreatedAt. It returns the zero time if CreatedAt is nil.
+func (o *RollStatus) CreatedAtTime() (time.Time, error) {
+	return spotinst.TimeFromString(o.CreatedAt)
+}
+
+// UpdatedAtTime parses UpdatedAt. It returns the zero time if UpdatedAt is nil.
+func (o *RollStatus) UpdatedAtTime() (time.Time, error) {
+	return spotinst.TimeFromString(o.UpdatedAt)
+}
+
 type Progress struct {
 	ProgressPercentage *float64   `json:"progressPercentage,omitempty"`
 	DetailedStatus     *RollNodes `json:"detailedStatus,omitempty"`
@@ -0,0 +1,1344 @@
+// Code generated by gettergen. DO NOT EDIT.
+
+package azure_np
+
+import (
+	"time"
+)
+
+func (o *AKS) GetClusterName() string {
+	if o == nil || o.ClusterName == nil {
+		return ""
+	}
+	return *o.ClusterName
+}
+
+func (o *AKS) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *AKS) GetRegion() string {
+	if o == nil || o.Region == nil {
+		return ""
+	}
+	return *o.Region
+}
+
+func (o *AKS) GetInfrastructureResourceGroupName() string {
+	if o == nil || o.InfrastructureResourceGroupName == nil {
+		return ""
+	}
+	return *o.InfrastructureResourceGroupName
+}
+
+func (o *AutoScale) GetHeadrooms() []*Headrooms {
+	if o == nil {
+		return nil
+	}
+	return o.Headrooms
+}
+
+func (o *AutoScaler) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *AutoScaler) GetResourceLimits() *ResourceLimits {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceLimits
+}
+
+func (o *AutoScaler) GetDown() *Down {
+	if o == nil {
+		return nil
+	}
+	return o.Down
+}
+
+func (o *AutoScaler) GetHeadroom() *Headroom {
+	if o == nil {
+		return nil
+	}
+	return o.Headroom
+}
+
+func (o *Automatic) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *Automatic) GetPercentage() int {
+	if o == nil || o.Percentage == nil {
+		return 0
+	}
+	return *o.Percentage
+}
+
+func (o *AzureBlob) GetId() string {
+	if o == nil || o.Id == nil {
+		return ""
+	}
+	return *o.Id
+}
+
+func (o *Cluster) GetId() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *Cluster) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Cluster) GetControllerClusterId() string {
+	if o == nil || o.ControllerClusterID == nil {
+		return ""
+	}
+	return *o.ControllerClusterID
+}
+
+func (o *Cluster) GetAKS() *AKS {
+	if o == nil {
+		return nil
+	}
+	return o.AKS
+}
+
+func (o *Cluster) GetAutoScaler() *AutoScaler {
+	if o == nil {
+		return nil
+	}
+	return o.AutoScaler
+}
+
+func (o *Cluster) GetHealth() *Health {
+	if o == nil {
+		return nil
+	}
+	return o.Health
+}
+
+func (o *Cluster) GetVirtualNodeGroupTemplate() *VirtualNodeGroupTemplate {
+	if o == nil {
+		return nil
+	}
+	return o.VirtualNodeGroupTemplate
+}
+
+func (o *Cluster) GetScheduling() *Scheduling {
+	if o == nil {
+		return nil
+	}
+	return o.Scheduling
+}
+
+func (o *Cluster) GetLogging() *Logging {
+	if o == nil {
+		return nil
+	}
+	return o.Logging
+}
+
+func (o *Cluster) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *Cluster) GetUpdatedAt() time.Time {
+	if o == nil || o.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *o.UpdatedAt
+}
+
+func (o *CreateClusterInput) GetCluster() *Cluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *CreateClusterOutput) GetCluster() *Cluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *CreateRollInput) GetRoll() *RollSpec {
+	if o == nil {
+		return nil
+	}
+	return o.Roll
+}
+
+func (o *CreateRollOutput) GetRoll() *RollStatus {
+	if o == nil {
+		return nil
+	}
+	return o.Roll
+}
+
+func (o *CreateVirtualNodeGroupInput) GetVirtualNodeGroup() *VirtualNodeGroup {
+	if o == nil {
+		return nil
+	}
+	return o.VirtualNodeGroup
+}
+
+func (o *CreateVirtualNodeGroupOutput) GetVirtualNodeGroup() *VirtualNodeGroup {
+	if o == nil {
+		return nil
+	}
+	return o.VirtualNodeGroup
+}
+
+func (o *DeleteClusterInput) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *DeleteVirtualNodeGroupInput) GetVirtualNodeGroupID() string {
+	if o == nil || o.VirtualNodeGroupID == nil {
+		return ""
+	}
+	return *o.VirtualNodeGroupID
+}
+
+func (o *Down) GetMaxScaleDownPercentage() int {
+	if o == nil || o.MaxScaleDownPercentage == nil {
+		return 0
+	}
+	return *o.MaxScaleDownPercentage
+}
+
+func (o *Export) GetAzureBlob() *AzureBlob {
+	if o == nil {
+		return nil
+	}
+	return o.AzureBlob
+}
+
+func (o *Filters) GetMinVcpu() int {
+	if o == nil || o.MinVcpu == nil {
+		return 0
+	}
+	return *o.MinVcpu
+}
+
+func (o *Filters) GetMaxVcpu() int {
+	if o == nil || o.MaxVcpu == nil {
+		return 0
+	}
+	return *o.MaxVcpu
+}
+
+func (o *Filters) GetMinMemoryGiB() float64 {
+	if o == nil || o.MinMemoryGiB == nil {
+		return 0
+	}
+	return *o.MinMemoryGiB
+}
+
+func (o *Filters) GetMaxMemoryGiB() float64 {
+	if o == nil || o.MaxMemoryGiB == nil {
+		return 0
+	}
+	return *o.MaxMemoryGiB
+}
+
+func (o *Filters) GetSeries() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Series
+}
+
+func (o *Filters) GetArchitectures() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Architectures
+}
+
+func (o *Filters) GetExcludeSeries() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ExcludeSeries
+}
+
+func (o *Filters) GetAcceleratedNetworking() string {
+	if o == nil || o.AcceleratedNetworking == nil {
+		return ""
+	}
+	return *o.AcceleratedNetworking
+}
+
+func (o *Filters) GetDiskPerformance() string {
+	if o == nil || o.DiskPerformance == nil {
+		return ""
+	}
+	return *o.DiskPerformance
+}
+
+func (o *Filters) GetMinGpu() float64 {
+	if o == nil || o.MinGpu == nil {
+		return 0
+	}
+	return *o.MinGpu
+}
+
+func (o *Filters) GetMaxGpu() float64 {
+	if o == nil || o.MaxGpu == nil {
+		return 0
+	}
+	return *o.MaxGpu
+}
+
+func (o *Filters) GetMinNICs() int {
+	if o == nil || o.MinNICs == nil {
+		return 0
+	}
+	return *o.MinNICs
+}
+
+func (o *Filters) GetVmTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.VmTypes
+}
+
+func (o *Filters) GetMinDisk() int {
+	if o == nil || o.MinDisk == nil {
+		return 0
+	}
+	return *o.MinDisk
+}
+
+func (o *Filters) GetGpuTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.GpuTypes
+}
+
+func (o *Headroom) GetAutomatic() *Automatic {
+	if o == nil {
+		return nil
+	}
+	return o.Automatic
+}
+
+func (o *Headrooms) GetCpuPerUnit() int {
+	if o == nil || o.CpuPerUnit == nil {
+		return 0
+	}
+	return *o.CpuPerUnit
+}
+
+func (o *Headrooms) GetMemoryPerUnit() int {
+	if o == nil || o.MemoryPerUnit == nil {
+		return 0
+	}
+	return *o.MemoryPerUnit
+}
+
+func (o *Headrooms) GetGpuPerUnit() int {
+	if o == nil || o.GpuPerUnit == nil {
+		return 0
+	}
+	return *o.GpuPerUnit
+}
+
+func (o *Headrooms) GetNumOfUnits() int {
+	if o == nil || o.NumberOfUnits == nil {
+		return 0
+	}
+	return *o.NumberOfUnits
+}
+
+func (o *Health) GetGracePeriod() int {
+	if o == nil || o.GracePeriod == nil {
+		return 0
+	}
+	return *o.GracePeriod
+}
+
+func (o *Health) GetShouldReplaceUnhealthyInstances() bool {
+	if o == nil || o.ShouldReplaceUnhealthyInstances == nil {
+		return false
+	}
+	return *o.ShouldReplaceUnhealthyInstances
+}
+
+func (o *Health) GetHealthCheckUnhealthyDurationBeforeReplacement() int {
+	if o == nil || o.HealthCheckUnhealthyDurationBeforeReplacement == nil {
+		return 0
+	}
+	return *o.HealthCheckUnhealthyDurationBeforeReplacement
+}
+
+func (o *ImportCluster) GetControllerClusterID() string {
+	if o == nil || o.ControllerClusterID == nil {
+		return ""
+	}
+	return *o.ControllerClusterID
+}
+
+func (o *ImportCluster) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *ImportCluster) GetAKS() *AKS {
+	if o == nil {
+		return nil
+	}
+	return o.AKS
+}
+
+func (o *ImportClusterInput) GetACDIdentifier() string {
+	if o == nil || o.ACDIdentifier == nil {
+		return ""
+	}
+	return *o.ACDIdentifier
+}
+
+func (o *ImportClusterInput) GetCluster() *ImportCluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *ImportClusterOutput) GetCluster() *Cluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *LaunchNewNodesInput) GetAdjustment() int {
+	if o == nil || o.Adjustment == nil {
+		return 0
+	}
+	return *o.Adjustment
+}
+
+func (o *LaunchNewNodesInput) GetApplicableVmSizes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ApplicableVmSizes
+}
+
+func (o *LaunchNewNodesInput) GetAvailabilityZones() []string {
+	if o == nil {
+		return nil
+	}
+	return o.AvailabilityZones
+}
+
+func (o *LaunchNewNodesInput) GetMinCoresPerNode() int {
+	if o == nil || o.MinCoresPerNode == nil {
+		return 0
+	}
+	return *o.MinCoresPerNode
+}
+
+func (o *LaunchNewNodesInput) GetMinMemoryGiBPerNode() float64 {
+	if o == nil || o.MinMemoryGiBPerNode == nil {
+		return 0
+	}
+	return *o.MinMemoryGiBPerNode
+}
+
+func (o *LaunchNewNodesInput) GetOceanId() string {
+	if o == nil || o.OceanId == nil {
+		return ""
+	}
+	return *o.OceanId
+}
+
+func (o *LaunchNewNodesInput) GetPreferredLifecycle() string {
+	if o == nil || o.PreferredLifecycle == nil {
+		return ""
+	}
+	return *o.PreferredLifecycle
+}
+
+func (o *LaunchNewNodesInput) GetVngIds() []string {
+	if o == nil {
+		return nil
+	}
+	return o.VngIds
+}
+
+func (o *LinuxOSConfig) GetSysctls() *Sysctls {
+	if o == nil {
+		return nil
+	}
+	return o.Sysctls
+}
+
+func (o *ListClustersOutput) GetClusters() []*Cluster {
+	if o == nil {
+		return nil
+	}
+	return o.Clusters
+}
+
+func (o *ListRollsInput) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *ListRollsOutput) GetRolls() []*RollStatus {
+	if o == nil {
+		return nil
+	}
+	return o.Rolls
+}
+
+func (o *ListVirtualNodeGroupsInput) GetOceanID() string {
+	if o == nil || o.OceanID == nil {
+		return ""
+	}
+	return *o.OceanID
+}
+
+func (o *ListVirtualNodeGroupsOutput) GetVirtualNodeGroups() []*VirtualNodeGroup {
+	if o == nil {
+		return nil
+	}
+	return o.VirtualNodeGroups
+}
+
+func (o *Logging) GetExport() *Export {
+	if o == nil {
+		return nil
+	}
+	return o.Export
+}
+
+func (o *NodeCountLimits) GetMinCount() int {
+	if o == nil || o.MinCount == nil {
+		return 0
+	}
+	return *o.MinCount
+}
+
+func (o *NodeCountLimits) GetMaxCount() int {
+	if o == nil || o.MaxCount == nil {
+		return 0
+	}
+	return *o.MaxCount
+}
+
+func (o *NodePoolProperties) GetMaxPodsPerNode() int {
+	if o == nil || o.MaxPodsPerNode == nil {
+		return 0
+	}
+	return *o.MaxPodsPerNode
+}
+
+func (o *NodePoolProperties) GetEnableNodePublicIP() bool {
+	if o == nil || o.EnableNodePublicIP == nil {
+		return false
+	}
+	return *o.EnableNodePublicIP
+}
+
+func (o *NodePoolProperties) GetOsDiskSizeGB() int {
+	if o == nil || o.OsDiskSizeGB == nil {
+		return 0
+	}
+	return *o.OsDiskSizeGB
+}
+
+func (o *NodePoolProperties) GetOsDiskType() string {
+	if o == nil || o.OsDiskType == nil {
+		return ""
+	}
+	return *o.OsDiskType
+}
+
+func (o *NodePoolProperties) GetOsType() string {
+	if o == nil || o.OsType == nil {
+		return ""
+	}
+	return *o.OsType
+}
+
+func (o *NodePoolProperties) GetOsSKU() string {
+	if o == nil || o.OsSKU == nil {
+		return ""
+	}
+	return *o.OsSKU
+}
+
+func (o *NodePoolProperties) GetKubernetesVersion() string {
+	if o == nil || o.KubernetesVersion == nil {
+		return ""
+	}
+	return *o.KubernetesVersion
+}
+
+func (o *NodePoolProperties) GetPodSubnetIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.PodSubnetIDs
+}
+
+func (o *NodePoolProperties) GetVnetSubnetIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.VnetSubnetIDs
+}
+
+func (o *NodePoolProperties) GetLinuxOSConfig() *LinuxOSConfig {
+	if o == nil {
+		return nil
+	}
+	return o.LinuxOSConfig
+}
+
+func (o *NodeStatus) GetNodeName() string {
+	if o == nil || o.NodeName == nil {
+		return ""
+	}
+	return *o.NodeName
+}
+
+func (o *NodeStatus) GetStatus() string {
+	if o == nil || o.Status == nil {
+		return ""
+	}
+	return *o.Status
+}
+
+func (o *ParameterClusterRoll) GetBatchSizePercentage() int {
+	if o == nil || o.BatchSizePercentage == nil {
+		return 0
+	}
+	return *o.BatchSizePercentage
+}
+
+func (o *ParameterClusterRoll) GetComment() string {
+	if o == nil || o.Comment == nil {
+		return ""
+	}
+	return *o.Comment
+}
+
+func (o *ParameterClusterRoll) GetRespectPdb() bool {
+	if o == nil || o.RespectPdb == nil {
+		return false
+	}
+	return *o.RespectPdb
+}
+
+func (o *ParameterClusterRoll) GetRespectRestrictScaleDown() bool {
+	if o == nil || o.RespectRestrictScaleDown == nil {
+		return false
+	}
+	return *o.RespectRestrictScaleDown
+}
+
+func (o *ParameterClusterRoll) GetBatchMinHealthyPercentage() int {
+	if o == nil || o.BatchMinHealthyPercentage == nil {
+		return 0
+	}
+	return *o.BatchMinHealthyPercentage
+}
+
+func (o *ParameterClusterRoll) GetVngIds() []string {
+	if o == nil {
+		return nil
+	}
+	return o.VngIds
+}
+
+func (o *Parameters) GetClusterRoll() *ParameterClusterRoll {
+	if o == nil {
+		return nil
+	}
+	return o.ClusterRoll
+}
+
+func (o *Parameters) GetUpgradeConfig() *UpgradeConfig {
+	if o == nil {
+		return nil
+	}
+	return o.UpgradeConfig
+}
+
+func (o *Progress) GetProgressPercentage() float64 {
+	if o == nil || o.ProgressPercentage == nil {
+		return 0
+	}
+	return *o.ProgressPercentage
+}
+
+func (o *Progress) GetDetailedStatus() *RollNodes {
+	if o == nil {
+		return nil
+	}
+	return o.DetailedStatus
+}
+
+func (o *ReadClusterInput) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *ReadClusterOutput) GetCluster() *Cluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *ReadRollInput) GetRollID() string {
+	if o == nil || o.RollID == nil {
+		return ""
+	}
+	return *o.RollID
+}
+
+func (o *ReadRollInput) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *ReadRollOutput) GetRoll() *RollStatus {
+	if o == nil {
+		return nil
+	}
+	return o.Roll
+}
+
+func (o *ReadVirtualNodeGroupInput) GetVirtualNodeGroupID() string {
+	if o == nil || o.VirtualNodeGroupID == nil {
+		return ""
+	}
+	return *o.VirtualNodeGroupID
+}
+
+func (o *ReadVirtualNodeGroupOutput) GetVirtualNodeGroup() *VirtualNodeGroup {
+	if o == nil {
+		return nil
+	}
+	return o.VirtualNodeGroup
+}
+
+func (o *ResourceLimits) GetMaxVcpu() int {
+	if o == nil || o.MaxVCPU == nil {
+		return 0
+	}
+	return *o.MaxVCPU
+}
+
+func (o *ResourceLimits) GetMaxMemoryGib() int {
+	if o == nil || o.MaxMemoryGib == nil {
+		return 0
+	}
+	return *o.MaxMemoryGib
+}
+
+func (o *RollNodes) GetRollNodes() []*NodeStatus {
+	if o == nil {
+		return nil
+	}
+	return o.RollNodes
+}
+
+func (o *RollSpec) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *RollSpec) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *RollSpec) GetComment() string {
+	if o == nil || o.Comment == nil {
+		return ""
+	}
+	return *o.Comment
+}
+
+func (o *RollSpec) GetStatus() string {
+	if o == nil || o.Status == nil {
+		return ""
+	}
+	return *o.Status
+}
+
+func (o *RollSpec) GetBatchSizePercentage() int {
+	if o == nil || o.BatchSizePercentage == nil {
+		return 0
+	}
+	return *o.BatchSizePercentage
+}
+
+func (o *RollSpec) GetBatchMinHealthyPercentage() int {
+	if o == nil || o.BatchMinHealthyPercentage == nil {
+		return 0
+	}
+	return *o.BatchMinHealthyPercentage
+}
+
+func (o *RollSpec) GetRespectPDB() bool {
+	if o == nil || o.RespectPDB == nil {
+		return false
+	}
+	return *o.RespectPDB
+}
+
+func (o *RollSpec) GetNodePoolNames() []string {
+	if o == nil {
+		return nil
+	}
+	return o.NodePoolNames
+}
+
+func (o *RollSpec) GetVngIds() []string {
+	if o == nil {
+		return nil
+	}
+	return o.VngIds
+}
+
+func (o *RollSpec) GetRespectRestrictScaleDown() bool {
+	if o == nil || o.RespectRestrictScaleDown == nil {
+		return false
+	}
+	return *o.RespectRestrictScaleDown
+}
+
+func (o *RollSpec) GetNodeNames() []string {
+	if o == nil {
+		return nil
+	}
+	return o.NodeNames
+}
+
+func (o *RollStatus) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *RollStatus) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *RollStatus) GetScope() string {
+	if o == nil || o.Scope == nil {
+		return ""
+	}
+	return *o.Scope
+}
+
+func (o *RollStatus) GetComment() string {
+	if o == nil || o.Comment == nil {
+		return ""
+	}
+	return *o.Comment
+}
+
+func (o *RollStatus) GetStatus() string {
+	if o == nil || o.Status == nil {
+		return ""
+	}
+	return *o.Status
+}
+
+func (o *RollStatus) GetProgress() *Progress {
+	if o == nil {
+		return nil
+	}
+	return o.Progress
+}
+
+func (o *RollStatus) GetRespectPDB() bool {
+	if o == nil || o.RespectPDB == nil {
+		return false
+	}
+	return *o.RespectPDB
+}
+
+func (o *RollStatus) GetRespectRestrictScaleDown() bool {
+	if o == nil || o.RespectRestrictScaleDown == nil {
+		return false
+	}
+	return *o.RespectRestrictScaleDown
+}
+
+func (o *RollStatus) GetBatchMinHealthyPercentage() int {
+	if o == nil || o.BatchMinHealthyPercentage == nil {
+		return 0
+	}
+	return *o.BatchMinHealthyPercentage
+}
+
+func (o *RollStatus) GetCurrentBatch() int {
+	if o == nil || o.CurrentBatch == nil {
+		return 0
+	}
+	return *o.CurrentBatch
+}
+
+func (o *RollStatus) GetNumOfBatches() int {
+	if o == nil || o.NumOfBatches == nil {
+		return 0
+	}
+	return *o.NumOfBatches
+}
+
+func (o *RollStatus) GetCreatedAt() string {
+	if o == nil || o.CreatedAt == nil {
+		return ""
+	}
+	return *o.CreatedAt
+}
+
+func (o *RollStatus) GetUpdatedAt() string {
+	if o == nil || o.UpdatedAt == nil {
+		return ""
+	}
+	return *o.UpdatedAt
+}
+
+func (o *Scheduling) GetShutdownHours() *ShutdownHours {
+	if o == nil {
+		return nil
+	}
+	return o.ShutdownHours
+}
+
+func (o *Scheduling) GetTasks() []*Tasks {
+	if o == nil {
+		return nil
+	}
+	return o.Tasks
+}
+
+func (o *Scheduling) GetSuspensionHours() *SuspensionHours {
+	if o == nil {
+		return nil
+	}
+	return o.SuspensionHours
+}
+
+func (o *ShutdownHours) GetTimeWindows() []string {
+	if o == nil {
+		return nil
+	}
+	return o.TimeWindows
+}
+
+func (o *ShutdownHours) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *StopRollInput) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *StopRollInput) GetRollID() string {
+	if o == nil || o.RollID == nil {
+		return ""
+	}
+	return *o.RollID
+}
+
+func (o *StopRollOutput) GetRolls() []*RollStatus {
+	if o == nil {
+		return nil
+	}
+	return o.Rolls
+}
+
+func (o *Strategy) GetSpotPercentage() int {
+	if o == nil || o.SpotPercentage == nil {
+		return 0
+	}
+	return *o.SpotPercentage
+}
+
+func (o *Strategy) GetFallbackToOD() bool {
+	if o == nil || o.FallbackToOD == nil {
+		return false
+	}
+	return *o.FallbackToOD
+}
+
+func (o *Strategy) GetDrainingTimeout() int {
+	if o == nil || o.DrainingTimeout == nil {
+		return 0
+	}
+	return *o.DrainingTimeout
+}
+
+func (o *Strategy) GetShouldUtilizeCommitments() bool {
+	if o == nil || o.ShouldUtilizeCommitments == nil {
+		return false
+	}
+	return *o.ShouldUtilizeCommitments
+}
+
+func (o *SuspensionHours) GetTimeWindows() []string {
+	if o == nil {
+		return nil
+	}
+	return o.TimeWindows
+}
+
+func (o *SuspensionHours) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *Sysctls) GetVmMaxMapCount() int {
+	if o == nil || o.VmMaxMapCount == nil {
+		return 0
+	}
+	return *o.VmMaxMapCount
+}
+
+func (o *Taint) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *Taint) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *Taint) GetEffect() string {
+	if o == nil || o.Effect == nil {
+		return ""
+	}
+	return *o.Effect
+}
+
+func (o *Tasks) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *Tasks) GetTaskType() string {
+	if o == nil || o.TaskType == nil {
+		return ""
+	}
+	return *o.TaskType
+}
+
+func (o *Tasks) GetCronExpression() string {
+	if o == nil || o.CronExpression == nil {
+		return ""
+	}
+	return *o.CronExpression
+}
+
+func (o *Tasks) GetParameters() *Parameters {
+	if o == nil {
+		return nil
+	}
+	return o.Parameters
+}
+
+func (o *UpdateClusterInput) GetCluster() *Cluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *UpdateClusterOutput) GetCluster() *Cluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *UpdateVirtualNodeGroupInput) GetVirtualNodeGroup() *VirtualNodeGroup {
+	if o == nil {
+		return nil
+	}
+	return o.VirtualNodeGroup
+}
+
+func (o *UpdateVirtualNodeGroupOutput) GetVirtualNodeGroup() *VirtualNodeGroup {
+	if o == nil {
+		return nil
+	}
+	return o.VirtualNodeGroup
+}
+
+func (o *UpgradeConfig) GetApplyRoll() bool {
+	if o == nil || o.ApplyRoll == nil {
+		return false
+	}
+	return *o.ApplyRoll
+}
+
+func (o *UpgradeConfig) GetScopeVersion() string {
+	if o == nil || o.ScopeVersion == nil {
+		return ""
+	}
+	return *o.ScopeVersion
+}
+
+func (o *UpgradeConfig) GetRollParameters() *ParameterClusterRoll {
+	if o == nil {
+		return nil
+	}
+	return o.RollParameters
+}
+
+func (o *VirtualNodeGroup) GetId() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *VirtualNodeGroup) GetOceanId() string {
+	if o == nil || o.OceanID == nil {
+		return ""
+	}
+	return *o.OceanID
+}
+
+func (o *VirtualNodeGroup) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *VirtualNodeGroup) GetLabels() map[string]string {
+	if o == nil || o.Labels == nil {
+		return nil
+	}
+	return *o.Labels
+}
+
+func (o *VirtualNodeGroup) GetAvailabilityZones() []string {
+	if o == nil {
+		return nil
+	}
+	return o.AvailabilityZones
+}
+
+func (o *VirtualNodeGroup) GetTags() map[string]string {
+	if o == nil || o.Tags == nil {
+		return nil
+	}
+	return *o.Tags
+}
+
+func (o *VirtualNodeGroup) GetStrategy() *Strategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategy
+}
+
+func (o *VirtualNodeGroup) GetNodePoolProperties() *NodePoolProperties {
+	if o == nil {
+		return nil
+	}
+	return o.NodePoolProperties
+}
+
+func (o *VirtualNodeGroup) GetNodeCountLimits() *NodeCountLimits {
+	if o == nil {
+		return nil
+	}
+	return o.NodeCountLimits
+}
+
+func (o *VirtualNodeGroup) GetAutoScale() *AutoScale {
+	if o == nil {
+		return nil
+	}
+	return o.AutoScale
+}
+
+func (o *VirtualNodeGroup) GetTaints() []*Taint {
+	if o == nil {
+		return nil
+	}
+	return o.Taints
+}
+
+func (o *VirtualNodeGroup) GetVmSizes() *VmSizes {
+	if o == nil {
+		return nil
+	}
+	return o.VmSizes
+}
+
+func (o *VirtualNodeGroup) GetScheduling() *Scheduling {
+	if o == nil {
+		return nil
+	}
+	return o.Scheduling
+}
+
+func (o *VirtualNodeGroup) GetRestrictScaleDown() bool {
+	if o == nil || o.RestrictScaleDown == nil {
+		return false
+	}
+	return *o.RestrictScaleDown
+}
+
+func (o *VirtualNodeGroup) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *VirtualNodeGroup) GetUpdatedAt() time.Time {
+	if o == nil || o.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *o.UpdatedAt
+}
+
+func (o *VirtualNodeGroupTemplate) GetAvailabilityZones() []string {
+	if o == nil {
+		return nil
+	}
+	return o.AvailabilityZones
+}
+
+func (o *VirtualNodeGroupTemplate) GetNodePoolProperties() *NodePoolProperties {
+	if o == nil {
+		return nil
+	}
+	return o.NodePoolProperties
+}
+
+func (o *VirtualNodeGroupTemplate) GetNodeCountLimits() *NodeCountLimits {
+	if o == nil {
+		return nil
+	}
+	return o.NodeCountLimits
+}
+
+func (o *VirtualNodeGroupTemplate) GetStrategy() *Strategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategy
+}
+
+func (o *VirtualNodeGroupTemplate) GetLabels() map[string]string {
+	if o == nil || o.Labels == nil {
+		return nil
+	}
+	return *o.Labels
+}
+
+func (o *VirtualNodeGroupTemplate) GetTags() map[string]string {
+	if o == nil || o.Tags == nil {
+		return nil
+	}
+	return *o.Tags
+}
+
+func (o *VirtualNodeGroupTemplate) GetTaints() []*Taint {
+	if o == nil {
+		return nil
+	}
+	return o.Taints
+}
+
+func (o *VirtualNodeGroupTemplate) GetAutoScale() *AutoScale {
+	if o == nil {
+		return nil
+	}
+	return o.AutoScale
+}
+
+func (o *VirtualNodeGroupTemplate) GetVmSizes() *VmSizes {
+	if o == nil {
+		return nil
+	}
+	return o.VmSizes
+}
+
+func (o *VirtualNodeGroupTemplate) GetScheduling() *Scheduling {
+	if o == nil {
+		return nil
+	}
+	return o.Scheduling
+}
+
+func (o *VmSizes) GetFilters() *Filters {
+	if o == nil {
+		return nil
+	}
+	return o.Filters
+}
@@ -0,0 +1,1477 @@
+// Code generated by gettergen. DO NOT EDIT.
+
+package gcp
+
+import (
+	"time"
+)
+
+func (o *AccessConfig) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *AccessConfig) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *AggressiveScaleDown) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *AliasIPRange) GetIPCIDRRange() string {
+	if o == nil || o.IPCIDRRange == nil {
+		return ""
+	}
+	return *o.IPCIDRRange
+}
+
+func (o *AliasIPRange) GetSubnetworkRangeName() string {
+	if o == nil || o.SubnetworkRangeName == nil {
+		return ""
+	}
+	return *o.SubnetworkRangeName
+}
+
+func (o *AutoScale) GetHeadrooms() []*AutoScaleHeadroom {
+	if o == nil {
+		return nil
+	}
+	return o.Headrooms
+}
+
+func (o *AutoScale) GetAutoHeadroomPercentage() int {
+	if o == nil || o.AutoHeadroomPercentage == nil {
+		return 0
+	}
+	return *o.AutoHeadroomPercentage
+}
+
+func (o *AutoScaleHeadroom) GetCPUPerUnit() int {
+	if o == nil || o.CPUPerUnit == nil {
+		return 0
+	}
+	return *o.CPUPerUnit
+}
+
+func (o *AutoScaleHeadroom) GetGPUPerUnit() int {
+	if o == nil || o.GPUPerUnit == nil {
+		return 0
+	}
+	return *o.GPUPerUnit
+}
+
+func (o *AutoScaleHeadroom) GetMemoryPerUnit() int {
+	if o == nil || o.MemoryPerUnit == nil {
+		return 0
+	}
+	return *o.MemoryPerUnit
+}
+
+func (o *AutoScaleHeadroom) GetNumOfUnits() int {
+	if o == nil || o.NumOfUnits == nil {
+		return 0
+	}
+	return *o.NumOfUnits
+}
+
+func (o *AutoScaler) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *AutoScaler) GetIsAutoConfig() bool {
+	if o == nil || o.IsAutoConfig == nil {
+		return false
+	}
+	return *o.IsAutoConfig
+}
+
+func (o *AutoScaler) GetCooldown() int {
+	if o == nil || o.Cooldown == nil {
+		return 0
+	}
+	return *o.Cooldown
+}
+
+func (o *AutoScaler) GetAutoHeadroomPercentage() int {
+	if o == nil || o.AutoHeadroomPercentage == nil {
+		return 0
+	}
+	return *o.AutoHeadroomPercentage
+}
+
+func (o *AutoScaler) GetHeadroom() *AutoScalerHeadroom {
+	if o == nil {
+		return nil
+	}
+	return o.Headroom
+}
+
+func (o *AutoScaler) GetResourceLimits() *AutoScalerResourceLimits {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceLimits
+}
+
+func (o *AutoScaler) GetDown() *AutoScalerDown {
+	if o == nil {
+		return nil
+	}
+	return o.Down
+}
+
+func (o *AutoScaler) GetEnableAutomaticAndManualHeadroom() bool {
+	if o == nil || o.EnableAutomaticAndManualHeadroom == nil {
+		return false
+	}
+	return *o.EnableAutomaticAndManualHeadroom
+}
+
+func (o *AutoScalerDown) GetEvaluationPeriods() int {
+	if o == nil || o.EvaluationPeriods == nil {
+		return 0
+	}
+	return *o.EvaluationPeriods
+}
+
+func (o *AutoScalerDown) GetMaxScaleDownPercentage() float64 {
+	if o == nil || o.MaxScaleDownPercentage == nil {
+		return 0
+	}
+	return *o.MaxScaleDownPercentage
+}
+
+func (o *AutoScalerDown) GetAggressiveScaleDown() *AggressiveScaleDown {
+	if o == nil {
+		return nil
+	}
+	return o.AggressiveScaleDown
+}
+
+func (o *AutoScalerHeadroom) GetCPUPerUnit() int {
+	if o == nil || o.CPUPerUnit == nil {
+		return 0
+	}
+	return *o.CPUPerUnit
+}
+
+func (o *AutoScalerHeadroom) GetMemoryPerUnit() int {
+	if o == nil || o.MemoryPerUnit == nil {
+		return 0
+	}
+	return *o.MemoryPerUnit
+}
+
+func (o *AutoScalerHeadroom) GetNumOfUnits() int {
+	if o == nil || o.NumOfUnits == nil {
+		return 0
+	}
+	return *o.NumOfUnits
+}
+
+func (o *AutoScalerHeadroom) GetGPUPerUnit() int {
+	if o == nil || o.GPUPerUnit == nil {
+		return 0
+	}
+	return *o.GPUPerUnit
+}
+
+func (o *AutoScalerResourceLimits) GetMaxVCPU() int {
+	if o == nil || o.MaxVCPU == nil {
+		return 0
+	}
+	return *o.MaxVCPU
+}
+
+func (o *AutoScalerResourceLimits) GetMaxMemoryGiB() int {
+	if o == nil || o.MaxMemoryGiB == nil {
+		return 0
+	}
+	return *o.MaxMemoryGiB
+}
+
+func (o *AutoUpdate) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *BackendService) GetBackendServiceName() string {
+	if o == nil || o.BackendServiceName == nil {
+		return ""
+	}
+	return *o.BackendServiceName
+}
+
+func (o *BackendService) GetLocationType() string {
+	if o == nil || o.LocationType == nil {
+		return ""
+	}
+	return *o.LocationType
+}
+
+func (o *BackendService) GetScheme() string {
+	if o == nil || o.Scheme == nil {
+		return ""
+	}
+	return *o.Scheme
+}
+
+func (o *BackendService) GetNamedPorts() *NamedPorts {
+	if o == nil {
+		return nil
+	}
+	return o.NamedPorts
+}
+
+func (o *Capacity) GetMinimum() int {
+	if o == nil || o.Minimum == nil {
+		return 0
+	}
+	return *o.Minimum
+}
+
+func (o *Capacity) GetMaximum() int {
+	if o == nil || o.Maximum == nil {
+		return 0
+	}
+	return *o.Maximum
+}
+
+func (o *Capacity) GetTarget() int {
+	if o == nil || o.Target == nil {
+		return 0
+	}
+	return *o.Target
+}
+
+func (o *Cluster) GetId() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *Cluster) GetControllerClusterId() string {
+	if o == nil || o.ControllerClusterID == nil {
+		return ""
+	}
+	return *o.ControllerClusterID
+}
+
+func (o *Cluster) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Cluster) GetScheduling() *Scheduling {
+	if o == nil {
+		return nil
+	}
+	return o.Scheduling
+}
+
+func (o *Cluster) GetAutoScaler() *AutoScaler {
+	if o == nil {
+		return nil
+	}
+	return o.AutoScaler
+}
+
+func (o *Cluster) GetCapacity() *Capacity {
+	if o == nil {
+		return nil
+	}
+	return o.Capacity
+}
+
+func (o *Cluster) GetCompute() *Compute {
+	if o == nil {
+		return nil
+	}
+	return o.Compute
+}
+
+func (o *Cluster) GetStrategy() *Strategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategy
+}
+
+func (o *Cluster) GetGKE() *GKE {
+	if o == nil {
+		return nil
+	}
+	return o.GKE
+}
+
+func (o *Cluster) GetAutoUpdate() *AutoUpdate {
+	if o == nil {
+		return nil
+	}
+	return o.AutoUpdate
+}
+
+func (o *Cluster) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *Cluster) GetUpdatedAt() time.Time {
+	if o == nil || o.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *o.UpdatedAt
+}
+
+func (o *ClusterRoll) GetBatchMinHealthyPercentage() int {
+	if o == nil || o.BatchMinHealthyPercentage == nil {
+		return 0
+	}
+	return *o.BatchMinHealthyPercentage
+}
+
+func (o *ClusterRoll) GetBatchSizePercentage() int {
+	if o == nil || o.BatchSizePercentage == nil {
+		return 0
+	}
+	return *o.BatchSizePercentage
+}
+
+func (o *ClusterRoll) GetComment() string {
+	if o == nil || o.Comment == nil {
+		return ""
+	}
+	return *o.Comment
+}
+
+func (o *ClusterRoll) GetRespectPdb() bool {
+	if o == nil || o.RespectPdb == nil {
+		return false
+	}
+	return *o.RespectPdb
+}
+
+func (o *Compute) GetAvailabilityZones() []string {
+	if o == nil {
+		return nil
+	}
+	return o.AvailabilityZones
+}
+
+func (o *Compute) GetInstanceTypes() *InstanceTypes {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceTypes
+}
+
+func (o *Compute) GetLaunchSpecification() *LaunchSpecification {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecification
+}
+
+func (o *Compute) GetBackendServices() []*BackendService {
+	if o == nil {
+		return nil
+	}
+	return o.BackendServices
+}
+
+func (o *Compute) GetNetworkInterfaces() []*NetworkInterface {
+	if o == nil {
+		return nil
+	}
+	return o.NetworkInterfaces
+}
+
+func (o *Compute) GetSubnetName() string {
+	if o == nil || o.SubnetName == nil {
+		return ""
+	}
+	return *o.SubnetName
+}
+
+func (o *CreateClusterInput) GetCluster() *Cluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *CreateClusterOutput) GetCluster() *Cluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *CreateLaunchSpecInput) GetLaunchSpec() *LaunchSpec {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpec
+}
+
+func (o *CreateLaunchSpecInput) GetInitialNodes() int {
+	if o == nil || o.InitialNodes == nil {
+		return 0
+	}
+	return *o.InitialNodes
+}
+
+func (o *CreateLaunchSpecOutput) GetLaunchSpec() *LaunchSpec {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpec
+}
+
+func (o *CreateRollInput) GetRoll() *RollSpec {
+	if o == nil {
+		return nil
+	}
+	return o.Roll
+}
+
+func (o *CreateRollOutput) GetRoll() *RollStatus {
+	if o == nil {
+		return nil
+	}
+	return o.Roll
+}
+
+func (o *DeleteClusterInput) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *DeleteLaunchSpecInput) GetLaunchSpecID() string {
+	if o == nil || o.LaunchSpecID == nil {
+		return ""
+	}
+	return *o.LaunchSpecID
+}
+
+func (o *Filters) GetExcludeFamilies() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ExcludeFamilies
+}
+
+func (o *Filters) GetIncludeFamilies() []string {
+	if o == nil {
+		return nil
+	}
+	return o.IncludeFamilies
+}
+
+func (o *Filters) GetMaxMemoryGiB() float64 {
+	if o == nil || o.MaxMemoryGiB == nil {
+		return 0
+	}
+	return *o.MaxMemoryGiB
+}
+
+func (o *Filters) GetMaxVcpu() int {
+	if o == nil || o.MaxVcpu == nil {
+		return 0
+	}
+	return *o.MaxVcpu
+}
+
+func (o *Filters) GetMinMemoryGiB() float64 {
+	if o == nil || o.MinMemoryGiB == nil {
+		return 0
+	}
+	return *o.MinMemoryGiB
+}
+
+func (o *Filters) GetMinVcpu() int {
+	if o == nil || o.MinVcpu == nil {
+		return 0
+	}
+	return *o.MinVcpu
+}
+
+func (o *GKE) GetClusterName() string {
+	if o == nil || o.ClusterName == nil {
+		return ""
+	}
+	return *o.ClusterName
+}
+
+func (o *GKE) GetMasterLocation() string {
+	if o == nil || o.MasterLocation == nil {
+		return ""
+	}
+	return *o.MasterLocation
+}
+
+func (o *GKELaunchSpecScheduling) GetTasks() []*GKELaunchSpecTask {
+	if o == nil {
+		return nil
+	}
+	return o.Tasks
+}
+
+func (o *GKELaunchSpecTask) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *GKELaunchSpecTask) GetCronExpression() string {
+	if o == nil || o.CronExpression == nil {
+		return ""
+	}
+	return *o.CronExpression
+}
+
+func (o *GKELaunchSpecTask) GetTaskType() string {
+	if o == nil || o.TaskType == nil {
+		return ""
+	}
+	return *o.TaskType
+}
+
+func (o *GKELaunchSpecTask) GetTaskConfig() *GKETaskConfig {
+	if o == nil {
+		return nil
+	}
+	return o.Config
+}
+
+func (o *GKELaunchSpecTaskHeadroom) GetCPUPerUnit() int {
+	if o == nil || o.CPUPerUnit == nil {
+		return 0
+	}
+	return *o.CPUPerUnit
+}
+
+func (o *GKELaunchSpecTaskHeadroom) GetGPUPerUnit() int {
+	if o == nil || o.GPUPerUnit == nil {
+		return 0
+	}
+	return *o.GPUPerUnit
+}
+
+func (o *GKELaunchSpecTaskHeadroom) GetMemoryPerUnit() int {
+	if o == nil || o.MemoryPerUnit == nil {
+		return 0
+	}
+	return *o.MemoryPerUnit
+}
+
+func (o *GKELaunchSpecTaskHeadroom) GetNumOfUnits() int {
+	if o == nil || o.NumOfUnits == nil {
+		return 0
+	}
+	return *o.NumOfUnits
+}
+
+func (o *GKETaskConfig) GetHeadrooms() []*GKELaunchSpecTaskHeadroom {
+	if o == nil {
+		return nil
+	}
+	return o.TaskHeadrooms
+}
+
+func (o *ImportOceanGKECluster) GetInstanceTypes() *InstanceTypes {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceTypes
+}
+
+func (o *ImportOceanGKECluster) GetBackendServices() []*BackendService {
+	if o == nil {
+		return nil
+	}
+	return o.BackendServices
+}
+
+func (o *ImportOceanGKEClusterInput) GetClusterName() string {
+	if o == nil || o.ClusterName == nil {
+		return ""
+	}
+	return *o.ClusterName
+}
+
+func (o *ImportOceanGKEClusterInput) GetLocation() string {
+	if o == nil || o.Location == nil {
+		return ""
+	}
+	return *o.Location
+}
+
+func (o *ImportOceanGKEClusterInput) GetNodePoolName() string {
+	if o == nil || o.NodePoolName == nil {
+		return ""
+	}
+	return *o.NodePoolName
+}
+
+func (o *ImportOceanGKEClusterInput) GetIncludeLaunchSpecs() string {
+	if o == nil || o.IncludeLaunchSpecs == nil {
+		return ""
+	}
+	return *o.IncludeLaunchSpecs
+}
+
+func (o *ImportOceanGKEClusterInput) GetCluster() *ImportOceanGKECluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *ImportOceanGKEClusterOutput) GetCluster() *Cluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *ImportOceanGKELaunchSpecInput) GetOceanId() string {
+	if o == nil || o.OceanId == nil {
+		return ""
+	}
+	return *o.OceanId
+}
+
+func (o *ImportOceanGKELaunchSpecInput) GetNodePoolName() string {
+	if o == nil || o.NodePoolName == nil {
+		return ""
+	}
+	return *o.NodePoolName
+}
+
+func (o *ImportOceanGKELaunchSpecOutput) GetLaunchSpec() *LaunchSpec {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpec
+}
+
+func (o *InstanceTypes) GetWhitelist() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Whitelist
+}
+
+func (o *InstanceTypes) GetBlacklist() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Blacklist
+}
+
+func (o *InstanceTypes) GetPreferredTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.PreferredTypes
+}
+
+func (o *InstanceTypes) GetFilters() *Filters {
+	if o == nil {
+		return nil
+	}
+	return o.Filters
+}
+
+func (o *Label) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *Label) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *LaunchSpec) GetId() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *LaunchSpec) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *LaunchSpec) GetOceanId() string {
+	if o == nil || o.OceanID == nil {
+		return ""
+	}
+	return *o.OceanID
+}
+
+func (o *LaunchSpec) GetSourceImage() string {
+	if o == nil || o.SourceImage == nil {
+		return ""
+	}
+	return *o.SourceImage
+}
+
+func (o *LaunchSpec) GetMetadata() []*Metadata {
+	if o == nil {
+		return nil
+	}
+	return o.Metadata
+}
+
+func (o *LaunchSpec) GetLabels() []*Label {
+	if o == nil {
+		return nil
+	}
+	return o.Labels
+}
+
+func (o *LaunchSpec) GetTaints() []*Taint {
+	if o == nil {
+		return nil
+	}
+	return o.Taints
+}
+
+func (o *LaunchSpec) GetAutoScale() *AutoScale {
+	if o == nil {
+		return nil
+	}
+	return o.AutoScale
+}
+
+func (o *LaunchSpec) GetRestrictScaleDown() bool {
+	if o == nil || o.RestrictScaleDown == nil {
+		return false
+	}
+	return *o.RestrictScaleDown
+}
+
+func (o *LaunchSpec) GetStrategy() *LaunchSpecStrategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategy
+}
+
+func (o *LaunchSpec) GetRootVolumeSizeInGB() int {
+	if o == nil || o.RootVolumeSizeInGB == nil {
+		return 0
+	}
+	return *o.RootVolumeSizeInGB
+}
+
+func (o *LaunchSpec) GetRootVolumeType() string {
+	if o == nil || o.RootVolumeType == nil {
+		return ""
+	}
+	return *o.RootVolumeType
+}
+
+func (o *LaunchSpec) GetShieldedInstanceConfig() *ShieldedInstanceConfig {
+	if o == nil {
+		return nil
+	}
+	return o.ShieldedInstanceConfig
+}
+
+func (o *LaunchSpec) GetServiceAccount() string {
+	if o == nil || o.ServiceAccount == nil {
+		return ""
+	}
+	return *o.ServiceAccount
+}
+
+func (o *LaunchSpec) GetInstanceTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceTypes
+}
+
+func (o *LaunchSpec) GetStorage() *Storage {
+	if o == nil {
+		return nil
+	}
+	return o.Storage
+}
+
+func (o *LaunchSpec) GetResourceLimits() *ResourceLimits {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceLimits
+}
+
+func (o *LaunchSpec) GetScheduling() *GKELaunchSpecScheduling {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecScheduling
+}
+
+func (o *LaunchSpec) GetLaunchSpecTags() []string {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecTags
+}
+
+func (o *LaunchSpec) GetLaunchSpecNetworkInterfaces() []*LaunchSpecNetworkInterfaces {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecNetworkInterfaces
+}
+
+func (o *LaunchSpec) GetFilters() *Filters {
+	if o == nil {
+		return nil
+	}
+	return o.Filters
+}
+
+func (o *LaunchSpecAccessConfigs) GetLaunchSpecAccessConfigsName() string {
+	if o == nil || o.LaunchSpecAccessConfigsName == nil {
+		return ""
+	}
+	return *o.LaunchSpecAccessConfigsName
+}
+
+func (o *LaunchSpecAccessConfigs) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *LaunchSpecAliasIPRanges) GetIPCidrRange() string {
+	if o == nil || o.IPCidrRange == nil {
+		return ""
+	}
+	return *o.IPCidrRange
+}
+
+func (o *LaunchSpecAliasIPRanges) GetSubnetworkRangeName() string {
+	if o == nil || o.SubnetworkRangeName == nil {
+		return ""
+	}
+	return *o.SubnetworkRangeName
+}
+
+func (o *LaunchSpecNetworkInterfaces) GetLaunchSpecAccessConfigs() []*LaunchSpecAccessConfigs {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecAccessConfigs
+}
+
+func (o *LaunchSpecNetworkInterfaces) GetLaunchSpecAliasIPRanges() []*LaunchSpecAliasIPRanges {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecAliasIPRanges
+}
+
+func (o *LaunchSpecNetworkInterfaces) GetNetwork() string {
+	if o == nil || o.Network == nil {
+		return ""
+	}
+	return *o.Network
+}
+
+func (o *LaunchSpecNetworkInterfaces) GetProjectId() string {
+	if o == nil || o.ProjectID == nil {
+		return ""
+	}
+	return *o.ProjectID
+}
+
+func (o *LaunchSpecShieldedInstanceConfig) GetEnableSecureBoot() bool {
+	if o == nil || o.EnableSecureBoot == nil {
+		return false
+	}
+	return *o.EnableSecureBoot
+}
+
+func (o *LaunchSpecShieldedInstanceConfig) GetEnableIntegrityMonitoring() bool {
+	if o == nil || o.EnableIntegrityMonitoring == nil {
+		return false
+	}
+	return *o.EnableIntegrityMonitoring
+}
+
+func (o *LaunchSpecStrategy) GetPreemptiblePercentage() int {
+	if o == nil || o.PreemptiblePercentage == nil {
+		return 0
+	}
+	return *o.PreemptiblePercentage
+}
+
+func (o *LaunchSpecStrategy) GetScalingOrientation() string {
+	if o == nil || o.ScalingOrientation == nil {
+		return ""
+	}
+	return *o.ScalingOrientation
+}
+
+func (o *LaunchSpecification) GetLabels() []*Label {
+	if o == nil {
+		return nil
+	}
+	return o.Labels
+}
+
+func (o *LaunchSpecification) GetIPForwarding() bool {
+	if o == nil || o.IPForwarding == nil {
+		return false
+	}
+	return *o.IPForwarding
+}
+
+func (o *LaunchSpecification) GetMetadata() []*Metadata {
+	if o == nil {
+		return nil
+	}
+	return o.Metadata
+}
+
+func (o *LaunchSpecification) GetRootVolumeSizeInGB() int {
+	if o == nil || o.RootVolumeSizeInGB == nil {
+		return 0
+	}
+	return *o.RootVolumeSizeInGB
+}
+
+func (o *LaunchSpecification) GetServiceAccount() string {
+	if o == nil || o.ServiceAccount == nil {
+		return ""
+	}
+	return *o.ServiceAccount
+}
+
+func (o *LaunchSpecification) GetSourceImage() string {
+	if o == nil || o.SourceImage == nil {
+		return ""
+	}
+	return *o.SourceImage
+}
+
+func (o *LaunchSpecification) GetTags() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Tags
+}
+
+func (o *LaunchSpecification) GetRootVolumeType() string {
+	if o == nil || o.RootVolumeType == nil {
+		return ""
+	}
+	return *o.RootVolumeType
+}
+
+func (o *LaunchSpecification) GetShieldedInstanceConfig() *LaunchSpecShieldedInstanceConfig {
+	if o == nil {
+		return nil
+	}
+	return o.ShieldedInstanceConfig
+}
+
+func (o *LaunchSpecification) GetUseAsTemplateOnly() bool {
+	if o == nil || o.UseAsTemplateOnly == nil {
+		return false
+	}
+	return *o.UseAsTemplateOnly
+}
+
+func (o *ListClustersOutput) GetClusters() []*Cluster {
+	if o == nil {
+		return nil
+	}
+	return o.Clusters
+}
+
+func (o *ListLaunchSpecsInput) GetOceanID() string {
+	if o == nil || o.OceanID == nil {
+		return ""
+	}
+	return *o.OceanID
+}
+
+func (o *ListLaunchSpecsOutput) GetLaunchSpecs() []*LaunchSpec {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecs
+}
+
+func (o *Metadata) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *Metadata) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *NamedPorts) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *NamedPorts) GetPorts() []int {
+	if o == nil {
+		return nil
+	}
+	return o.Ports
+}
+
+func (o *NetworkInterface) GetAccessConfigs() []*AccessConfig {
+	if o == nil {
+		return nil
+	}
+	return o.AccessConfigs
+}
+
+func (o *NetworkInterface) GetAliasIPRanges() []*AliasIPRange {
+	if o == nil {
+		return nil
+	}
+	return o.AliasIPRanges
+}
+
+func (o *NetworkInterface) GetNetwork() string {
+	if o == nil || o.Network == nil {
+		return ""
+	}
+	return *o.Network
+}
+
+func (o *NetworkInterface) GetProjectId() string {
+	if o == nil || o.ProjectID == nil {
+		return ""
+	}
+	return *o.ProjectID
+}
+
+func (o *Parameters) GetClusterRoll() *ClusterRoll {
+	if o == nil {
+		return nil
+	}
+	return o.ClusterRoll
+}
+
+func (o *Progress) GetUnit() string {
+	if o == nil || o.Unit == nil {
+		return ""
+	}
+	return *o.Unit
+}
+
+func (o *Progress) GetValue() float64 {
+	if o == nil || o.Value == nil {
+		return 0
+	}
+	return *o.Value
+}
+
+func (o *ReadClusterInput) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *ReadClusterOutput) GetCluster() *Cluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *ReadLaunchSpecInput) GetLaunchSpecID() string {
+	if o == nil || o.LaunchSpecID == nil {
+		return ""
+	}
+	return *o.LaunchSpecID
+}
+
+func (o *ReadLaunchSpecOutput) GetLaunchSpec() *LaunchSpec {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpec
+}
+
+func (o *ResourceLimits) GetMaxInstanceCount() int {
+	if o == nil || o.MaxInstanceCount == nil {
+		return 0
+	}
+	return *o.MaxInstanceCount
+}
+
+func (o *ResourceLimits) GetMinInstanceCount() int {
+	if o == nil || o.MinInstanceCount == nil {
+		return 0
+	}
+	return *o.MinInstanceCount
+}
+
+func (o *RollSpec) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *RollSpec) GetComment() string {
+	if o == nil || o.Comment == nil {
+		return ""
+	}
+	return *o.Comment
+}
+
+func (o *RollSpec) GetBatchSizePercentage() int {
+	if o == nil || o.BatchSizePercentage == nil {
+		return 0
+	}
+	return *o.BatchSizePercentage
+}
+
+func (o *RollSpec) GetBatchMinHealthyPercentage() int {
+	if o == nil || o.BatchMinHealthyPercentage == nil {
+		return 0
+	}
+	return *o.BatchMinHealthyPercentage
+}
+
+func (o *RollSpec) GetLaunchSpecIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecIDs
+}
+
+func (o *RollSpec) GetInstanceNames() []string {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceNames
+}
+
+func (o *RollSpec) GetRespectPDB() bool {
+	if o == nil || o.RespectPDB == nil {
+		return false
+	}
+	return *o.RespectPDB
+}
+
+func (o *RollStatus) GetRollID() string {
+	if o == nil || o.RollID == nil {
+		return ""
+	}
+	return *o.RollID
+}
+
+func (o *RollStatus) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *RollStatus) GetComment() string {
+	if o == nil || o.Comment == nil {
+		return ""
+	}
+	return *o.Comment
+}
+
+func (o *RollStatus) GetStatus() string {
+	if o == nil || o.Status == nil {
+		return ""
+	}
+	return *o.Status
+}
+
+func (o *RollStatus) GetProgress() *Progress {
+	if o == nil {
+		return nil
+	}
+	return o.Progress
+}
+
+func (o *RollStatus) GetBatchNumber() int {
+	if o == nil || o.BatchNumber == nil {
+		return 0
+	}
+	return *o.BatchNumber
+}
+
+func (o *RollStatus) GetNumOfBatches() int {
+	if o == nil || o.NumOfBatches == nil {
+		return 0
+	}
+	return *o.NumOfBatches
+}
+
+func (o *RollStatus) GetLaunchSpecIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecIDs
+}
+
+func (o *RollStatus) GetInstanceNames() []string {
+	if o == nil {
+		return nil
+	}
+	return o.InstanceNames
+}
+
+func (o *RollStatus) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *RollStatus) GetUpdatedAt() time.Time {
+	if o == nil || o.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *o.UpdatedAt
+}
+
+func (o *Scheduling) GetShutdownHours() *ShutdownHours {
+	if o == nil {
+		return nil
+	}
+	return o.ShutdownHours
+}
+
+func (o *Scheduling) GetTasks() []*Task {
+	if o == nil {
+		return nil
+	}
+	return o.Tasks
+}
+
+func (o *ShieldedInstanceConfig) GetEnableSecureBoot() bool {
+	if o == nil || o.EnableSecureBoot == nil {
+		return false
+	}
+	return *o.EnableSecureBoot
+}
+
+func (o *ShieldedInstanceConfig) GetEnableIntegrityMonitoring() bool {
+	if o == nil || o.EnableIntegrityMonitoring == nil {
+		return false
+	}
+	return *o.EnableIntegrityMonitoring
+}
+
+func (o *ShutdownHours) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *ShutdownHours) GetTimeWindows() []string {
+	if o == nil {
+		return nil
+	}
+	return o.TimeWindows
+}
+
+func (o *Storage) GetLocalSSDCount() int {
+	if o == nil || o.LocalSSDCount == nil {
+		return 0
+	}
+	return *o.LocalSSDCount
+}
+
+func (o *Storage) GetLocalNvmeSsdCount() int {
+	if o == nil || o.LocalNvmeSsdCount == nil {
+		return 0
+	}
+	return *o.LocalNvmeSsdCount
+}
+
+func (o *Storage) GetLocalSsdEphemeralStorageCount() int {
+	if o == nil || o.LocalSsdEphemeralStorageCount == nil {
+		return 0
+	}
+	return *o.LocalSsdEphemeralStorageCount
+}
+
+func (o *Strategy) GetDrainingTimeout() int {
+	if o == nil || o.DrainingTimeout == nil {
+		return 0
+	}
+	return *o.DrainingTimeout
+}
+
+func (o *Strategy) GetProvisioningModel() string {
+	if o == nil || o.ProvisioningModel == nil {
+		return ""
+	}
+	return *o.ProvisioningModel
+}
+
+func (o *Strategy) GetPreemptiblePercentage() int {
+	if o == nil || o.PreemptiblePercentage == nil {
+		return 0
+	}
+	return *o.PreemptiblePercentage
+}
+
+func (o *Strategy) GetShouldUtilizeCommitments() bool {
+	if o == nil || o.ShouldUtilizeCommitments == nil {
+		return false
+	}
+	return *o.ShouldUtilizeCommitments
+}
+
+func (o *Strategy) GetScalingOrientation() string {
+	if o == nil || o.ScalingOrientation == nil {
+		return ""
+	}
+	return *o.ScalingOrientation
+}
+
+func (o *Tag) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *Tag) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *Taint) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *Taint) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *Taint) GetEffect() string {
+	if o == nil || o.Effect == nil {
+		return ""
+	}
+	return *o.Effect
+}
+
+func (o *Task) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *Task) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *Task) GetCronExpression() string {
+	if o == nil || o.CronExpression == nil {
+		return ""
+	}
+	return *o.CronExpression
+}
+
+func (o *Task) GetParameters() *Parameters {
+	if o == nil {
+		return nil
+	}
+	return o.Parameters
+}
+
+func (o *UpdateClusterInput) GetCluster() *Cluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *UpdateClusterOutput) GetCluster() *Cluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *UpdateLaunchSpecInput) GetLaunchSpec() *LaunchSpec {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpec
+}
+
+func (o *UpdateLaunchSpecOutput) GetLaunchSpec() *LaunchSpec {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpec
+}
@@ -0,0 +1,514 @@
+// Code generated by gettergen. DO NOT EDIT.
+
+package right_sizing
+
+func (o *AutoApplyDefinition) GetEnabled() bool {
+	if o == nil || o.Enabled == nil {
+		return false
+	}
+	return *o.Enabled
+}
+
+func (o *AutoApplyDefinition) GetLabels() map[string][]string {
+	if o == nil || o.Labels == nil {
+		return nil
+	}
+	return *o.Labels
+}
+
+func (o *AutoApplyDefinition) GetNamespaces() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Namespaces
+}
+
+func (o *Cpu) GetMin() float64 {
+	if o == nil || o.Min == nil {
+		return 0
+	}
+	return *o.Min
+}
+
+func (o *Cpu) GetMax() float64 {
+	if o == nil || o.Max == nil {
+		return 0
+	}
+	return *o.Max
+}
+
+func (o *CreateRightsizingRuleInput) GetRightsizingRule() *RightsizingRule {
+	if o == nil {
+		return nil
+	}
+	return o.RightsizingRule
+}
+
+func (o *CreateRightsizingRuleOutput) GetRightsizingRule() *RightsizingRule {
+	if o == nil {
+		return nil
+	}
+	return o.RightsizingRule
+}
+
+func (o *DeleteRightsizingRuleInput) GetRuleNames() []string {
+	if o == nil {
+		return nil
+	}
+	return o.RuleNames
+}
+
+func (o *DeleteRightsizingRuleInput) GetOceanId() string {
+	if o == nil || o.OceanId == nil {
+		return ""
+	}
+	return *o.OceanId
+}
+
+func (o *IntervalHours) GetStartTime() string {
+	if o == nil || o.StartTime == nil {
+		return ""
+	}
+	return *o.StartTime
+}
+
+func (o *IntervalHours) GetEndTime() string {
+	if o == nil || o.EndTime == nil {
+		return ""
+	}
+	return *o.EndTime
+}
+
+func (o *Label) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *Label) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *ListRightsizingRulesInput) GetOceanId() string {
+	if o == nil || o.OceanId == nil {
+		return ""
+	}
+	return *o.OceanId
+}
+
+func (o *ListRightsizingRulesOutput) GetRightsizingRules() []*RightsizingRule {
+	if o == nil {
+		return nil
+	}
+	return o.RightsizingRules
+}
+
+func (o *Memory) GetMin() int {
+	if o == nil || o.Min == nil {
+		return 0
+	}
+	return *o.Min
+}
+
+func (o *Memory) GetMax() int {
+	if o == nil || o.Max == nil {
+		return 0
+	}
+	return *o.Max
+}
+
+func (o *MonthlyRepetitionBasis) GetIntervalMonths() []int {
+	if o == nil {
+		return nil
+	}
+	return o.IntervalMonths
+}
+
+func (o *MonthlyRepetitionBasis) GetWeekOfTheMonth() []string {
+	if o == nil {
+		return nil
+	}
+	return o.WeekOfTheMonth
+}
+
+func (o *MonthlyRepetitionBasis) GetMonthlyWeeklyRepetitionBasis() *WeeklyRepetitionBasis {
+	if o == nil {
+		return nil
+	}
+	return o.WeeklyRepetitionBasis
+}
+
+func (o *Namespace) GetNamespaceName() string {
+	if o == nil || o.NamespaceName == nil {
+		return ""
+	}
+	return *o.NamespaceName
+}
+
+func (o *Namespace) GetWorkloads() []*Workload {
+	if o == nil {
+		return nil
+	}
+	return o.Workloads
+}
+
+func (o *Namespace) GetLabels() []*Label {
+	if o == nil {
+		return nil
+	}
+	return o.Labels
+}
+
+func (o *ReadRightsizingRuleAttachedWorkloadsInput) GetRuleName() string {
+	if o == nil || o.RuleName == nil {
+		return ""
+	}
+	return *o.RuleName
+}
+
+func (o *ReadRightsizingRuleAttachedWorkloadsInput) GetOceanId() string {
+	if o == nil || o.OceanId == nil {
+		return ""
+	}
+	return *o.OceanId
+}
+
+func (o *ReadRightsizingRuleAttachedWorkloadsOutput) GetRightsizingRuleAttachedWorkloads() *RightsizingRuleAttachedWorkloads {
+	if o == nil {
+		return nil
+	}
+	return o.RightsizingRuleAttachedWorkloads
+}
+
+func (o *ReadRightsizingRuleInput) GetRuleName() string {
+	if o == nil || o.RuleName == nil {
+		return ""
+	}
+	return *o.RuleName
+}
+
+func (o *ReadRightsizingRuleInput) GetOceanId() string {
+	if o == nil || o.OceanId == nil {
+		return ""
+	}
+	return *o.OceanId
+}
+
+func (o *ReadRightsizingRuleOutput) GetRightsizingRule() *RightsizingRule {
+	if o == nil {
+		return nil
+	}
+	return o.RightsizingRule
+}
+
+func (o *RecommendationApplicationBoundaries) GetCpu() *Cpu {
+	if o == nil {
+		return nil
+	}
+	return o.Cpu
+}
+
+func (o *RecommendationApplicationBoundaries) GetMemory() *Memory {
+	if o == nil {
+		return nil
+	}
+	return o.Memory
+}
+
+func (o *RecommendationApplicationHPA) GetAllowHPARecommendations() bool {
+	if o == nil || o.AllowHPARecommendations == nil {
+		return false
+	}
+	return *o.AllowHPARecommendations
+}
+
+func (o *RecommendationApplicationIntervals) GetRepetitionBasis() string {
+	if o == nil || o.RepetitionBasis == nil {
+		return ""
+	}
+	return *o.RepetitionBasis
+}
+
+func (o *RecommendationApplicationIntervals) GetWeeklyRepetitionBasis() *WeeklyRepetitionBasis {
+	if o == nil {
+		return nil
+	}
+	return o.WeeklyRepetitionBasis
+}
+
+func (o *RecommendationApplicationIntervals) GetMonthlyRepetitionBasis() *MonthlyRepetitionBasis {
+	if o == nil {
+		return nil
+	}
+	return o.MonthlyRepetitionBasis
+}
+
+func (o *RecommendationApplicationMinThreshold) GetCpuPercentage() float64 {
+	if o == nil || o.CpuPercentage == nil {
+		return 0
+	}
+	return *o.CpuPercentage
+}
+
+func (o *RecommendationApplicationMinThreshold) GetMemoryPercentage() float64 {
+	if o == nil || o.MemoryPercentage == nil {
+		return 0
+	}
+	return *o.MemoryPercentage
+}
+
+func (o *RecommendationApplicationOverheadValues) GetOverheadCpuPercentage() float64 {
+	if o == nil || o.CpuPercentage == nil {
+		return 0
+	}
+	return *o.CpuPercentage
+}
+
+func (o *RecommendationApplicationOverheadValues) GetOverheadMemoryPercentage() float64 {
+	if o == nil || o.MemoryPercentage == nil {
+		return 0
+	}
+	return *o.MemoryPercentage
+}
+
+func (o *RightSizingAttachDetachInput) GetRuleName() string {
+	if o == nil || o.RuleName == nil {
+		return ""
+	}
+	return *o.RuleName
+}
+
+func (o *RightSizingAttachDetachInput) GetOceanId() string {
+	if o == nil || o.OceanId == nil {
+		return ""
+	}
+	return *o.OceanId
+}
+
+func (o *RightSizingAttachDetachInput) GetNamespaces() []*Namespace {
+	if o == nil {
+		return nil
+	}
+	return o.Namespaces
+}
+
+func (o *RightsizingRule) GetRuleName() string {
+	if o == nil || o.RuleName == nil {
+		return ""
+	}
+	return *o.RuleName
+}
+
+func (o *RightsizingRule) GetOceanId() string {
+	if o == nil || o.OceanId == nil {
+		return ""
+	}
+	return *o.OceanId
+}
+
+func (o *RightsizingRule) GetRestartReplicas() string {
+	if o == nil || o.RestartReplicas == nil {
+		return ""
+	}
+	return *o.RestartReplicas
+}
+
+func (o *RightsizingRule) GetExcludePreliminaryRecommendations() bool {
+	if o == nil || o.ExcludePreliminaryRecommendations == nil {
+		return false
+	}
+	return *o.ExcludePreliminaryRecommendations
+}
+
+func (o *RightsizingRule) GetRecommendationApplicationIntervals() []*RecommendationApplicationIntervals {
+	if o == nil {
+		return nil
+	}
+	return o.RecommendationApplicationIntervals
+}
+
+func (o *RightsizingRule) GetRecommendationApplicationMinThreshold() *RecommendationApplicationMinThreshold {
+	if o == nil {
+		return nil
+	}
+	return o.RecommendationApplicationMinThreshold
+}
+
+func (o *RightsizingRule) GetRecommendationApplicationBoundaries() *RecommendationApplicationBoundaries {
+	if o == nil {
+		return nil
+	}
+	return o.RecommendationApplicationBoundaries
+}
+
+func (o *RightsizingRule) GetRecommendationApplicationOverheadValues() *RecommendationApplicationOverheadValues {
+	if o == nil {
+		return nil
+	}
+	return o.RecommendationApplicationOverheadValues
+}
+
+func (o *RightsizingRule) GetRecommendationApplicationHPA() *RecommendationApplicationHPA {
+	if o == nil {
+		return nil
+	}
+	return o.RecommendationApplicationHPA
+}
+
+func (o *RightsizingRule) GetAutoApplyDefinition() *AutoApplyDefinition {
+	if o == nil {
+		return nil
+	}
+	return o.AutoApplyDefinition
+}
+
+func (o *RightsizingRule) GetDownsideOnly() bool {
+	if o == nil || o.DownsideOnly == nil {
+		return false
+	}
+	return *o.DownsideOnly
+}
+
+func (o *RightsizingRuleAttachedWorkloads) GetRightsizingRuleWorkloads() []*RightsizingRuleWorkloads {
+	if o == nil {
+		return nil
+	}
+	return o.RightsizingRuleWorkloads
+}
+
+func (o *RightsizingRuleAttachedWorkloads) GetRightsizingRuleLabels() []*RightsizingRuleLabels {
+	if o == nil {
+		return nil
+	}
+	return o.RightsizingRuleLabels
+}
+
+func (o *RightsizingRuleAttachedWorkloads) GetRightsizingRuleRegex() []*RightsizingRuleRegex {
+	if o == nil {
+		return nil
+	}
+	return o.RightsizingRuleRegex
+}
+
+func (o *RightsizingRuleLabels) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *RightsizingRuleLabels) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *RightsizingRuleLabels) GetNamespace() string {
+	if o == nil || o.Namespace == nil {
+		return ""
+	}
+	return *o.Namespace
+}
+
+func (o *RightsizingRuleRegex) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *RightsizingRuleRegex) GetWorkloadType() string {
+	if o == nil || o.WorkloadType == nil {
+		return ""
+	}
+	return *o.WorkloadType
+}
+
+func (o *RightsizingRuleRegex) GetNamespace() string {
+	if o == nil || o.Namespace == nil {
+		return ""
+	}
+	return *o.Namespace
+}
+
+func (o *RightsizingRuleWorkloads) GetNamespace() string {
+	if o == nil || o.Namespace == nil {
+		return ""
+	}
+	return *o.Namespace
+}
+
+func (o *RightsizingRuleWorkloads) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *RightsizingRuleWorkloads) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *UpdateRightsizingRuleInput) GetRuleName() string {
+	if o == nil || o.RuleName == nil {
+		return ""
+	}
+	return *o.RuleName
+}
+
+func (o *UpdateRightsizingRuleInput) GetRightsizingRule() *RightsizingRule {
+	if o == nil {
+		return nil
+	}
+	return o.RightsizingRule
+}
+
+func (o *UpdateRightsizingRuleOutput) GetRightsizingRule() *RightsizingRule {
+	if o == nil {
+		return nil
+	}
+	return o.RightsizingRule
+}
+
+func (o *WeeklyRepetitionBasis) GetIntervalDays() []string {
+	if o == nil {
+		return nil
+	}
+	return o.IntervalDays
+}
+
+func (o *WeeklyRepetitionBasis) GetIntervalHours() *IntervalHours {
+	if o == nil {
+		return nil
+	}
+	return o.IntervalHours
+}
+
+func (o *Workload) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Workload) GetWorkloadType() string {
+	if o == nil || o.WorkloadType == nil {
+		return ""
+	}
+	return *o.WorkloadType
+}
+
+func (o *Workload) GetRegexName() string {
+	if o == nil || o.RegexName == nil {
+		return ""
+	}
+	return *o.RegexName
+}
@@ -0,0 +1,1589 @@
+// Code generated by gettergen. DO NOT EDIT.
+
+package oceancd
+
+import (
+	"time"
+)
+
+func (o *AdditionalIngressAnnotations) GetCanaryByHeader() string {
+	if o == nil || o.CanaryByHeader == nil {
+		return ""
+	}
+	return *o.CanaryByHeader
+}
+
+func (o *AdditionalIngressAnnotations) GetKey1() string {
+	if o == nil || o.Key1 == nil {
+		return ""
+	}
+	return *o.Key1
+}
+
+func (o *Alb) GetAnnotationPrefix() string {
+	if o == nil || o.AnnotationPrefix == nil {
+		return ""
+	}
+	return *o.AnnotationPrefix
+}
+
+func (o *Alb) GetIngress() string {
+	if o == nil || o.Ingress == nil {
+		return ""
+	}
+	return *o.Ingress
+}
+
+func (o *Alb) GetRootService() string {
+	if o == nil || o.RootService == nil {
+		return ""
+	}
+	return *o.RootService
+}
+
+func (o *Alb) GetServicePort() int {
+	if o == nil || o.ServicePort == nil {
+		return 0
+	}
+	return *o.ServicePort
+}
+
+func (o *Alb) GetStickinessConfig() *StickinessConfig {
+	if o == nil {
+		return nil
+	}
+	return o.StickinessConfig
+}
+
+func (o *Ambassador) GetMappings() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Mappings
+}
+
+func (o *Args) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Args) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *Args) GetValueFrom() *ValueFrom {
+	if o == nil {
+		return nil
+	}
+	return o.ValueFrom
+}
+
+func (o *BackgroundVerification) GetTemplateNames() []string {
+	if o == nil {
+		return nil
+	}
+	return o.TemplateNames
+}
+
+func (o *Baseline) GetMaxRange() int {
+	if o == nil || o.MaxRange == nil {
+		return 0
+	}
+	return *o.MaxRange
+}
+
+func (o *Baseline) GetMinRange() int {
+	if o == nil || o.MinRange == nil {
+		return 0
+	}
+	return *o.MinRange
+}
+
+func (o *Baseline) GetProvider() *Provider {
+	if o == nil {
+		return nil
+	}
+	return o.Provider
+}
+
+func (o *Baseline) GetThreshold() string {
+	if o == nil || o.Threshold == nil {
+		return ""
+	}
+	return *o.Threshold
+}
+
+func (o *Canary) GetBackgroundVerification() *BackgroundVerification {
+	if o == nil {
+		return nil
+	}
+	return o.BackgroundVerification
+}
+
+func (o *Canary) GetSteps() []*CanarySteps {
+	if o == nil {
+		return nil
+	}
+	return o.Steps
+}
+
+func (o *CanarySteps) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *CanarySteps) GetPause() *Pause {
+	if o == nil {
+		return nil
+	}
+	return o.Pause
+}
+
+func (o *CanarySteps) GetSetCanaryScale() *SetCanaryScale {
+	if o == nil {
+		return nil
+	}
+	return o.SetCanaryScale
+}
+
+func (o *CanarySteps) GetSetHeaderRoute() *SetHeaderRoute {
+	if o == nil {
+		return nil
+	}
+	return o.SetHeaderRoute
+}
+
+func (o *CanarySteps) GetSetWeight() int {
+	if o == nil || o.SetWeight == nil {
+		return 0
+	}
+	return *o.SetWeight
+}
+
+func (o *CanarySteps) GetVerification() *Verification {
+	if o == nil {
+		return nil
+	}
+	return o.Verification
+}
+
+func (o *CloudWatch) GetIAmArn() string {
+	if o == nil || o.IAmArn == nil {
+		return ""
+	}
+	return *o.IAmArn
+}
+
+func (o *CloudWatchProvider) GetDuration() string {
+	if o == nil || o.Duration == nil {
+		return ""
+	}
+	return *o.Duration
+}
+
+func (o *CloudWatchProvider) GetMetricDataQueries() []*MetricDataQueries {
+	if o == nil {
+		return nil
+	}
+	return o.MetricDataQueries
+}
+
+func (o *Containers) GetCommand() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Command
+}
+
+func (o *Containers) GetImage() string {
+	if o == nil || o.Image == nil {
+		return ""
+	}
+	return *o.Image
+}
+
+func (o *Containers) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *CreateRolloutSpecInput) GetRolloutSpec() *RolloutSpec {
+	if o == nil {
+		return nil
+	}
+	return o.RolloutSpec
+}
+
+func (o *CreateRolloutSpecOutput) GetRolloutSpec() *RolloutSpec {
+	if o == nil {
+		return nil
+	}
+	return o.RolloutSpec
+}
+
+func (o *CreateStrategyInput) GetStrategy() *Strategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategy
+}
+
+func (o *CreateStrategyOutput) GetStrategy() *Strategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategy
+}
+
+func (o *CreateVerificationProviderInput) GetVerificationProvider() *VerificationProvider {
+	if o == nil {
+		return nil
+	}
+	return o.VerificationProvider
+}
+
+func (o *CreateVerificationProviderOutput) GetVerificationProvider() *VerificationProvider {
+	if o == nil {
+		return nil
+	}
+	return o.VerificationProvider
+}
+
+func (o *CreateVerificationTemplateInput) GetVerificationTemplate() *VerificationTemplate {
+	if o == nil {
+		return nil
+	}
+	return o.VerificationTemplate
+}
+
+func (o *CreateVerificationTemplateOutput) GetVerificationTemplate() *VerificationTemplate {
+	if o == nil {
+		return nil
+	}
+	return o.VerificationTemplate
+}
+
+func (o *DataDog) GetAddress() string {
+	if o == nil || o.Address == nil {
+		return ""
+	}
+	return *o.Address
+}
+
+func (o *DataDog) GetApiKey() string {
+	if o == nil || o.ApiKey == nil {
+		return ""
+	}
+	return *o.ApiKey
+}
+
+func (o *DataDog) GetAppKey() string {
+	if o == nil || o.AppKey == nil {
+		return ""
+	}
+	return *o.AppKey
+}
+
+func (o *DataDogProvider) GetDuration() string {
+	if o == nil || o.Duration == nil {
+		return ""
+	}
+	return *o.Duration
+}
+
+func (o *DataDogProvider) GetQuery() string {
+	if o == nil || o.Query == nil {
+		return ""
+	}
+	return *o.Query
+}
+
+func (o *DeleteRolloutSpecInput) GetRolloutSpecName() string {
+	if o == nil || o.RolloutSpecName == nil {
+		return ""
+	}
+	return *o.RolloutSpecName
+}
+
+func (o *DeleteStrategyInput) GetStrategyName() string {
+	if o == nil || o.StrategyName == nil {
+		return ""
+	}
+	return *o.StrategyName
+}
+
+func (o *DeleteVerificationProviderInput) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *DeleteVerificationTemplateInput) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *DestinationRule) GetCanarySubsetName() string {
+	if o == nil || o.CanarySubsetName == nil {
+		return ""
+	}
+	return *o.CanarySubsetName
+}
+
+func (o *DestinationRule) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *DestinationRule) GetStableSubsetName() string {
+	if o == nil || o.StableSubsetName == nil {
+		return ""
+	}
+	return *o.StableSubsetName
+}
+
+func (o *Dimensions) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Dimensions) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *FailurePolicy) GetAction() string {
+	if o == nil || o.Action == nil {
+		return ""
+	}
+	return *o.Action
+}
+
+func (o *FieldRef) GetFieldPath() string {
+	if o == nil || o.FieldPath == nil {
+		return ""
+	}
+	return *o.FieldPath
+}
+
+func (o *HeaderValue) GetExact() string {
+	if o == nil || o.Exact == nil {
+		return ""
+	}
+	return *o.Exact
+}
+
+func (o *HeaderValue) GetPrefix() string {
+	if o == nil || o.Prefix == nil {
+		return ""
+	}
+	return *o.Prefix
+}
+
+func (o *HeaderValue) GetRegex() string {
+	if o == nil || o.Regex == nil {
+		return ""
+	}
+	return *o.Regex
+}
+
+func (o *Headers) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *Headers) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *Istio) GetDestinationRule() *DestinationRule {
+	if o == nil {
+		return nil
+	}
+	return o.DestinationRule
+}
+
+func (o *Istio) GetVirtualServices() []*VirtualServices {
+	if o == nil {
+		return nil
+	}
+	return o.VirtualServices
+}
+
+func (o *Jenkins) GetApiToken() string {
+	if o == nil || o.ApiToken == nil {
+		return ""
+	}
+	return *o.ApiToken
+}
+
+func (o *Jenkins) GetBaseUrl() string {
+	if o == nil || o.BaseUrl == nil {
+		return ""
+	}
+	return *o.BaseUrl
+}
+
+func (o *Jenkins) GetUserName() string {
+	if o == nil || o.UserName == nil {
+		return ""
+	}
+	return *o.UserName
+}
+
+func (o *JenkinsProvider) GetInterval() string {
+	if o == nil || o.Interval == nil {
+		return ""
+	}
+	return *o.Interval
+}
+
+func (o *JenkinsProvider) GetPipelineName() string {
+	if o == nil || o.PipelineName == nil {
+		return ""
+	}
+	return *o.PipelineName
+}
+
+func (o *JenkinsProvider) GetParameters() []*Parameters {
+	if o == nil {
+		return nil
+	}
+	return o.Parameters
+}
+
+func (o *JenkinsProvider) GetTimeout() string {
+	if o == nil || o.Timeout == nil {
+		return ""
+	}
+	return *o.Timeout
+}
+
+func (o *JenkinsProvider) GetTLSVerification() bool {
+	if o == nil || o.TLSVerification == nil {
+		return false
+	}
+	return *o.TLSVerification
+}
+
+func (o *Job) GetSpec() *Spec {
+	if o == nil {
+		return nil
+	}
+	return o.Spec
+}
+
+func (o *ListRolloutSpecsOutput) GetRolloutSpecs() []*RolloutSpec {
+	if o == nil {
+		return nil
+	}
+	return o.RolloutSpecs
+}
+
+func (o *ListStrategiesOutput) GetStrategies() []*Strategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategies
+}
+
+func (o *ListVerificationProvidersOutput) GetVerificationProviders() []*VerificationProvider {
+	if o == nil {
+		return nil
+	}
+	return o.VerificationProviders
+}
+
+func (o *ListVerificationTemplatesOutput) GetVerificationTemplate() []*VerificationTemplate {
+	if o == nil {
+		return nil
+	}
+	return o.VerificationTemplate
+}
+
+func (o *Match) GetHeaderName() string {
+	if o == nil || o.HeaderName == nil {
+		return ""
+	}
+	return *o.HeaderName
+}
+
+func (o *Match) GetHeaderValue() *HeaderValue {
+	if o == nil {
+		return nil
+	}
+	return o.HeaderValue
+}
+
+func (o *Metric) GetDimensions() []*Dimensions {
+	if o == nil {
+		return nil
+	}
+	return o.Dimensions
+}
+
+func (o *Metric) GetMetricName() string {
+	if o == nil || o.MetricName == nil {
+		return ""
+	}
+	return *o.MetricName
+}
+
+func (o *Metric) GetNamespace() string {
+	if o == nil || o.Namespace == nil {
+		return ""
+	}
+	return *o.Namespace
+}
+
+func (o *MetricDataQueries) GetExpression() string {
+	if o == nil || o.Expression == nil {
+		return ""
+	}
+	return *o.Expression
+}
+
+func (o *MetricDataQueries) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *MetricDataQueries) GetLabel() string {
+	if o == nil || o.Label == nil {
+		return ""
+	}
+	return *o.Label
+}
+
+func (o *MetricDataQueries) GetMetricStat() *MetricStat {
+	if o == nil {
+		return nil
+	}
+	return o.MetricStat
+}
+
+func (o *MetricDataQueries) GetPeriod() int {
+	if o == nil || o.Period == nil {
+		return 0
+	}
+	return *o.Period
+}
+
+func (o *MetricDataQueries) GetReturnData() bool {
+	if o == nil || o.ReturnData == nil {
+		return false
+	}
+	return *o.ReturnData
+}
+
+func (o *MetricStat) GetMetric() *Metric {
+	if o == nil {
+		return nil
+	}
+	return o.Metric
+}
+
+func (o *MetricStat) GetPeriod() int {
+	if o == nil || o.Period == nil {
+		return 0
+	}
+	return *o.Period
+}
+
+func (o *MetricStat) GetStat() string {
+	if o == nil || o.Stat == nil {
+		return ""
+	}
+	return *o.Stat
+}
+
+func (o *MetricStat) GetUnit() string {
+	if o == nil || o.Unit == nil {
+		return ""
+	}
+	return *o.Unit
+}
+
+func (o *Metrics) GetBaseLine() *Baseline {
+	if o == nil {
+		return nil
+	}
+	return o.Baseline
+}
+
+func (o *Metrics) GetConsecutiveErrorLimit() int {
+	if o == nil || o.ConsecutiveErrorLimit == nil {
+		return 0
+	}
+	return *o.ConsecutiveErrorLimit
+}
+
+func (o *Metrics) GetCount() int {
+	if o == nil || o.Count == nil {
+		return 0
+	}
+	return *o.Count
+}
+
+func (o *Metrics) GetDryRun() bool {
+	if o == nil || o.DryRun == nil {
+		return false
+	}
+	return *o.DryRun
+}
+
+func (o *Metrics) GetFailureCondition() string {
+	if o == nil || o.FailureCondition == nil {
+		return ""
+	}
+	return *o.FailureCondition
+}
+
+func (o *Metrics) GetFailureLimit() int {
+	if o == nil || o.FailureLimit == nil {
+		return 0
+	}
+	return *o.FailureLimit
+}
+
+func (o *Metrics) GetInitialDelay() string {
+	if o == nil || o.InitialDelay == nil {
+		return ""
+	}
+	return *o.InitialDelay
+}
+
+func (o *Metrics) GetInterval() string {
+	if o == nil || o.Interval == nil {
+		return ""
+	}
+	return *o.Interval
+}
+
+func (o *Metrics) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Metrics) GetProvider() *Provider {
+	if o == nil {
+		return nil
+	}
+	return o.Provider
+}
+
+func (o *Metrics) GetSuccessCondition() string {
+	if o == nil || o.SuccessCondition == nil {
+		return ""
+	}
+	return *o.SuccessCondition
+}
+
+func (o *NewRelic) GetAccountId() string {
+	if o == nil || o.AccountId == nil {
+		return ""
+	}
+	return *o.AccountId
+}
+
+func (o *NewRelic) GetBaseUrlNerdGraph() string {
+	if o == nil || o.BaseUrlNerdGraph == nil {
+		return ""
+	}
+	return *o.BaseUrlNerdGraph
+}
+
+func (o *NewRelic) GetBaseUrlRest() string {
+	if o == nil || o.BaseUrlRest == nil {
+		return ""
+	}
+	return *o.BaseUrlRest
+}
+
+func (o *NewRelic) GetPersonalApiKey() string {
+	if o == nil || o.PersonalApiKey == nil {
+		return ""
+	}
+	return *o.PersonalApiKey
+}
+
+func (o *NewRelic) GetRegion() string {
+	if o == nil || o.Region == nil {
+		return ""
+	}
+	return *o.Region
+}
+
+func (o *NewRelicProvider) GetProfile() string {
+	if o == nil || o.Profile == nil {
+		return ""
+	}
+	return *o.Profile
+}
+
+func (o *NewRelicProvider) GetQuery() string {
+	if o == nil || o.Query == nil {
+		return ""
+	}
+	return *o.Query
+}
+
+func (o *Nginx) GetAdditionalIngressAnnotation() *AdditionalIngressAnnotations {
+	if o == nil {
+		return nil
+	}
+	return o.AdditionalIngressAnnotations
+}
+
+func (o *Nginx) GetAnnotationPreffix() string {
+	if o == nil || o.AnnotationPrefix == nil {
+		return ""
+	}
+	return *o.AnnotationPrefix
+}
+
+func (o *Nginx) GetStableIngress() string {
+	if o == nil || o.StableIngress == nil {
+		return ""
+	}
+	return *o.StableIngress
+}
+
+func (o *Parameters) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *Parameters) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *PatchRolloutSpecInput) GetRolloutSpec() *RolloutSpec {
+	if o == nil {
+		return nil
+	}
+	return o.RolloutSpec
+}
+
+func (o *PatchRolloutSpecOutput) GetRolloutSpec() *RolloutSpec {
+	if o == nil {
+		return nil
+	}
+	return o.RolloutSpec
+}
+
+func (o *PatchStrategyInput) GetStrategy() *Strategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategy
+}
+
+func (o *PatchStrategyOutput) GetStrategy() *Strategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategy
+}
+
+func (o *PatchVerificationProviderInput) GetVerificationProvider() *VerificationProvider {
+	if o == nil {
+		return nil
+	}
+	return o.VerificationProvider
+}
+
+func (o *PatchVerificationProviderOutput) GetVerificationProvider() *VerificationProvider {
+	if o == nil {
+		return nil
+	}
+	return o.VerificationProvider
+}
+
+func (o *PatchVerificationTemplateInput) GetVerificationTemplate() *VerificationTemplate {
+	if o == nil {
+		return nil
+	}
+	return o.VerificationTemplate
+}
+
+func (o *PatchVerificationTemplateOutput) GetVerificationTemplate() *VerificationTemplate {
+	if o == nil {
+		return nil
+	}
+	return o.VerificationTemplate
+}
+
+func (o *Pause) GetDuration() string {
+	if o == nil || o.Duration == nil {
+		return ""
+	}
+	return *o.Duration
+}
+
+func (o *PingPong) GetPingService() string {
+	if o == nil || o.PingService == nil {
+		return ""
+	}
+	return *o.PingService
+}
+
+func (o *PingPong) GetPongService() string {
+	if o == nil || o.PongService == nil {
+		return ""
+	}
+	return *o.PongService
+}
+
+func (o *Prometheus) GetAddress() string {
+	if o == nil || o.Address == nil {
+		return ""
+	}
+	return *o.Address
+}
+
+func (o *PrometheusProvider) GetQuery() string {
+	if o == nil || o.Query == nil {
+		return ""
+	}
+	return *o.Query
+}
+
+func (o *Provider) GetCloudWatch() *CloudWatchProvider {
+	if o == nil {
+		return nil
+	}
+	return o.CloudWatch
+}
+
+func (o *Provider) GetDataDog() *DataDogProvider {
+	if o == nil {
+		return nil
+	}
+	return o.Datadog
+}
+
+func (o *Provider) GetJenkins() *JenkinsProvider {
+	if o == nil {
+		return nil
+	}
+	return o.Jenkins
+}
+
+func (o *Provider) GetNewRelic() *NewRelicProvider {
+	if o == nil {
+		return nil
+	}
+	return o.NewRelic
+}
+
+func (o *Provider) GetPrometheus() *PrometheusProvider {
+	if o == nil {
+		return nil
+	}
+	return o.Prometheus
+}
+
+func (o *Provider) GetJob() *Job {
+	if o == nil {
+		return nil
+	}
+	return o.Job
+}
+
+func (o *Provider) GetWeb() *Web {
+	if o == nil {
+		return nil
+	}
+	return o.Web
+}
+
+func (o *ReadRolloutSpecInput) GetRolloutSpecName() string {
+	if o == nil || o.RolloutSpecName == nil {
+		return ""
+	}
+	return *o.RolloutSpecName
+}
+
+func (o *ReadRolloutSpecOutput) GetRolloutSpec() *RolloutSpec {
+	if o == nil {
+		return nil
+	}
+	return o.RolloutSpec
+}
+
+func (o *ReadStrategyInput) GetStrategyName() string {
+	if o == nil || o.StrategyName == nil {
+		return ""
+	}
+	return *o.StrategyName
+}
+
+func (o *ReadStrategyOutput) GetStrategy() *Strategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategy
+}
+
+func (o *ReadVerificationProviderInput) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *ReadVerificationProviderOutput) GetVerificationProvider() *VerificationProvider {
+	if o == nil {
+		return nil
+	}
+	return o.VerificationProvider
+}
+
+func (o *ReadVerificationTemplateInput) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *ReadVerificationTemplateOutput) GetVerificationTemplate() *VerificationTemplate {
+	if o == nil {
+		return nil
+	}
+	return o.VerificationTemplate
+}
+
+func (o *Rolling) GetSteps() []*RollingSteps {
+	if o == nil {
+		return nil
+	}
+	return o.Steps
+}
+
+func (o *RollingSteps) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *RollingSteps) GetPause() *Pause {
+	if o == nil {
+		return nil
+	}
+	return o.Pause
+}
+
+func (o *RollingSteps) GetVerification() *Verification {
+	if o == nil {
+		return nil
+	}
+	return o.Verification
+}
+
+func (o *RolloutSpec) GetFailurePolicy() *FailurePolicy {
+	if o == nil {
+		return nil
+	}
+	return o.FailurePolicy
+}
+
+func (o *RolloutSpec) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *RolloutSpec) GetSpotDeployment() *SpotDeployment {
+	if o == nil {
+		return nil
+	}
+	return o.SpotDeployment
+}
+
+func (o *RolloutSpec) GetSpotDeployments() []*SpotDeployment {
+	if o == nil {
+		return nil
+	}
+	return o.SpotDeployments
+}
+
+func (o *RolloutSpec) GetStrategy() *RolloutSpecStrategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategy
+}
+
+func (o *RolloutSpec) GetTraffic() *Traffic {
+	if o == nil {
+		return nil
+	}
+	return o.Traffic
+}
+
+func (o *RolloutSpec) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *RolloutSpec) GetUpdatedAt() time.Time {
+	if o == nil || o.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *o.UpdatedAt
+}
+
+func (o *RolloutSpecArgs) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *RolloutSpecArgs) GetValue() string {
+	if o == nil || o.Value == nil {
+		return ""
+	}
+	return *o.Value
+}
+
+func (o *RolloutSpecArgs) GetValueFrom() *RolloutSpecValueFrom {
+	if o == nil {
+		return nil
+	}
+	return o.ValueFrom
+}
+
+func (o *RolloutSpecStrategy) GetArgs() []*RolloutSpecArgs {
+	if o == nil {
+		return nil
+	}
+	return o.Args
+}
+
+func (o *RolloutSpecStrategy) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *RolloutSpecValueFrom) GetFieldRef() *FieldRef {
+	if o == nil {
+		return nil
+	}
+	return o.FieldRef
+}
+
+func (o *SecretKeyRef) GetKey() string {
+	if o == nil || o.Key == nil {
+		return ""
+	}
+	return *o.Key
+}
+
+func (o *SecretKeyRef) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *SetCanaryScale) GetMatchTrafficWeight() bool {
+	if o == nil || o.MatchTrafficWeight == nil {
+		return false
+	}
+	return *o.MatchTrafficWeight
+}
+
+func (o *SetCanaryScale) GetReplicas() int {
+	if o == nil || o.Replicas == nil {
+		return 0
+	}
+	return *o.Replicas
+}
+
+func (o *SetCanaryScale) GetWeight() int {
+	if o == nil || o.Weight == nil {
+		return 0
+	}
+	return *o.Weight
+}
+
+func (o *SetHeaderRoute) GetMatch() []*Match {
+	if o == nil {
+		return nil
+	}
+	return o.Match
+}
+
+func (o *SetHeaderRoute) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Smi) GetRootService() string {
+	if o == nil || o.RootService == nil {
+		return ""
+	}
+	return *o.RootService
+}
+
+func (o *Smi) GetTrafficSplitName() string {
+	if o == nil || o.TrafficSplitName == nil {
+		return ""
+	}
+	return *o.TrafficSplitName
+}
+
+func (o *Spec) GetBackoffLimit() int {
+	if o == nil || o.BackoffLimit == nil {
+		return 0
+	}
+	return *o.BackoffLimit
+}
+
+func (o *Spec) GetTemplate() *Template {
+	if o == nil {
+		return nil
+	}
+	return o.Template
+}
+
+func (o *SpotDeployment) GetClusterId() string {
+	if o == nil || o.ClusterId == nil {
+		return ""
+	}
+	return *o.ClusterId
+}
+
+func (o *SpotDeployment) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *SpotDeployment) GetNamespace() string {
+	if o == nil || o.Namespace == nil {
+		return ""
+	}
+	return *o.Namespace
+}
+
+func (o *StickinessConfig) GetDurationSeconds() int {
+	if o == nil || o.DurationSeconds == nil {
+		return 0
+	}
+	return *o.DurationSeconds
+}
+
+func (o *StickinessConfig) GetEnabled() bool {
+	if o == nil || o.Enabled == nil {
+		return false
+	}
+	return *o.Enabled
+}
+
+func (o *Strategy) GetCanary() *Canary {
+	if o == nil {
+		return nil
+	}
+	return o.Canary
+}
+
+func (o *Strategy) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Strategy) GetRolling() *Rolling {
+	if o == nil {
+		return nil
+	}
+	return o.Rolling
+}
+
+func (o *Strategy) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *Strategy) GetUpdatedAt() time.Time {
+	if o == nil || o.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *o.UpdatedAt
+}
+
+func (o *Template) GetSpec() *TemplateSpec {
+	if o == nil {
+		return nil
+	}
+	return o.Spec
+}
+
+func (o *TemplateSpec) GetRestartPolicy() string {
+	if o == nil || o.RestartPolicy == nil {
+		return ""
+	}
+	return *o.RestartPolicy
+}
+
+func (o *TemplateSpec) GetContainers() []*Containers {
+	if o == nil {
+		return nil
+	}
+	return o.Containers
+}
+
+func (o *TlsRoutes) GetPort() int {
+	if o == nil || o.Port == nil {
+		return 0
+	}
+	return *o.Port
+}
+
+func (o *TlsRoutes) GetSniHosts() []string {
+	if o == nil {
+		return nil
+	}
+	return o.SniHosts
+}
+
+func (o *Traffic) GetAlb() *Alb {
+	if o == nil {
+		return nil
+	}
+	return o.Alb
+}
+
+func (o *Traffic) GetAmbassador() *Ambassador {
+	if o == nil {
+		return nil
+	}
+	return o.Ambassador
+}
+
+func (o *Traffic) GetCanaryService() string {
+	if o == nil || o.CanaryService == nil {
+		return ""
+	}
+	return *o.CanaryService
+}
+
+func (o *Traffic) GetIstio() *Istio {
+	if o == nil {
+		return nil
+	}
+	return o.Istio
+}
+
+func (o *Traffic) GetNginx() *Nginx {
+	if o == nil {
+		return nil
+	}
+	return o.Nginx
+}
+
+func (o *Traffic) GetPingPong() *PingPong {
+	if o == nil {
+		return nil
+	}
+	return o.PingPong
+}
+
+func (o *Traffic) GetSmi() *Smi {
+	if o == nil {
+		return nil
+	}
+	return o.Smi
+}
+
+func (o *Traffic) GetStableService() string {
+	if o == nil || o.StableService == nil {
+		return ""
+	}
+	return *o.StableService
+}
+
+func (o *UpdateRolloutSpecInput) GetRolloutSpec() *RolloutSpec {
+	if o == nil {
+		return nil
+	}
+	return o.RolloutSpec
+}
+
+func (o *UpdateRolloutSpecOutput) GetRolloutSpec() *RolloutSpec {
+	if o == nil {
+		return nil
+	}
+	return o.RolloutSpec
+}
+
+func (o *UpdateStrategyInput) GetStrategy() *Strategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategy
+}
+
+func (o *UpdateStrategyOutput) GetStrategy() *Strategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategy
+}
+
+func (o *UpdateVerificationProviderInput) GetVerificationProvider() *VerificationProvider {
+	if o == nil {
+		return nil
+	}
+	return o.VerificationProvider
+}
+
+func (o *UpdateVerificationProviderOutput) GetVerificationProvider() *VerificationProvider {
+	if o == nil {
+		return nil
+	}
+	return o.VerificationProvider
+}
+
+func (o *UpdateVerificationTemplateInput) GetVerificationTemplate() *VerificationTemplate {
+	if o == nil {
+		return nil
+	}
+	return o.VerificationTemplate
+}
+
+func (o *UpdateVerificationTemplateOutput) GetVerificationTemplate() *VerificationTemplate {
+	if o == nil {
+		return nil
+	}
+	return o.VerificationTemplate
+}
+
+func (o *ValueFrom) GetSecretKeyRef() *SecretKeyRef {
+	if o == nil {
+		return nil
+	}
+	return o.SecretKeyRef
+}
+
+func (o *Verification) GetTemplateNames() []string {
+	if o == nil {
+		return nil
+	}
+	return o.TemplateNames
+}
+
+func (o *VerificationProvider) GetCloudWatch() *CloudWatch {
+	if o == nil {
+		return nil
+	}
+	return o.CloudWatch
+}
+
+func (o *VerificationProvider) GetClusterIDs() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ClusterIDs
+}
+
+func (o *VerificationProvider) GetDataDog() *DataDog {
+	if o == nil {
+		return nil
+	}
+	return o.DataDog
+}
+
+func (o *VerificationProvider) GetJenkins() *Jenkins {
+	if o == nil {
+		return nil
+	}
+	return o.Jenkins
+}
+
+func (o *VerificationProvider) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *VerificationProvider) GetNewRelic() *NewRelic {
+	if o == nil {
+		return nil
+	}
+	return o.NewRelic
+}
+
+func (o *VerificationProvider) GetPrometheus() *Prometheus {
+	if o == nil {
+		return nil
+	}
+	return o.Prometheus
+}
+
+func (o *VerificationProvider) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *VerificationProvider) GetUpdatedAt() time.Time {
+	if o == nil || o.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *o.UpdatedAt
+}
+
+func (o *VerificationTemplate) GetArgs() []*Args {
+	if o == nil {
+		return nil
+	}
+	return o.Args
+}
+
+func (o *VerificationTemplate) GetMetrics() []*Metrics {
+	if o == nil {
+		return nil
+	}
+	return o.Metrics
+}
+
+func (o *VerificationTemplate) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *VerificationTemplate) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *VerificationTemplate) GetUpdatedAt() time.Time {
+	if o == nil || o.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *o.UpdatedAt
+}
+
+func (o *VirtualServices) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *VirtualServices) GetRoutes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Routes
+}
+
+func (o *VirtualServices) GetTlsRoutes() []*TlsRoutes {
+	if o == nil {
+		return nil
+	}
+	return o.TlsRoutes
+}
+
+func (o *Web) GetBody() string {
+	if o == nil || o.Body == nil {
+		return ""
+	}
+	return *o.Body
+}
+
+func (o *Web) GetInsecure() bool {
+	if o == nil || o.Insecure == nil {
+		return false
+	}
+	return *o.Insecure
+}
+
+func (o *Web) GetHeaders() []*Headers {
+	if o == nil {
+		return nil
+	}
+	return o.Headers
+}
+
+func (o *Web) GetJsonPath() string {
+	if o == nil || o.JsonPath == nil {
+		return ""
+	}
+	return *o.JsonPath
+}
+
+func (o *Web) GetMethod() string {
+	if o == nil || o.Method == nil {
+		return ""
+	}
+	return *o.Method
+}
+
+func (o *Web) GetTimeoutSeconds() int {
+	if o == nil || o.TimeoutSeconds == nil {
+		return 0
+	}
+	return *o.TimeoutSeconds
+}
+
+func (o *Web) GetUrl() string {
+	if o == nil || o.Url == nil {
+		return ""
+	}
+	return *o.Url
+}
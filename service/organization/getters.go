@@ -0,0 +1,654 @@
+// Code generated by gettergen. DO NOT EDIT.
+
+package organization
+
+func (o *Account) GetAccountId() string {
+	if o == nil || o.Id == nil {
+		return ""
+	}
+	return *o.Id
+}
+
+func (o *Account) GetRole() string {
+	if o == nil || o.Role == nil {
+		return ""
+	}
+	return *o.Role
+}
+
+func (o *CreatePolicyInput) GetPolicy() *Policy {
+	if o == nil {
+		return nil
+	}
+	return o.Policy
+}
+
+func (o *CreatePolicyOutput) GetPolicy() *Policy {
+	if o == nil {
+		return nil
+	}
+	return o.Policy
+}
+
+func (o *CreateProgrammaticUserOutput) GetProgrammaticUser() *ProgrammaticUser {
+	if o == nil {
+		return nil
+	}
+	return o.ProgrammaticUser
+}
+
+func (o *CreateUserGroupOutput) GetUserGroup() *UserGroup {
+	if o == nil {
+		return nil
+	}
+	return o.UserGroup
+}
+
+func (o *CreateUserOutput) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+	return o.User
+}
+
+func (o *DeletePolicyInput) GetPolicyID() string {
+	if o == nil || o.PolicyID == nil {
+		return ""
+	}
+	return *o.PolicyID
+}
+
+func (o *DeleteUserGroupInput) GetUserGroupID() string {
+	if o == nil || o.UserGroupID == nil {
+		return ""
+	}
+	return *o.UserGroupID
+}
+
+func (o *DeleteUserInput) GetUserID() string {
+	if o == nil || o.UserID == nil {
+		return ""
+	}
+	return *o.UserID
+}
+
+func (o *GetGroupUser) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *GetGroupUser) GetUserId() string {
+	if o == nil || o.UserId == nil {
+		return ""
+	}
+	return *o.UserId
+}
+
+func (o *GetGroupUser) GetUserName() string {
+	if o == nil || o.UserName == nil {
+		return ""
+	}
+	return *o.UserName
+}
+
+func (o *Group) GetId() string {
+	if o == nil || o.Id == nil {
+		return ""
+	}
+	return *o.Id
+}
+
+func (o *Group) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Group) GetPolicyNames() []*string {
+	if o == nil {
+		return nil
+	}
+	return o.PolicyNames
+}
+
+func (o *ListPoliciesOutput) GetPolicies() []*Policy {
+	if o == nil {
+		return nil
+	}
+	return o.Policies
+}
+
+func (o *ListUserGroupsOutput) GetUserGroups() []*UserGroup {
+	if o == nil {
+		return nil
+	}
+	return o.UserGroups
+}
+
+func (o *ListUsersOutput) GetUsers() []*User {
+	if o == nil {
+		return nil
+	}
+	return o.Users
+}
+
+func (o *Policy) GetDescription() string {
+	if o == nil || o.Description == nil {
+		return ""
+	}
+	return *o.Description
+}
+
+func (o *Policy) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Policy) GetPolicyContent() *PolicyContent {
+	if o == nil {
+		return nil
+	}
+	return o.PolicyContent
+}
+
+func (o *Policy) GetPolicyID() string {
+	if o == nil || o.PolicyID == nil {
+		return ""
+	}
+	return *o.PolicyID
+}
+
+func (o *PolicyContent) GetStatements() []*Statement {
+	if o == nil {
+		return nil
+	}
+	return o.Statements
+}
+
+func (o *ProgPolicy) GetPolicyId() string {
+	if o == nil || o.PolicyId == nil {
+		return ""
+	}
+	return *o.PolicyId
+}
+
+func (o *ProgPolicy) GetAccountIds() []string {
+	if o == nil {
+		return nil
+	}
+	return o.AccountIds
+}
+
+func (o *ProgrammaticUser) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *ProgrammaticUser) GetDescription() string {
+	if o == nil || o.Description == nil {
+		return ""
+	}
+	return *o.Description
+}
+
+func (o *ProgrammaticUser) GetPolicies() []*ProgPolicy {
+	if o == nil {
+		return nil
+	}
+	return o.Policies
+}
+
+func (o *ProgrammaticUser) GetAccounts() []*Account {
+	if o == nil {
+		return nil
+	}
+	return o.Accounts
+}
+
+func (o *ProgrammaticUser) GetToken() string {
+	if o == nil || o.Token == nil {
+		return ""
+	}
+	return *o.Token
+}
+
+func (o *ProgrammaticUser) GetProgUserId() string {
+	if o == nil || o.ProgUserId == nil {
+		return ""
+	}
+	return *o.ProgUserId
+}
+
+func (o *ProgrammaticUser) GetProgUserGroupIds() []string {
+	if o == nil {
+		return nil
+	}
+	return o.UserGroupIds
+}
+
+func (o *ReadPolicyInput) GetPolicyID() string {
+	if o == nil || o.PolicyID == nil {
+		return ""
+	}
+	return *o.PolicyID
+}
+
+func (o *ReadPolicyOutput) GetPolicy() *Policy {
+	if o == nil {
+		return nil
+	}
+	return o.Policy
+}
+
+func (o *ReadProgUserOutput) GetProgUser() *ProgrammaticUser {
+	if o == nil {
+		return nil
+	}
+	return o.ProgUser
+}
+
+func (o *ReadUserGroupInput) GetUserGroupID() string {
+	if o == nil || o.UserGroupID == nil {
+		return ""
+	}
+	return *o.UserGroupID
+}
+
+func (o *ReadUserGroupOutput) GetUserGroup() *UserGroup {
+	if o == nil {
+		return nil
+	}
+	return o.UserGroup
+}
+
+func (o *ReadUserInput) GetUserID() string {
+	if o == nil || o.UserID == nil {
+		return ""
+	}
+	return *o.UserID
+}
+
+func (o *ReadUserOutput) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+	return o.User
+}
+
+func (o *Statement) GetActions() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Actions
+}
+
+func (o *Statement) GetEffect() string {
+	if o == nil || o.Effect == nil {
+		return ""
+	}
+	return *o.Effect
+}
+
+func (o *Statement) GetResources() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Resources
+}
+
+func (o *Token) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Token) GetCreatedAt() string {
+	if o == nil || o.CreatedAt == nil {
+		return ""
+	}
+	return *o.CreatedAt
+}
+
+func (o *Token) GetTokenId() int {
+	if o == nil || o.TokenId == nil {
+		return 0
+	}
+	return *o.TokenId
+}
+
+func (o *Token) GetTokenLastDigits() string {
+	if o == nil || o.TokenLastDigits == nil {
+		return ""
+	}
+	return *o.TokenLastDigits
+}
+
+func (o *UpdatePolicyInput) GetPolicy() *Policy {
+	if o == nil {
+		return nil
+	}
+	return o.Policy
+}
+
+func (o *UpdatePolicyMappingOfUserGroupInput) GetUserGroupId() string {
+	if o == nil || o.UserGroupId == nil {
+		return ""
+	}
+	return *o.UserGroupId
+}
+
+func (o *UpdatePolicyMappingOfUserGroupInput) GetPolicies() []*UserPolicy {
+	if o == nil {
+		return nil
+	}
+	return o.Policies
+}
+
+func (o *UpdatePolicyMappingOfUserInput) GetUserID() string {
+	if o == nil || o.UserID == nil {
+		return ""
+	}
+	return *o.UserID
+}
+
+func (o *UpdatePolicyMappingOfUserInput) GetPolicies() []*UserPolicy {
+	if o == nil {
+		return nil
+	}
+	return o.Policies
+}
+
+func (o *UpdatePolicyOutput) GetPolicy() *Policy {
+	if o == nil {
+		return nil
+	}
+	return o.Policy
+}
+
+func (o *UpdateUserGroupInput) GetUserGroupID() string {
+	if o == nil || o.UserGroupID == nil {
+		return ""
+	}
+	return *o.UserGroupID
+}
+
+func (o *UpdateUserGroupMappingOfUserInput) GetUserID() string {
+	if o == nil || o.UserID == nil {
+		return ""
+	}
+	return *o.UserID
+}
+
+func (o *UpdateUserGroupMappingOfUserInput) GetUserGroupIds() []string {
+	if o == nil {
+		return nil
+	}
+	return o.UserGroupIds
+}
+
+func (o *UpdateUserMappingOfUserGroupInput) GetUserGroupId() string {
+	if o == nil || o.UserGroupId == nil {
+		return ""
+	}
+	return *o.UserGroupId
+}
+
+func (o *UpdateUserMappingOfUserGroupInput) GetUserIds() []string {
+	if o == nil {
+		return nil
+	}
+	return o.UserIds
+}
+
+func (o *User) GetEmail() string {
+	if o == nil || o.Email == nil {
+		return ""
+	}
+	return *o.Email
+}
+
+func (o *User) GetFirstName() string {
+	if o == nil || o.FirstName == nil {
+		return ""
+	}
+	return *o.FirstName
+}
+
+func (o *User) GetLastName() string {
+	if o == nil || o.LastName == nil {
+		return ""
+	}
+	return *o.LastName
+}
+
+func (o *User) GetPassword() string {
+	if o == nil || o.Password == nil {
+		return ""
+	}
+	return *o.Password
+}
+
+func (o *User) GetRole() string {
+	if o == nil || o.Role == nil {
+		return ""
+	}
+	return *o.Role
+}
+
+func (o *User) GetUserID() string {
+	if o == nil || o.UserID == nil {
+		return ""
+	}
+	return *o.UserID
+}
+
+func (o *User) GetUsername() string {
+	if o == nil || o.Username == nil {
+		return ""
+	}
+	return *o.Username
+}
+
+func (o *User) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *User) GetMfa() bool {
+	if o == nil || o.Mfa == nil {
+		return false
+	}
+	return *o.Mfa
+}
+
+func (o *User) GetUserPolicies() []*UserPolicy {
+	if o == nil {
+		return nil
+	}
+	return o.Policies
+}
+
+func (o *User) GetTokens() []*Token {
+	if o == nil {
+		return nil
+	}
+	return o.Tokens
+}
+
+func (o *User) GetPersonalAccessToken() string {
+	if o == nil || o.PersonalAccessToken == nil {
+		return ""
+	}
+	return *o.PersonalAccessToken
+}
+
+func (o *User) GetId() int {
+	if o == nil || o.Id == nil {
+		return 0
+	}
+	return *o.Id
+}
+
+func (o *User) GetGroupNames() []*string {
+	if o == nil {
+		return nil
+	}
+	return o.GroupNames
+}
+
+func (o *User) GetGroups() []*Group {
+	if o == nil {
+		return nil
+	}
+	return o.Groups
+}
+
+func (o *User) GetDisplayName() string {
+	if o == nil || o.DisplayName == nil {
+		return ""
+	}
+	return *o.DisplayName
+}
+
+func (o *User) GetOrganizationId() int {
+	if o == nil || o.OrganizationId == nil {
+		return 0
+	}
+	return *o.OrganizationId
+}
+
+func (o *User) GetUserGroupIds() []string {
+	if o == nil {
+		return nil
+	}
+	return o.UserGroupIds
+}
+
+func (o *UserGroup) GetDescription() string {
+	if o == nil || o.Description == nil {
+		return ""
+	}
+	return *o.Description
+}
+
+func (o *UserGroup) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *UserGroup) GetPolicies() []*UserGroupPolicy {
+	if o == nil {
+		return nil
+	}
+	return o.Policies
+}
+
+func (o *UserGroup) GetUserIds() []string {
+	if o == nil {
+		return nil
+	}
+	return o.UserIds
+}
+
+func (o *UserGroup) GetUserGroupId() string {
+	if o == nil || o.UserGroupId == nil {
+		return ""
+	}
+	return *o.UserGroupId
+}
+
+func (o *UserGroup) GetUsers() []*GetGroupUser {
+	if o == nil {
+		return nil
+	}
+	return o.Users
+}
+
+func (o *UserGroup) GetCreatedAt() string {
+	if o == nil || o.CreatedAt == nil {
+		return ""
+	}
+	return *o.CreatedAt
+}
+
+func (o *UserGroup) GetPolicyNames() []string {
+	if o == nil {
+		return nil
+	}
+	return o.PolicyNames
+}
+
+func (o *UserGroup) GetUsersCount() int {
+	if o == nil || o.UsersCount == nil {
+		return 0
+	}
+	return *o.UsersCount
+}
+
+func (o *UserGroupPolicy) GetAccountIds() []string {
+	if o == nil {
+		return nil
+	}
+	return o.AccountIds
+}
+
+func (o *UserGroupPolicy) GetPolicyId() string {
+	if o == nil || o.PolicyId == nil {
+		return ""
+	}
+	return *o.PolicyId
+}
+
+func (o *UserGroupPolicy) GetPolicyName() string {
+	if o == nil || o.PolicyName == nil {
+		return ""
+	}
+	return *o.PolicyName
+}
+
+func (o *UserGroupPolicy) GetPolicyType() string {
+	if o == nil || o.PolicyType == nil {
+		return ""
+	}
+	return *o.PolicyType
+}
+
+func (o *UserPolicy) GetUserPolicyId() string {
+	if o == nil || o.PolicyId == nil {
+		return ""
+	}
+	return *o.PolicyId
+}
+
+func (o *UserPolicy) GetPolicyName() string {
+	if o == nil || o.PolicyName == nil {
+		return ""
+	}
+	return *o.PolicyName
+}
+
+func (o *UserPolicy) GetPolicyType() string {
+	if o == nil || o.PolicyType == nil {
+		return ""
+	}
+	return *o.PolicyType
+}
+
+func (o *UserPolicy) GetUserPolicyAccountIds() []string {
+	if o == nil {
+		return nil
+	}
+	return o.AccountIds
+}
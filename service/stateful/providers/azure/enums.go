@@ -0,0 +1,253 @@
+package azure
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+)
+
+// A StatefulNodeAction represents a state transition that can be requested
+// for a stateful node.
+type StatefulNodeAction string
+
+const (
+	// StatefulNodeActionPause pauses a running stateful node.
+	StatefulNodeActionPause StatefulNodeAction = "pause"
+
+	// StatefulNodeActionResume resumes a paused stateful node.
+	StatefulNodeActionResume StatefulNodeAction = "resume"
+
+	// StatefulNodeActionRecycle recycles a stateful node.
+	StatefulNodeActionRecycle StatefulNodeAction = "recycle"
+)
+
+var statefulNodeActions = []StatefulNodeAction{
+	StatefulNodeActionPause,
+	StatefulNodeActionResume,
+	StatefulNodeActionRecycle,
+}
+
+// ParseStatefulNodeAction parses a string into a StatefulNodeAction. The
+// comparison is case insensitive and an error is returned for unknown values.
+func ParseStatefulNodeAction(s string) (StatefulNodeAction, error) {
+	for _, v := range statefulNodeActions {
+		if strings.EqualFold(s, string(v)) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("spotinst: invalid stateful node action %q", s)
+}
+
+// IsValid reports whether the action is a known value.
+func (a StatefulNodeAction) IsValid() bool {
+	_, err := ParseStatefulNodeAction(string(a))
+	return err == nil
+}
+
+func (a StatefulNodeAction) String() string {
+	return string(a)
+}
+
+// A StatefulNodeStatus represents the status of a stateful node as reported
+// by GetState.
+type StatefulNodeStatus string
+
+const (
+	// StatefulNodeStatusActive represents a running stateful node.
+	StatefulNodeStatusActive StatefulNodeStatus = "ACTIVE"
+
+	// StatefulNodeStatusPausing represents a stateful node being paused.
+	StatefulNodeStatusPausing StatefulNodeStatus = "PAUSING"
+
+	// StatefulNodeStatusPaused represents a paused stateful node.
+	StatefulNodeStatusPaused StatefulNodeStatus = "PAUSED"
+
+	// StatefulNodeStatusResuming represents a stateful node being resumed.
+	StatefulNodeStatusResuming StatefulNodeStatus = "RESUMING"
+
+	// StatefulNodeStatusRecycling represents a stateful node being recycled.
+	StatefulNodeStatusRecycling StatefulNodeStatus = "RECYCLING"
+
+	// StatefulNodeStatusDeallocating represents a stateful node whose
+	// resources are being deallocated.
+	StatefulNodeStatusDeallocating StatefulNodeStatus = "DEALLOCATING"
+
+	// StatefulNodeStatusDeallocated represents a stateful node whose
+	// resources have been deallocated.
+	StatefulNodeStatusDeallocated StatefulNodeStatus = "DEALLOCATED"
+
+	// StatefulNodeStatusError represents a stateful node in error.
+	StatefulNodeStatusError StatefulNodeStatus = "ERROR"
+)
+
+var statefulNodeStatuses = []StatefulNodeStatus{
+	StatefulNodeStatusActive,
+	StatefulNodeStatusPausing,
+	StatefulNodeStatusPaused,
+	StatefulNodeStatusResuming,
+	StatefulNodeStatusRecycling,
+	StatefulNodeStatusDeallocating,
+	StatefulNodeStatusDeallocated,
+	StatefulNodeStatusError,
+}
+
+// ParseStatefulNodeStatus parses a string into a StatefulNodeStatus. The
+// comparison is case insensitive and an error is returned for unknown values.
+func ParseStatefulNodeStatus(s string) (StatefulNodeStatus, error) {
+	for _, v := range statefulNodeStatuses {
+		if strings.EqualFold(s, string(v)) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("spotinst: invalid stateful node status %q", s)
+}
+
+// IsValid reports whether the status is a known value.
+func (s StatefulNodeStatus) IsValid() bool {
+	_, err := ParseStatefulNodeStatus(string(s))
+	return err == nil
+}
+
+func (s StatefulNodeStatus) String() string {
+	return string(s)
+}
+
+// A PreferredLifecycle represents the lifecycle a stateful node should run on
+// when capacity is available.
+type PreferredLifecycle string
+
+const (
+	// PreferredLifecycleSpot prefers spot VMs.
+	PreferredLifecycleSpot PreferredLifecycle = "spot"
+
+	// PreferredLifecycleOnDemand prefers on-demand VMs.
+	PreferredLifecycleOnDemand PreferredLifecycle = "od"
+)
+
+var preferredLifecycles = []PreferredLifecycle{
+	PreferredLifecycleSpot,
+	PreferredLifecycleOnDemand,
+}
+
+// ParsePreferredLifecycle parses a string into a PreferredLifecycle. The
+// comparison is case insensitive and an error is returned for unknown values.
+func ParsePreferredLifecycle(s string) (PreferredLifecycle, error) {
+	for _, v := range preferredLifecycles {
+		if strings.EqualFold(s, string(v)) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("spotinst: invalid preferred lifecycle %q", s)
+}
+
+// IsValid reports whether the lifecycle is a known value.
+func (l PreferredLifecycle) IsValid() bool {
+	_, err := ParsePreferredLifecycle(string(l))
+	return err == nil
+}
+
+func (l PreferredLifecycle) String() string {
+	return string(l)
+}
+
+// A SignalType represents the type of a signal sent by a stateful node.
+type SignalType string
+
+const (
+	// SignalTypeVMReady signals that a VM is ready to receive traffic.
+	SignalTypeVMReady SignalType = "vmReady"
+
+	// SignalTypeVMReadyToShutdown signals that a VM can be shut down.
+	SignalTypeVMReadyToShutdown SignalType = "vmReadyToShutdown"
+)
+
+var signalTypes = []SignalType{
+	SignalTypeVMReady,
+	SignalTypeVMReadyToShutdown,
+}
+
+// ParseSignalType parses a string into a SignalType. The comparison is case
+// insensitive and an error is returned for unknown values.
+func ParseSignalType(s string) (SignalType, error) {
+	for _, v := range signalTypes {
+		if strings.EqualFold(s, string(v)) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("spotinst: invalid signal type %q", s)
+}
+
+// IsValid reports whether the signal type is a known value.
+func (t SignalType) IsValid() bool {
+	_, err := ParseSignalType(string(t))
+	return err == nil
+}
+
+func (t SignalType) String() string {
+	return string(t)
+}
+
+// PreferredLifecycleEnum returns the PreferredLifecycle field as a typed
+// PreferredLifecycle, or an empty PreferredLifecycle if it isn't set.
+func (o *Strategy) PreferredLifecycleEnum() PreferredLifecycle {
+	if o == nil || o.PreferredLifecycle == nil {
+		return ""
+	}
+	return PreferredLifecycle(*o.PreferredLifecycle)
+}
+
+// SetPreferredLifecycleEnum sets the PreferredLifecycle field to v, or
+// clears it if v is empty.
+func (o *Strategy) SetPreferredLifecycleEnum(v PreferredLifecycle) *Strategy {
+	if v == "" {
+		return o.SetPreferredLifecycle(nil)
+	}
+	return o.SetPreferredLifecycle(spotinst.String(string(v)))
+}
+
+// TypeEnum returns the Type field as a typed SignalType, or an empty
+// SignalType if it isn't set.
+func (o *Signal) TypeEnum() SignalType {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return SignalType(*o.Type)
+}
+
+// SetTypeEnum sets the Type field to v, or clears it if v is empty.
+func (o *Signal) SetTypeEnum(v SignalType) *Signal {
+	if v == "" {
+		return o.SetType(nil)
+	}
+	return o.SetType(spotinst.String(string(v)))
+}
+
+// StatefulNodeStateEnum returns the StatefulNodeState field as a typed
+// StatefulNodeAction, or an empty StatefulNodeAction if it isn't set.
+func (o *UpdateStatefulNodeStateInput) StatefulNodeStateEnum() StatefulNodeAction {
+	if o == nil || o.StatefulNodeState == nil {
+		return ""
+	}
+	return StatefulNodeAction(*o.StatefulNodeState)
+}
+
+// SetStatefulNodeStateEnum sets the StatefulNodeState field to v, or clears
+// it if v is empty.
+func (o *UpdateStatefulNodeStateInput) SetStatefulNodeStateEnum(v StatefulNodeAction) *UpdateStatefulNodeStateInput {
+	if v == "" {
+		o.StatefulNodeState = nil
+	} else {
+		o.StatefulNodeState = spotinst.String(string(v))
+	}
+	return o
+}
+
+// StatusEnum returns the Status field as a typed StatefulNodeStatus, or an
+// empty StatefulNodeStatus if it isn't set.
+func (o *StatefulNodeState) StatusEnum() StatefulNodeStatus {
+	if o == nil || o.Status == nil {
+		return ""
+	}
+	return StatefulNodeStatus(*o.Status)
+}
@@ -0,0 +1,85 @@
+package azure
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type enumCase struct {
+	in, want string
+	ok       bool
+}
+
+func TestParseEnums(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		parse func(string) (string, bool, error)
+		cases []enumCase
+	}{
+		{"StatefulNodeAction", func(s string) (string, bool, error) {
+			v, err := ParseStatefulNodeAction(s)
+			return string(v), v.IsValid(), err
+		}, []enumCase{
+			{"recycle", "recycle", true},
+			{"RECYCLE", "recycle", true},
+			{"bogus", "", false},
+			{"", "", false},
+		}},
+		{"StatefulNodeStatus", func(s string) (string, bool, error) {
+			v, err := ParseStatefulNodeStatus(s)
+			return string(v), v.IsValid(), err
+		}, []enumCase{
+			{"ERROR", "ERROR", true},
+			{"error", "ERROR", true},
+			{"bogus", "", false},
+			{"", "", false},
+		}},
+		{"PreferredLifecycle", func(s string) (string, bool, error) {
+			v, err := ParsePreferredLifecycle(s)
+			return string(v), v.IsValid(), err
+		}, []enumCase{
+			{"od", "od", true},
+			{"OD", "od", true},
+			{"bogus", "", false},
+			{"", "", false},
+		}},
+		{"SignalType", func(s string) (string, bool, error) {
+			v, err := ParseSignalType(s)
+			return string(v), v.IsValid(), err
+		}, []enumCase{
+			{"vmReadyToShutdown", "vmReadyToShutdown", true},
+			{"VMREADYTOSHUTDOWN", "vmReadyToShutdown", true},
+			{"bogus", "", false},
+			{"", "", false},
+		}},
+	} {
+		for _, c := range tt.cases {
+			got, valid, err := tt.parse(c.in)
+			if (err == nil) != c.ok || got != c.want || valid != c.ok {
+				t.Errorf("Parse%s(%q) = %q (valid %v), %v; want %q, ok %v", tt.name, c.in, got, valid, err, c.want, c.ok)
+			}
+		}
+	}
+}
+
+func TestEnumAccessors(t *testing.T) {
+	var o *Signal
+	if got := o.TypeEnum(); got != "" {
+		t.Errorf("got %q from nil Signal, want empty", got)
+	}
+
+	o = new(Signal).SetTypeEnum(SignalTypeVMReady)
+	if got := o.TypeEnum(); got != SignalTypeVMReady {
+		t.Errorf("got %q, want %q", got, SignalTypeVMReady)
+	}
+
+	// An empty value clears the field.
+	o.SetTypeEnum("")
+	b, err := json.Marshal(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), `{"type":null}`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,1519 @@
+// Code generated by gettergen. DO NOT EDIT.
+
+package azure
+
+import (
+	"time"
+)
+
+func (o *AdditionalIPConfiguration) GetPrivateIPAddressVersion() string {
+	if o == nil || o.PrivateIPAddressVersion == nil {
+		return ""
+	}
+	return *o.PrivateIPAddressVersion
+}
+
+func (o *AdditionalIPConfiguration) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *ApplicationSecurityGroup) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *ApplicationSecurityGroup) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *AttachStatefulNodeDataDiskInput) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *AttachStatefulNodeDataDiskInput) GetDataDiskName() string {
+	if o == nil || o.DataDiskName == nil {
+		return ""
+	}
+	return *o.DataDiskName
+}
+
+func (o *AttachStatefulNodeDataDiskInput) GetDataDiskResourceGroupName() string {
+	if o == nil || o.DataDiskResourceGroupName == nil {
+		return ""
+	}
+	return *o.DataDiskResourceGroupName
+}
+
+func (o *AttachStatefulNodeDataDiskInput) GetStorageAccountType() string {
+	if o == nil || o.StorageAccountType == nil {
+		return ""
+	}
+	return *o.StorageAccountType
+}
+
+func (o *AttachStatefulNodeDataDiskInput) GetSizeGB() int {
+	if o == nil || o.SizeGB == nil {
+		return 0
+	}
+	return *o.SizeGB
+}
+
+func (o *AttachStatefulNodeDataDiskInput) GetLUN() int {
+	if o == nil || o.LUN == nil {
+		return 0
+	}
+	return *o.LUN
+}
+
+func (o *AttachStatefulNodeDataDiskInput) GetZone() string {
+	if o == nil || o.Zone == nil {
+		return ""
+	}
+	return *o.Zone
+}
+
+func (o *BootDiagnostics) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *BootDiagnostics) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *BootDiagnostics) GetStorageURL() string {
+	if o == nil || o.StorageURL == nil {
+		return ""
+	}
+	return *o.StorageURL
+}
+
+func (o *CapacityReservation) GetShouldUtilize() bool {
+	if o == nil || o.ShouldUtilize == nil {
+		return false
+	}
+	return *o.ShouldUtilize
+}
+
+func (o *CapacityReservation) GetUtilizationStrategy() string {
+	if o == nil || o.UtilizationStrategy == nil {
+		return ""
+	}
+	return *o.UtilizationStrategy
+}
+
+func (o *CapacityReservation) GetCapacityReservationGroups() []*CapacityReservationGroup {
+	if o == nil {
+		return nil
+	}
+	return o.CapacityReservationGroups
+}
+
+func (o *CapacityReservationGroup) GetCRGName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *CapacityReservationGroup) GetCRGResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *CapacityReservationGroup) GetShouldPrioritize() bool {
+	if o == nil || o.ShouldPrioritize == nil {
+		return false
+	}
+	return *o.ShouldPrioritize
+}
+
+func (o *Compute) GetOS() string {
+	if o == nil || o.OS == nil {
+		return ""
+	}
+	return *o.OS
+}
+
+func (o *Compute) GetVMSizes() *VMSizes {
+	if o == nil {
+		return nil
+	}
+	return o.VMSizes
+}
+
+func (o *Compute) GetZones() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Zones
+}
+
+func (o *Compute) GetPreferredZone() string {
+	if o == nil || o.PreferredZone == nil {
+		return ""
+	}
+	return *o.PreferredZone
+}
+
+func (o *Compute) GetLaunchSpecification() *LaunchSpecification {
+	if o == nil {
+		return nil
+	}
+	return o.LaunchSpecification
+}
+
+func (o *CreateStatefulNodeInput) GetStatefulNode() *StatefulNode {
+	if o == nil {
+		return nil
+	}
+	return o.StatefulNode
+}
+
+func (o *CreateStatefulNodeOutput) GetStatefulNode() *StatefulNode {
+	if o == nil {
+		return nil
+	}
+	return o.StatefulNode
+}
+
+func (o *CustomImage) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *CustomImage) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *DataDisk) GetSizeGB() int {
+	if o == nil || o.SizeGB == nil {
+		return 0
+	}
+	return *o.SizeGB
+}
+
+func (o *DataDisk) GetLUN() int {
+	if o == nil || o.LUN == nil {
+		return 0
+	}
+	return *o.LUN
+}
+
+func (o *DataDisk) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *DeallocationConfig) GetShouldTerminateVM() bool {
+	if o == nil || o.ShouldTerminateVM == nil {
+		return false
+	}
+	return *o.ShouldTerminateVM
+}
+
+func (o *DeallocationConfig) GetNetworkDeallocationConfig() *ResourceDeallocationConfig {
+	if o == nil {
+		return nil
+	}
+	return o.NetworkDeallocationConfig
+}
+
+func (o *DeallocationConfig) GetDiskDeallocationConfig() *ResourceDeallocationConfig {
+	if o == nil {
+		return nil
+	}
+	return o.DiskDeallocationConfig
+}
+
+func (o *DeallocationConfig) GetSnapshotDeallocationConfig() *ResourceDeallocationConfig {
+	if o == nil {
+		return nil
+	}
+	return o.SnapshotDeallocationConfig
+}
+
+func (o *DeallocationConfig) GetPublicIPDeallocationConfig() *ResourceDeallocationConfig {
+	if o == nil {
+		return nil
+	}
+	return o.PublicIPDeallocationConfig
+}
+
+func (o *DeallocationConfig) GetShouldDeregisterFromLb() bool {
+	if o == nil || o.ShouldDeregisterFromLb == nil {
+		return false
+	}
+	return *o.ShouldDeregisterFromLb
+}
+
+func (o *DeallocationConfig) GetShouldRevertToOd() bool {
+	if o == nil || o.ShouldRevertToOd == nil {
+		return false
+	}
+	return *o.ShouldRevertToOd
+}
+
+func (o *DeleteStatefulNodeInput) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *DeleteStatefulNodeInput) GetDeallocationConfig() *DeallocationConfig {
+	if o == nil {
+		return nil
+	}
+	return o.DeallocationConfig
+}
+
+func (o *DetachStatefulNodeDataDiskInput) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *DetachStatefulNodeDataDiskInput) GetDataDiskName() string {
+	if o == nil || o.DataDiskName == nil {
+		return ""
+	}
+	return *o.DataDiskName
+}
+
+func (o *DetachStatefulNodeDataDiskInput) GetDataDiskResourceGroupName() string {
+	if o == nil || o.DataDiskResourceGroupName == nil {
+		return ""
+	}
+	return *o.DataDiskResourceGroupName
+}
+
+func (o *DetachStatefulNodeDataDiskInput) GetShouldDeallocate() bool {
+	if o == nil || o.ShouldDeallocate == nil {
+		return false
+	}
+	return *o.ShouldDeallocate
+}
+
+func (o *DetachStatefulNodeDataDiskInput) GetTTLInHours() int {
+	if o == nil || o.TTLInHours == nil {
+		return 0
+	}
+	return *o.TTLInHours
+}
+
+func (o *Extension) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Extension) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *Extension) GetPublisher() string {
+	if o == nil || o.Publisher == nil {
+		return ""
+	}
+	return *o.Publisher
+}
+
+func (o *Extension) GetAPIVersion() string {
+	if o == nil || o.APIVersion == nil {
+		return ""
+	}
+	return *o.APIVersion
+}
+
+func (o *Extension) GetMinorVersionAutoUpgrade() bool {
+	if o == nil || o.MinorVersionAutoUpgrade == nil {
+		return false
+	}
+	return *o.MinorVersionAutoUpgrade
+}
+
+func (o *Extension) GetProtectedSettings() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.ProtectedSettings
+}
+
+func (o *Extension) GetPublicSettings() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.PublicSettings
+}
+
+func (o *Gallery) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *Gallery) GetGalleryName() string {
+	if o == nil || o.GalleryName == nil {
+		return ""
+	}
+	return *o.GalleryName
+}
+
+func (o *Gallery) GetImageName() string {
+	if o == nil || o.ImageName == nil {
+		return ""
+	}
+	return *o.ImageName
+}
+
+func (o *Gallery) GetVersionName() string {
+	if o == nil || o.VersionName == nil {
+		return ""
+	}
+	return *o.VersionName
+}
+
+func (o *Gallery) GetSpotAccountId() string {
+	if o == nil || o.SpotAccountId == nil {
+		return ""
+	}
+	return *o.SpotAccountId
+}
+
+func (o *GetStatefulNodeStateInput) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *GetStatefulNodeStateOutput) GetStatefulNodeState() *StatefulNodeState {
+	if o == nil {
+		return nil
+	}
+	return o.StatefulNodeState
+}
+
+func (o *Health) GetHealthCheckTypes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.HealthCheckTypes
+}
+
+func (o *Health) GetGracePeriod() int {
+	if o == nil || o.GracePeriod == nil {
+		return 0
+	}
+	return *o.GracePeriod
+}
+
+func (o *Health) GetUnhealthyDuration() int {
+	if o == nil || o.UnhealthyDuration == nil {
+		return 0
+	}
+	return *o.UnhealthyDuration
+}
+
+func (o *Health) GetAutoHealing() bool {
+	if o == nil || o.AutoHealing == nil {
+		return false
+	}
+	return *o.AutoHealing
+}
+
+func (o *Image) GetMarketPlaceImage() *MarketPlaceImage {
+	if o == nil {
+		return nil
+	}
+	return o.MarketPlace
+}
+
+func (o *Image) GetCustom() *CustomImage {
+	if o == nil {
+		return nil
+	}
+	return o.Custom
+}
+
+func (o *Image) GetGallery() *Gallery {
+	if o == nil {
+		return nil
+	}
+	return o.Gallery
+}
+
+func (o *ImportVMStatefulNodeInput) GetStatefulNodeImport() *StatefulNodeImport {
+	if o == nil {
+		return nil
+	}
+	return o.StatefulNodeImport
+}
+
+func (o *ImportVMStatefulNodeOutput) GetStatefulNodeImport() *StatefulNodeImport {
+	if o == nil {
+		return nil
+	}
+	return o.StatefulNodeImport
+}
+
+func (o *InterruptionToleration) GetCooldown() int {
+	if o == nil || o.Cooldown == nil {
+		return 0
+	}
+	return *o.Cooldown
+}
+
+func (o *InterruptionToleration) GetEvaluationPeriod() int {
+	if o == nil || o.EvaluationPeriod == nil {
+		return 0
+	}
+	return *o.EvaluationPeriod
+}
+
+func (o *InterruptionToleration) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *InterruptionToleration) GetThreshold() int {
+	if o == nil || o.Threshold == nil {
+		return 0
+	}
+	return *o.Threshold
+}
+
+func (o *LaunchSpecification) GetImage() *Image {
+	if o == nil {
+		return nil
+	}
+	return o.Image
+}
+
+func (o *LaunchSpecification) GetNetwork() *Network {
+	if o == nil {
+		return nil
+	}
+	return o.Network
+}
+
+func (o *LaunchSpecification) GetLogin() *Login {
+	if o == nil {
+		return nil
+	}
+	return o.Login
+}
+
+func (o *LaunchSpecification) GetCustomData() string {
+	if o == nil || o.CustomData == nil {
+		return ""
+	}
+	return *o.CustomData
+}
+
+func (o *LaunchSpecification) GetShutdownScript() string {
+	if o == nil || o.ShutdownScript == nil {
+		return ""
+	}
+	return *o.ShutdownScript
+}
+
+func (o *LaunchSpecification) GetLoadBalancersConfig() *LoadBalancersConfig {
+	if o == nil {
+		return nil
+	}
+	return o.LoadBalancersConfig
+}
+
+func (o *LaunchSpecification) GetTags() []*Tag {
+	if o == nil {
+		return nil
+	}
+	return o.Tags
+}
+
+func (o *LaunchSpecification) GetManagedServiceIdentities() []*ManagedServiceIdentity {
+	if o == nil {
+		return nil
+	}
+	return o.ManagedServiceIdentities
+}
+
+func (o *LaunchSpecification) GetExtensions() []*Extension {
+	if o == nil {
+		return nil
+	}
+	return o.Extensions
+}
+
+func (o *LaunchSpecification) GetOSDisk() *OSDisk {
+	if o == nil {
+		return nil
+	}
+	return o.OSDisk
+}
+
+func (o *LaunchSpecification) GetDataDisks() []*DataDisk {
+	if o == nil {
+		return nil
+	}
+	return o.DataDisks
+}
+
+func (o *LaunchSpecification) GetSecrets() []*Secret {
+	if o == nil {
+		return nil
+	}
+	return o.Secrets
+}
+
+func (o *LaunchSpecification) GetBootDiagnostics() *BootDiagnostics {
+	if o == nil {
+		return nil
+	}
+	return o.BootDiagnostics
+}
+
+func (o *LaunchSpecification) GetUserData() string {
+	if o == nil || o.UserData == nil {
+		return ""
+	}
+	return *o.UserData
+}
+
+func (o *LaunchSpecification) GetVMName() string {
+	if o == nil || o.VMName == nil {
+		return ""
+	}
+	return *o.VMName
+}
+
+func (o *LaunchSpecification) GetSecurity() *Security {
+	if o == nil {
+		return nil
+	}
+	return o.Security
+}
+
+func (o *LaunchSpecification) GetProximityPlacementGroups() []*ProximityPlacementGroups {
+	if o == nil {
+		return nil
+	}
+	return o.ProximityPlacementGroups
+}
+
+func (o *LaunchSpecification) GetLicenseType() string {
+	if o == nil || o.LicenseType == nil {
+		return ""
+	}
+	return *o.LicenseType
+}
+
+func (o *LaunchSpecification) GetVMNamePrefix() string {
+	if o == nil || o.VMNamePrefix == nil {
+		return ""
+	}
+	return *o.VMNamePrefix
+}
+
+func (o *ListStatefulNodesOutput) GetStatefulNodes() []*StatefulNode {
+	if o == nil {
+		return nil
+	}
+	return o.StatefulNodes
+}
+
+func (o *LoadBalancer) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *LoadBalancer) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *LoadBalancer) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *LoadBalancer) GetSKU() string {
+	if o == nil || o.SKU == nil {
+		return ""
+	}
+	return *o.SKU
+}
+
+func (o *LoadBalancer) GetBackendPoolNames() []string {
+	if o == nil {
+		return nil
+	}
+	return o.BackendPoolNames
+}
+
+func (o *LoadBalancersConfig) GetLoadBalancers() []*LoadBalancer {
+	if o == nil {
+		return nil
+	}
+	return o.LoadBalancers
+}
+
+func (o *Login) GetUserName() string {
+	if o == nil || o.UserName == nil {
+		return ""
+	}
+	return *o.UserName
+}
+
+func (o *Login) GetSSHPublicKey() string {
+	if o == nil || o.SSHPublicKey == nil {
+		return ""
+	}
+	return *o.SSHPublicKey
+}
+
+func (o *Login) GetPassword() string {
+	if o == nil || o.Password == nil {
+		return ""
+	}
+	return *o.Password
+}
+
+func (o *ManagedServiceIdentity) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *ManagedServiceIdentity) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *ManagedServiceIdentity) GetSubscriptionID() string {
+	if o == nil || o.SubscriptionID == nil {
+		return ""
+	}
+	return *o.SubscriptionID
+}
+
+func (o *MarketPlaceImage) GetPublisher() string {
+	if o == nil || o.Publisher == nil {
+		return ""
+	}
+	return *o.Publisher
+}
+
+func (o *MarketPlaceImage) GetOffer() string {
+	if o == nil || o.Offer == nil {
+		return ""
+	}
+	return *o.Offer
+}
+
+func (o *MarketPlaceImage) GetSKU() string {
+	if o == nil || o.SKU == nil {
+		return ""
+	}
+	return *o.SKU
+}
+
+func (o *MarketPlaceImage) GetVersion() string {
+	if o == nil || o.Version == nil {
+		return ""
+	}
+	return *o.Version
+}
+
+func (o *Network) GetVirtualNetworkName() string {
+	if o == nil || o.VirtualNetworkName == nil {
+		return ""
+	}
+	return *o.VirtualNetworkName
+}
+
+func (o *Network) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *Network) GetNetworkInterfaces() []*NetworkInterface {
+	if o == nil {
+		return nil
+	}
+	return o.NetworkInterfaces
+}
+
+func (o *NetworkInterface) GetSubnetName() string {
+	if o == nil || o.SubnetName == nil {
+		return ""
+	}
+	return *o.SubnetName
+}
+
+func (o *NetworkInterface) GetAssignPublicIP() bool {
+	if o == nil || o.AssignPublicIP == nil {
+		return false
+	}
+	return *o.AssignPublicIP
+}
+
+func (o *NetworkInterface) GetIsPrimary() bool {
+	if o == nil || o.IsPrimary == nil {
+		return false
+	}
+	return *o.IsPrimary
+}
+
+func (o *NetworkInterface) GetPublicIPSku() string {
+	if o == nil || o.PublicIPSku == nil {
+		return ""
+	}
+	return *o.PublicIPSku
+}
+
+func (o *NetworkInterface) GetNetworkSecurityGroup() *NetworkSecurityGroup {
+	if o == nil {
+		return nil
+	}
+	return o.NetworkSecurityGroup
+}
+
+func (o *NetworkInterface) GetEnableIPForwarding() bool {
+	if o == nil || o.EnableIPForwarding == nil {
+		return false
+	}
+	return *o.EnableIPForwarding
+}
+
+func (o *NetworkInterface) GetPrivateIPAddresses() []string {
+	if o == nil {
+		return nil
+	}
+	return o.PrivateIPAddresses
+}
+
+func (o *NetworkInterface) GetAdditionalIPConfigurations() []*AdditionalIPConfiguration {
+	if o == nil {
+		return nil
+	}
+	return o.AdditionalIPConfigurations
+}
+
+func (o *NetworkInterface) GetPublicIPs() []*PublicIP {
+	if o == nil {
+		return nil
+	}
+	return o.PublicIPs
+}
+
+func (o *NetworkInterface) GetApplicationSecurityGroups() []*ApplicationSecurityGroup {
+	if o == nil {
+		return nil
+	}
+	return o.ApplicationSecurityGroups
+}
+
+func (o *NetworkSecurityGroup) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *NetworkSecurityGroup) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *OSDisk) GetSizeGB() int {
+	if o == nil || o.SizeGB == nil {
+		return 0
+	}
+	return *o.SizeGB
+}
+
+func (o *OSDisk) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *OSDisk) GetCaching() string {
+	if o == nil || o.Caching == nil {
+		return ""
+	}
+	return *o.Caching
+}
+
+func (o *Persistence) GetShouldPersistOSDisk() bool {
+	if o == nil || o.ShouldPersistOSDisk == nil {
+		return false
+	}
+	return *o.ShouldPersistOSDisk
+}
+
+func (o *Persistence) GetOSDiskPersistenceMode() string {
+	if o == nil || o.OSDiskPersistenceMode == nil {
+		return ""
+	}
+	return *o.OSDiskPersistenceMode
+}
+
+func (o *Persistence) GetShouldPersistDataDisks() bool {
+	if o == nil || o.ShouldPersistDataDisks == nil {
+		return false
+	}
+	return *o.ShouldPersistDataDisks
+}
+
+func (o *Persistence) GetDataDisksPersistenceMode() string {
+	if o == nil || o.DataDisksPersistenceMode == nil {
+		return ""
+	}
+	return *o.DataDisksPersistenceMode
+}
+
+func (o *Persistence) GetShouldPersistNetwork() bool {
+	if o == nil || o.ShouldPersistNetwork == nil {
+		return false
+	}
+	return *o.ShouldPersistNetwork
+}
+
+func (o *ProximityPlacementGroups) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *ProximityPlacementGroups) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *PublicIP) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *PublicIP) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *ReadStatefulNodeInput) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *ReadStatefulNodeOutput) GetStatefulNode() *StatefulNode {
+	if o == nil {
+		return nil
+	}
+	return o.StatefulNode
+}
+
+func (o *ResourceDeallocationConfig) GetShouldDeallocate() bool {
+	if o == nil || o.ShouldDeallocate == nil {
+		return false
+	}
+	return *o.ShouldDeallocate
+}
+
+func (o *ResourceDeallocationConfig) GetTTLInHours() int {
+	if o == nil || o.TTLInHours == nil {
+		return 0
+	}
+	return *o.TTLInHours
+}
+
+func (o *RevertToSpot) GetPerformAt() string {
+	if o == nil || o.PerformAt == nil {
+		return ""
+	}
+	return *o.PerformAt
+}
+
+func (o *Scheduling) GetTasks() []*Task {
+	if o == nil {
+		return nil
+	}
+	return o.Tasks
+}
+
+func (o *Secret) GetSourceVault() *SourceVault {
+	if o == nil {
+		return nil
+	}
+	return o.SourceVault
+}
+
+func (o *Secret) GetVaultCertificates() []*VaultCertificate {
+	if o == nil {
+		return nil
+	}
+	return o.VaultCertificates
+}
+
+func (o *Security) GetSecureBootEnabled() bool {
+	if o == nil || o.SecureBootEnabled == nil {
+		return false
+	}
+	return *o.SecureBootEnabled
+}
+
+func (o *Security) GetSecurityType() string {
+	if o == nil || o.SecurityType == nil {
+		return ""
+	}
+	return *o.SecurityType
+}
+
+func (o *Security) GetVTpmEnabled() bool {
+	if o == nil || o.VTpmEnabled == nil {
+		return false
+	}
+	return *o.VTpmEnabled
+}
+
+func (o *Security) GetEncryptionAtHost() bool {
+	if o == nil || o.EncryptionAtHost == nil {
+		return false
+	}
+	return *o.EncryptionAtHost
+}
+
+func (o *Security) GetConfidentialOsDiskEncryption() bool {
+	if o == nil || o.ConfidentialOsDiskEncryption == nil {
+		return false
+	}
+	return *o.ConfidentialOsDiskEncryption
+}
+
+func (o *Signal) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *Signal) GetTimeout() int {
+	if o == nil || o.Timeout == nil {
+		return 0
+	}
+	return *o.Timeout
+}
+
+func (o *SourceVault) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *SourceVault) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *SpotSizeAttributes) GetMaxCpu() int {
+	if o == nil || o.MaxCpu == nil {
+		return 0
+	}
+	return *o.MaxCpu
+}
+
+func (o *SpotSizeAttributes) GetMaxMemory() int {
+	if o == nil || o.MaxMemory == nil {
+		return 0
+	}
+	return *o.MaxMemory
+}
+
+func (o *SpotSizeAttributes) GetMaxStorage() int {
+	if o == nil || o.MaxStorage == nil {
+		return 0
+	}
+	return *o.MaxStorage
+}
+
+func (o *SpotSizeAttributes) GetMinCpu() int {
+	if o == nil || o.MinCpu == nil {
+		return 0
+	}
+	return *o.MinCpu
+}
+
+func (o *SpotSizeAttributes) GetMinMemory() int {
+	if o == nil || o.MinMemory == nil {
+		return 0
+	}
+	return *o.MinMemory
+}
+
+func (o *SpotSizeAttributes) GetMinStorage() int {
+	if o == nil || o.MinStorage == nil {
+		return 0
+	}
+	return *o.MinStorage
+}
+
+func (o *StatefulNode) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *StatefulNode) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *StatefulNode) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *StatefulNode) GetRegion() string {
+	if o == nil || o.Region == nil {
+		return ""
+	}
+	return *o.Region
+}
+
+func (o *StatefulNode) GetDescription() string {
+	if o == nil || o.Description == nil {
+		return ""
+	}
+	return *o.Description
+}
+
+func (o *StatefulNode) GetStrategy() *Strategy {
+	if o == nil {
+		return nil
+	}
+	return o.Strategy
+}
+
+func (o *StatefulNode) GetCompute() *Compute {
+	if o == nil {
+		return nil
+	}
+	return o.Compute
+}
+
+func (o *StatefulNode) GetPersistence() *Persistence {
+	if o == nil {
+		return nil
+	}
+	return o.Persistence
+}
+
+func (o *StatefulNode) GetScheduling() *Scheduling {
+	if o == nil {
+		return nil
+	}
+	return o.Scheduling
+}
+
+func (o *StatefulNode) GetHealth() *Health {
+	if o == nil {
+		return nil
+	}
+	return o.Health
+}
+
+func (o *StatefulNode) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *StatefulNode) GetUpdatedAt() time.Time {
+	if o == nil || o.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *o.UpdatedAt
+}
+
+func (o *StatefulNodeImport) GetStatefulImportID() string {
+	if o == nil || o.StatefulImportID == nil {
+		return ""
+	}
+	return *o.StatefulImportID
+}
+
+func (o *StatefulNodeImport) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *StatefulNodeImport) GetOriginalVMName() string {
+	if o == nil || o.OriginalVMName == nil {
+		return ""
+	}
+	return *o.OriginalVMName
+}
+
+func (o *StatefulNodeImport) GetDrainingTimeout() int {
+	if o == nil || o.DrainingTimeout == nil {
+		return 0
+	}
+	return *o.DrainingTimeout
+}
+
+func (o *StatefulNodeImport) GetResourcesRetentionTime() int {
+	if o == nil || o.ResourcesRetentionTime == nil {
+		return 0
+	}
+	return *o.ResourcesRetentionTime
+}
+
+func (o *StatefulNodeImport) GetStatefulNode() *StatefulNode {
+	if o == nil {
+		return nil
+	}
+	return o.StatefulNode
+}
+
+func (o *StatefulNodeState) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *StatefulNodeState) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *StatefulNodeState) GetRegion() string {
+	if o == nil || o.Region == nil {
+		return ""
+	}
+	return *o.Region
+}
+
+func (o *StatefulNodeState) GetResourceGroupName() string {
+	if o == nil || o.ResourceGroupName == nil {
+		return ""
+	}
+	return *o.ResourceGroupName
+}
+
+func (o *StatefulNodeState) GetStatus() string {
+	if o == nil || o.Status == nil {
+		return ""
+	}
+	return *o.Status
+}
+
+func (o *StatefulNodeState) GetVMName() string {
+	if o == nil || o.VMName == nil {
+		return ""
+	}
+	return *o.VMName
+}
+
+func (o *StatefulNodeState) GetVMSize() string {
+	if o == nil || o.VMSize == nil {
+		return ""
+	}
+	return *o.VMSize
+}
+
+func (o *StatefulNodeState) GetLifeCycle() string {
+	if o == nil || o.LifeCycle == nil {
+		return ""
+	}
+	return *o.LifeCycle
+}
+
+func (o *StatefulNodeState) GetRollbackReason() string {
+	if o == nil || o.RollbackReason == nil {
+		return ""
+	}
+	return *o.RollbackReason
+}
+
+func (o *StatefulNodeState) GetErrorReason() string {
+	if o == nil || o.ErrorReason == nil {
+		return ""
+	}
+	return *o.ErrorReason
+}
+
+func (o *StatefulNodeState) GetPrivateIP() string {
+	if o == nil || o.PrivateIP == nil {
+		return ""
+	}
+	return *o.PrivateIP
+}
+
+func (o *StatefulNodeState) GetPublicIP() string {
+	if o == nil || o.PublicIP == nil {
+		return ""
+	}
+	return *o.PublicIP
+}
+
+func (o *Strategy) GetPreferredLifecycle() string {
+	if o == nil || o.PreferredLifecycle == nil {
+		return ""
+	}
+	return *o.PreferredLifecycle
+}
+
+func (o *Strategy) GetSignals() []*Signal {
+	if o == nil {
+		return nil
+	}
+	return o.Signals
+}
+
+func (o *Strategy) GetFallbackToOnDemand() bool {
+	if o == nil || o.FallbackToOnDemand == nil {
+		return false
+	}
+	return *o.FallbackToOnDemand
+}
+
+func (o *Strategy) GetDrainingTimeout() int {
+	if o == nil || o.DrainingTimeout == nil {
+		return 0
+	}
+	return *o.DrainingTimeout
+}
+
+func (o *Strategy) GetRevertToSpot() *RevertToSpot {
+	if o == nil {
+		return nil
+	}
+	return o.RevertToSpot
+}
+
+func (o *Strategy) GetOptimizationWindows() []string {
+	if o == nil {
+		return nil
+	}
+	return o.OptimizationWindows
+}
+
+func (o *Strategy) GetCapacityReservation() *CapacityReservation {
+	if o == nil {
+		return nil
+	}
+	return o.CapacityReservation
+}
+
+func (o *Strategy) GetAvailabilityVsCost() int {
+	if o == nil || o.AvailabilityVsCost == nil {
+		return 0
+	}
+	return *o.AvailabilityVsCost
+}
+
+func (o *Strategy) GetOdWindows() []string {
+	if o == nil {
+		return nil
+	}
+	return o.OdWindows
+}
+
+func (o *Strategy) GetVmAdmins() []string {
+	if o == nil {
+		return nil
+	}
+	return o.VmAdmins
+}
+
+func (o *Strategy) GetInterruptionToleration() *InterruptionToleration {
+	if o == nil {
+		return nil
+	}
+	return o.InterruptionToleration
+}
+
+func (o *Tag) GetTagKey() string {
+	if o == nil || o.TagKey == nil {
+		return ""
+	}
+	return *o.TagKey
+}
+
+func (o *Tag) GetTagValue() string {
+	if o == nil || o.TagValue == nil {
+		return ""
+	}
+	return *o.TagValue
+}
+
+func (o *Task) GetIsEnabled() bool {
+	if o == nil || o.IsEnabled == nil {
+		return false
+	}
+	return *o.IsEnabled
+}
+
+func (o *Task) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+func (o *Task) GetCronExpression() string {
+	if o == nil || o.CronExpression == nil {
+		return ""
+	}
+	return *o.CronExpression
+}
+
+func (o *UpdateStatefulNodeInput) GetStatefulNode() *StatefulNode {
+	if o == nil {
+		return nil
+	}
+	return o.StatefulNode
+}
+
+func (o *UpdateStatefulNodeOutput) GetStatefulNode() *StatefulNode {
+	if o == nil {
+		return nil
+	}
+	return o.StatefulNode
+}
+
+func (o *UpdateStatefulNodeStateInput) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *UpdateStatefulNodeStateInput) GetStatefulNodeState() string {
+	if o == nil || o.StatefulNodeState == nil {
+		return ""
+	}
+	return *o.StatefulNodeState
+}
+
+func (o *VMSizes) GetOnDemandSizes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.OnDemandSizes
+}
+
+func (o *VMSizes) GetSpotSizes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.SpotSizes
+}
+
+func (o *VMSizes) GetPreferredSpotSizes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.PreferredSpotSizes
+}
+
+func (o *VMSizes) GetExcludedVmSizes() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ExcludedVmSizes
+}
+
+func (o *VMSizes) GetSpotSizeAttributes() *SpotSizeAttributes {
+	if o == nil {
+		return nil
+	}
+	return o.SpotSizeAttributes
+}
+
+func (o *VaultCertificate) GetCertificateURL() string {
+	if o == nil || o.CertificateURL == nil {
+		return ""
+	}
+	return *o.CertificateURL
+}
+
+func (o *VaultCertificate) GetCertificateStore() string {
+	if o == nil || o.CertificateStore == nil {
+		return ""
+	}
+	return *o.CertificateStore
+}
@@ -0,0 +1,40 @@
+package azure
+
+import (
+	"context"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/informer"
+)
+
+// NewStatefulNodeInformer returns an informer that lists stateful nodes. The
+// list, key and version functions of cfg are set, and its indexers are
+// merged with indexes by name, region and tag. Objects are of type
+// *StatefulNode.
+func (s *ServiceOp) NewStatefulNodeInformer(cfg informer.Config) *informer.Informer {
+	cfg.List = func(ctx context.Context) ([]interface{}, error) {
+		out, err := s.List(ctx, &ListStatefulNodesInput{})
+		if err != nil {
+			return nil, err
+		}
+		objs := make([]interface{}, len(out.StatefulNodes))
+		for i, n := range out.StatefulNodes {
+			objs[i] = n
+		}
+		return objs, nil
+	}
+	cfg.Key = func(obj interface{}) string { return obj.(*StatefulNode).GetID() }
+	cfg.Version = func(obj interface{}) time.Time { return obj.(*StatefulNode).GetUpdatedAt() }
+	cfg.Indexers = informer.Merge(informer.Indexers{
+		informer.IndexName:   func(obj interface{}) []string { return []string{obj.(*StatefulNode).GetName()} },
+		informer.IndexRegion: func(obj interface{}) []string { return []string{obj.(*StatefulNode).GetRegion()} },
+		informer.IndexTag: func(obj interface{}) []string {
+			tags := obj.(*StatefulNode).GetCompute().GetLaunchSpecification().GetTags()
+			return informer.TagIndexValues(len(tags), func(i int) (string, string) {
+				return tags[i].GetTagKey(), tags[i].GetTagValue()
+			})
+		},
+	}, cfg.Indexers)
+
+	return informer.New(cfg)
+}
@@ -0,0 +1,135 @@
+package azure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+// StateTransitionError is returned by UpdateStateAndWait when a stateful node
+// fails to reach the state requested by an action.
+type StateTransitionError struct {
+	ID     string
+	Action StatefulNodeAction
+
+	// Status is the last known status of the stateful node.
+	Status StatefulNodeStatus
+
+	// ErrorReason and RollbackReason are the reasons reported by the API, if
+	// any.
+	ErrorReason    string
+	RollbackReason string
+
+	// Err is the error returned by the waiter.
+	Err error
+}
+
+func (e *StateTransitionError) Error() string {
+	msg := fmt.Sprintf("spotinst: failed to %s stateful node %q (status: %s)", e.Action, e.ID, e.Status)
+	if e.ErrorReason != "" {
+		msg += ": error: " + e.ErrorReason
+	}
+	if e.RollbackReason != "" {
+		msg += ": rollback: " + e.RollbackReason
+	}
+	return msg
+}
+
+// Unwrap returns the error returned by the waiter.
+func (e *StateTransitionError) Unwrap() error {
+	return e.Err
+}
+
+// IsRollback reports whether the transition was rolled back.
+func (e *StateTransitionError) IsRollback() bool {
+	return e.RollbackReason != ""
+}
+
+// UpdateStateAndWait requests a state transition and polls GetState until
+// the stateful node reaches the state implied by the action: PAUSED for
+// pause, and ACTIVE for resume and recycle.
+//
+// If the node reaches the ERROR status or the API reports a new error or
+// rollback reason, a *StateTransitionError wrapping waiter.ErrFailureState is
+// returned.
+func (s *ServiceOp) UpdateStateAndWait(ctx context.Context, input *UpdateStatefulNodeStateInput, opts ...waiter.Option) (*StatefulNodeState, error) {
+	return updateStateAndWait(ctx, s, input, opts)
+}
+
+func updateStateAndWait(ctx context.Context, svc Service, input *UpdateStatefulNodeStateInput, opts []waiter.Option) (*StatefulNodeState, error) {
+	action, err := ParseStatefulNodeAction(spotinst.StringValue(input.StatefulNodeState))
+	if err != nil {
+		return nil, err
+	}
+	target := StatefulNodeStatusActive
+	if action == StatefulNodeActionPause {
+		target = StatefulNodeStatusPaused
+	}
+
+	// Reasons already reported before the transition was requested are
+	// ignored.
+	getInput := &GetStatefulNodeStateInput{ID: input.ID}
+	before, err := svc.GetState(ctx, getInput)
+	if err != nil {
+		return nil, err
+	}
+	errorReason := before.StatefulNodeState.GetErrorReason()
+	rollbackReason := before.StatefulNodeState.GetRollbackReason()
+
+	t := &waiter.Transition{
+		Name: "StatefulNodeStatus" + target.String(),
+		Do: func(ctx context.Context) error {
+			_, err := svc.UpdateState(ctx, input)
+			return err
+		},
+		Poll: func(ctx context.Context) (interface{}, error) {
+			out, err := svc.GetState(ctx, getInput)
+			if err != nil {
+				return nil, err
+			}
+			if out.StatefulNodeState == nil {
+				return new(StatefulNodeState), nil
+			}
+			return out.StatefulNodeState, nil
+		},
+		Done: func(output interface{}) bool {
+			return statefulNodeStatus(output.(*StatefulNodeState)) == target
+		},
+		Failed: func(output interface{}) bool {
+			state := output.(*StatefulNodeState)
+			if statefulNodeStatus(state) == StatefulNodeStatusError {
+				return true
+			}
+			if r := state.GetErrorReason(); r != "" && r != errorReason {
+				return true
+			}
+			if r := state.GetRollbackReason(); r != "" && r != rollbackReason {
+				return true
+			}
+			return false
+		},
+		Reenter: action == StatefulNodeActionRecycle,
+	}
+
+	out, err := t.Run(ctx, opts...)
+	last, _ := out.(*StatefulNodeState)
+	if errors.Is(err, waiter.ErrFailureState) {
+		err = &StateTransitionError{
+			ID:             spotinst.StringValue(input.ID),
+			Action:         action,
+			Status:         statefulNodeStatus(last),
+			ErrorReason:    last.GetErrorReason(),
+			RollbackReason: last.GetRollbackReason(),
+			Err:            err,
+		}
+	}
+	return last, err
+}
+
+func statefulNodeStatus(state *StatefulNodeState) StatefulNodeStatus {
+	return StatefulNodeStatus(strings.ToUpper(state.GetStatus()))
+}
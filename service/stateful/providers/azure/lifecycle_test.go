@@ -0,0 +1,164 @@
+package azure
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+// fakeClock advances instantly when slept on.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.now = c.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+type fakeStatefulNodes struct {
+	Service
+
+	// states are returned by successive GetState calls, the last one
+	// repeated. The first one, or the first two for a recycle, are read
+	// before the state is updated.
+	states []*StatefulNodeState
+
+	n       int
+	updated string
+}
+
+func (f *fakeStatefulNodes) GetState(context.Context, *GetStatefulNodeStateInput) (*GetStatefulNodeStateOutput, error) {
+	state := f.states[f.n]
+	if f.n < len(f.states)-1 {
+		f.n++
+	}
+	return &GetStatefulNodeStateOutput{StatefulNodeState: state}, nil
+}
+
+func (f *fakeStatefulNodes) UpdateState(_ context.Context, input *UpdateStatefulNodeStateInput) (*UpdateStatefulNodeStateOutput, error) {
+	f.updated = spotinst.StringValue(input.StatefulNodeState)
+	return &UpdateStatefulNodeStateOutput{}, nil
+}
+
+func nodeState(status StatefulNodeStatus, errorReason, rollbackReason string) *StatefulNodeState {
+	state := &StatefulNodeState{Status: spotinst.String(string(status))}
+	if errorReason != "" {
+		state.ErrorReason = spotinst.String(errorReason)
+	}
+	if rollbackReason != "" {
+		state.RollbackReason = spotinst.String(rollbackReason)
+	}
+	return state
+}
+
+func TestUpdateStateAndWait(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		action   StatefulNodeAction
+		states   []*StatefulNodeState
+		status   StatefulNodeStatus
+		rollback bool
+		errIs    error
+	}{
+		{
+			name:   "paused",
+			action: StatefulNodeActionPause,
+			states: []*StatefulNodeState{
+				nodeState(StatefulNodeStatusActive, "", ""),
+				nodeState(StatefulNodeStatusPausing, "", ""),
+				nodeState(StatefulNodeStatusPaused, "", ""),
+			},
+			status: StatefulNodeStatusPaused,
+		},
+		{
+			// A recycle reads the state once more before the update, and
+			// isn't done until the node has left ACTIVE.
+			name:   "recycled from active",
+			action: StatefulNodeActionRecycle,
+			states: []*StatefulNodeState{
+				nodeState(StatefulNodeStatusActive, "", ""),
+				nodeState(StatefulNodeStatusActive, "", ""),
+				nodeState(StatefulNodeStatusActive, "", ""),
+				nodeState(StatefulNodeStatusRecycling, "", ""),
+				nodeState(StatefulNodeStatusActive, "", ""),
+			},
+			status: StatefulNodeStatusActive,
+		},
+		{
+			name:   "old reasons ignored",
+			action: StatefulNodeActionResume,
+			states: []*StatefulNodeState{
+				nodeState(StatefulNodeStatusPaused, "quota exceeded", ""),
+				nodeState(StatefulNodeStatusResuming, "quota exceeded", ""),
+				nodeState(StatefulNodeStatusActive, "quota exceeded", ""),
+			},
+			status: StatefulNodeStatusActive,
+		},
+		{
+			name:   "error",
+			action: StatefulNodeActionResume,
+			states: []*StatefulNodeState{
+				nodeState(StatefulNodeStatusPaused, "", ""),
+				nodeState(StatefulNodeStatusError, "", ""),
+			},
+			status: StatefulNodeStatusError,
+			errIs:  waiter.ErrFailureState,
+		},
+		{
+			name:   "rolled back",
+			action: StatefulNodeActionPause,
+			states: []*StatefulNodeState{
+				nodeState(StatefulNodeStatusActive, "", ""),
+				nodeState(StatefulNodeStatusPausing, "", ""),
+				nodeState(StatefulNodeStatusActive, "", "disk detach failed"),
+			},
+			status:   StatefulNodeStatusActive,
+			rollback: true,
+			errIs:    waiter.ErrFailureState,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &fakeStatefulNodes{states: tt.states}
+			input := &UpdateStatefulNodeStateInput{ID: spotinst.String("ssn-1")}
+			input.SetStatefulNodeStateEnum(tt.action)
+
+			state, err := updateStateAndWait(context.Background(), svc, input, []waiter.Option{
+				waiter.WithClock(&fakeClock{now: time.Unix(0, 0)}),
+				waiter.WithMaxDuration(time.Hour),
+			})
+			if !errors.Is(err, tt.errIs) || (tt.errIs == nil && err != nil) {
+				t.Fatalf("got error %v, want %v", err, tt.errIs)
+			}
+			if svc.updated != string(tt.action) {
+				t.Errorf("got state updated to %q, want %q", svc.updated, tt.action)
+			}
+			if got := statefulNodeStatus(state); got != tt.status {
+				t.Errorf("got status %s, want %s", got, tt.status)
+			}
+			if svc.n != len(tt.states)-1 {
+				t.Errorf("done after %d reads, want %d", svc.n+1, len(tt.states))
+			}
+
+			var terr *StateTransitionError
+			if errors.As(err, &terr) {
+				if terr.ID != "ssn-1" || terr.Action != tt.action || terr.Status != tt.status {
+					t.Errorf("got error %+v", terr)
+				}
+				if terr.IsRollback() != tt.rollback {
+					t.Errorf("got rollback %v, want %v", terr.IsRollback(), tt.rollback)
+				}
+			} else if tt.errIs != nil {
+				t.Errorf("got error %T, want *StateTransitionError", err)
+			}
+		})
+	}
+}
@@ -5,7 +5,9 @@ import (
 
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/informer"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
 )
 
 // Service provides the API operation methods for making requests to endpoints
@@ -17,11 +19,14 @@ type Service interface {
 	Update(context.Context, *UpdateStatefulNodeInput) (*UpdateStatefulNodeOutput, error)
 	Delete(context.Context, *DeleteStatefulNodeInput) (*DeleteStatefulNodeOutput, error)
 	List(context.Context, *ListStatefulNodesInput) (*ListStatefulNodesOutput, error)
+	NewStatefulNodeInformer(informer.Config) *informer.Informer
 	UpdateState(context.Context, *UpdateStatefulNodeStateInput) (*UpdateStatefulNodeStateOutput, error)
+	UpdateStateAndWait(context.Context, *UpdateStatefulNodeStateInput, ...waiter.Option) (*StatefulNodeState, error)
 	DetachDataDisk(context.Context, *DetachStatefulNodeDataDiskInput) (*DetachStatefulNodeDataDiskOutput, error)
 	AttachDataDisk(context.Context, *AttachStatefulNodeDataDiskInput) (*AttachStatefulNodeDataDiskOutput, error)
 	ImportVM(context.Context, *ImportVMStatefulNodeInput) (*ImportVMStatefulNodeOutput, error)
 	GetState(context.Context, *GetStatefulNodeStateInput) (*GetStatefulNodeStateOutput, error)
+	WaitUntilStatefulNodeStatus(context.Context, *GetStatefulNodeStateInput, StatefulNodeStatus, ...waiter.Option) error
 }
 
 type ServiceOp struct {
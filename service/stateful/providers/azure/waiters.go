@@ -0,0 +1,36 @@
+package azure
+
+import (
+	"context"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+// WaitUntilStatefulNodeStatus polls GetState until the stateful node reaches
+// the given status. It returns an error wrapping waiter.ErrFailureState if
+// the node reaches the ERROR status instead.
+func (s *ServiceOp) WaitUntilStatefulNodeStatus(ctx context.Context, input *GetStatefulNodeStateInput, status StatefulNodeStatus, opts ...waiter.Option) error {
+	w := &waiter.Waiter{
+		Name: "StatefulNodeStatus" + status.String(),
+		Poll: func(ctx context.Context) (interface{}, error) {
+			return s.GetState(ctx, input)
+		},
+		Acceptors: []waiter.Acceptor{
+			{
+				State:   waiter.Success,
+				Matcher: waiter.PathMatcher("StatefulNodeState.Status", status.String()),
+			},
+			{
+				State:   waiter.Failure,
+				Matcher: waiter.PathMatcher("StatefulNodeState.Status", StatefulNodeStatusError.String()),
+			},
+		},
+		Options: waiter.DefaultOptions(),
+	}
+	w.Options.MinDelay = 10 * time.Second
+	w.Options.MaxDelay = 30 * time.Second
+
+	_, err := w.Apply(opts...).Wait(ctx)
+	return err
+}
@@ -0,0 +1,101 @@
+// Code generated by gettergen. DO NOT EDIT.
+
+package subscription
+
+func (o *CreateSubscriptionInput) GetSubscription() *Subscription {
+	if o == nil {
+		return nil
+	}
+	return o.Subscription
+}
+
+func (o *CreateSubscriptionOutput) GetSubscription() *Subscription {
+	if o == nil {
+		return nil
+	}
+	return o.Subscription
+}
+
+func (o *DeleteSubscriptionInput) GetSubscriptionID() string {
+	if o == nil || o.SubscriptionID == nil {
+		return ""
+	}
+	return *o.SubscriptionID
+}
+
+func (o *ListSubscriptionsOutput) GetSubscriptions() []*Subscription {
+	if o == nil {
+		return nil
+	}
+	return o.Subscriptions
+}
+
+func (o *ReadSubscriptionInput) GetSubscriptionID() string {
+	if o == nil || o.SubscriptionID == nil {
+		return ""
+	}
+	return *o.SubscriptionID
+}
+
+func (o *ReadSubscriptionOutput) GetSubscription() *Subscription {
+	if o == nil {
+		return nil
+	}
+	return o.Subscription
+}
+
+func (o *Subscription) GetId() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *Subscription) GetResourceId() string {
+	if o == nil || o.ResourceID == nil {
+		return ""
+	}
+	return *o.ResourceID
+}
+
+func (o *Subscription) GetEventType() string {
+	if o == nil || o.EventType == nil {
+		return ""
+	}
+	return *o.EventType
+}
+
+func (o *Subscription) GetProtocol() string {
+	if o == nil || o.Protocol == nil {
+		return ""
+	}
+	return *o.Protocol
+}
+
+func (o *Subscription) GetEndpoint() string {
+	if o == nil || o.Endpoint == nil {
+		return ""
+	}
+	return *o.Endpoint
+}
+
+func (o *Subscription) GetFormat() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.Format
+}
+
+func (o *UpdateSubscriptionInput) GetSubscription() *Subscription {
+	if o == nil {
+		return nil
+	}
+	return o.Subscription
+}
+
+func (o *UpdateSubscriptionOutput) GetSubscription() *Subscription {
+	if o == nil {
+		return nil
+	}
+	return o.Subscription
+}
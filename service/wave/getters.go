@@ -0,0 +1,322 @@
+// Code generated by gettergen. DO NOT EDIT.
+
+package wave
+
+import (
+	"time"
+)
+
+func (o *Cluster) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *Cluster) GetClusterIdentifier() string {
+	if o == nil || o.ClusterIdentifier == nil {
+		return ""
+	}
+	return *o.ClusterIdentifier
+}
+
+func (o *Cluster) GetEnvironment() *Environment {
+	if o == nil {
+		return nil
+	}
+	return o.Environment
+}
+
+func (o *Cluster) GetConfig() *Config {
+	if o == nil {
+		return nil
+	}
+	return o.Config
+}
+
+func (o *Cluster) GetState() string {
+	if o == nil || o.State == nil {
+		return ""
+	}
+	return *o.State
+}
+
+func (o *Cluster) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *Cluster) GetUpdatedAt() time.Time {
+	if o == nil || o.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *o.UpdatedAt
+}
+
+func (o *Component) GetUID() string {
+	if o == nil || o.UID == nil {
+		return ""
+	}
+	return *o.UID
+}
+
+func (o *Component) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *Component) GetOperatorVersion() string {
+	if o == nil || o.OperatorVersion == nil {
+		return ""
+	}
+	return *o.OperatorVersion
+}
+
+func (o *Component) GetVersion() string {
+	if o == nil || o.Version == nil {
+		return ""
+	}
+	return *o.Version
+}
+
+func (o *Component) GetProperties() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.Properties
+}
+
+func (o *Component) GetState() string {
+	if o == nil || o.State == nil {
+		return ""
+	}
+	return *o.State
+}
+
+func (o *Config) GetComponents() []*Component {
+	if o == nil {
+		return nil
+	}
+	return o.Components
+}
+
+func (o *DeleteClusterInput) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *DeleteClusterInput) GetShouldDeleteOcean() bool {
+	if o == nil || o.ShouldDeleteOcean == nil {
+		return false
+	}
+	return *o.ShouldDeleteOcean
+}
+
+func (o *Environment) GetOperatorVersion() string {
+	if o == nil || o.OperatorVersion == nil {
+		return ""
+	}
+	return *o.OperatorVersion
+}
+
+func (o *Environment) GetCertManagerDeployed() bool {
+	if o == nil || o.CertManagerDeployed == nil {
+		return false
+	}
+	return *o.CertManagerDeployed
+}
+
+func (o *Environment) GetK8sClusterProvisioned() bool {
+	if o == nil || o.K8sClusterProvisioned == nil {
+		return false
+	}
+	return *o.K8sClusterProvisioned
+}
+
+func (o *Environment) GetOceanClusterProvisioned() bool {
+	if o == nil || o.OceanClusterProvisioned == nil {
+		return false
+	}
+	return *o.OceanClusterProvisioned
+}
+
+func (o *Environment) GetEnvironmentNamespace() string {
+	if o == nil || o.EnvironmentNamespace == nil {
+		return ""
+	}
+	return *o.EnvironmentNamespace
+}
+
+func (o *Environment) GetOceanClusterID() string {
+	if o == nil || o.OceanClusterID == nil {
+		return ""
+	}
+	return *o.OceanClusterID
+}
+
+func (o *ListClustersInput) GetClusterIdentifier() string {
+	if o == nil || o.ClusterIdentifier == nil {
+		return ""
+	}
+	return *o.ClusterIdentifier
+}
+
+func (o *ListClustersInput) GetClusterState() string {
+	if o == nil || o.ClusterState == nil {
+		return ""
+	}
+	return *o.ClusterState
+}
+
+func (o *ListClustersOutput) GetClusters() []*Cluster {
+	if o == nil {
+		return nil
+	}
+	return o.Clusters
+}
+
+func (o *ListSparkApplicationsInput) GetClusterIdentifier() string {
+	if o == nil || o.ClusterIdentifier == nil {
+		return ""
+	}
+	return *o.ClusterIdentifier
+}
+
+func (o *ListSparkApplicationsInput) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *ListSparkApplicationsInput) GetNamespace() string {
+	if o == nil || o.Namespace == nil {
+		return ""
+	}
+	return *o.Namespace
+}
+
+func (o *ListSparkApplicationsInput) GetApplicationID() string {
+	if o == nil || o.ApplicationID == nil {
+		return ""
+	}
+	return *o.ApplicationID
+}
+
+func (o *ListSparkApplicationsInput) GetApplicationState() string {
+	if o == nil || o.ApplicationState == nil {
+		return ""
+	}
+	return *o.ApplicationState
+}
+
+func (o *ListSparkApplicationsInput) GetHeritage() string {
+	if o == nil || o.Heritage == nil {
+		return ""
+	}
+	return *o.Heritage
+}
+
+func (o *ListSparkApplicationsOutput) GetSparkApplications() []*SparkApplication {
+	if o == nil {
+		return nil
+	}
+	return o.SparkApplications
+}
+
+func (o *ReadClusterInput) GetClusterID() string {
+	if o == nil || o.ClusterID == nil {
+		return ""
+	}
+	return *o.ClusterID
+}
+
+func (o *ReadClusterOutput) GetCluster() *Cluster {
+	if o == nil {
+		return nil
+	}
+	return o.Cluster
+}
+
+func (o *ReadSparkApplicationInput) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *ReadSparkApplicationOutput) GetSparkApplication() *SparkApplication {
+	if o == nil {
+		return nil
+	}
+	return o.SparkApplication
+}
+
+func (o *SparkApplication) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
+	}
+	return *o.ID
+}
+
+func (o *SparkApplication) GetApplicationID() string {
+	if o == nil || o.ApplicationID == nil {
+		return ""
+	}
+	return *o.ApplicationID
+}
+
+func (o *SparkApplication) GetClusterIdentifier() string {
+	if o == nil || o.ClusterIdentifier == nil {
+		return ""
+	}
+	return *o.ClusterIdentifier
+}
+
+func (o *SparkApplication) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+func (o *SparkApplication) GetNamespace() string {
+	if o == nil || o.Namespace == nil {
+		return ""
+	}
+	return *o.Namespace
+}
+
+func (o *SparkApplication) GetHeritage() string {
+	if o == nil || o.Heritage == nil {
+		return ""
+	}
+	return *o.Heritage
+}
+
+func (o *SparkApplication) GetApplicationState() string {
+	if o == nil || o.ApplicationState == nil {
+		return ""
+	}
+	return *o.ApplicationState
+}
+
+func (o *SparkApplication) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+func (o *SparkApplication) GetUpdatedAt() time.Time {
+	if o == nil || o.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *o.UpdatedAt
+}
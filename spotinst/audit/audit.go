@@ -0,0 +1,147 @@
+// Package audit records the mutating requests of a client, e.g. to know who
+// changed which group from an automation.
+//
+// A Sink is set on a spotinst.Config:
+//
+//	sink, err := audit.OpenFile("audit.jsonl")
+//	...
+//	sess := session.New(spotinst.DefaultConfig().WithAudit(sink))
+//
+// The client then writes a Record for every request other than GET once it
+// completes, and the actor of the requests is set on their context:
+//
+//	ctx = audit.WithActor(ctx, map[string]string{"user": "jane", "pipeline": "deploy-42"})
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+// A Record describes a mutating request.
+type Record struct {
+	Time time.Time `json:"time"`
+
+	// Account is the ID of the account of the request, if any.
+	Account string `json:"account,omitempty"`
+
+	// Operation is the name of the operation, e.g. "UpdateGroup" or
+	// "RollGroup".
+	Operation string `json:"operation"`
+
+	Method string `json:"method"`
+	Path   string `json:"path"`
+
+	// ResourceID is the ID of the resource the operation applies to, or of
+	// the created resource.
+	ResourceID string `json:"resourceId,omitempty"`
+
+	// RequestID is the ID the API assigned to the request.
+	RequestID string `json:"requestId,omitempty"`
+
+	// StatusCode is the HTTP status code of the response, or 0 if the
+	// request failed without one.
+	StatusCode int `json:"statusCode,omitempty"`
+
+	// DryRun reports whether the request was recorded by a dry run instead
+	// of being sent, in which case StatusCode is that of the synthetic
+	// response.
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// Error is the error of the request, if any.
+	Error string `json:"error,omitempty"`
+
+	// Actor is the actor metadata of the context of the request.
+	Actor map[string]string `json:"actor,omitempty"`
+
+	// Body is the body of the request, with the values of sensitive fields
+	// redacted.
+	Body json.RawMessage `json:"body,omitempty"`
+
+	// Changes are the fields changed by an update, compared to the state of
+	// the resource read right before it.
+	Changes []*Change `json:"changes,omitempty"`
+}
+
+// A Sink stores records. It must be safe for concurrent use.
+type Sink interface {
+	Write(ctx context.Context, rec *Record) error
+}
+
+// SinkFunc is an adapter to allow the use of ordinary functions as sinks.
+type SinkFunc func(ctx context.Context, rec *Record) error
+
+// Write calls f(ctx, rec).
+func (f SinkFunc) Write(ctx context.Context, rec *Record) error { return f(ctx, rec) }
+
+// A FileSink writes records to a file as JSON Lines, i.e. a JSON object per
+// line.
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+	w    *bufio.Writer
+}
+
+// OpenFile returns a FileSink appending records to the file name, which is
+// created if needed.
+func OpenFile(name string) (*FileSink, error) {
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{file: f, w: bufio.NewWriter(f)}, nil
+}
+
+// Write writes rec as a line of the file and flushes it, so that records
+// aren't lost if the process exits.
+func (s *FileSink) Write(_ context.Context, rec *Record) error {
+	b, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.w.Write(append(b, '\n')); err != nil {
+		return err
+	}
+	return s.w.Flush()
+}
+
+// Close closes the file.
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.w.Flush(); err != nil {
+		s.file.Close()
+		return err
+	}
+	return s.file.Close()
+}
+
+type actorKey struct{}
+
+// WithActor returns a copy of ctx carrying the actor metadata of the
+// requests made with it, merged with the metadata already in ctx.
+func WithActor(ctx context.Context, actor map[string]string) context.Context {
+	merged := make(map[string]string)
+	for k, v := range Actor(ctx) {
+		merged[k] = v
+	}
+	for k, v := range actor {
+		merged[k] = v
+	}
+	return context.WithValue(ctx, actorKey{}, merged)
+}
+
+// Actor returns the actor metadata of ctx, or nil if there is none.
+func Actor(ctx context.Context) map[string]string {
+	actor, _ := ctx.Value(actorKey{}).(map[string]string)
+	return actor
+}
@@ -0,0 +1,142 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFileSink(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "audit.jsonl")
+	sink, err := OpenFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := WithActor(context.Background(), map[string]string{"user": "jane"})
+	ctx = WithActor(ctx, map[string]string{"pipeline": "deploy-42"})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rec := &Record{
+				Time:       time.Now(),
+				Operation:  "UpdateGroup",
+				Method:     "PUT",
+				Path:       "/aws/ec2/group/sig-12345",
+				ResourceID: "sig-12345",
+				Actor:      Actor(ctx),
+			}
+			if err := sink.Write(ctx, rec); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Records are appended to existing files.
+	sink, err = OpenFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Write(ctx, &Record{Operation: "DeleteGroup"}); err != nil {
+		t.Fatal(err)
+	}
+	sink.Close()
+
+	f, err := os.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var recs []*Record
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		rec := new(Record)
+		if err := json.Unmarshal(scanner.Bytes(), rec); err != nil {
+			t.Fatalf("invalid line %q: %v", scanner.Text(), err)
+		}
+		recs = append(recs, rec)
+	}
+	if len(recs) != 11 {
+		t.Fatalf("got %d records, want 11", len(recs))
+	}
+	want := map[string]string{"user": "jane", "pipeline": "deploy-42"}
+	if !reflect.DeepEqual(recs[0].Actor, want) {
+		t.Errorf("got actor %v, want %v", recs[0].Actor, want)
+	}
+	if recs[10].Operation != "DeleteGroup" {
+		t.Errorf("got operation %q, want DeleteGroup", recs[10].Operation)
+	}
+}
+
+func decode(t *testing.T, s string) interface{} {
+	t.Helper()
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestRedactAndDiff(t *testing.T) {
+	previous := decode(t, `{
+		"id": "sig-12345",
+		"name": "web",
+		"capacity": {"minimum": 1, "maximum": 5, "target": 2},
+		"compute": {"launchSpecification": {"userData": "b2xk", "securityGroupIds": ["sg-1"]}},
+		"thirdPartiesIntegration": {"rancher": {"accessKey": "old", "secretKey": "old"}}
+	}`)
+	update := decode(t, `{
+		"capacity": {"maximum": 10, "target": 2},
+		"description": "web servers",
+		"compute": {"launchSpecification": {"userData": "bmV3", "securityGroupIds": ["sg-1", "sg-2"]}},
+		"thirdPartiesIntegration": {"rancher": {"accessKey": "new", "secretKey": null}}
+	}`)
+
+	changes := Diff(Redact(previous), Redact(update))
+	want := []*Change{
+		{Path: "capacity.maximum", Old: float64(5), New: float64(10)},
+		{Path: "compute.launchSpecification.securityGroupIds", Old: []interface{}{"sg-1"}, New: []interface{}{"sg-1", "sg-2"}},
+		{Path: "description", Old: nil, New: "web servers"},
+		{Path: "thirdPartiesIntegration.rancher.secretKey", Old: Redacted, New: nil},
+	}
+	if len(changes) != len(want) {
+		b, _ := json.Marshal(changes)
+		t.Fatalf("got changes %s", b)
+	}
+	for i, c := range changes {
+		if !reflect.DeepEqual(c, want[i]) {
+			t.Errorf("got change %+v, want %+v", c, want[i])
+		}
+	}
+}
+
+func TestOperationName(t *testing.T) {
+	for _, tt := range []struct {
+		method, input, kind, want string
+	}{
+		{"PUT", "UpdateGroupInput", "group", "UpdateGroup"},
+		{"PUT", "RollGroupInput", "group", "RollGroup"},
+		{"DELETE", "", "group", "DeleteGroup"},
+		{"DELETE", "", "launchSpec", "DeleteLaunchSpec"},
+		{"POST", "", "", "Create"},
+		{"OPTIONS", "", "group", "OptionsGroup"},
+	} {
+		if got := OperationName(tt.method, tt.input, tt.kind); got != tt.want {
+			t.Errorf("OperationName(%q, %q, %q) = %q, want %q", tt.method, tt.input, tt.kind, got, tt.want)
+		}
+	}
+}
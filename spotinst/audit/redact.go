@@ -0,0 +1,130 @@
+package audit
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Redacted replaces the values of sensitive fields.
+const Redacted = "REDACTED"
+
+// SensitiveFields are the case-insensitive substrings of the names of the
+// fields whose values are redacted, e.g. "userData" or "apiToken".
+var SensitiveFields = []string{
+	"password",
+	"secret",
+	"token",
+	"userdata",
+	"privatekey",
+	"accesskey",
+	"apikey",
+	"credential",
+}
+
+// Redact returns a copy of v, a value decoded from JSON, whose sensitive
+// fields have their values replaced by Redacted.
+func Redact(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			if isSensitive(k) && e != nil {
+				out[k] = Redacted
+			} else {
+				out[k] = Redact(e)
+			}
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, e := range v {
+			out[i] = Redact(e)
+		}
+		return out
+	}
+	return v
+}
+
+func isSensitive(name string) bool {
+	name = strings.ToLower(name)
+	for _, s := range SensitiveFields {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// A Change is a field changed by an update.
+type Change struct {
+	// Path is the JSON path of the field, e.g. "capacity.target".
+	Path string `json:"path"`
+
+	// Old is the value of the field before the update, or nil if it wasn't
+	// set.
+	Old interface{} `json:"old"`
+
+	// New is the value of the field in the update, or nil if the update
+	// clears it.
+	New interface{} `json:"new"`
+}
+
+// Diff returns the changes made by update to previous, both decoded from
+// JSON. Only the fields of update are compared, since fields missing from an
+// update are left as-is. Arrays are compared as a whole.
+func Diff(previous, update interface{}) []*Change {
+	var changes []*Change
+	diff(&changes, "", previous, update)
+	return changes
+}
+
+func diff(changes *[]*Change, path string, old, new interface{}) {
+	if n, ok := new.(map[string]interface{}); ok {
+		if o, ok := old.(map[string]interface{}); ok {
+			keys := make([]string, 0, len(n))
+			for k := range n {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				p := k
+				if path != "" {
+					p = path + "." + k
+				}
+				diff(changes, p, o[k], n[k])
+			}
+			return
+		}
+	}
+	if !reflect.DeepEqual(old, new) {
+		*changes = append(*changes, &Change{Path: path, Old: old, New: new})
+	}
+}
+
+// OperationName returns the name of an operation from the type name of its
+// input, e.g. "UpdateGroup" for "UpdateGroupInput", or, if it has none, from
+// its method and the kind of its resource, e.g. "DeleteGroup" for DELETE
+// requests of "/aws/ec2/group/sig-12345".
+func OperationName(method, inputType, kind string) string {
+	if inputType != "" {
+		return strings.TrimSuffix(inputType, "Input")
+	}
+	verb := map[string]string{
+		"POST":   "Create",
+		"PUT":    "Update",
+		"PATCH":  "Update",
+		"DELETE": "Delete",
+	}[strings.ToUpper(method)]
+	if verb == "" {
+		verb = capitalize(strings.ToLower(method))
+	}
+	return verb + capitalize(kind)
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
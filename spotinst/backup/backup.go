@@ -0,0 +1,260 @@
+// Package backup exports the resources of an account to a directory and
+// restores them, possibly into another account.
+//
+// A backup is a directory holding an index and a manifest per resource:
+//
+//	backup/
+//	  index.json
+//	  ElastigroupAWS/sig-12345.yaml
+//	  OceanAWS/o-12345.yaml
+//	  OceanAWSLaunchSpec/ols-12345.yaml
+//	  OceanCDStrategy/canary.yaml
+//	  ...
+//
+// The index records the version of the layout and the kind, ID, name and
+// file of every resource. Restore recreates the resources in dependency
+// order, e.g. clusters before their launch specs, and rewrites the
+// references between them to the IDs of the recreated resources.
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"time"
+
+	elastigroupaws "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	oceanaws "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/manifest"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+)
+
+// Version is the version of the layout written by Export.
+const Version = 1
+
+// IndexFile is the name of the index of a backup.
+const IndexFile = "index.json"
+
+var (
+	// ErrUnsupportedVersion is returned when restoring a backup with an
+	// unknown layout version.
+	ErrUnsupportedVersion = errors.New("backup: unsupported version")
+
+	// ErrExists is returned when exporting to a directory that already holds
+	// a backup.
+	ErrExists = errors.New("backup: directory already holds a backup")
+)
+
+// An Index describes the content of a backup.
+type Index struct {
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"createdAt"`
+	Resources []*Entry  `json:"resources"`
+}
+
+// An Entry describes a resource of a backup.
+type Entry struct {
+	Kind manifest.Kind `json:"kind"`
+	ID   string        `json:"id,omitempty"`
+	Name string        `json:"name,omitempty"`
+
+	// File is the slash-separated path of the manifest of the resource,
+	// relative to the backup directory.
+	File string `json:"file"`
+}
+
+// Export writes all the supported resources of the account of sess to dir,
+// which is created if needed, and returns the index of the backup.
+func Export(ctx context.Context, sess *session.Session, dir string, cfgs ...*spotinst.Config) (*Index, error) {
+	return export(ctx, newHandlers(sess, cfgs...), dir)
+}
+
+// Restore recreates the resources of the backup in dir in the account of
+// sess, and returns a map of the IDs of the backed up resources to the IDs
+// of the recreated ones. It stops at the first failure, in which case the
+// returned map holds the resources recreated so far.
+func Restore(ctx context.Context, sess *session.Session, dir string, cfgs ...*spotinst.Config) (map[string]string, error) {
+	return restore(ctx, newHandlers(sess, cfgs...), dir)
+}
+
+// ReadIndex reads the index of the backup in dir.
+func ReadIndex(dir string) (*Index, error) {
+	b, err := os.ReadFile(filepath.Join(dir, IndexFile))
+	if err != nil {
+		return nil, err
+	}
+	index := new(Index)
+	if err := json.Unmarshal(b, index); err != nil {
+		return nil, fmt.Errorf("backup: invalid index: %v", err)
+	}
+	if index.Version != Version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, index.Version)
+	}
+	return index, nil
+}
+
+func export(ctx context.Context, handlers []*handler, dir string) (*Index, error) {
+	indexPath := filepath.Join(dir, IndexFile)
+	if _, err := os.Stat(indexPath); err == nil {
+		return nil, fmt.Errorf("%w: %s", ErrExists, dir)
+	}
+
+	index := &Index{Version: Version, CreatedAt: time.Now().UTC()}
+	files := make(map[string]bool)
+	for _, h := range handlers {
+		objs, err := h.list(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("backup: failed to list %s resources: %w", h.kind, err)
+		}
+		if len(objs) == 0 {
+			continue
+		}
+		if err := os.MkdirAll(filepath.Join(dir, string(h.kind)), 0755); err != nil {
+			return nil, err
+		}
+
+		for _, obj := range objs {
+			id, name := manifest.Identity(obj)
+			e := &Entry{Kind: h.kind, ID: id, Name: name, File: uniqueFile(files, h.kind, id, name)}
+			m := &manifest.Manifest{APIVersion: manifest.APIVersion, Kind: h.kind, Spec: obj}
+			if err := manifest.SaveFile(filepath.Join(dir, filepath.FromSlash(e.File)), m); err != nil {
+				return nil, fmt.Errorf("backup: failed to write %s: %w", e.File, err)
+			}
+			index.Resources = append(index.Resources, e)
+		}
+	}
+
+	b, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(indexPath, append(b, '\n'), 0644); err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+func restore(ctx context.Context, handlers []*handler, dir string) (map[string]string, error) {
+	index, err := ReadIndex(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	supported := make(map[manifest.Kind]bool, len(handlers))
+	for _, h := range handlers {
+		supported[h.kind] = true
+	}
+	for _, e := range index.Resources {
+		if !supported[e.Kind] {
+			return nil, fmt.Errorf("backup: unsupported kind %q", e.Kind)
+		}
+	}
+
+	ids := make(map[string]string)
+	for _, h := range handlers {
+		for _, e := range index.Resources {
+			if e.Kind != h.kind {
+				continue
+			}
+
+			ms, err := manifest.LoadFile(filepath.Join(dir, filepath.FromSlash(e.File)))
+			if err != nil {
+				return ids, fmt.Errorf("backup: failed to read %s: %w", e.File, err)
+			}
+			if len(ms) != 1 || ms[0].Kind != e.Kind {
+				return ids, fmt.Errorf("backup: %s: expected a single %s manifest", e.File, e.Kind)
+			}
+
+			obj := ms[0].Spec
+			clearReadOnly(obj)
+			remap(obj, ids)
+
+			created, err := h.create(ctx, obj)
+			if err != nil {
+				return ids, fmt.Errorf("backup: failed to restore %s %q: %w", e.Kind, e.File, err)
+			}
+			if e.ID != "" {
+				id, _ := manifest.Identity(created)
+				ids[e.ID] = id
+			}
+		}
+	}
+	return ids, nil
+}
+
+// readOnlyFields are the fields cleared before a resource is recreated, for
+// the kinds without a helper of their own.
+var readOnlyFields = []string{"ID", "PolicyID", "CreatedAt", "UpdatedAt"}
+
+// referenceFields are the fields holding the IDs of other resources.
+var referenceFields = []string{"OceanID", "OceanId", "ResourceID"}
+
+// clearReadOnly clears the read-only fields of a model, with the helper of
+// its service if it has one.
+func clearReadOnly(obj interface{}) {
+	switch obj := obj.(type) {
+	case *elastigroupaws.Group:
+		elastigroupaws.ClearReadOnly(obj)
+		return
+	case *oceanaws.Cluster:
+		oceanaws.ClearClusterReadOnly(obj)
+		return
+	case *oceanaws.LaunchSpec:
+		oceanaws.ClearLaunchSpecReadOnly(obj)
+		return
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(obj))
+	for _, name := range readOnlyFields {
+		if f := v.FieldByName(name); f.IsValid() && f.CanSet() {
+			f.Set(reflect.Zero(f.Type()))
+		}
+	}
+}
+
+// remap rewrites the references of a model to other resources with their
+// new IDs.
+func remap(obj interface{}, ids map[string]string) {
+	v := reflect.Indirect(reflect.ValueOf(obj))
+	for _, name := range referenceFields {
+		f := v.FieldByName(name)
+		if !f.IsValid() || f.Type() != reflect.TypeOf((*string)(nil)) || f.IsNil() {
+			continue
+		}
+		if id, ok := ids[f.Elem().String()]; ok {
+			f.Set(reflect.ValueOf(spotinst.String(id)))
+		}
+	}
+}
+
+var unsafeChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// uniqueFile returns a unique file name for a resource, based on its ID, or
+// on its name if it has none.
+func uniqueFile(files map[string]bool, kind manifest.Kind, id, name string) string {
+	base := id
+	if base == "" {
+		base = name
+	}
+	base = unsafeChars.ReplaceAllString(base, "_")
+	if base == "" {
+		base = "resource"
+	}
+
+	file := path.Join(string(kind), base+".yaml")
+	for i := 2; files[file]; i++ {
+		file = path.Join(string(kind), fmt.Sprintf("%s-%d.yaml", base, i))
+	}
+	files[file] = true
+	return file
+}
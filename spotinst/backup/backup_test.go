@@ -0,0 +1,132 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	elastigroupaws "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	oceanaws "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/service/oceancd"
+	"github.com/spotinst/spotinst-sdk-go/service/subscription"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/manifest"
+)
+
+// fakeHandler returns a handler listing objs and recording the created
+// resources, to which it assigns IDs prefixed with "new-".
+func fakeHandler(kind manifest.Kind, created *[]interface{}, objs ...interface{}) *handler {
+	return &handler{
+		kind: kind,
+		list: func(context.Context) ([]interface{}, error) { return objs, nil },
+		create: func(_ context.Context, obj interface{}) (interface{}, error) {
+			*created = append(*created, obj)
+			switch v := obj.(type) {
+			case *oceanaws.Cluster:
+				v.SetId(spotinst.String(fmt.Sprintf("new-o-%d", len(*created))))
+			case *oceanaws.LaunchSpec:
+				v.SetId(spotinst.String(fmt.Sprintf("new-ols-%d", len(*created))))
+			case *elastigroupaws.Group:
+				v.SetId(spotinst.String(fmt.Sprintf("new-sig-%d", len(*created))))
+			case *subscription.Subscription:
+				v.SetId(spotinst.String(fmt.Sprintf("new-sis-%d", len(*created))))
+			}
+			return obj, nil
+		},
+	}
+}
+
+func TestExportRestore(t *testing.T) {
+	var created []interface{}
+	handlers := []*handler{
+		fakeHandler(manifest.KindElastigroupAWS, &created,
+			&elastigroupaws.Group{ID: spotinst.String("sig-1"), Name: spotinst.String("web"),
+				Compute: &elastigroupaws.Compute{LaunchSpecification: &elastigroupaws.LaunchSpecification{
+					NetworkInterfaces: []*elastigroupaws.NetworkInterface{{ID: spotinst.String("eni-1")}},
+				}}}),
+		fakeHandler(manifest.KindOceanAWS, &created,
+			&oceanaws.Cluster{ID: spotinst.String("o-1"), Name: spotinst.String("prod")}),
+		fakeHandler(manifest.KindOceanAWSLaunchSpec, &created,
+			&oceanaws.LaunchSpec{ID: spotinst.String("ols-1"), Name: spotinst.String("default"), OceanID: spotinst.String("o-1")},
+			&oceanaws.LaunchSpec{ID: spotinst.String("ols-2"), Name: spotinst.String("gpu"), OceanID: spotinst.String("o-1")}),
+		fakeHandler(manifest.KindSubscription, &created,
+			&subscription.Subscription{ID: spotinst.String("sis-1"), ResourceID: spotinst.String("sig-1"), EventType: spotinst.String("AWS_EC2_INSTANCE_TERMINATE")}),
+		fakeHandler(manifest.KindOceanCDStrategy, &created,
+			&oceancd.Strategy{Name: spotinst.String("canary/v1")}),
+	}
+
+	dir := filepath.Join(t.TempDir(), "backup")
+	ctx := context.Background()
+	index, err := export(ctx, handlers, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(index.Resources) != 6 {
+		t.Fatalf("got %d resources, want 6", len(index.Resources))
+	}
+	for _, file := range []string{IndexFile, "OceanAWS/o-1.yaml", "OceanAWSLaunchSpec/ols-2.yaml", "OceanCDStrategy/canary_v1.yaml"} {
+		if _, err := os.Stat(filepath.Join(dir, file)); err != nil {
+			t.Errorf("expected %s to exist: %v", file, err)
+		}
+	}
+
+	if _, err := export(ctx, handlers, dir); !errors.Is(err, ErrExists) {
+		t.Errorf("got error %v, want ErrExists", err)
+	}
+
+	read, err := ReadIndex(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(read.Resources) != len(index.Resources) {
+		t.Fatalf("got %d resources in index, want %d", len(read.Resources), len(index.Resources))
+	}
+
+	created = nil
+	ids, err := restore(ctx, handlers, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(created) != 6 {
+		t.Fatalf("got %d created resources, want 6", len(created))
+	}
+
+	want := map[string]string{
+		"sig-1": "new-sig-1",
+		"o-1":   "new-o-2",
+		"ols-1": "new-ols-3",
+		"ols-2": "new-ols-4",
+		"sis-1": "new-sis-5",
+	}
+	for old, id := range want {
+		if ids[old] != id {
+			t.Errorf("got %s => %q, want %q", old, ids[old], id)
+		}
+	}
+
+	group := created[0].(*elastigroupaws.Group)
+	if ni := group.Compute.LaunchSpecification.NetworkInterfaces[0]; ni.ID != nil {
+		t.Errorf("got network interface ID %q, want none", *ni.ID)
+	}
+	ls := created[2].(*oceanaws.LaunchSpec)
+	if got := ls.GetOceanId(); got != "new-o-2" {
+		t.Errorf("got launch spec oceanId %q, want new-o-2", got)
+	}
+	sub := created[4].(*subscription.Subscription)
+	if got := sub.GetResourceId(); got != "new-sig-1" {
+		t.Errorf("got subscription resourceId %q, want new-sig-1", got)
+	}
+}
+
+func TestRestoreUnsupportedVersion(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, IndexFile), []byte(`{"version":99}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := restore(context.Background(), nil, dir); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("got error %v, want ErrUnsupportedVersion", err)
+	}
+}
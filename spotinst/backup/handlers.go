@@ -0,0 +1,255 @@
+package backup
+
+import (
+	"context"
+
+	elastigroupaws "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	elastigroupazurev3 "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/azure/v3"
+	elastigroupgcp "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/service/healthcheck"
+	oceanaws "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	oceanazurenp "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/azure_np"
+	oceangcp "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/right_sizing"
+	"github.com/spotinst/spotinst-sdk-go/service/oceancd"
+	"github.com/spotinst/spotinst-sdk-go/service/organization"
+	"github.com/spotinst/spotinst-sdk-go/service/subscription"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/manifest"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+)
+
+// A handler lists and creates the resources of a kind.
+type handler struct {
+	kind manifest.Kind
+
+	// list lists the resources of the kind.
+	list manifest.ListFunc
+
+	// create creates a resource and returns the created resource.
+	create func(ctx context.Context, obj interface{}) (interface{}, error)
+}
+
+// newHandlers returns the handlers of the supported kinds, in dependency
+// order: the resources of a kind may only refer to the resources of the
+// kinds before it.
+func newHandlers(sess *session.Session, cfgs ...*spotinst.Config) []*handler {
+	egAWS := elastigroupaws.New(sess, cfgs...)
+	egGCP := elastigroupgcp.New(sess, cfgs...)
+	egAzure := elastigroupazurev3.New(sess, cfgs...)
+	oceanAWS := oceanaws.New(sess, cfgs...)
+	oceanGCP := oceangcp.New(sess, cfgs...)
+	oceanAKS := oceanazurenp.New(sess, cfgs...)
+	rightsizing := right_sizing.New(sess, cfgs...)
+	healthChecks := healthcheck.New(sess, cfgs...)
+	subscriptions := subscription.New(sess, cfgs...)
+	cd := oceancd.New(sess, cfgs...)
+	org := organization.New(sess, cfgs...)
+
+	handlers := []*handler{
+		{
+			kind: manifest.KindElastigroupAWS,
+			create: func(ctx context.Context, obj interface{}) (interface{}, error) {
+				out, err := egAWS.Create(ctx, &elastigroupaws.CreateGroupInput{Group: obj.(*elastigroupaws.Group)})
+				if err != nil {
+					return nil, err
+				}
+				return out.Group, nil
+			},
+		},
+		{
+			kind: manifest.KindElastigroupGCP,
+			create: func(ctx context.Context, obj interface{}) (interface{}, error) {
+				out, err := egGCP.Create(ctx, &elastigroupgcp.CreateGroupInput{Group: obj.(*elastigroupgcp.Group)})
+				if err != nil {
+					return nil, err
+				}
+				return out.Group, nil
+			},
+		},
+		{
+			kind: manifest.KindElastigroupAzureV3,
+			create: func(ctx context.Context, obj interface{}) (interface{}, error) {
+				out, err := egAzure.Create(ctx, &elastigroupazurev3.CreateGroupInput{Group: obj.(*elastigroupazurev3.Group)})
+				if err != nil {
+					return nil, err
+				}
+				return out.Group, nil
+			},
+		},
+		{
+			kind: manifest.KindOceanAWS,
+			create: func(ctx context.Context, obj interface{}) (interface{}, error) {
+				out, err := oceanAWS.CreateCluster(ctx, &oceanaws.CreateClusterInput{Cluster: obj.(*oceanaws.Cluster)})
+				if err != nil {
+					return nil, err
+				}
+				return out.Cluster, nil
+			},
+		},
+		{
+			kind: manifest.KindOceanECS,
+			create: func(ctx context.Context, obj interface{}) (interface{}, error) {
+				out, err := oceanAWS.CreateECSCluster(ctx, &oceanaws.CreateECSClusterInput{Cluster: obj.(*oceanaws.ECSCluster)})
+				if err != nil {
+					return nil, err
+				}
+				return out.Cluster, nil
+			},
+		},
+		{
+			kind: manifest.KindOceanGCP,
+			create: func(ctx context.Context, obj interface{}) (interface{}, error) {
+				out, err := oceanGCP.CreateCluster(ctx, &oceangcp.CreateClusterInput{Cluster: obj.(*oceangcp.Cluster)})
+				if err != nil {
+					return nil, err
+				}
+				return out.Cluster, nil
+			},
+		},
+		{
+			kind: manifest.KindOceanAKSNP,
+			create: func(ctx context.Context, obj interface{}) (interface{}, error) {
+				out, err := oceanAKS.CreateCluster(ctx, &oceanazurenp.CreateClusterInput{Cluster: obj.(*oceanazurenp.Cluster)})
+				if err != nil {
+					return nil, err
+				}
+				return out.Cluster, nil
+			},
+		},
+		{
+			kind: manifest.KindOceanAWSLaunchSpec,
+			create: func(ctx context.Context, obj interface{}) (interface{}, error) {
+				out, err := oceanAWS.CreateLaunchSpec(ctx, &oceanaws.CreateLaunchSpecInput{LaunchSpec: obj.(*oceanaws.LaunchSpec)})
+				if err != nil {
+					return nil, err
+				}
+				return out.LaunchSpec, nil
+			},
+		},
+		{
+			kind: manifest.KindOceanECSLaunchSpec,
+			create: func(ctx context.Context, obj interface{}) (interface{}, error) {
+				out, err := oceanAWS.CreateECSLaunchSpec(ctx, &oceanaws.CreateECSLaunchSpecInput{LaunchSpec: obj.(*oceanaws.ECSLaunchSpec)})
+				if err != nil {
+					return nil, err
+				}
+				return out.LaunchSpec, nil
+			},
+		},
+		{
+			kind: manifest.KindOceanGCPLaunchSpec,
+			create: func(ctx context.Context, obj interface{}) (interface{}, error) {
+				out, err := oceanGCP.CreateLaunchSpec(ctx, &oceangcp.CreateLaunchSpecInput{LaunchSpec: obj.(*oceangcp.LaunchSpec)})
+				if err != nil {
+					return nil, err
+				}
+				return out.LaunchSpec, nil
+			},
+		},
+		{
+			kind: manifest.KindOceanAKSNPVirtualNodeGroup,
+			create: func(ctx context.Context, obj interface{}) (interface{}, error) {
+				out, err := oceanAKS.CreateVirtualNodeGroup(ctx, &oceanazurenp.CreateVirtualNodeGroupInput{
+					VirtualNodeGroup: obj.(*oceanazurenp.VirtualNodeGroup),
+				})
+				if err != nil {
+					return nil, err
+				}
+				return out.VirtualNodeGroup, nil
+			},
+		},
+		{
+			kind: manifest.KindOceanRightsizingRule,
+			create: func(ctx context.Context, obj interface{}) (interface{}, error) {
+				out, err := rightsizing.CreateRightsizingRule(ctx, &right_sizing.CreateRightsizingRuleInput{
+					RightsizingRule: obj.(*right_sizing.RightsizingRule),
+				})
+				if err != nil {
+					return nil, err
+				}
+				return out.RightsizingRule, nil
+			},
+		},
+		{
+			kind: manifest.KindHealthCheck,
+			create: func(ctx context.Context, obj interface{}) (interface{}, error) {
+				out, err := healthChecks.Create(ctx, &healthcheck.CreateHealthCheckInput{HealthCheck: obj.(*healthcheck.HealthCheck)})
+				if err != nil {
+					return nil, err
+				}
+				return out.HealthCheck, nil
+			},
+		},
+		{
+			kind: manifest.KindSubscription,
+			create: func(ctx context.Context, obj interface{}) (interface{}, error) {
+				out, err := subscriptions.Create(ctx, &subscription.CreateSubscriptionInput{Subscription: obj.(*subscription.Subscription)})
+				if err != nil {
+					return nil, err
+				}
+				return out.Subscription, nil
+			},
+		},
+		{
+			kind: manifest.KindOceanCDVerificationProvider,
+			create: func(ctx context.Context, obj interface{}) (interface{}, error) {
+				out, err := cd.CreateVerificationProvider(ctx, &oceancd.CreateVerificationProviderInput{
+					VerificationProvider: obj.(*oceancd.VerificationProvider),
+				})
+				if err != nil {
+					return nil, err
+				}
+				return out.VerificationProvider, nil
+			},
+		},
+		{
+			kind: manifest.KindOceanCDVerificationTemplate,
+			create: func(ctx context.Context, obj interface{}) (interface{}, error) {
+				out, err := cd.CreateVerificationTemplate(ctx, &oceancd.CreateVerificationTemplateInput{
+					VerificationTemplate: obj.(*oceancd.VerificationTemplate),
+				})
+				if err != nil {
+					return nil, err
+				}
+				return out.VerificationTemplate, nil
+			},
+		},
+		{
+			kind: manifest.KindOceanCDStrategy,
+			create: func(ctx context.Context, obj interface{}) (interface{}, error) {
+				out, err := cd.CreateStrategy(ctx, &oceancd.CreateStrategyInput{Strategy: obj.(*oceancd.Strategy)})
+				if err != nil {
+					return nil, err
+				}
+				return out.Strategy, nil
+			},
+		},
+		{
+			kind: manifest.KindOceanCDRolloutSpec,
+			create: func(ctx context.Context, obj interface{}) (interface{}, error) {
+				out, err := cd.CreateRolloutSpec(ctx, &oceancd.CreateRolloutSpecInput{RolloutSpec: obj.(*oceancd.RolloutSpec)})
+				if err != nil {
+					return nil, err
+				}
+				return out.RolloutSpec, nil
+			},
+		},
+		{
+			kind: manifest.KindOrganizationPolicy,
+			create: func(ctx context.Context, obj interface{}) (interface{}, error) {
+				out, err := org.CreatePolicy(ctx, &organization.CreatePolicyInput{Policy: obj.(*organization.Policy)})
+				if err != nil {
+					return nil, err
+				}
+				return out.Policy, nil
+			},
+		},
+	}
+
+	listers := manifest.NewListers(sess, cfgs...)
+	for _, h := range handlers {
+		h.list = listers[h.kind]
+	}
+	return handlers
+}
@@ -0,0 +1,253 @@
+// Package bulk runs an operation on many resources at once.
+//
+// An Executor runs an operation on each of a list of IDs with bounded
+// concurrency, retrying failed items, and reports a result per item. A
+// failure on one item doesn't abort the others.
+package bulk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+)
+
+const (
+	// DefaultConcurrency is the default number of items processed at once.
+	DefaultConcurrency = 5
+
+	// DefaultRetryDelay is the default delay before the first retry of an
+	// item. Subsequent delays double.
+	DefaultRetryDelay = time.Second
+)
+
+// An Operation runs on a single item and returns its output, if any.
+type Operation func(ctx context.Context, id string) (interface{}, error)
+
+// A Status represents the outcome of an item.
+type Status string
+
+const (
+	// StatusSucceeded represents an item whose operation succeeded.
+	StatusSucceeded Status = "succeeded"
+
+	// StatusFailed represents an item whose operation failed on every
+	// attempt.
+	StatusFailed Status = "failed"
+
+	// StatusDryRun represents an item that was skipped because of a dry run.
+	StatusDryRun Status = "dry-run"
+
+	// StatusCanceled represents an item that wasn't processed because the
+	// context was done.
+	StatusCanceled Status = "canceled"
+)
+
+// A Result describes the outcome of an item.
+type Result struct {
+	ID         string        `json:"id"`
+	Status     Status        `json:"status"`
+	Output     interface{}   `json:"output,omitempty"`
+	Error      string        `json:"error,omitempty"`
+	Attempts   int           `json:"attempts"`
+	StartedAt  time.Time     `json:"startedAt"`
+	FinishedAt time.Time     `json:"finishedAt"`
+	Duration   time.Duration `json:"duration"`
+
+	// Err is the error of the last attempt.
+	Err error `json:"-"`
+}
+
+// An Executor runs an operation on many items.
+type Executor struct {
+	// Concurrency is the number of items processed at once. Defaults to
+	// DefaultConcurrency.
+	Concurrency int
+
+	// Retries is the number of retries of a failed item.
+	Retries int
+
+	// RetryDelay is the delay before the first retry of an item. Defaults
+	// to DefaultRetryDelay.
+	RetryDelay time.Duration
+
+	// Retryable reports whether an error is worth retrying. Defaults to
+	// IsRetryable.
+	Retryable func(error) bool
+
+	// DryRun skips the operation and reports every item as StatusDryRun.
+	DryRun bool
+
+	// OnResult, if set, is called with the result of each item as soon as
+	// it's known. Calls are serialized.
+	OnResult func(*Result)
+}
+
+// Run runs op on each item and returns a report with the results in the
+// order of ids.
+func (e *Executor) Run(ctx context.Context, ids []string, op Operation) *Report {
+	concurrency := e.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	report := &Report{Results: make([]*Result, len(ids))}
+	var (
+		wg  sync.WaitGroup
+		mu  sync.Mutex
+		sem = make(chan struct{}, concurrency)
+	)
+	for i, id := range ids {
+		i, id := i, id
+
+		// Once ctx is done, the remaining items are reported as canceled
+		// without waiting for a slot.
+		acquired := false
+		select {
+		case sem <- struct{}{}:
+			acquired = true
+		case <-ctx.Done():
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if acquired {
+				defer func() { <-sem }()
+			}
+
+			var r *Result
+			if ctx.Err() == nil {
+				r = e.run(ctx, id, op)
+			} else {
+				r = &Result{ID: id, Status: StatusCanceled, Err: ctx.Err(), Error: ctx.Err().Error()}
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Results[i] = r
+			if e.OnResult != nil {
+				e.OnResult(r)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return report
+}
+
+func (e *Executor) run(ctx context.Context, id string, op Operation) *Result {
+	r := &Result{ID: id, StartedAt: time.Now()}
+	defer func() {
+		r.FinishedAt = time.Now()
+		r.Duration = r.FinishedAt.Sub(r.StartedAt)
+	}()
+
+	if e.DryRun {
+		r.Status = StatusDryRun
+		return r
+	}
+
+	retryable := e.Retryable
+	if retryable == nil {
+		retryable = IsRetryable
+	}
+	delay := e.RetryDelay
+	if delay <= 0 {
+		delay = DefaultRetryDelay
+	}
+
+	for {
+		r.Attempts++
+		r.Output, r.Err = op(ctx, id)
+		if r.Err == nil {
+			r.Status = StatusSucceeded
+			return r
+		}
+		if r.Attempts > e.Retries || !retryable(r.Err) {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			r.Err = errors.Join(r.Err, ctx.Err())
+		case <-time.After(delay):
+			delay *= 2
+			continue
+		}
+		break
+	}
+
+	r.Status = StatusFailed
+	r.Error = r.Err.Error()
+	return r
+}
+
+// IsRetryable reports whether err is an API error with status 429 Too Many
+// Requests or a 5xx status.
+func IsRetryable(err error) bool {
+	var errs client.Errors
+	if !errors.As(err, &errs) {
+		return false
+	}
+	for _, e := range errs {
+		if e.Response == nil {
+			continue
+		}
+		if code := e.Response.StatusCode; code == http.StatusTooManyRequests || code >= http.StatusInternalServerError {
+			return true
+		}
+	}
+	return false
+}
+
+// A Report holds the results of a run.
+type Report struct {
+	Results []*Result `json:"results"`
+}
+
+// Count returns the number of results with the given status.
+func (r *Report) Count(status Status) int {
+	n := 0
+	for _, res := range r.Results {
+		if res.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// Failed returns the results of the items that failed or were canceled.
+func (r *Report) Failed() []*Result {
+	var failed []*Result
+	for _, res := range r.Results {
+		if res.Status == StatusFailed || res.Status == StatusCanceled {
+			failed = append(failed, res)
+		}
+	}
+	return failed
+}
+
+// Err returns an error describing the failed items, or nil if there are
+// none.
+func (r *Report) Err() error {
+	var errs []error
+	for _, res := range r.Failed() {
+		errs = append(errs, fmt.Errorf("%s: %w", res.ID, res.Err))
+	}
+	return errors.Join(errs...)
+}
+
+// WriteJSON writes the report to w as indented JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
@@ -0,0 +1,182 @@
+package bulk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+)
+
+func apiError(status int) error {
+	return client.Errors{{
+		Response: &http.Response{
+			StatusCode: status,
+			Request:    &http.Request{Method: http.MethodPut, URL: &url.URL{Path: "/aws/ec2/group"}},
+		},
+		Code: http.StatusText(status),
+	}}
+}
+
+func TestRunConcurrency(t *testing.T) {
+	var running, peak int32
+	op := func(ctx context.Context, id string) (interface{}, error) {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return id, nil
+	}
+
+	ids := []string{"a", "b", "c", "d", "e", "f", "g"}
+	e := &Executor{Concurrency: 2}
+	report := e.Run(context.Background(), ids, op)
+
+	if peak > 2 {
+		t.Errorf("got %d concurrent operations, want at most 2", peak)
+	}
+	for i, r := range report.Results {
+		if r.ID != ids[i] || r.Status != StatusSucceeded || r.Output != ids[i] {
+			t.Errorf("result %d: got %+v", i, r)
+		}
+	}
+	if err := report.Err(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestRunRetries(t *testing.T) {
+	var mu sync.Mutex
+	calls := make(map[string]int)
+	op := func(ctx context.Context, id string) (interface{}, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls[id]++
+		switch id {
+		case "flaky":
+			if calls[id] < 3 {
+				return nil, apiError(http.StatusServiceUnavailable)
+			}
+			return nil, nil
+		case "throttled":
+			return nil, apiError(http.StatusTooManyRequests)
+		default:
+			return nil, apiError(http.StatusBadRequest)
+		}
+	}
+
+	e := &Executor{Retries: 3, RetryDelay: time.Millisecond}
+	report := e.Run(context.Background(), []string{"flaky", "throttled", "invalid"}, op)
+
+	want := []struct {
+		status   Status
+		attempts int
+	}{
+		{StatusSucceeded, 3},
+		{StatusFailed, 4},
+		{StatusFailed, 1},
+	}
+	for i, w := range want {
+		r := report.Results[i]
+		if r.Status != w.status || r.Attempts != w.attempts {
+			t.Errorf("%s: got status %q after %d attempts, want %q after %d",
+				r.ID, r.Status, r.Attempts, w.status, w.attempts)
+		}
+	}
+	if n := len(report.Failed()); n != 2 {
+		t.Errorf("got %d failures, want 2", n)
+	}
+	var errs client.Errors
+	if err := report.Err(); !errors.As(err, &errs) {
+		t.Errorf("expected report error to wrap the API errors, got %v", err)
+	}
+}
+
+func TestRunDryRun(t *testing.T) {
+	op := func(ctx context.Context, id string) (interface{}, error) {
+		t.Errorf("operation called on %s during dry run", id)
+		return nil, nil
+	}
+
+	e := &Executor{DryRun: true}
+	report := e.Run(context.Background(), []string{"a", "b"}, op)
+	if n := report.Count(StatusDryRun); n != 2 {
+		t.Errorf("got %d dry-run results, want 2", n)
+	}
+}
+
+func TestRunCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	op := func(ctx context.Context, id string) (interface{}, error) {
+		cancel()
+		return nil, nil
+	}
+
+	var notified int32
+	e := &Executor{
+		Concurrency: 1,
+		OnResult:    func(*Result) { atomic.AddInt32(&notified, 1) },
+	}
+	report := e.Run(ctx, []string{"a", "b", "c"}, op)
+
+	if report.Results[0].Status != StatusSucceeded {
+		t.Errorf("got status %q for the first item, want %q", report.Results[0].Status, StatusSucceeded)
+	}
+	if n := report.Count(StatusCanceled); n != 2 {
+		t.Errorf("got %d canceled results, want 2", n)
+	}
+	if notified != 3 {
+		t.Errorf("got %d notifications, want 3", notified)
+	}
+	if err := report.Err(); !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want context.Canceled", err)
+	}
+}
+
+func TestReportWriteJSON(t *testing.T) {
+	op := func(ctx context.Context, id string) (interface{}, error) {
+		if id == "bad" {
+			return nil, errors.New("boom")
+		}
+		return map[string]string{"id": id}, nil
+	}
+
+	report := (&Executor{}).Run(context.Background(), []string{"good", "bad"}, op)
+
+	var buf bytes.Buffer
+	if err := report.WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var out struct {
+		Results []struct {
+			ID     string            `json:"id"`
+			Status Status            `json:"status"`
+			Output map[string]string `json:"output"`
+			Error  string            `json:"error"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Results) != 2 {
+		t.Fatalf("got %d results, want 2", len(out.Results))
+	}
+	if r := out.Results[0]; r.Status != StatusSucceeded || r.Output["id"] != "good" {
+		t.Errorf("got %+v", r)
+	}
+	if r := out.Results[1]; r.Status != StatusFailed || r.Error != "boom" {
+		t.Errorf("got %+v", r)
+	}
+}
@@ -0,0 +1,140 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/audit"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/dryrun"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/guardrail"
+)
+
+// doAudited checks a mutating request against the guardrails and sends it,
+// and writes its audit record, including when it is denied.
+func (c *Client) doAudited(ctx context.Context, op *guardrail.Operation, req *http.Request) (*http.Response, error) {
+	rec := &audit.Record{
+		Time:       time.Now().UTC(),
+		Account:    req.URL.Query().Get("accountId"),
+		Operation:  audit.OperationName(req.Method, op.InputType(), resourceKind(op)),
+		Method:     req.Method,
+		Path:       req.URL.Path,
+		ResourceID: op.ResourceID(),
+		Actor:      audit.Actor(ctx),
+	}
+	if err := c.auditBody(ctx, op, rec); err != nil {
+		c.logf("SPOTINST: Failed to audit request %q: %v", req.Method+" "+req.URL.Path, err)
+	}
+
+	resp, err := c.checkAndDo(ctx, op, req)
+	if err != nil {
+		rec.Error = err.Error()
+	}
+	if resp != nil {
+		rec.StatusCode = resp.StatusCode
+		rec.DryRun = dryrun.IsDryRun(resp)
+		c.auditResponse(resp, rec)
+	}
+
+	if werr := c.config.Audit.Write(ctx, rec); werr != nil {
+		c.logf("SPOTINST: Failed to write audit record of request %q: %v", req.Method+" "+req.URL.Path, werr)
+	}
+	return resp, err
+}
+
+func (c *Client) checkAndDo(ctx context.Context, op *guardrail.Operation, req *http.Request) (*http.Response, error) {
+	if err := c.config.Guardrails.Check(ctx, op); err != nil {
+		return nil, err
+	}
+	return c.do(req)
+}
+
+// auditBody sets the redacted body of a request and, for updates, the
+// changes it makes to the current state of the resource.
+func (c *Client) auditBody(ctx context.Context, op *guardrail.Operation, rec *audit.Record) error {
+	input, err := op.InputJSON()
+	if err != nil || input == nil {
+		return err
+	}
+	body := audit.Redact(input)
+	if rec.Body, err = json.Marshal(body); err != nil {
+		return err
+	}
+
+	// Updates are PUT requests of the resource itself, e.g. not of
+	// "/aws/ec2/group/sig-12345/roll", whose body wraps the resource, e.g.
+	// {"group": {...}}.
+	if op.Method != http.MethodPut || op.Path != op.ResourcePath() {
+		return nil
+	}
+	wrapper, ok := body.(map[string]interface{})
+	if !ok || len(wrapper) != 1 {
+		return nil
+	}
+	current, err := op.Current(ctx)
+	if err != nil || current == nil {
+		return err
+	}
+	for _, update := range wrapper {
+		rec.Changes = audit.Diff(audit.Redact(current), update)
+	}
+	return nil
+}
+
+// auditResponse sets the request ID of a response, the ID of the created
+// resource and the API errors, leaving the body of the response unread.
+func (c *Client) auditResponse(resp *http.Response, rec *audit.Record) {
+	if resp.Body == nil {
+		return
+	}
+	b, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return
+	}
+
+	var out Response
+	if err := json.Unmarshal(b, &out); err != nil {
+		return
+	}
+	rec.RequestID = out.Request.ID
+
+	if rec.ResourceID == "" && len(out.Response.Items) > 0 {
+		var item struct {
+			ID string `json:"id"`
+		}
+		if json.Unmarshal(out.Response.Items[0], &item) == nil {
+			rec.ResourceID = item.ID
+		}
+	}
+
+	if rec.Error == "" && resp.StatusCode != http.StatusOK {
+		var msgs []string
+		for _, e := range out.Response.Errors {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", e.Code, e.Message))
+		}
+		if len(msgs) == 0 {
+			msgs = append(msgs, http.StatusText(resp.StatusCode))
+		}
+		rec.Error = strings.Join(msgs, "; ")
+	}
+}
+
+// resourceKind returns the kind of the resource of an operation, i.e. the
+// path segment before its ID, e.g. "group" for "/aws/ec2/group/sig-12345",
+// or the last path segment if it has no ID, e.g. for creates.
+func resourceKind(op *guardrail.Operation) string {
+	p := op.ResourcePath()
+	if p != "" {
+		p = p[:strings.LastIndexByte(p, '/')]
+	} else {
+		p = op.Path
+	}
+	return p[strings.LastIndexByte(p, '/')+1:]
+}
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/httpcache"
+)
+
+// doCached serves GET requests from the cache, unless their context bypasses
+// it, and invalidates the cache after other requests.
+func (c *Client) doCached(req *http.Request) (*http.Response, error) {
+	cache := c.config.Cache
+	account := req.URL.Query().Get("accountId")
+
+	if req.Method != http.MethodGet {
+		defer cache.Invalidate(account, req.URL.Path)
+		return c.send(req)
+	}
+	if httpcache.Bypassed(req.Context()) {
+		return c.send(req)
+	}
+
+	// The shared fetch runs detached from the context of the request that
+	// started it, so that canceling that request doesn't fail the others
+	// waiting on it, and is bounded by the cache's fetch timeout instead.
+	// Each caller stops waiting when its own context is done.
+	var (
+		fetchCtx context.Context = detachedContext{req.Context()}
+		cancel                   = func() {}
+	)
+	if timeout := cache.FetchTimeout(); timeout > 0 {
+		fetchCtx, cancel = context.WithTimeout(fetchCtx, timeout)
+	}
+	fetchReq := req.WithContext(fetchCtx)
+	done := make(chan cacheResult, 1)
+	go func() {
+		defer cancel()
+
+		var r cacheResult
+		defer func() {
+			if r.panicked = recover(); r.panicked != nil {
+				r.err = fmt.Errorf("spotinst: cached request panicked: %v", r.panicked)
+			}
+			done <- r
+		}()
+		r.entry, _, r.err = cache.Get(account, req.URL, func() (*httpcache.Entry, error) {
+			resp, err := c.send(fetchReq)
+			if err != nil {
+				return nil, err
+			}
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			return &httpcache.Entry{
+				StatusCode: resp.StatusCode,
+				Header:     resp.Header,
+				Body:       body,
+			}, nil
+		})
+	}()
+
+	var r cacheResult
+	select {
+	case r = <-done:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	if r.panicked != nil {
+		panic(r.panicked)
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	entry := r.entry
+
+	// Each caller gets its own response, as entries are shared.
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", entry.StatusCode, http.StatusText(entry.StatusCode)),
+		StatusCode:    entry.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header(entry.Header).Clone(),
+		Body:          io.NopCloser(bytes.NewReader(entry.Body)),
+		ContentLength: int64(len(entry.Body)),
+		Request:       req,
+	}, nil
+}
+
+type cacheResult struct {
+	entry    *httpcache.Entry
+	err      error
+	panicked interface{}
+}
+
+// detachedContext carries the values of its parent but is never canceled and
+// has no deadline, like context.WithoutCancel, which needs Go 1.21.
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/credentials"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/httpcache"
+)
+
+func newCachedClient(url string, cache *httpcache.Cache) *Client {
+	return New(spotinst.DefaultConfig().
+		WithBaseURL(url).
+		WithCredentials(credentials.NewStaticCredentials("token", "act-1")).
+		WithCache(cache))
+}
+
+type doResult struct {
+	resp *http.Response
+	err  error
+}
+
+func doAsync(ctx context.Context, c *Client) <-chan doResult {
+	ch := make(chan doResult, 1)
+	go func() {
+		resp, err := c.Do(ctx, NewRequest(http.MethodGet, "/aws/ec2/group"))
+		ch <- doResult{resp, err}
+	}()
+	return ch
+}
+
+func TestDoCachedWaiterGivesUp(t *testing.T) {
+	var hits int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&hits, 1) == 1 {
+			close(started)
+		}
+		<-release
+		io.WriteString(w, `{"response":{"items":[]}}`)
+	}))
+	defer srv.Close()
+
+	c := newCachedClient(srv.URL, httpcache.New(time.Minute))
+	first := doAsync(context.Background(), c)
+	<-started
+
+	// A second caller waits on the shared fetch and gives up on its own.
+	ctx, cancel := context.WithCancel(context.Background())
+	second := doAsync(ctx, c)
+	cancel()
+	if r := <-second; !errors.Is(r.err, context.Canceled) {
+		t.Errorf("got error %v, want %v", r.err, context.Canceled)
+	}
+
+	// The shared fetch goes on for the first caller.
+	close(release)
+	r := <-first
+	if r.err != nil {
+		t.Fatalf("got error %v", r.err)
+	}
+	defer r.resp.Body.Close()
+	if r.resp.StatusCode != http.StatusOK {
+		t.Errorf("got status %d, want %d", r.resp.StatusCode, http.StatusOK)
+	}
+	if n := atomic.LoadInt32(&hits); n != 1 {
+		t.Errorf("got %d requests, want 1", n)
+	}
+}
+
+func TestDoCachedFetchTimeout(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&hits, 1) == 1 {
+			<-r.Context().Done() // hangs until the client gives up
+			return
+		}
+		io.WriteString(w, `{"response":{"items":[]}}`)
+	}))
+	defer srv.Close()
+
+	c := newCachedClient(srv.URL, httpcache.New(time.Minute).WithFetchTimeout(50*time.Millisecond))
+	if r := <-doAsync(context.Background(), c); !errors.Is(r.err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v, want %v", r.err, context.DeadlineExceeded)
+	}
+
+	// The hung fetch no longer holds up identical requests.
+	r := <-doAsync(context.Background(), c)
+	if r.err != nil {
+		t.Fatalf("got error %v", r.err)
+	}
+	r.resp.Body.Close()
+	if n := atomic.LoadInt32(&hits); n != 2 {
+		t.Errorf("got %d requests, want 2", n)
+	}
+}
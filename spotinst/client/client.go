@@ -40,6 +40,42 @@ func (c *Client) Do(ctx context.Context, r *Request) (*http.Response, error) {
 	if err != nil {
 		return nil, err
 	}
+	return c.run(ctx, r, req)
+}
+
+// run checks a request against the guardrails, sends it and audits it, if
+// it is a mutating request.
+func (c *Client) run(ctx context.Context, r *Request, req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet || (len(c.config.Guardrails) == 0 && c.config.Audit == nil) {
+		return c.do(req)
+	}
+
+	op := c.newOperation(r, req)
+	if c.config.Audit != nil {
+		return c.doAudited(ctx, op, req)
+	}
+	if err := c.config.Guardrails.Check(ctx, op); err != nil {
+		return nil, err
+	}
+	return c.do(req)
+}
+
+// do sends an HTTP request, through the cache if one is configured, or
+// records it if it is a mutating request of a dry run.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	if c.config.DryRun != nil && req.Method != http.MethodGet {
+		c.logRequest(req)
+		c.logf(logDryRunMsg, req.Method, req.URL)
+		return c.config.DryRun.Do(req)
+	}
+	if c.config.Cache != nil {
+		return c.doCached(req)
+	}
+	return c.send(req)
+}
+
+// send sends an HTTP request and logs it along with its response.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
 	c.logRequest(req)
 	resp, err := c.config.HTTPClient.Do(req)
 	c.logResponse(resp)
@@ -66,6 +102,8 @@ func (c *Client) logRequest(req *http.Request) {
 	}
 }
 
+const logDryRunMsg = `SPOTINST: Dry run: request "%s %s" recorded and not sent`
+
 const logRespMsg = `SPOTINST: Response "%s %s" details:
 ---[ RESPONSE ]----------------------------------------
 %s
@@ -86,8 +124,5 @@ func (c *Client) DoOrg(ctx context.Context, r *Request) (*http.Response, error)
 	if err != nil {
 		return nil, err
 	}
-	c.logRequest(req)
-	resp, err := c.config.HTTPClient.Do(req)
-	c.logResponse(resp)
-	return resp, err
+	return c.run(ctx, r, req)
 }
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/guardrail"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/httpcache"
+)
+
+// newOperation returns the guardrail operation of a request.
+func (c *Client) newOperation(r *Request, req *http.Request) *guardrail.Operation {
+	return guardrail.NewOperation(req.Method, req.URL.Path, r.Obj, func(ctx context.Context, path string) (json.RawMessage, error) {
+		return c.fetch(ctx, req, path)
+	})
+}
+
+// fetch reads the resource at path, with the credentials of req, and returns
+// its JSON, or nil if the response holds no item.
+func (c *Client) fetch(ctx context.Context, req *http.Request, path string) (json.RawMessage, error) {
+	u := *req.URL
+	u.Path = path
+	query := make(url.Values)
+	if account := req.URL.Query().Get("accountId"); account != "" {
+		query.Set("accountId", account)
+	}
+	u.RawQuery = query.Encode()
+
+	// The state is read right before it's changed, so skip the cache.
+	get, err := http.NewRequestWithContext(httpcache.WithBypass(ctx), http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	get.Host = req.Host
+	get.Header = req.Header.Clone()
+
+	resp, err := RequireOK(c.do(get))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var out Response
+	if err := DecodeBody(resp, &out); err != nil {
+		return nil, err
+	}
+	if len(out.Response.Items) == 0 {
+		return nil, nil
+	}
+	return out.Response.Items[0], nil
+}
@@ -0,0 +1,470 @@
+// Command gettergen generates nil-safe getters for the model types of one or
+// more packages.
+//
+// For every exported field of every exported model type, a GetX method is
+// generated that returns the field's value, or the zero value when the
+// receiver is nil. Pointers to scalar values (*string, *int, *bool, ...) are
+// dereferenced, so that deeply nested fields can be read in one expression:
+//
+//	arn := group.GetCompute().GetLaunchSpecification().GetIAMInstanceProfile().GetArn()
+//
+// Getters mirror the names of the existing SetX setters when present, e.g.
+// GetId for the ID field set by SetId.
+//
+// Model types are the struct types that have a nullFields field or a
+// json-tagged field; option, error and service types are skipped.
+//
+// Usage:
+//
+//	go run ./spotinst/cmd/gettergen ./service/...
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// outputFile is the name of the generated file in each package.
+const outputFile = "getters.go"
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: gettergen [dir|dir/...]...\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) == 0 {
+		args = []string{"."}
+	}
+
+	dirs, err := expand(args)
+	if err != nil {
+		fatal(err)
+	}
+	for _, dir := range dirs {
+		if err := generate(dir); err != nil {
+			fatal(fmt.Errorf("%s: %v", dir, err))
+		}
+	}
+}
+
+func fatal(err error) {
+	fmt.Fprintf(os.Stderr, "gettergen: %v\n", err)
+	os.Exit(1)
+}
+
+// expand resolves "dir/..." patterns into the directories below dir.
+func expand(args []string) ([]string, error) {
+	var dirs []string
+	for _, arg := range args {
+		root := strings.TrimSuffix(arg, "/...")
+		if root == arg {
+			dirs = append(dirs, arg)
+			continue
+		}
+		err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				if name := info.Name(); path != root && (name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".")) {
+					return filepath.SkipDir
+				}
+				dirs = append(dirs, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return dirs, nil
+}
+
+// structType is a struct type declared in the package being generated.
+type structType struct {
+	name   string
+	fields []*field
+	file   *ast.File
+}
+
+type field struct {
+	name string
+	typ  ast.Expr
+}
+
+type generator struct {
+	fset    *token.FileSet
+	pkgName string
+	types   map[string]ast.Expr // all declared types
+	structs []*structType
+	setters map[string]map[string]string // type -> field -> setter name
+	members map[string]map[string]bool   // type -> existing methods and fields
+}
+
+func generate(dir string) error {
+	g := &generator{
+		fset:    token.NewFileSet(),
+		types:   make(map[string]ast.Expr),
+		setters: make(map[string]map[string]string),
+		members: make(map[string]map[string]bool),
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	var files []*ast.File
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || name == outputFile || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(g.fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return err
+		}
+		if f.Name.Name == "main" {
+			continue
+		}
+		g.pkgName = f.Name.Name
+		files = append(files, f)
+	}
+
+	for _, f := range files {
+		g.collect(f)
+	}
+	sort.Slice(g.structs, func(i, j int) bool { return g.structs[i].name < g.structs[j].name })
+
+	out := filepath.Join(dir, outputFile)
+	src, err := g.render()
+	if err != nil {
+		return err
+	}
+	if src == nil {
+		// Nothing to generate; remove stale output, if any.
+		if err := os.Remove(out); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	return os.WriteFile(out, src, 0644)
+}
+
+func (g *generator) collect(f *ast.File) {
+	for _, decl := range f.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			if d.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range d.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if ts.TypeParams != nil {
+					continue
+				}
+				g.types[ts.Name.Name] = ts.Type
+
+				st, ok := ts.Type.(*ast.StructType)
+				if !ok || !ts.Name.IsExported() || !isModel(st) {
+					continue
+				}
+				s := &structType{name: ts.Name.Name, file: f}
+				for _, fl := range st.Fields.List {
+					for _, n := range fl.Names {
+						g.addMember(ts.Name.Name, n.Name)
+						if n.IsExported() {
+							s.fields = append(s.fields, &field{name: n.Name, typ: fl.Type})
+						}
+					}
+				}
+				g.structs = append(g.structs, s)
+			}
+
+		case *ast.FuncDecl:
+			recv := receiver(d)
+			if recv == "" {
+				continue
+			}
+			g.addMember(recv, d.Name.Name)
+			if strings.HasPrefix(d.Name.Name, "Set") {
+				if fieldName := setterField(d); fieldName != "" {
+					if g.setters[recv] == nil {
+						g.setters[recv] = make(map[string]string)
+					}
+					if _, ok := g.setters[recv][fieldName]; !ok {
+						g.setters[recv][fieldName] = d.Name.Name
+					}
+				}
+			}
+		}
+	}
+}
+
+// isModel reports whether st is a model type, that is, a struct with a
+// nullFields field or with fields encoded by encoding/json.
+func isModel(st *ast.StructType) bool {
+	for _, fl := range st.Fields.List {
+		for _, n := range fl.Names {
+			if n.Name == "nullFields" {
+				return true
+			}
+		}
+		if fl.Tag == nil {
+			continue
+		}
+		tag, err := strconv.Unquote(fl.Tag.Value)
+		if err != nil {
+			continue
+		}
+		if name, ok := reflect.StructTag(tag).Lookup("json"); ok && name != "-" {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *generator) addMember(typeName, name string) {
+	if g.members[typeName] == nil {
+		g.members[typeName] = make(map[string]bool)
+	}
+	g.members[typeName][name] = true
+}
+
+// receiver returns the name of the receiver type of a method.
+func receiver(d *ast.FuncDecl) string {
+	if d.Recv == nil || len(d.Recv.List) != 1 {
+		return ""
+	}
+	t := d.Recv.List[0].Type
+	if star, ok := t.(*ast.StarExpr); ok {
+		t = star.X
+	}
+	if id, ok := t.(*ast.Ident); ok {
+		return id.Name
+	}
+	return ""
+}
+
+// setterField returns the name of the field assigned by a setter of the
+// form `o.Field = v`.
+func setterField(d *ast.FuncDecl) string {
+	if d.Body == nil || len(d.Type.Params.List) != 1 || len(d.Type.Params.List[0].Names) != 1 {
+		return ""
+	}
+	param := d.Type.Params.List[0].Names[0].Name
+
+	var name string
+	ast.Inspect(d.Body, func(n ast.Node) bool {
+		as, ok := n.(*ast.AssignStmt)
+		if !ok || name != "" || len(as.Lhs) != 1 || len(as.Rhs) != 1 {
+			return name == ""
+		}
+		sel, ok := as.Lhs[0].(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if rhs, ok := as.Rhs[0].(*ast.Ident); ok && rhs.Name == param {
+			name = sel.Sel.Name
+		}
+		return true
+	})
+	return name
+}
+
+func (g *generator) render() ([]byte, error) {
+	var body bytes.Buffer
+	used := make(map[string]string)
+
+	for _, s := range g.structs {
+		for _, f := range s.fields {
+			name := "Get" + f.name
+			if setter, ok := g.setters[s.name][f.name]; ok {
+				name = "Get" + strings.TrimPrefix(setter, "Set")
+			}
+			if g.members[s.name][name] {
+				continue // avoid clashing with existing methods or fields
+			}
+			g.addMember(s.name, name)
+
+			if err := g.qualifiers(f.typ, s.file, used); err != nil {
+				return nil, fmt.Errorf("%s.%s: %v", s.name, f.name, err)
+			}
+			g.getter(&body, s.name, name, f)
+		}
+	}
+	if body.Len() == 0 {
+		return nil, nil
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gettergen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", g.pkgName)
+	if len(used) > 0 {
+		paths := make([]string, 0, len(used))
+		for _, p := range used {
+			paths = append(paths, p)
+		}
+		// Standard library imports go first, separated from the others.
+		sort.Slice(paths, func(i, j int) bool {
+			si, sj := !strings.Contains(paths[i], "."), !strings.Contains(paths[j], ".")
+			if si != sj {
+				return si
+			}
+			return paths[i] < paths[j]
+		})
+		fmt.Fprintf(&buf, "import (\n")
+		for i, p := range paths {
+			if i > 0 && strings.Contains(p, ".") && !strings.Contains(paths[i-1], ".") {
+				fmt.Fprintf(&buf, "\n")
+			}
+			fmt.Fprintf(&buf, "\t%q\n", p)
+		}
+		fmt.Fprintf(&buf, ")\n\n")
+	}
+	buf.Write(body.Bytes())
+
+	return format.Source(buf.Bytes())
+}
+
+// qualifiers records the imports needed by the package qualifiers of t.
+func (g *generator) qualifiers(t ast.Expr, f *ast.File, used map[string]string) error {
+	var err error
+	ast.Inspect(t, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		pkg, ok := sel.X.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		path := importPath(f, pkg.Name)
+		if path == "" {
+			err = fmt.Errorf("unresolved package %q", pkg.Name)
+			return false
+		}
+		if p, ok := used[pkg.Name]; ok && p != path {
+			err = fmt.Errorf("conflicting imports for %q", pkg.Name)
+			return false
+		}
+		used[pkg.Name] = path
+		return false
+	})
+	return err
+}
+
+func importPath(f *ast.File, name string) string {
+	for _, imp := range f.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		if imp.Name != nil {
+			if imp.Name.Name == name {
+				return path
+			}
+			continue
+		}
+		if path[strings.LastIndex(path, "/")+1:] == name {
+			return path
+		}
+	}
+	return ""
+}
+
+func (g *generator) getter(w *bytes.Buffer, typeName, name string, f *field) {
+	typ := g.expr(f.typ)
+
+	// Pointers to scalar values are dereferenced.
+	if star, ok := f.typ.(*ast.StarExpr); ok {
+		if zero, ok := g.zero(star.X); ok && !g.isStruct(star.X) {
+			fmt.Fprintf(w, "func (o *%s) %s() %s {\n", typeName, name, g.expr(star.X))
+			fmt.Fprintf(w, "\tif o == nil || o.%s == nil {\n\t\treturn %s\n\t}\n", f.name, zero)
+			fmt.Fprintf(w, "\treturn *o.%s\n}\n\n", f.name)
+			return
+		}
+	}
+
+	fmt.Fprintf(w, "func (o *%s) %s() %s {\n", typeName, name, typ)
+	if zero, ok := g.zero(f.typ); ok {
+		fmt.Fprintf(w, "\tif o == nil {\n\t\treturn %s\n\t}\n", zero)
+	} else {
+		fmt.Fprintf(w, "\tif o == nil {\n\t\tvar zero %s\n\t\treturn zero\n\t}\n", typ)
+	}
+	fmt.Fprintf(w, "\treturn o.%s\n}\n\n", f.name)
+}
+
+func (g *generator) expr(e ast.Expr) string {
+	var buf bytes.Buffer
+	format.Node(&buf, g.fset, e)
+	return buf.String()
+}
+
+func (g *generator) isStruct(e ast.Expr) bool {
+	switch t := e.(type) {
+	case *ast.Ident:
+		_, ok := g.types[t.Name].(*ast.StructType)
+		return ok
+	case *ast.StructType:
+		return true
+	}
+	return false
+}
+
+// zero returns the zero value literal of type e, if known.
+func (g *generator) zero(e ast.Expr) (string, bool) {
+	switch t := e.(type) {
+	case *ast.StarExpr, *ast.MapType, *ast.InterfaceType, *ast.FuncType, *ast.ChanType:
+		return "nil", true
+	case *ast.ArrayType:
+		if t.Len == nil {
+			return "nil", true
+		}
+	case *ast.Ident:
+		switch t.Name {
+		case "string":
+			return `""`, true
+		case "bool":
+			return "false", true
+		case "int", "int8", "int16", "int32", "int64",
+			"uint", "uint8", "uint16", "uint32", "uint64", "uintptr",
+			"float32", "float64", "byte", "rune":
+			return "0", true
+		case "error", "any":
+			return "nil", true
+		}
+		if u, ok := g.types[t.Name]; ok {
+			if _, ok := u.(*ast.StructType); ok {
+				return t.Name + "{}", true
+			}
+			return g.zero(u)
+		}
+	case *ast.SelectorExpr:
+		switch g.expr(t) {
+		case "time.Time":
+			return "time.Time{}", true
+		case "spotinst.Timestamp":
+			return "spotinst.Timestamp{}", true
+		case "spotinst.Date":
+			return "spotinst.Date{}", true
+		case "time.Duration":
+			return "0", true
+		case "json.RawMessage":
+			return "nil", true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,148 @@
+// Package concurrency provides optimistic concurrency control for updates of
+// resources: an update is only sent if the resource is unchanged since the
+// caller read it, and a conflict is reported otherwise.
+//
+// The state of a resource is identified by a version: its UpdatedAt time if
+// it has one, or a hash of its content otherwise. Versions are compared
+// client-side right before sending the update, so they narrow the window in
+// which concurrent updates overwrite each other but don't close it.
+package concurrency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+const (
+	// DefaultAttempts is the default maximum number of attempts of
+	// RetryOnConflict.
+	DefaultAttempts = 5
+
+	// DefaultDelay is the default delay of RetryOnConflict before the second
+	// attempt.
+	DefaultDelay = 100 * time.Millisecond
+)
+
+// ErrConflict is matched by the errors returned for resources modified
+// since they were read, e.g. errors.Is(err, concurrency.ErrConflict).
+var ErrConflict = errors.New("concurrency: resource modified concurrently")
+
+// A ConflictError is returned when a resource was modified since it was
+// read.
+type ConflictError struct {
+	ResourceID string
+
+	// Expected is the version of the resource when it was read.
+	Expected string
+
+	// Actual is the current version of the resource.
+	Actual string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("concurrency: resource %q modified since it was read (version %s, now %s)",
+		e.ResourceID, e.Expected, e.Actual)
+}
+
+// Is reports whether target is ErrConflict.
+func (e *ConflictError) Is(target error) bool { return target == ErrConflict }
+
+// Version returns the version of a model: its UpdatedAt time, e.g.
+// "2024-01-02T03:04:05Z", or, if it has none, the SHA-256 hash of its JSON
+// encoding, e.g. "sha256:0123...".
+func Version(obj interface{}) (string, error) {
+	if t := updatedAt(obj); t != nil {
+		return t.UTC().Format(time.RFC3339Nano), nil
+	}
+
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	// Decoding into an interface{} and encoding it again sorts the keys of
+	// objects, so that the hash doesn't depend on the order of the fields.
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return "", err
+	}
+	if b, err = json.Marshal(v); err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+// updatedAt returns the UpdatedAt field of a model, if any.
+func updatedAt(obj interface{}) *time.Time {
+	v := reflect.Indirect(reflect.ValueOf(obj))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	f := v.FieldByName("UpdatedAt")
+	if !f.IsValid() {
+		return nil
+	}
+	t, _ := f.Interface().(*time.Time)
+	return t
+}
+
+// Check returns a *ConflictError if the version of current isn't version.
+func Check(id, version string, current interface{}) error {
+	actual, err := Version(current)
+	if err != nil {
+		return err
+	}
+	if actual != version {
+		return &ConflictError{ResourceID: id, Expected: version, Actual: actual}
+	}
+	return nil
+}
+
+// A Retry configures RetryOnConflict.
+type Retry struct {
+	// Attempts is the maximum number of attempts. It defaults to
+	// DefaultAttempts.
+	Attempts int
+
+	// Delay is the delay before the second attempt, doubled before each
+	// following attempt. It defaults to DefaultDelay.
+	Delay time.Duration
+}
+
+// RetryOnConflict calls fn until it returns an error other than a conflict,
+// or the maximum number of attempts is reached. fn is expected to read the
+// resource, apply its changes and update it if it is unchanged. A nil retry
+// uses the default values.
+func RetryOnConflict(ctx context.Context, retry *Retry, fn func(ctx context.Context) error) error {
+	attempts, delay := DefaultAttempts, DefaultDelay
+	if retry != nil {
+		if retry.Attempts > 0 {
+			attempts = retry.Attempts
+		}
+		if retry.Delay > 0 {
+			delay = retry.Delay
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx)
+		if err == nil || !errors.Is(err, ErrConflict) || attempt >= attempts {
+			return err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Join(err, ctx.Err())
+		case <-timer.C:
+		}
+		delay *= 2
+	}
+}
@@ -0,0 +1,109 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type resource struct {
+	ID        *string    `json:"id,omitempty"`
+	Name      *string    `json:"name,omitempty"`
+	Labels    []string   `json:"labels,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+type unversioned struct {
+	Name   string            `json:"name"`
+	Labels map[string]string `json:"labels"`
+}
+
+func strPtr(v string) *string { return &v }
+
+func TestVersion(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 6, time.FixedZone("", 3600))
+	v, err := Version(&resource{Name: strPtr("web"), UpdatedAt: &now})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != "2024-01-02T02:04:05.000000006Z" {
+		t.Errorf("got version %q", v)
+	}
+
+	a, err := Version(&unversioned{Name: "web", Labels: map[string]string{"a": "1", "b": "2"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := Version(unversioned{Name: "web", Labels: map[string]string{"b": "2", "a": "1"}})
+	c, _ := Version(&unversioned{Name: "api", Labels: map[string]string{"a": "1", "b": "2"}})
+	if !strings.HasPrefix(a, "sha256:") || a != b || a == c {
+		t.Errorf("unexpected hashes %q, %q and %q", a, b, c)
+	}
+
+	// Resources without an update time are hashed too.
+	if v, _ := Version(&resource{Name: strPtr("web")}); !strings.HasPrefix(v, "sha256:") {
+		t.Errorf("got version %q, want a hash", v)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	then := time.Now()
+	now := then.Add(time.Second)
+	previous := &resource{ID: strPtr("sig-1"), UpdatedAt: &then}
+	version, _ := Version(previous)
+
+	if err := Check("sig-1", version, &resource{ID: strPtr("sig-1"), UpdatedAt: &then}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	err := Check("sig-1", version, &resource{ID: strPtr("sig-1"), UpdatedAt: &now})
+	var conflict *ConflictError
+	if !errors.As(err, &conflict) || !errors.Is(err, ErrConflict) || conflict.ResourceID != "sig-1" {
+		t.Fatalf("got error %v, want a conflict", err)
+	}
+}
+
+func TestRetryOnConflict(t *testing.T) {
+	ctx := context.Background()
+	retry := &Retry{Attempts: 3, Delay: time.Millisecond}
+	conflict := &ConflictError{ResourceID: "sig-1"}
+
+	var calls int
+	err := RetryOnConflict(ctx, retry, func(context.Context) error {
+		if calls++; calls < 3 {
+			return conflict
+		}
+		return nil
+	})
+	if err != nil || calls != 3 {
+		t.Errorf("got error %v after %d calls, want success after 3", err, calls)
+	}
+
+	calls = 0
+	err = RetryOnConflict(ctx, retry, func(context.Context) error {
+		calls++
+		return conflict
+	})
+	if !errors.Is(err, ErrConflict) || calls != 3 {
+		t.Errorf("got error %v after %d calls, want a conflict after 3", err, calls)
+	}
+
+	calls = 0
+	failure := errors.New("failure")
+	err = RetryOnConflict(ctx, retry, func(context.Context) error {
+		calls++
+		return failure
+	})
+	if err != failure || calls != 1 {
+		t.Errorf("got error %v after %d calls, want the failure after 1", err, calls)
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	err = RetryOnConflict(canceled, &Retry{Delay: time.Hour}, func(context.Context) error { return conflict })
+	if !errors.Is(err, context.Canceled) || !errors.Is(err, ErrConflict) {
+		t.Errorf("got error %v, want the conflict and the cancellation", err)
+	}
+}
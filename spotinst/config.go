@@ -8,7 +8,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/spotinst/spotinst-sdk-go/spotinst/audit"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/credentials"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/dryrun"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/guardrail"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/httpcache"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/log"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/util/useragent"
 )
@@ -47,6 +51,29 @@ type Config struct {
 	// The User-Agent and Content-Type HTTP headers to set when invoking HTTP
 	// requests.
 	UserAgent, ContentType string
+
+	// The cache of GET responses. Mutating requests invalidate the cached
+	// responses of the same resource path.
+	//
+	// Defaults to nil, which disables caching.
+	Cache *httpcache.Cache
+
+	// The guardrails checked, in order, before sending requests other than
+	// GET. A request denied by a guardrail is not sent.
+	//
+	// Defaults to nil, which allows all requests.
+	Guardrails guardrail.Chain
+
+	// The recorder of a dry run. Requests other than GET are recorded instead
+	// of being sent, and get a synthetic response.
+	//
+	// Defaults to nil, which sends all requests.
+	DryRun *dryrun.Recorder
+
+	// The sink of the audit records of requests other than GET.
+	//
+	// Defaults to nil, which disables auditing.
+	Audit audit.Sink
 }
 
 // DefaultBaseURL returns the default base URL.
@@ -151,6 +178,31 @@ func (c *Config) WithLogger(logger log.Logger) *Config {
 	return c
 }
 
+// WithCache defines the cache of GET responses. It is nil by default.
+func (c *Config) WithCache(cache *httpcache.Cache) *Config {
+	c.Cache = cache
+	return c
+}
+
+// WithGuardrails appends guardrails to the ones checked before sending
+// requests other than GET.
+func (c *Config) WithGuardrails(guardrails ...guardrail.Guardrail) *Config {
+	c.Guardrails = append(c.Guardrails, guardrails...)
+	return c
+}
+
+// WithDryRun defines the recorder of a dry run. It is nil by default.
+func (c *Config) WithDryRun(rec *dryrun.Recorder) *Config {
+	c.DryRun = rec
+	return c
+}
+
+// WithAudit defines the sink of audit records. It is nil by default.
+func (c *Config) WithAudit(sink audit.Sink) *Config {
+	c.Audit = sink
+	return c
+}
+
 // Merge merges the passed in configs into the existing config object.
 func (c *Config) Merge(cfgs ...*Config) {
 	for _, cfg := range cfgs {
@@ -180,4 +232,16 @@ func mergeConfigs(c1, c2 *Config) {
 	if c2.Logger != nil {
 		c1.Logger = c2.Logger
 	}
+	if c2.Cache != nil {
+		c1.Cache = c2.Cache
+	}
+	if c2.Guardrails != nil {
+		c1.Guardrails = c2.Guardrails
+	}
+	if c2.DryRun != nil {
+		c1.DryRun = c2.DryRun
+	}
+	if c2.Audit != nil {
+		c1.Audit = c2.Audit
+	}
 }
@@ -0,0 +1,225 @@
+// Package drift detects changes made to resources outside of a pipeline.
+//
+// A Detector lists the live resources of an account and compares them
+// against a baseline, which is either a snapshot of the same resources
+// taken earlier or a set of hand-written manifests. Read-only fields, such
+// as IDs and timestamps, are ignored, and every changed field is reported
+// with its JSON path. Snapshots are plain manifests, so they can be saved
+// and loaded with the manifest package:
+//
+//	d := drift.New(sess)
+//	snapshot, err := d.Snapshot(ctx)
+//	...
+//	err = manifest.SaveFile("baseline.yaml", snapshot...)
+//	...
+//	baseline, err := manifest.LoadFile("baseline.yaml")
+//	...
+//	report, err := d.Detect(ctx, baseline)
+//	...
+//	report.WriteJUnit(os.Stdout)
+package drift
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/manifest"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/reconcile"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+)
+
+// A Detector compares live resources against a baseline.
+type Detector struct {
+	// Listers lists the live resources by kind. Only the kinds with a lister
+	// are snapshotted and compared.
+	Listers map[manifest.Kind]manifest.ListFunc
+
+	// Ignore holds the paths to ignore by kind, in addition to
+	// reconcile.ReadOnlyPaths, e.g. "capacity.target" for groups scaled by
+	// their scaling policies. Nested paths are accepted, with "[]" matching
+	// any array index, e.g. "compute.launchSpecification.tags[].tagValue".
+	Ignore map[manifest.Kind][]string
+
+	// Strict also reports the fields set on a live resource and not in its
+	// baseline. It should be set when the baseline is a snapshot, and left
+	// unset when it's a set of manifests, which usually leave many fields to
+	// their server-side defaults.
+	Strict bool
+
+	// Unmanaged also reports the live resources that match no resource of
+	// the baseline, for the kinds present in the baseline.
+	Unmanaged bool
+}
+
+// New returns a Detector listing Elastigroups, Ocean clusters and Ocean
+// launch specs on AWS, rightsizing rules and notification policies.
+func New(sess *session.Session, cfgs ...*spotinst.Config) *Detector {
+	all := manifest.NewListers(sess, cfgs...)
+	listers := make(map[manifest.Kind]manifest.ListFunc)
+	for _, kind := range []manifest.Kind{
+		manifest.KindElastigroupAWS,
+		manifest.KindOceanAWS,
+		manifest.KindOceanAWSLaunchSpec,
+		manifest.KindOceanRightsizingRule,
+		manifest.KindNotificationPolicy,
+	} {
+		listers[kind] = all[kind]
+	}
+	return &Detector{Listers: listers}
+}
+
+// Snapshot lists the live resources of all kinds, sorted by kind.
+func (d *Detector) Snapshot(ctx context.Context) ([]*manifest.Manifest, error) {
+	return d.list(ctx, d.kinds())
+}
+
+// Detect lists the live resources of the kinds present in baseline and
+// compares them against it.
+func (d *Detector) Detect(ctx context.Context, baseline []*manifest.Manifest) (*Report, error) {
+	seen := make(map[manifest.Kind]bool)
+	var kinds []manifest.Kind
+	for i, m := range baseline {
+		kind, err := kindOf(m)
+		if err != nil {
+			return nil, fmt.Errorf("drift: manifest %d: %v", i, err)
+		}
+		if _, ok := d.Listers[kind]; !ok {
+			return nil, fmt.Errorf("drift: manifest %d: unsupported kind %q", i, kind)
+		}
+		if !seen[kind] {
+			seen[kind] = true
+			kinds = append(kinds, kind)
+		}
+	}
+
+	live, err := d.list(ctx, kinds)
+	if err != nil {
+		return nil, err
+	}
+	return d.Compare(baseline, live)
+}
+
+// Compare compares live resources against a baseline.
+func (d *Detector) Compare(baseline, live []*manifest.Manifest) (*Report, error) {
+	type entry struct {
+		kind    manifest.Kind
+		id, key string
+		spec    interface{}
+		matched bool
+	}
+	index := func(ms []*manifest.Manifest) ([]*entry, error) {
+		entries := make([]*entry, len(ms))
+		for i, m := range ms {
+			kind, err := kindOf(m)
+			if err != nil {
+				return nil, fmt.Errorf("drift: manifest %d: %v", i, err)
+			}
+			id, key := manifest.Identity(m.Spec)
+			entries[i] = &entry{kind: kind, id: id, key: key, spec: m.Spec}
+		}
+		return entries, nil
+	}
+
+	want, err := index(baseline)
+	if err != nil {
+		return nil, err
+	}
+	have, err := index(live)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{GeneratedAt: time.Now().UTC()}
+	kinds := make(map[manifest.Kind]bool)
+	for _, w := range want {
+		kinds[w.kind] = true
+
+		// Match by ID when the baseline has one, by name otherwise.
+		var match *entry
+		for _, h := range have {
+			if h.matched || h.kind != w.kind {
+				continue
+			}
+			if (w.id != "" && h.id == w.id) || (w.id == "" && w.key != "" && h.key == w.key) {
+				match = h
+				break
+			}
+		}
+
+		r := &Resource{Kind: w.kind, ID: w.id, Name: w.key}
+		if match == nil {
+			r.Status = StatusMissing
+			report.Resources = append(report.Resources, r)
+			continue
+		}
+		match.matched = true
+		r.ID = match.id
+
+		diff := reconcile.Diff
+		if d.Strict {
+			diff = reconcile.DiffAll
+		}
+		diffs, err := diff(w.spec, match.spec, d.Ignore[w.kind]...)
+		if err != nil {
+			return nil, fmt.Errorf("drift: %s %q: %w", w.kind, w.key, err)
+		}
+		r.Status = StatusInSync
+		for _, fd := range diffs {
+			r.Status = StatusModified
+			r.Changes = append(r.Changes, &Change{Path: fd.Path, Expected: fd.New, Actual: fd.Old})
+		}
+		report.Resources = append(report.Resources, r)
+	}
+
+	if d.Unmanaged {
+		for _, h := range have {
+			if !h.matched && kinds[h.kind] {
+				report.Resources = append(report.Resources, &Resource{
+					Kind:   h.kind,
+					ID:     h.id,
+					Name:   h.key,
+					Status: StatusUnmanaged,
+				})
+			}
+		}
+	}
+
+	return report, nil
+}
+
+func (d *Detector) kinds() []manifest.Kind {
+	kinds := make([]manifest.Kind, 0, len(d.Listers))
+	for kind := range d.Listers {
+		kinds = append(kinds, kind)
+	}
+	sort.Slice(kinds, func(i, j int) bool { return kinds[i] < kinds[j] })
+	return kinds
+}
+
+func (d *Detector) list(ctx context.Context, kinds []manifest.Kind) ([]*manifest.Manifest, error) {
+	var out []*manifest.Manifest
+	for _, kind := range kinds {
+		objs, err := d.Listers[kind](ctx)
+		if err != nil {
+			return nil, fmt.Errorf("drift: failed to list %s resources: %w", kind, err)
+		}
+		for _, obj := range objs {
+			out = append(out, &manifest.Manifest{
+				APIVersion: manifest.APIVersion,
+				Kind:       kind,
+				Spec:       obj,
+			})
+		}
+	}
+	return out, nil
+}
+
+func kindOf(m *manifest.Manifest) (manifest.Kind, error) {
+	if m.Kind != "" {
+		return m.Kind, nil
+	}
+	return manifest.KindOf(m.Spec)
+}
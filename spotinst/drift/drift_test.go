@@ -0,0 +1,191 @@
+package drift
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	elastigroupaws "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	oceanaws "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/manifest"
+)
+
+func group(id, name string, target int) *elastigroupaws.Group {
+	now := time.Now()
+	return &elastigroupaws.Group{
+		ID:        spotinst.String(id),
+		Name:      spotinst.String(name),
+		Capacity:  &elastigroupaws.Capacity{Target: spotinst.Int(target)},
+		CreatedAt: &now,
+		UpdatedAt: &now,
+	}
+}
+
+func lister(objs ...interface{}) manifest.ListFunc {
+	return func(context.Context) ([]interface{}, error) { return objs, nil }
+}
+
+func TestDetectSnapshot(t *testing.T) {
+	d := &Detector{
+		Listers: map[manifest.Kind]manifest.ListFunc{
+			manifest.KindElastigroupAWS: lister(group("sig-1", "web", 2), group("sig-2", "api", 1)),
+			manifest.KindOceanAWSLaunchSpec: lister(&oceanaws.LaunchSpec{
+				ID:      spotinst.String("ols-1"),
+				Name:    spotinst.String("default"),
+				OceanID: spotinst.String("o-1"),
+			}),
+		},
+		Strict:    true,
+		Unmanaged: true,
+	}
+
+	ctx := context.Background()
+	snapshot, err := d.Snapshot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshot) != 3 {
+		t.Fatalf("got %d resources in snapshot, want 3", len(snapshot))
+	}
+
+	// A snapshot compared against itself has no drift, even though the
+	// timestamps change.
+	d.Listers[manifest.KindElastigroupAWS] = lister(group("sig-1", "web", 2), group("sig-2", "api", 1))
+	report, err := d.Detect(ctx, snapshot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.HasDrift() {
+		t.Fatalf("unexpected drift: %+v", report.Drifted())
+	}
+
+	modified := group("sig-1", "web", 3)
+	modified.SetDescription(spotinst.String("changed by hand"))
+	d.Listers[manifest.KindElastigroupAWS] = lister(modified, group("sig-3", "new", 1))
+	report, err = d.Detect(ctx, snapshot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	drifted := report.Drifted()
+	if len(drifted) != 3 {
+		t.Fatalf("got %d drifted resources, want 3", len(drifted))
+	}
+	web := drifted[0]
+	if web.Status != StatusModified || len(web.Changes) != 2 ||
+		web.Changes[0].Path != "capacity.target" || web.Changes[1].Path != "description" {
+		t.Errorf("unexpected drift of web: %+v", web)
+	}
+	if drifted[1].ID != "sig-2" || drifted[1].Status != StatusMissing {
+		t.Errorf("got %+v, want sig-2 to be missing", drifted[1])
+	}
+	if drifted[2].ID != "sig-3" || drifted[2].Status != StatusUnmanaged {
+		t.Errorf("got %+v, want sig-3 to be unmanaged", drifted[2])
+	}
+}
+
+func TestCompareManifests(t *testing.T) {
+	ms, err := manifest.Decode(strings.NewReader(`apiVersion: spotinst.io/v1
+kind: ElastigroupAWS
+spec:
+  name: web
+  capacity:
+    target: 2
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	live := []*manifest.Manifest{{Kind: manifest.KindElastigroupAWS, Spec: group("sig-1", "web", 3)}}
+
+	d := &Detector{}
+	report, err := d.Compare(ms, live)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Resources) != 1 {
+		t.Fatalf("got %d resources, want 1", len(report.Resources))
+	}
+	r := report.Resources[0]
+	if r.ID != "sig-1" || r.Status != StatusModified || len(r.Changes) != 1 {
+		t.Fatalf("unexpected drift: %+v", r)
+	}
+	if c := r.Changes[0]; c.Path != "capacity.target" || c.Expected != float64(2) || c.Actual != float64(3) {
+		t.Errorf("unexpected change: %+v", c)
+	}
+
+	d.Ignore = map[manifest.Kind][]string{manifest.KindElastigroupAWS: {"capacity.target"}}
+	if report, _ := d.Compare(ms, live); report.HasDrift() {
+		t.Errorf("expected ignored field not to drift")
+	}
+}
+
+func TestCompareScopedNames(t *testing.T) {
+	launchSpec := func(id, oceanID string, types ...string) *oceanaws.LaunchSpec {
+		ls := &oceanaws.LaunchSpec{Name: spotinst.String("default"), OceanID: spotinst.String(oceanID), InstanceTypes: types}
+		if id != "" {
+			ls.ID = spotinst.String(id)
+		}
+		return ls
+	}
+	baseline := []*manifest.Manifest{{Kind: manifest.KindOceanAWSLaunchSpec, Spec: launchSpec("", "o-2", "m5.large")}}
+	live := []*manifest.Manifest{
+		{Kind: manifest.KindOceanAWSLaunchSpec, Spec: launchSpec("ols-1", "o-1", "c5.large")},
+		{Kind: manifest.KindOceanAWSLaunchSpec, Spec: launchSpec("ols-2", "o-2", "m5.large")},
+	}
+
+	report, err := (&Detector{}).Compare(baseline, live)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := report.Resources[0]; r.ID != "ols-2" || r.Name != "o-2/default" || r.Status != StatusInSync {
+		t.Errorf("got %+v, want ols-2 in sync", r)
+	}
+}
+
+func TestReportOutputs(t *testing.T) {
+	report := &Report{
+		GeneratedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Resources: []*Resource{
+			{Kind: manifest.KindElastigroupAWS, ID: "sig-1", Name: "web", Status: StatusModified, Changes: []*Change{
+				{Path: "capacity.target", Expected: float64(2), Actual: float64(3)},
+			}},
+			{Kind: manifest.KindElastigroupAWS, ID: "sig-2", Name: "api", Status: StatusInSync},
+			{Kind: manifest.KindOceanAWS, ID: "o-1", Name: "prod", Status: StatusMissing},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := report.WriteJUnit(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var suites junitTestSuites
+	if err := xml.Unmarshal(buf.Bytes(), &suites); err != nil {
+		t.Fatalf("invalid JUnit XML: %v\n%s", err, buf.String())
+	}
+	suite := suites.Suites[0]
+	if suite.Tests != 3 || suite.Failures != 2 || suite.Cases[1].Failure != nil {
+		t.Errorf("unexpected JUnit report:\n%s", buf.String())
+	}
+	if !strings.Contains(suite.Cases[0].Failure.Text, "capacity.target: expected 2, got 3") {
+		t.Errorf("unexpected failure text %q", suite.Cases[0].Failure.Text)
+	}
+
+	buf.Reset()
+	if err := report.WriteMarkdown(&buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"2 of 3 resource(s) drifted",
+		`## modified: ElastigroupAWS "web" (sig-1)`,
+		"| `capacity.target` | `2` | `3` |",
+		"The resource no longer exists.",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("Markdown report doesn't contain %q:\n%s", want, buf.String())
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package drift
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/manifest"
+)
+
+// A Status is the drift status of a resource.
+type Status string
+
+const (
+	// StatusInSync represents a resource that matches its baseline.
+	StatusInSync Status = "in-sync"
+
+	// StatusModified represents a resource with fields that differ from its
+	// baseline.
+	StatusModified Status = "modified"
+
+	// StatusMissing represents a resource of the baseline that no longer
+	// exists.
+	StatusMissing Status = "missing"
+
+	// StatusUnmanaged represents a live resource that isn't in the baseline.
+	StatusUnmanaged Status = "unmanaged"
+)
+
+// A Change is a field whose live value differs from its baseline.
+type Change struct {
+	// Path is the JSON path of the field, e.g. "capacity.target".
+	Path string `json:"path"`
+
+	// Expected is the value of the baseline, or nil if the field isn't set.
+	Expected interface{} `json:"expected"`
+
+	// Actual is the live value, or nil if the field isn't set.
+	Actual interface{} `json:"actual"`
+}
+
+// A Resource is the drift status of a resource.
+type Resource struct {
+	Kind manifest.Kind `json:"kind"`
+	ID   string        `json:"id,omitempty"`
+
+	// Name is the name of the resource, prefixed with the ID of its cluster
+	// for launch specs and rightsizing rules, e.g. "o-12345/default".
+	Name string `json:"name,omitempty"`
+
+	Status  Status    `json:"status"`
+	Changes []*Change `json:"changes,omitempty"`
+}
+
+func (r *Resource) title() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %q", r.Kind, r.Name)
+	if r.ID != "" {
+		fmt.Fprintf(&b, " (%s)", r.ID)
+	}
+	return b.String()
+}
+
+// A Report holds the drift status of resources.
+type Report struct {
+	GeneratedAt time.Time   `json:"generatedAt"`
+	Resources   []*Resource `json:"resources"`
+}
+
+// Drifted returns the resources that aren't in sync.
+func (r *Report) Drifted() []*Resource {
+	var drifted []*Resource
+	for _, res := range r.Resources {
+		if res.Status != StatusInSync {
+			drifted = append(drifted, res)
+		}
+	}
+	return drifted
+}
+
+// HasDrift reports whether any resource isn't in sync.
+func (r *Report) HasDrift() bool {
+	return len(r.Drifted()) > 0
+}
+
+// WriteJSON writes the report to w as indented JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Timestamp string          `xml:"timestamp,attr"`
+	Cases     []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	ClassName string        `xml:"classname,attr"`
+	Name      string        `xml:"name,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the report to w as JUnit XML, with a test case per
+// resource that fails if the resource isn't in sync.
+func (r *Report) WriteJUnit(w io.Writer) error {
+	suite := junitTestSuite{
+		Name:      "drift",
+		Tests:     len(r.Resources),
+		Timestamp: r.GeneratedAt.Format(time.RFC3339),
+	}
+	for _, res := range r.Resources {
+		tc := junitTestCase{ClassName: string(res.Kind), Name: res.title()}
+		if res.Status != StatusInSync {
+			suite.Failures++
+			var text strings.Builder
+			for _, c := range res.Changes {
+				fmt.Fprintf(&text, "%s: expected %s, got %s\n", c.Path, formatValue(c.Expected), formatValue(c.Actual))
+			}
+			tc.Failure = &junitFailure{
+				Message: failureMessage(res),
+				Type:    string(res.Status),
+				Text:    text.String(),
+			}
+		}
+		suite.Cases = append(suite.Cases, tc)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(junitTestSuites{Suites: []junitTestSuite{suite}}); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func failureMessage(res *Resource) string {
+	switch res.Status {
+	case StatusModified:
+		return fmt.Sprintf("%d field(s) drifted", len(res.Changes))
+	case StatusMissing:
+		return "resource no longer exists"
+	case StatusUnmanaged:
+		return "resource is not in the baseline"
+	default:
+		return string(res.Status)
+	}
+}
+
+// WriteMarkdown writes the report to w as Markdown, with a table of the
+// changed fields of each drifted resource.
+func (r *Report) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	drifted := r.Drifted()
+
+	b.WriteString("# Drift report\n\n")
+	fmt.Fprintf(&b, "%d of %d resource(s) drifted as of %s.\n",
+		len(drifted), len(r.Resources), r.GeneratedAt.Format(time.RFC3339))
+
+	for _, res := range drifted {
+		fmt.Fprintf(&b, "\n## %s: %s\n\n", res.Status, markdownEscape(res.title()))
+		if len(res.Changes) == 0 {
+			fmt.Fprintf(&b, "The %s.\n", failureMessage(res))
+			continue
+		}
+		b.WriteString("| Path | Expected | Actual |\n")
+		b.WriteString("| --- | --- | --- |\n")
+		for _, c := range res.Changes {
+			fmt.Fprintf(&b, "| `%s` | `%s` | `%s` |\n", c.Path,
+				markdownEscape(formatValue(c.Expected)), markdownEscape(formatValue(c.Actual)))
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// markdownEscape escapes the characters that would break a table cell.
+func markdownEscape(s string) string {
+	return strings.NewReplacer("|", `\|`, "`", "'", "\n", " ").Replace(s)
+}
+
+// formatValue formats a value for display.
+func formatValue(v interface{}) string {
+	if v == nil {
+		return "null"
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
@@ -0,0 +1,172 @@
+// Package dryrun records the mutating requests of a client instead of
+// sending them, to review exactly what an operation would send.
+//
+// A Recorder is set on a spotinst.Config:
+//
+//	rec := dryrun.New()
+//	sess := session.New(spotinst.DefaultConfig().WithDryRun(rec))
+//
+// The client then records every request other than GET, with its body as
+// encoded on the wire, and returns a synthetic response marked with the
+// Header header and the RequestID request ID instead of sending it.
+package dryrun
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// Header is set on synthetic responses.
+	Header = "X-Spotinst-Dry-Run"
+
+	// RequestID is the request ID of synthetic responses.
+	RequestID = "dry-run"
+)
+
+// A Request is a request recorded instead of being sent.
+type Request struct {
+	Time   time.Time `json:"time"`
+	Method string    `json:"method"`
+
+	// URL is the URL of the request, including its query parameters.
+	URL string `json:"url"`
+
+	// Body is the body of the request, as encoded on the wire, or nil if
+	// the request has no body.
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+// A Recorder collects the requests of a dry run. It is safe for concurrent
+// use.
+type Recorder struct {
+	// OnRequest, if set, is called with each recorded request, e.g. to log
+	// it.
+	OnRequest func(*Request)
+
+	mu       sync.Mutex
+	requests []*Request
+}
+
+// New returns a new Recorder.
+func New() *Recorder {
+	return new(Recorder)
+}
+
+// Record records req.
+func (r *Recorder) Record(req *Request) {
+	r.mu.Lock()
+	r.requests = append(r.requests, req)
+	r.mu.Unlock()
+
+	if r.OnRequest != nil {
+		r.OnRequest(req)
+	}
+}
+
+// Requests returns the recorded requests, in order.
+func (r *Recorder) Requests() []*Request {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*Request(nil), r.requests...)
+}
+
+// Reset discards the recorded requests.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	r.requests = nil
+	r.mu.Unlock()
+}
+
+// WriteJSON writes the recorded requests to w as indented JSON.
+func (r *Recorder) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r.Requests())
+}
+
+// Do records req and returns a synthetic response to it, without sending
+// it.
+func (r *Recorder) Do(req *http.Request) (*http.Response, error) {
+	rec := &Request{
+		Time:   time.Now().UTC(),
+		Method: req.Method,
+		URL:    req.URL.String(),
+	}
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if b = bytes.TrimSpace(b); len(b) > 0 {
+			rec.Body = b
+		}
+	}
+	r.Record(rec)
+
+	body := Response(rec.Body)
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+	header.Set(Header, "true")
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// IsDryRun reports whether resp is a synthetic response of a dry run.
+func IsDryRun(resp *http.Response) bool {
+	return resp != nil && resp.Header.Get(Header) == "true"
+}
+
+// Response returns the body of the synthetic response to a request with
+// body. So that calling code keeps running, a body wrapping a single object,
+// e.g. {"group": {...}}, is echoed as the only item of the response, as if
+// the API returned the resource as sent.
+func Response(body []byte) []byte {
+	items := []json.RawMessage{}
+	var wrapper map[string]json.RawMessage
+	if json.Unmarshal(body, &wrapper) == nil && len(wrapper) == 1 {
+		for _, v := range wrapper {
+			if v = bytes.TrimSpace(v); len(v) > 0 && v[0] == '{' {
+				items = append(items, v)
+			}
+		}
+	}
+
+	var resp struct {
+		Request struct {
+			ID string `json:"id"`
+		} `json:"request"`
+		Response struct {
+			Status struct {
+				Code    int    `json:"code"`
+				Message string `json:"message"`
+			} `json:"status"`
+			Kind  string            `json:"kind"`
+			Items []json.RawMessage `json:"items"`
+			Count int               `json:"count"`
+		} `json:"response"`
+	}
+	resp.Request.ID = RequestID
+	resp.Response.Status.Code = http.StatusOK
+	resp.Response.Status.Message = "OK"
+	resp.Response.Kind = "spotinst:dryRun"
+	resp.Response.Items = items
+	resp.Response.Count = len(items)
+
+	b, _ := json.Marshal(resp)
+	return b
+}
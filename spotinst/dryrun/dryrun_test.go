@@ -0,0 +1,90 @@
+package dryrun
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDo(t *testing.T) {
+	var logged []*Request
+	rec := New()
+	rec.OnRequest = func(req *Request) { logged = append(logged, req) }
+
+	body := `{"group":{"name":"web","capacity":{"target":0,"maximum":null}}}` + "\n"
+	req, err := http.NewRequest(http.MethodPut, "https://api.spotinst.io/aws/ec2/group/sig-12345?accountId=act-12345", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := rec.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsDryRun(resp) || resp.StatusCode != http.StatusOK {
+		t.Errorf("got response %+v, want a synthetic 200 response", resp)
+	}
+
+	var out struct {
+		Request struct {
+			ID string `json:"id"`
+		} `json:"request"`
+		Response struct {
+			Items []map[string]interface{} `json:"items"`
+		} `json:"response"`
+	}
+	b, _ := io.ReadAll(resp.Body)
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatalf("invalid response %s: %v", b, err)
+	}
+	if out.Request.ID != RequestID || len(out.Response.Items) != 1 || out.Response.Items[0]["name"] != "web" {
+		t.Errorf("unexpected response %s", b)
+	}
+
+	reqs := rec.Requests()
+	if len(reqs) != 1 || len(logged) != 1 {
+		t.Fatalf("got %d recorded and %d logged requests, want 1", len(reqs), len(logged))
+	}
+	if r := reqs[0]; r.Method != http.MethodPut || !strings.HasSuffix(r.URL, "/aws/ec2/group/sig-12345?accountId=act-12345") ||
+		string(r.Body) != strings.TrimSpace(body) {
+		t.Errorf("unexpected recorded request %+v", r)
+	}
+
+	var buf bytes.Buffer
+	if err := rec.WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `"maximum": null`) {
+		t.Errorf("expected the body to be written as JSON:\n%s", buf.String())
+	}
+
+	rec.Reset()
+	if len(rec.Requests()) != 0 {
+		t.Errorf("expected no requests after reset")
+	}
+}
+
+func TestResponse(t *testing.T) {
+	for body, items := range map[string]int{
+		``:                              0,
+		`{"group":{"name":"web"}}`:      1,
+		`{"instancesToDetach":["i-1"]}`: 0,
+		`{"batchSizePercentage":20}`:    0,
+		`{"a":{},"b":{}}`:               0,
+	} {
+		var out struct {
+			Response struct {
+				Items []json.RawMessage `json:"items"`
+				Count int               `json:"count"`
+			} `json:"response"`
+		}
+		if err := json.Unmarshal(Response([]byte(body)), &out); err != nil {
+			t.Fatal(err)
+		}
+		if len(out.Response.Items) != items || out.Response.Count != items {
+			t.Errorf("%s: got %d items, want %d", body, len(out.Response.Items), items)
+		}
+	}
+}
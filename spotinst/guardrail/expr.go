@@ -0,0 +1,539 @@
+package guardrail
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// An ExprRule denies the operations for which an expression is true.
+//
+// Expressions combine comparisons (==, !=, <, <=, >, >= and matches, which
+// matches a string against a path.Match pattern) with &&, || and !, and may
+// use the following variables:
+//
+//	method     the HTTP method, e.g. "DELETE"
+//	path       the URL path, e.g. "/aws/ec2/group/sig-12345"
+//	operation  the type name of the input, e.g. "RollGroupInput"
+//	approved   whether the operation is approved with WithApproval
+//	input      the input, as encoded in the body of the request
+//	current    the current state of the resource, read on first use
+//	tags       the tags of the current state of the resource
+//
+// Fields are selected with dots and indexes, e.g. input.group.capacity.maximum,
+// input.instancesToDetach[0] or tags["cost-center"]. Missing fields are null.
+// For example:
+//
+//	method == "DELETE" && path matches "/aws/ec2/group/*" && tags.env == "prod"
+type ExprRule struct {
+	Name string
+
+	// Message is the reason of the violations. It defaults to the
+	// expression.
+	Message string
+
+	expr string
+	root node
+}
+
+// Expr returns an ExprRule denying the operations for which expr is true.
+func Expr(name, expr string) (*ExprRule, error) {
+	p := &parser{input: expr}
+	if err := p.next(); err != nil {
+		return nil, p.errorf("%v", err)
+	}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokEOF {
+		return nil, p.errorf("unexpected %s", p.tok)
+	}
+	return &ExprRule{Name: name, expr: expr, root: root}, nil
+}
+
+// MustExpr is like Expr but panics if the expression is invalid.
+func MustExpr(name, expr string) *ExprRule {
+	r, err := Expr(name, expr)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Check implements the Guardrail interface.
+func (r *ExprRule) Check(ctx context.Context, op *Operation) error {
+	v, err := r.root.eval(&env{ctx: ctx, op: op})
+	if err != nil {
+		return fmt.Errorf("guardrail: rule %q: %w", r.Name, err)
+	}
+	deny, err := truth(v)
+	if err != nil {
+		return fmt.Errorf("guardrail: rule %q: %w", r.Name, err)
+	}
+	if !deny {
+		return nil
+	}
+	msg := r.Message
+	if msg == "" {
+		msg = r.expr
+	}
+	return Deny(ruleName(r.Name, "expr"), op, "%s", msg)
+}
+
+// String returns the expression of the rule.
+func (r *ExprRule) String() string { return r.expr }
+
+type env struct {
+	ctx context.Context
+	op  *Operation
+}
+
+type node interface {
+	eval(e *env) (interface{}, error)
+}
+
+type (
+	literalNode struct{ v interface{} }
+	varNode     struct{ name string }
+	fieldNode   struct {
+		x   node
+		key interface{} // string or int
+	}
+	notNode   struct{ x node }
+	logicNode struct {
+		op   string
+		x, y node
+	}
+	compareNode struct {
+		op   string
+		x, y node
+	}
+)
+
+func (n *literalNode) eval(*env) (interface{}, error) { return n.v, nil }
+
+func (n *varNode) eval(e *env) (interface{}, error) {
+	switch n.name {
+	case "method":
+		return e.op.Method, nil
+	case "path":
+		return e.op.Path, nil
+	case "operation":
+		return e.op.InputType(), nil
+	case "approved":
+		return Approved(e.ctx), nil
+	case "input":
+		return e.op.InputJSON()
+	case "current":
+		return e.op.Current(e.ctx)
+	case "tags":
+		tags, err := e.op.Tags(e.ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[string]interface{}, len(tags))
+		for k, v := range tags {
+			m[k] = v
+		}
+		return m, nil
+	}
+	return nil, fmt.Errorf("unknown variable %q", n.name)
+}
+
+func (n *fieldNode) eval(e *env) (interface{}, error) {
+	v, err := n.x.eval(e)
+	if err != nil {
+		return nil, err
+	}
+	switch key := n.key.(type) {
+	case string:
+		if m, ok := v.(map[string]interface{}); ok {
+			return m[key], nil
+		}
+	case int:
+		if list, ok := v.([]interface{}); ok && key >= 0 && key < len(list) {
+			return list[key], nil
+		}
+	}
+	return nil, nil
+}
+
+func (n *notNode) eval(e *env) (interface{}, error) {
+	v, err := n.x.eval(e)
+	if err != nil {
+		return nil, err
+	}
+	b, err := truth(v)
+	return !b, err
+}
+
+func (n *logicNode) eval(e *env) (interface{}, error) {
+	v, err := n.x.eval(e)
+	if err != nil {
+		return nil, err
+	}
+	x, err := truth(v)
+	if err != nil {
+		return nil, err
+	}
+	if (n.op == "&&" && !x) || (n.op == "||" && x) {
+		return x, nil
+	}
+	if v, err = n.y.eval(e); err != nil {
+		return nil, err
+	}
+	return truth(v)
+}
+
+func (n *compareNode) eval(e *env) (interface{}, error) {
+	x, err := n.x.eval(e)
+	if err != nil {
+		return nil, err
+	}
+	y, err := n.y.eval(e)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "==":
+		return reflect.DeepEqual(x, y), nil
+	case "!=":
+		return !reflect.DeepEqual(x, y), nil
+	case "matches":
+		s, ok1 := x.(string)
+		pattern, ok2 := y.(string)
+		if !ok1 || !ok2 {
+			return false, nil
+		}
+		return path.Match(pattern, s)
+	}
+
+	// Ordering comparisons of null or of values of different types are
+	// false, so that missing fields don't deny operations.
+	var c int
+	switch x := x.(type) {
+	case float64:
+		y, ok := y.(float64)
+		if !ok {
+			return false, nil
+		}
+		c = compareFloats(x, y)
+	case string:
+		y, ok := y.(string)
+		if !ok {
+			return false, nil
+		}
+		c = strings.Compare(x, y)
+	default:
+		return false, nil
+	}
+	switch n.op {
+	case "<":
+		return c < 0, nil
+	case "<=":
+		return c <= 0, nil
+	case ">":
+		return c > 0, nil
+	default:
+		return c >= 0, nil
+	}
+}
+
+func compareFloats(x, y float64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// truth returns the boolean value of v. Null is false.
+func truth(v interface{}) (bool, error) {
+	switch v := v.(type) {
+	case nil:
+		return false, nil
+	case bool:
+		return v, nil
+	}
+	return false, fmt.Errorf("%s is not a boolean", formatValue(v))
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokNumber
+	tokString
+	tokOp
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func (t token) String() string {
+	if t.kind == tokEOF {
+		return "end of expression"
+	}
+	return strconv.Quote(t.text)
+}
+
+type parser struct {
+	input string
+	pos   int
+	tok   token
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("guardrail: invalid expression %q at offset %d: %s",
+		p.input, p.tok.pos, fmt.Sprintf(format, args...))
+}
+
+// next reads the next token.
+func (p *parser) next() error {
+	for p.pos < len(p.input) && unicode.IsSpace(rune(p.input[p.pos])) {
+		p.pos++
+	}
+	start := p.pos
+	if p.pos >= len(p.input) {
+		p.tok = token{kind: tokEOF, pos: start}
+		return nil
+	}
+
+	c := p.input[p.pos]
+	switch {
+	case c == '_' || unicode.IsLetter(rune(c)):
+		for p.pos < len(p.input) && (p.input[p.pos] == '_' ||
+			unicode.IsLetter(rune(p.input[p.pos])) || unicode.IsDigit(rune(p.input[p.pos]))) {
+			p.pos++
+		}
+		p.tok = token{kind: tokIdent, text: p.input[start:p.pos], pos: start}
+	case unicode.IsDigit(rune(c)) || (c == '-' && p.pos+1 < len(p.input) && unicode.IsDigit(rune(p.input[p.pos+1]))):
+		p.pos++
+		for p.pos < len(p.input) && (unicode.IsDigit(rune(p.input[p.pos])) || p.input[p.pos] == '.') {
+			p.pos++
+		}
+		p.tok = token{kind: tokNumber, text: p.input[start:p.pos], pos: start}
+	case c == '"':
+		p.pos++
+		for p.pos < len(p.input) && p.input[p.pos] != '"' {
+			if p.input[p.pos] == '\\' {
+				p.pos++
+			}
+			p.pos++
+		}
+		if p.pos >= len(p.input) {
+			p.tok = token{pos: start}
+			return fmt.Errorf("unterminated string")
+		}
+		p.pos++
+		p.tok = token{kind: tokString, text: p.input[start:p.pos], pos: start}
+	default:
+		for _, op := range []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!", "(", ")", "[", "]", "."} {
+			if strings.HasPrefix(p.input[p.pos:], op) {
+				p.pos += len(op)
+				p.tok = token{kind: tokOp, text: op, pos: start}
+				return nil
+			}
+		}
+		p.tok = token{pos: start}
+		return fmt.Errorf("unexpected character %q", c)
+	}
+	return nil
+}
+
+func (p *parser) advance() error {
+	if err := p.next(); err != nil {
+		return p.errorf("%v", err)
+	}
+	return nil
+}
+
+func (p *parser) expect(op string) error {
+	if p.tok.kind != tokOp || p.tok.text != op {
+		return p.errorf("expected %q, got %s", op, p.tok)
+	}
+	return p.advance()
+}
+
+func (p *parser) parseOr() (node, error) {
+	x, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tokOp && p.tok.text == "||" {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		y, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		x = &logicNode{op: "||", x: x, y: y}
+	}
+	return x, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	x, err := p.parseComparison()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tokOp && p.tok.text == "&&" {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		y, err := p.parseComparison()
+		if err != nil {
+			return nil, err
+		}
+		x = &logicNode{op: "&&", x: x, y: y}
+	}
+	return x, nil
+}
+
+func (p *parser) parseComparison() (node, error) {
+	x, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	op := p.tok.text
+	switch {
+	case p.tok.kind == tokOp && (op == "==" || op == "!=" || op == "<" || op == "<=" || op == ">" || op == ">="):
+	case p.tok.kind == tokIdent && op == "matches":
+	default:
+		return x, nil
+	}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	y, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	return &compareNode{op: op, x: x, y: y}, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.tok.kind == tokOp && p.tok.text == "!" {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{x: x}, nil
+	}
+	return p.parsePostfix()
+}
+
+func (p *parser) parsePostfix() (node, error) {
+	x, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tokOp {
+		switch p.tok.text {
+		case ".":
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			if p.tok.kind != tokIdent {
+				return nil, p.errorf("expected a field name, got %s", p.tok)
+			}
+			x = &fieldNode{x: x, key: p.tok.text}
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+		case "[":
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			var key interface{}
+			switch p.tok.kind {
+			case tokNumber:
+				n, err := strconv.Atoi(p.tok.text)
+				if err != nil {
+					return nil, p.errorf("invalid index %s", p.tok)
+				}
+				key = n
+			case tokString:
+				s, err := strconv.Unquote(p.tok.text)
+				if err != nil {
+					return nil, p.errorf("invalid string %s", p.tok)
+				}
+				key = s
+			default:
+				return nil, p.errorf("expected an index or a string, got %s", p.tok)
+			}
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			x = &fieldNode{x: x, key: key}
+		default:
+			return x, nil
+		}
+	}
+	return x, nil
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.tok
+	switch tok.kind {
+	case tokNumber:
+		f, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, p.errorf("invalid number %s", tok)
+		}
+		return &literalNode{v: f}, p.advance()
+	case tokString:
+		s, err := strconv.Unquote(tok.text)
+		if err != nil {
+			return nil, p.errorf("invalid string %s", tok)
+		}
+		return &literalNode{v: s}, p.advance()
+	case tokIdent:
+		var n node
+		switch tok.text {
+		case "true":
+			n = &literalNode{v: true}
+		case "false":
+			n = &literalNode{v: false}
+		case "null":
+			n = &literalNode{v: nil}
+		case "method", "path", "operation", "approved", "input", "current", "tags":
+			n = &varNode{name: tok.text}
+		default:
+			return nil, p.errorf("unknown variable %s", tok)
+		}
+		return n, p.advance()
+	case tokOp:
+		if tok.text == "(" {
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			x, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			return x, p.expect(")")
+		}
+	}
+	return nil, p.errorf("unexpected %s", tok)
+}
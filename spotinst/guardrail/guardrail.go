@@ -0,0 +1,361 @@
+// Package guardrail inspects mutating API operations before they are sent,
+// to enforce policies such as "groups tagged env=prod are never deleted"
+// inside every tool that uses the SDK.
+//
+// Guardrails are set on a spotinst.Config:
+//
+//	cfg := spotinst.DefaultConfig().WithGuardrails(
+//		&guardrail.DenyTag{
+//			Match: guardrail.Match{Methods: []string{http.MethodDelete}},
+//			Key:   "env",
+//			Value: "prod",
+//		},
+//		guardrail.MustExpr("max-capacity", `input.group.capacity.maximum > 100`),
+//	)
+//
+// The client checks every request other than GET against the guardrails of
+// its config, in order, and returns the first error, a *Violation for the
+// built-in rules, without sending the request.
+package guardrail
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrDenied is matched by the errors returned for denied operations, e.g.
+// errors.Is(err, guardrail.ErrDenied).
+var ErrDenied = errors.New("guardrail: operation denied")
+
+// A Guardrail inspects an operation before it is sent. It returns a non-nil
+// error to deny the operation.
+type Guardrail interface {
+	Check(ctx context.Context, op *Operation) error
+}
+
+// Func is an adapter to allow the use of ordinary functions as guardrails.
+type Func func(ctx context.Context, op *Operation) error
+
+// Check calls f(ctx, op).
+func (f Func) Check(ctx context.Context, op *Operation) error { return f(ctx, op) }
+
+// A Chain is a list of guardrails checked in order.
+type Chain []Guardrail
+
+// Check checks op against the guardrails of the chain, in order, and returns
+// the first error.
+func (c Chain) Check(ctx context.Context, op *Operation) error {
+	for _, g := range c {
+		if err := g.Check(ctx, op); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// A Violation is returned by the built-in rules for denied operations.
+type Violation struct {
+	Rule   string
+	Method string
+	Path   string
+	Reason string
+}
+
+// Deny returns a Violation of rule for op.
+func Deny(rule string, op *Operation, format string, args ...interface{}) *Violation {
+	return &Violation{
+		Rule:   rule,
+		Method: op.Method,
+		Path:   op.Path,
+		Reason: fmt.Sprintf(format, args...),
+	}
+}
+
+func (v *Violation) Error() string {
+	return fmt.Sprintf("guardrail: %s %s denied by rule %q: %s", v.Method, v.Path, v.Rule, v.Reason)
+}
+
+// Is reports whether target is ErrDenied.
+func (v *Violation) Is(target error) bool { return target == ErrDenied }
+
+type approvalKey struct{}
+
+// WithApproval returns a copy of ctx marking the operations made with it as
+// approved, e.g. after a human confirmation. Rules such as ForbidValue may
+// allow approved operations.
+func WithApproval(ctx context.Context) context.Context {
+	return context.WithValue(ctx, approvalKey{}, true)
+}
+
+// Approved reports whether the operations made with ctx are approved.
+func Approved(ctx context.Context) bool {
+	approved, _ := ctx.Value(approvalKey{}).(bool)
+	return approved
+}
+
+// A FetchFunc returns the JSON of the resource at path, or nil if there is
+// none.
+type FetchFunc func(ctx context.Context, path string) (json.RawMessage, error)
+
+// An Operation is a mutating request about to be sent.
+type Operation struct {
+	// Method is the HTTP method of the request, e.g. "DELETE".
+	Method string
+
+	// Path is the URL path of the request, e.g. "/aws/ec2/group/sig-12345".
+	Path string
+
+	// Input is the typed input encoded as the body of the request, e.g. an
+	// *aws.RollGroupInput, or nil if the request has no body.
+	Input interface{}
+
+	fetch FetchFunc
+
+	inputOnce sync.Once
+	input     interface{}
+	inputErr  error
+
+	currentOnce sync.Once
+	current     interface{}
+	currentErr  error
+}
+
+// NewOperation returns an Operation. fetch is used to read the current state
+// of the resource, and may be nil.
+func NewOperation(method, path string, input interface{}, fetch FetchFunc) *Operation {
+	return &Operation{Method: method, Path: path, Input: input, fetch: fetch}
+}
+
+// InputType returns the name of the type of the input without its package,
+// e.g. "RollGroupInput", or an empty string if there is no input.
+func (op *Operation) InputType() string {
+	if op.Input == nil {
+		return ""
+	}
+	t := reflect.TypeOf(op.Input)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+// InputJSON returns the input as it is encoded in the body of the request,
+// decoded into maps, slices and scalars.
+func (op *Operation) InputJSON() (interface{}, error) {
+	op.inputOnce.Do(func() {
+		if op.Input == nil {
+			return
+		}
+		b, err := json.Marshal(op.Input)
+		if err != nil {
+			op.inputErr = err
+			return
+		}
+		op.inputErr = json.Unmarshal(b, &op.input)
+	})
+	return op.input, op.inputErr
+}
+
+// Field returns the value of the field of the input at the dotted JSON path
+// p, e.g. "group.capacity.maximum", and whether it is set.
+func (op *Operation) Field(p string) (interface{}, bool, error) {
+	v, err := op.InputJSON()
+	if err != nil {
+		return nil, false, err
+	}
+	v, ok := lookup(v, p)
+	return v, ok, nil
+}
+
+// resourceID matches the path segments that are resource IDs, e.g.
+// "sig-12345" or "o-12345".
+var resourceID = regexp.MustCompile(`^[a-z]+-[0-9a-z]+$`)
+
+// ResourcePath returns the path of the resource the operation applies to,
+// i.e. Path up to its last segment that is a resource ID, e.g.
+// "/aws/ec2/group/sig-12345" for "/aws/ec2/group/sig-12345/roll". It
+// returns an empty string if Path holds no resource ID.
+func (op *Operation) ResourcePath() string {
+	segments := strings.Split(op.Path, "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if resourceID.MatchString(segments[i]) {
+			return strings.Join(segments[:i+1], "/")
+		}
+	}
+	return ""
+}
+
+// ResourceID returns the ID of the resource the operation applies to, e.g.
+// "sig-12345", or an empty string if Path holds no resource ID.
+func (op *Operation) ResourceID() string {
+	p := op.ResourcePath()
+	return p[strings.LastIndexByte(p, '/')+1:]
+}
+
+// Current returns the current state of the resource the operation applies
+// to, decoded into maps, slices and scalars, or nil if there is none. It is
+// read once, on first use.
+func (op *Operation) Current(ctx context.Context) (interface{}, error) {
+	op.currentOnce.Do(func() {
+		p := op.ResourcePath()
+		if p == "" || op.fetch == nil {
+			return
+		}
+		b, err := op.fetch(ctx, p)
+		if err != nil {
+			op.currentErr = fmt.Errorf("guardrail: failed to read %s: %w", p, err)
+			return
+		}
+		if len(b) > 0 {
+			op.currentErr = json.Unmarshal(b, &op.current)
+		}
+	})
+	return op.current, op.currentErr
+}
+
+// Tags returns the tags of the current state of the resource, i.e. the
+// elements of the "tags" arrays it holds at any depth, e.g.
+// "compute.launchSpecification.tags" for an Elastigroup.
+func (op *Operation) Tags(ctx context.Context) (map[string]string, error) {
+	current, err := op.Current(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]string)
+	collectTags(current, tags)
+	return tags, nil
+}
+
+func collectTags(v interface{}, tags map[string]string) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if list, ok := e.([]interface{}); ok && k == "tags" {
+				for _, tag := range list {
+					if key, value, ok := tagKeyValue(tag); ok {
+						tags[key] = value
+					}
+				}
+				continue
+			}
+			collectTags(e, tags)
+		}
+	case []interface{}:
+		for _, e := range v {
+			collectTags(e, tags)
+		}
+	}
+}
+
+// tagKeyValue returns the key and value of a tag, which are named
+// tagKey/tagValue or key/value depending on the resource.
+func tagKeyValue(v interface{}) (key, value string, ok bool) {
+	m, isMap := v.(map[string]interface{})
+	if !isMap {
+		return "", "", false
+	}
+	for _, names := range [][2]string{{"tagKey", "tagValue"}, {"key", "value"}} {
+		if k, isString := m[names[0]].(string); isString {
+			value, _ := m[names[1]].(string)
+			return k, value, true
+		}
+	}
+	return "", "", false
+}
+
+// lookup returns the value at the dotted JSON path p of v, where array
+// elements are selected with an index, e.g. "tags[0].tagKey".
+func lookup(v interface{}, p string) (interface{}, bool) {
+	if p == "" {
+		return v, v != nil
+	}
+	for _, part := range strings.Split(p, ".") {
+		name, indexes := part, []int(nil)
+		if i := strings.IndexByte(part, '['); i >= 0 {
+			name = part[:i]
+			for _, s := range strings.Split(strings.TrimSuffix(part[i+1:], "]"), "][") {
+				n, err := strconv.Atoi(s)
+				if err != nil {
+					return nil, false
+				}
+				indexes = append(indexes, n)
+			}
+		}
+		if name != "" {
+			m, ok := v.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			if v, ok = m[name]; !ok {
+				return nil, false
+			}
+		}
+		for _, n := range indexes {
+			list, ok := v.([]interface{})
+			if !ok || n < 0 || n >= len(list) {
+				return nil, false
+			}
+			v = list[n]
+		}
+	}
+	return v, v != nil
+}
+
+// A Match selects operations. An empty Match selects all operations.
+type Match struct {
+	// Methods are the HTTP methods of the selected operations.
+	Methods []string
+
+	// Paths are path.Match patterns of the paths of the selected
+	// operations, e.g. "/aws/ec2/group/*".
+	Paths []string
+
+	// Inputs are the type names of the inputs of the selected operations,
+	// e.g. "RollGroupInput".
+	Inputs []string
+}
+
+// Matches reports whether m selects op.
+func (m Match) Matches(op *Operation) bool {
+	if len(m.Methods) > 0 && !containsFold(m.Methods, op.Method) {
+		return false
+	}
+	if len(m.Inputs) > 0 && !contains(m.Inputs, op.InputType()) {
+		return false
+	}
+	if len(m.Paths) > 0 {
+		for _, pattern := range m.Paths {
+			if ok, _ := path.Match(pattern, op.Path); ok {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(list []string, s string) bool {
+	for _, e := range list {
+		if strings.EqualFold(e, s) {
+			return true
+		}
+	}
+	return false
+}
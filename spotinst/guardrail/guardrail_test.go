@@ -0,0 +1,215 @@
+package guardrail
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type capacity struct {
+	Maximum *int `json:"maximum,omitempty"`
+}
+
+type group struct {
+	Capacity *capacity `json:"capacity,omitempty"`
+}
+
+type updateGroupInput struct {
+	Group *group `json:"group,omitempty"`
+}
+
+type detachGroupInput struct {
+	InstanceIDs              []string `json:"instancesToDetach,omitempty"`
+	ShouldTerminateInstances *bool    `json:"shouldTerminateInstances,omitempty"`
+}
+
+type rollInput struct {
+	Roll struct {
+		Comment *string `json:"comment,omitempty"`
+	} `json:"roll"`
+}
+
+func intPtr(v int) *int       { return &v }
+func boolPtr(v bool) *bool    { return &v }
+func strPtr(v string) *string { return &v }
+
+// fetcher returns a FetchFunc serving a group tagged env=prod and counting
+// its calls.
+func fetcher(calls *int) FetchFunc {
+	return func(_ context.Context, path string) (json.RawMessage, error) {
+		*calls++
+		if path != "/aws/ec2/group/sig-12345" {
+			return nil, errors.New("unexpected path " + path)
+		}
+		return json.RawMessage(`{"id":"sig-12345","compute":{"launchSpecification":{
+			"tags":[{"tagKey":"env","tagValue":"prod"},{"tagKey":"cost-center","tagValue":"42"}]}}}`), nil
+	}
+}
+
+func TestBuiltinRules(t *testing.T) {
+	var calls int
+	chain := Chain{
+		&DenyTag{
+			Match: Match{Methods: []string{http.MethodDelete}, Paths: []string{"/aws/ec2/group/*"}},
+			Key:   "env",
+			Value: "prod",
+		},
+		&MaxValue{Name: "max-capacity", Field: "group.capacity.maximum", Max: 100},
+		&RequireField{Match: Match{Inputs: []string{"rollInput"}}, Field: "roll.comment"},
+		&ForbidValue{
+			Match:         Match{Inputs: []string{"detachGroupInput"}},
+			Field:         "shouldTerminateInstances",
+			Value:         true,
+			AllowApproved: true,
+		},
+	}
+
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		ctx  context.Context
+		op   *Operation
+		rule string
+	}{
+		{
+			name: "delete prod",
+			op:   NewOperation(http.MethodDelete, "/aws/ec2/group/sig-12345", nil, fetcher(&calls)),
+			rule: "deny-tag",
+		},
+		{
+			name: "capacity within maximum",
+			op: NewOperation(http.MethodPut, "/aws/ec2/group/sig-12345", &updateGroupInput{
+				Group: &group{Capacity: &capacity{Maximum: intPtr(100)}},
+			}, fetcher(&calls)),
+		},
+		{
+			name: "capacity above maximum",
+			op: NewOperation(http.MethodPut, "/aws/ec2/group/sig-12345", &updateGroupInput{
+				Group: &group{Capacity: &capacity{Maximum: intPtr(101)}},
+			}, fetcher(&calls)),
+			rule: "max-capacity",
+		},
+		{
+			name: "roll without comment",
+			op:   NewOperation(http.MethodPost, "/ocean/aws/k8s/cluster/o-12345/roll", &rollInput{}, nil),
+			rule: "require-field",
+		},
+		{
+			name: "detach and terminate",
+			op: NewOperation(http.MethodPut, "/aws/ec2/group/sig-12345/detachInstances", &detachGroupInput{
+				ShouldTerminateInstances: boolPtr(true),
+			}, nil),
+			rule: "forbid-value",
+		},
+		{
+			name: "approved detach and terminate",
+			ctx:  WithApproval(ctx),
+			op: NewOperation(http.MethodPut, "/aws/ec2/group/sig-12345/detachInstances", &detachGroupInput{
+				ShouldTerminateInstances: boolPtr(true),
+			}, nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tt.ctx
+			if c == nil {
+				c = ctx
+			}
+			err := chain.Check(c, tt.op)
+			if tt.rule == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var v *Violation
+			if !errors.As(err, &v) || v.Rule != tt.rule || !errors.Is(err, ErrDenied) {
+				t.Fatalf("got error %v, want a violation of %q", err, tt.rule)
+			}
+		})
+	}
+
+	// Only the delete needs the current state of the group.
+	if calls != 1 {
+		t.Errorf("got %d fetches, want 1", calls)
+	}
+}
+
+func TestExpr(t *testing.T) {
+	var calls int
+	del := NewOperation(http.MethodDelete, "/aws/ec2/group/sig-12345", nil, fetcher(&calls))
+	update := NewOperation(http.MethodPut, "/aws/ec2/group/sig-12345", &updateGroupInput{
+		Group: &group{Capacity: &capacity{Maximum: intPtr(200)}},
+	}, fetcher(&calls))
+	detach := NewOperation(http.MethodPut, "/aws/ec2/group/sig-12345/detachInstances", &detachGroupInput{
+		InstanceIDs:              []string{"i-1"},
+		ShouldTerminateInstances: boolPtr(true),
+	}, nil)
+
+	tests := []struct {
+		expr string
+		op   *Operation
+		deny bool
+	}{
+		{`method == "DELETE" && path matches "/aws/ec2/group/*" && tags.env == "prod"`, del, true},
+		{`method == "DELETE" && tags["cost-center"] != "42"`, del, false},
+		{`current.compute.launchSpecification.tags[0].tagValue == "prod"`, del, true},
+		{`input.group.capacity.maximum > 100`, update, true},
+		{`input.group.capacity.maximum <= 100`, update, false},
+		{`input.group.capacity.minimum > 1`, update, false},
+		{`operation == "detachGroupInput" && input.shouldTerminateInstances && !approved`, detach, true},
+		{`input.instancesToDetach[0] == "i-1" && (approved || input.drainingTimeout == null)`, detach, true},
+		{`!(method == "PUT")`, detach, false},
+	}
+	for _, tt := range tests {
+		r, err := Expr("test", tt.expr)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.expr, err)
+		}
+		err = r.Check(context.Background(), tt.op)
+		if deny := errors.Is(err, ErrDenied); deny != tt.deny || (err != nil && !deny) {
+			t.Errorf("%s: got error %v, want denied=%t", tt.expr, err, tt.deny)
+		}
+	}
+}
+
+func TestExprErrors(t *testing.T) {
+	for _, expr := range []string{
+		``,
+		`method ==`,
+		`method == "DELETE`,
+		`(method == "PUT"`,
+		`unknown == 1`,
+		`input.`,
+		`input[true]`,
+		`method = "PUT"`,
+	} {
+		if _, err := Expr("test", expr); err == nil || !strings.HasPrefix(err.Error(), "guardrail: invalid expression") {
+			t.Errorf("%q: got error %v, want an invalid expression", expr, err)
+		}
+	}
+
+	// Non-boolean results fail rather than allow the operation silently.
+	r := MustExpr("test", `method`)
+	op := NewOperation(http.MethodPut, "/aws/ec2/group/sig-12345", nil, nil)
+	if err := r.Check(context.Background(), op); err == nil || errors.Is(err, ErrDenied) {
+		t.Errorf("got error %v, want an evaluation error", err)
+	}
+}
+
+func TestResourcePath(t *testing.T) {
+	for p, want := range map[string]string{
+		"/aws/ec2/group/sig-12345":                           "/aws/ec2/group/sig-12345",
+		"/aws/ec2/group/sig-12345/roll":                      "/aws/ec2/group/sig-12345",
+		"/ocean/aws/k8s/launchSpec/ols-12345":                "/ocean/aws/k8s/launchSpec/ols-12345",
+		"/ocean/aws/k8s/cluster/o-12345/roll/scr-12345/stop": "/ocean/aws/k8s/cluster/o-12345/roll/scr-12345",
+		"/aws/ec2/group":                                     "",
+	} {
+		if got := NewOperation(http.MethodPut, p, nil, nil).ResourcePath(); got != want {
+			t.Errorf("%s: got %q, want %q", p, got, want)
+		}
+	}
+}
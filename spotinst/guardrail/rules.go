@@ -0,0 +1,145 @@
+package guardrail
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// DenyTag denies the operations on resources tagged Key=Value, e.g. the
+// deletion of groups tagged env=prod. It reads the current state of the
+// resource of each selected operation.
+type DenyTag struct {
+	Name  string
+	Match Match
+	Key   string
+	Value string
+}
+
+// Check implements the Guardrail interface.
+func (r *DenyTag) Check(ctx context.Context, op *Operation) error {
+	if !r.Match.Matches(op) {
+		return nil
+	}
+	tags, err := op.Tags(ctx)
+	if err != nil {
+		return err
+	}
+	if value, ok := tags[r.Key]; ok && value == r.Value {
+		return Deny(ruleName(r.Name, "deny-tag"), op, "resource is tagged %s=%s", r.Key, r.Value)
+	}
+	return nil
+}
+
+// MaxValue denies the operations whose input sets the numeric field at
+// Field, e.g. "group.capacity.maximum", above Max.
+type MaxValue struct {
+	Name  string
+	Match Match
+	Field string
+	Max   float64
+}
+
+// Check implements the Guardrail interface.
+func (r *MaxValue) Check(ctx context.Context, op *Operation) error {
+	if !r.Match.Matches(op) {
+		return nil
+	}
+	v, ok, err := op.Field(r.Field)
+	if err != nil || !ok {
+		return err
+	}
+	if n, isNumber := v.(float64); isNumber && n > r.Max {
+		return Deny(ruleName(r.Name, "max-value"), op, "%s is %v, above the maximum of %v", r.Field, n, r.Max)
+	}
+	return nil
+}
+
+// RequireField denies the operations whose input doesn't set the field at
+// Field, e.g. "roll.comment", to a non-empty value.
+type RequireField struct {
+	Name  string
+	Match Match
+	Field string
+}
+
+// Check implements the Guardrail interface.
+func (r *RequireField) Check(ctx context.Context, op *Operation) error {
+	if !r.Match.Matches(op) {
+		return nil
+	}
+	v, ok, err := op.Field(r.Field)
+	if err != nil {
+		return err
+	}
+	if s, isString := v.(string); !ok || (isString && s == "") {
+		return Deny(ruleName(r.Name, "require-field"), op, "%s is required", r.Field)
+	}
+	return nil
+}
+
+// ForbidValue denies the operations whose input sets the field at Field to
+// Value, e.g. "shouldTerminateInstances" to true, unless AllowApproved is
+// set and the operation is approved with WithApproval.
+type ForbidValue struct {
+	Name          string
+	Match         Match
+	Field         string
+	Value         interface{}
+	AllowApproved bool
+}
+
+// Check implements the Guardrail interface.
+func (r *ForbidValue) Check(ctx context.Context, op *Operation) error {
+	if !r.Match.Matches(op) || (r.AllowApproved && Approved(ctx)) {
+		return nil
+	}
+	v, ok, err := op.Field(r.Field)
+	if err != nil || !ok {
+		return err
+	}
+	want, err := normalize(r.Value)
+	if err != nil {
+		return err
+	}
+	if reflect.DeepEqual(v, want) {
+		reason := fmt.Sprintf("%s must not be %s", r.Field, formatValue(v))
+		if r.AllowApproved {
+			reason += " without approval"
+		}
+		return Deny(ruleName(r.Name, "forbid-value"), op, "%s", reason)
+	}
+	return nil
+}
+
+func ruleName(name, def string) string {
+	if name != "" {
+		return name
+	}
+	return def
+}
+
+// normalize returns v as decoded from its JSON encoding, so that it can be
+// compared with the fields of operations.
+func normalize(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = json.Unmarshal(b, &out)
+	return out, err
+}
+
+// formatValue formats a value for display.
+func formatValue(v interface{}) string {
+	if v == nil {
+		return "null"
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
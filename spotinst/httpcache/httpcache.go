@@ -0,0 +1,266 @@
+// Package httpcache provides a read-through cache for API responses.
+//
+// Responses are cached per (account, path, query) for a TTL, which can be
+// configured per path prefix. Concurrent identical requests are collapsed
+// into a single request, and a mutating request invalidates the cached
+// responses of its path, of the paths below it, and of the paths above it,
+// e.g. an update of a group invalidates both the group and the list of
+// groups.
+package httpcache
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultTTL is the default time-to-live of cached responses.
+	DefaultTTL = 30 * time.Second
+
+	// DefaultFetchTimeout is the default upper bound of a shared fetch.
+	DefaultFetchTimeout = time.Minute
+)
+
+// errFetchPanicked is returned to the callers waiting on a fetch that
+// panicked.
+var errFetchPanicked = errors.New("httpcache: fetch panicked")
+
+// An Entry is a cached response.
+type Entry struct {
+	StatusCode int
+	Header     map[string][]string
+	Body       []byte
+}
+
+// A FetchFunc performs a request and returns its response. Only responses
+// with status 200 OK are cached.
+type FetchFunc func() (*Entry, error)
+
+// Cache is a read-through cache of API responses. It's safe for concurrent
+// use.
+type Cache struct {
+	ttl          time.Duration
+	ttls         []pathTTL
+	fetchTimeout time.Duration
+	now          func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*cached
+	calls   map[string]*call
+
+	// gen is incremented on invalidation, so that responses fetched before
+	// are not cached.
+	gen uint64
+}
+
+type pathTTL struct {
+	prefix string
+	ttl    time.Duration
+}
+
+type cached struct {
+	account string
+	path    string
+	entry   *Entry
+	expires time.Time
+}
+
+type call struct {
+	gen   uint64
+	wg    sync.WaitGroup
+	entry *Entry
+	err   error
+}
+
+// New returns a cache whose entries live for ttl, or DefaultTTL if ttl is
+// not positive.
+func New(ttl time.Duration) *Cache {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Cache{
+		ttl:          ttl,
+		fetchTimeout: DefaultFetchTimeout,
+		now:          time.Now,
+		entries:      make(map[string]*cached),
+		calls:        make(map[string]*call),
+	}
+}
+
+// WithFetchTimeout sets the upper bound of a fetch shared by concurrent
+// identical requests. The fetch doesn't end when the request that started it
+// is canceled, so a hung fetch would otherwise hold up every later identical
+// request. A timeout that is not positive means no limit.
+func (c *Cache) WithFetchTimeout(timeout time.Duration) *Cache {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.fetchTimeout = timeout
+	return c
+}
+
+// FetchTimeout returns the upper bound of a shared fetch, or zero if there
+// is none.
+func (c *Cache) FetchTimeout() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.fetchTimeout < 0 {
+		return 0
+	}
+	return c.fetchTimeout
+}
+
+// WithPathTTL sets the TTL of the responses of paths starting with prefix.
+// The longest matching prefix wins. A TTL that is not positive disables
+// caching for those paths.
+func (c *Cache) WithPathTTL(prefix string, ttl time.Duration) *Cache {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ttls = append(c.ttls, pathTTL{prefix: prefix, ttl: ttl})
+	sort.SliceStable(c.ttls, func(i, j int) bool {
+		return len(c.ttls[i].prefix) > len(c.ttls[j].prefix)
+	})
+	return c
+}
+
+// TTL returns the TTL of the responses of a path.
+func (c *Cache) TTL(path string) time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.pathTTL(path)
+}
+
+func (c *Cache) pathTTL(path string) time.Duration {
+	for _, t := range c.ttls {
+		if strings.HasPrefix(path, t.prefix) {
+			return t.ttl
+		}
+	}
+	return c.ttl
+}
+
+// Get returns the cached response of a request, calling fetch if there is
+// none. Concurrent calls for the same request share a single fetch. The
+// returned bool reports whether the response was served without calling
+// fetch in this call.
+func (c *Cache) Get(account string, u *url.URL, fetch FetchFunc) (*Entry, bool, error) {
+	key := Key(account, u)
+
+	c.mu.Lock()
+	ttl := c.pathTTL(u.Path)
+	if ttl <= 0 {
+		c.mu.Unlock()
+		entry, err := fetch()
+		return entry, false, err
+	}
+	if e, ok := c.entries[key]; ok {
+		if c.now().Before(e.expires) {
+			c.mu.Unlock()
+			return e.entry, true, nil
+		}
+		delete(c.entries, key)
+	}
+	if cl, ok := c.calls[key]; ok {
+		c.mu.Unlock()
+		cl.wg.Wait()
+		return cl.entry, true, cl.err
+	}
+	cl := &call{gen: c.gen}
+	cl.wg.Add(1)
+	c.calls[key] = cl
+	c.mu.Unlock()
+
+	c.fetch(cl, key, account, u.Path, ttl, fetch)
+	return cl.entry, false, cl.err
+}
+
+// fetch calls fetch on behalf of all callers waiting on cl, and caches its
+// response. Waiters are released even if fetch panics.
+func (c *Cache) fetch(cl *call, key, account, path string, ttl time.Duration, fetch FetchFunc) {
+	defer func() {
+		c.mu.Lock()
+		delete(c.calls, key)
+		if cl.err == nil && cl.entry != nil && cl.entry.StatusCode == http.StatusOK && cl.gen == c.gen {
+			c.entries[key] = &cached{
+				account: account,
+				path:    path,
+				entry:   cl.entry,
+				expires: c.now().Add(ttl),
+			}
+		}
+		c.mu.Unlock()
+		cl.wg.Done()
+	}()
+
+	cl.err = errFetchPanicked
+	cl.entry, cl.err = fetch()
+}
+
+// Invalidate removes the cached responses of an account whose paths are
+// equal to, below or above path.
+func (c *Cache) Invalidate(account, path string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.gen++
+	for key, e := range c.entries {
+		if e.account == account && (isSubpath(e.path, path) || isSubpath(path, e.path)) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// Purge removes all cached responses.
+func (c *Cache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.gen++
+	c.entries = make(map[string]*cached)
+}
+
+// Len returns the number of cached responses, including expired ones that
+// haven't been evicted yet.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
+
+type bypassKey struct{}
+
+// WithBypass returns a copy of ctx whose requests skip the cache, e.g. to
+// read the latest state of a resource before updating it.
+func WithBypass(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassKey{}, true)
+}
+
+// Bypassed reports whether the requests made with ctx skip the cache.
+func Bypassed(ctx context.Context) bool {
+	bypassed, _ := ctx.Value(bypassKey{}).(bool)
+	return bypassed
+}
+
+// Key returns the cache key of a request: the account, the path and the
+// sorted query parameters other than accountId.
+func Key(account string, u *url.URL) string {
+	query := u.Query()
+	query.Del("accountId")
+	return account + "|" + u.Path + "?" + query.Encode()
+}
+
+// isSubpath reports whether path is equal to or below parent.
+func isSubpath(path, parent string) bool {
+	path, parent = strings.TrimSuffix(path, "/"), strings.TrimSuffix(parent, "/")
+	return path == parent || strings.HasPrefix(path, parent+"/")
+}
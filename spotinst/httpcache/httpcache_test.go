@@ -0,0 +1,142 @@
+package httpcache
+
+import (
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func mustParse(t *testing.T, rawurl string) *url.URL {
+	t.Helper()
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
+
+func counter(n *int32, status int) FetchFunc {
+	return func() (*Entry, error) {
+		atomic.AddInt32(n, 1)
+		return &Entry{StatusCode: status, Body: []byte("{}")}, nil
+	}
+}
+
+func TestKey(t *testing.T) {
+	a := Key("act-1", mustParse(t, "/aws/ec2/group?b=2&accountId=act-1&a=1"))
+	b := Key("act-1", mustParse(t, "/aws/ec2/group?a=1&b=2"))
+	if a != b {
+		t.Errorf("got different keys %q and %q", a, b)
+	}
+	if c := Key("act-2", mustParse(t, "/aws/ec2/group?a=1&b=2")); c == a {
+		t.Errorf("expected accounts to have different keys")
+	}
+}
+
+func TestGetTTL(t *testing.T) {
+	now := time.Unix(0, 0)
+	c := New(time.Minute).WithPathTTL("/ocean", 0)
+	c.now = func() time.Time { return now }
+
+	var n int32
+	u := mustParse(t, "/aws/ec2/group/sig-1")
+	for i := 0; i < 3; i++ {
+		if _, _, err := c.Get("act-1", u, counter(&n, 200)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n != 1 {
+		t.Errorf("got %d fetches, want 1", n)
+	}
+
+	now = now.Add(time.Minute)
+	c.Get("act-1", u, counter(&n, 200))
+	if n != 2 {
+		t.Errorf("got %d fetches after expiry, want 2", n)
+	}
+
+	// Errors aren't cached.
+	u = mustParse(t, "/aws/ec2/group/sig-2")
+	c.Get("act-1", u, counter(&n, 404))
+	c.Get("act-1", u, counter(&n, 404))
+	if n != 4 {
+		t.Errorf("got %d fetches, want 4", n)
+	}
+
+	// Caching is disabled by a zero TTL.
+	u = mustParse(t, "/ocean/aws/k8s/cluster/o-1")
+	c.Get("act-1", u, counter(&n, 200))
+	c.Get("act-1", u, counter(&n, 200))
+	if n != 6 {
+		t.Errorf("got %d fetches, want 6", n)
+	}
+}
+
+func TestGetSingleflight(t *testing.T) {
+	c := New(time.Minute)
+	u := mustParse(t, "/aws/ec2/group")
+
+	release := make(chan struct{})
+	var n int32
+	fetch := func() (*Entry, error) {
+		atomic.AddInt32(&n, 1)
+		<-release
+		return &Entry{StatusCode: 200}, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := c.Get("act-1", u, fetch); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	for {
+		c.mu.Lock()
+		pending := len(c.calls)
+		c.mu.Unlock()
+		if pending > 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n != 1 {
+		t.Errorf("got %d fetches, want 1", n)
+	}
+}
+
+func TestInvalidate(t *testing.T) {
+	c := New(time.Minute)
+	var n int32
+	for _, p := range []string{
+		"/aws/ec2/group",
+		"/aws/ec2/group/sig-1",
+		"/aws/ec2/group/sig-1/status",
+		"/aws/ec2/group/sig-10",
+	} {
+		c.Get("act-1", mustParse(t, p), counter(&n, 200))
+		c.Get("act-2", mustParse(t, p), counter(&n, 200))
+	}
+
+	c.Invalidate("act-1", "/aws/ec2/group/sig-1")
+	if got, want := c.Len(), 5; got != want {
+		t.Errorf("got %d entries, want %d", got, want)
+	}
+	if _, hit, _ := c.Get("act-1", mustParse(t, "/aws/ec2/group/sig-10"), counter(&n, 200)); !hit {
+		t.Error("expected sibling path to stay cached")
+	}
+
+	c.Purge()
+	if c.Len() != 0 {
+		t.Errorf("got %d entries after purge", c.Len())
+	}
+}
@@ -0,0 +1,196 @@
+package informer
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Names of the indexes maintained by the informers of the service packages.
+const (
+	IndexName      = "name"
+	IndexRegion    = "region"
+	IndexClusterID = "clusterID"
+	IndexTag       = "tag"
+)
+
+// An IndexFunc returns the values an object is indexed by.
+type IndexFunc func(obj interface{}) []string
+
+// Indexers maps index names to index functions.
+type Indexers map[string]IndexFunc
+
+// TagIndexValue returns the IndexTag value of a tag.
+func TagIndexValue(key, value string) string {
+	return key + "=" + value
+}
+
+// TagIndexValues returns the IndexTag values of n tags, whose keys and values
+// are returned by tag.
+func TagIndexValues(n int, tag func(i int) (key, value string)) []string {
+	values := make([]string, n)
+	for i := range values {
+		values[i] = TagIndexValue(tag(i))
+	}
+	return values
+}
+
+// Cache is a thread-safe store of objects by key, with secondary indexes.
+type Cache struct {
+	mu       sync.RWMutex
+	items    map[string]interface{}
+	indexers Indexers
+
+	// indices maps index names to index values to keys.
+	indices map[string]map[string]map[string]struct{}
+}
+
+// NewCache returns an empty cache maintaining the given indexes.
+func NewCache(indexers Indexers) *Cache {
+	c := &Cache{
+		items:    make(map[string]interface{}),
+		indexers: indexers,
+		indices:  make(map[string]map[string]map[string]struct{}, len(indexers)),
+	}
+	for name := range indexers {
+		c.indices[name] = make(map[string]map[string]struct{})
+	}
+	return c
+}
+
+// Get returns the object stored under key.
+func (c *Cache) Get(key string) (interface{}, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	obj, ok := c.items[key]
+	return obj, ok
+}
+
+// Keys returns the keys of the stored objects, sorted.
+func (c *Cache) Keys() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	keys := make([]string, 0, len(c.items))
+	for key := range c.items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// List returns the stored objects, sorted by key.
+func (c *Cache) List() []interface{} {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	keys := make([]string, 0, len(c.items))
+	for key := range c.items {
+		keys = append(keys, key)
+	}
+	return c.objects(keys)
+}
+
+// Len returns the number of stored objects.
+func (c *Cache) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return len(c.items)
+}
+
+// ByIndex returns the objects whose index values include value, sorted by
+// key. It returns an error if the index doesn't exist.
+func (c *Cache) ByIndex(name, value string) ([]interface{}, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	index, ok := c.indices[name]
+	if !ok {
+		return nil, fmt.Errorf("informer: index %q does not exist", name)
+	}
+	keys := make([]string, 0, len(index[value]))
+	for key := range index[value] {
+		keys = append(keys, key)
+	}
+	return c.objects(keys), nil
+}
+
+// IndexValues returns the values of an index, sorted. It returns an error if
+// the index doesn't exist.
+func (c *Cache) IndexValues(name string) ([]string, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	index, ok := c.indices[name]
+	if !ok {
+		return nil, fmt.Errorf("informer: index %q does not exist", name)
+	}
+	values := make([]string, 0, len(index))
+	for value := range index {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+	return values, nil
+}
+
+// objects returns the objects stored under the given keys, sorted by key.
+// The caller must hold the lock.
+func (c *Cache) objects(keys []string) []interface{} {
+	sort.Strings(keys)
+	objs := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		objs = append(objs, c.items[key])
+	}
+	return objs
+}
+
+// snapshot returns a copy of the stored objects by key.
+func (c *Cache) snapshot() map[string]interface{} {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	items := make(map[string]interface{}, len(c.items))
+	for key, obj := range c.items {
+		items[key] = obj
+	}
+	return items
+}
+
+// replace replaces the stored objects with items. The indexes are built
+// before the lock is taken, so readers see either the old or the new
+// objects, never a mix of both.
+func (c *Cache) replace(items map[string]interface{}) {
+	indices := make(map[string]map[string]map[string]struct{}, len(c.indexers))
+	for name, fn := range c.indexers {
+		index := make(map[string]map[string]struct{})
+		for key, obj := range items {
+			for _, value := range fn(obj) {
+				if index[value] == nil {
+					index[value] = make(map[string]struct{})
+				}
+				index[value][key] = struct{}{}
+			}
+		}
+		indices[name] = index
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.items = items
+	c.indices = indices
+}
+
+// Merge returns the union of the given indexers. Later indexers replace
+// earlier ones of the same name.
+func Merge(indexers ...Indexers) Indexers {
+	merged := make(Indexers)
+	for _, idx := range indexers {
+		for name, fn := range idx {
+			merged[name] = fn
+		}
+	}
+	return merged
+}
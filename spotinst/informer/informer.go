@@ -0,0 +1,233 @@
+// Package informer provides a polling alternative to watching resources.
+//
+// An Informer periodically lists a resource type, diffs the result against
+// the previous snapshot by key and version, and notifies registered handlers
+// of the objects that were added, modified or deleted. The latest snapshot is
+// kept in a thread-safe, indexed Cache, so that readers don't need to call
+// the List endpoints themselves.
+package informer
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/waiter"
+)
+
+// DefaultInterval is the default delay between lists.
+const DefaultInterval = time.Minute
+
+// A ListFunc lists all objects of a resource type.
+type ListFunc func(ctx context.Context) ([]interface{}, error)
+
+// A KeyFunc returns the key of an object, usually its ID.
+type KeyFunc func(obj interface{}) string
+
+// A VersionFunc returns the version of an object, usually its UpdatedAt
+// time. Objects whose versions are both zero are compared deeply instead.
+type VersionFunc func(obj interface{}) time.Time
+
+// A Handler is notified of changes to the listed objects. Handlers are
+// called sequentially, after the cache has been updated, and may read the
+// informer and its cache but must not call AddEventHandler or Resync.
+type Handler interface {
+	OnAdd(obj interface{})
+	OnUpdate(oldObj, newObj interface{})
+	OnDelete(obj interface{})
+}
+
+// HandlerFuncs adapts functions to a Handler. Nil functions are ignored.
+type HandlerFuncs struct {
+	AddFunc    func(obj interface{})
+	UpdateFunc func(oldObj, newObj interface{})
+	DeleteFunc func(obj interface{})
+}
+
+// OnAdd calls AddFunc if it's not nil.
+func (h HandlerFuncs) OnAdd(obj interface{}) {
+	if h.AddFunc != nil {
+		h.AddFunc(obj)
+	}
+}
+
+// OnUpdate calls UpdateFunc if it's not nil.
+func (h HandlerFuncs) OnUpdate(oldObj, newObj interface{}) {
+	if h.UpdateFunc != nil {
+		h.UpdateFunc(oldObj, newObj)
+	}
+}
+
+// OnDelete calls DeleteFunc if it's not nil.
+func (h HandlerFuncs) OnDelete(obj interface{}) {
+	if h.DeleteFunc != nil {
+		h.DeleteFunc(obj)
+	}
+}
+
+// Config configures an Informer.
+type Config struct {
+	List    ListFunc
+	Key     KeyFunc
+	Version VersionFunc
+
+	// Indexers are the indexes maintained by the cache.
+	Indexers Indexers
+
+	// Interval is the delay between lists. Defaults to DefaultInterval.
+	Interval time.Duration
+
+	// OnError, if set, is called when listing fails. The informer keeps
+	// running and retries after Interval.
+	OnError func(error)
+
+	// Clock is used to sleep between lists. Defaults to
+	// waiter.SystemClock.
+	Clock waiter.Clock
+}
+
+// An Informer lists a resource type periodically and notifies handlers of
+// changes.
+type Informer struct {
+	cfg   Config
+	cache *Cache
+
+	// notifyMu serializes cache updates and handler notifications.
+	notifyMu sync.Mutex
+
+	mu       sync.Mutex
+	handlers []Handler
+	synced   bool
+}
+
+// New returns an Informer configured by cfg.
+func New(cfg Config) *Informer {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultInterval
+	}
+	if cfg.Clock == nil {
+		cfg.Clock = waiter.SystemClock
+	}
+	return &Informer{
+		cfg:   cfg,
+		cache: NewCache(cfg.Indexers),
+	}
+}
+
+// Cache returns the informer's cache.
+func (i *Informer) Cache() *Cache {
+	return i.cache
+}
+
+// HasSynced reports whether the first list has completed.
+func (i *Informer) HasSynced() bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.synced
+}
+
+// AddEventHandler registers a handler. If the informer has synced already,
+// the handler is notified of the cached objects as additions.
+func (i *Informer) AddEventHandler(h Handler) {
+	i.notifyMu.Lock()
+	defer i.notifyMu.Unlock()
+
+	i.mu.Lock()
+	i.handlers = append(i.handlers, h)
+	synced := i.synced
+	i.mu.Unlock()
+
+	if synced {
+		for _, obj := range i.cache.List() {
+			h.OnAdd(obj)
+		}
+	}
+}
+
+// Run lists the resource type every Interval until ctx is done.
+func (i *Informer) Run(ctx context.Context) {
+	for {
+		if err := i.Resync(ctx); err != nil && ctx.Err() == nil && i.cfg.OnError != nil {
+			i.cfg.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-i.cfg.Clock.After(i.cfg.Interval):
+		}
+	}
+}
+
+// Resync lists the resource type once, replaces the cache contents and
+// notifies the handlers of the changes.
+func (i *Informer) Resync(ctx context.Context) error {
+	objs, err := i.cfg.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	i.notifyMu.Lock()
+	defer i.notifyMu.Unlock()
+
+	prev := i.cache.snapshot()
+	items := make(map[string]interface{}, len(objs))
+	var notify []func(Handler)
+	for _, obj := range objs {
+		obj := obj
+		key := i.cfg.Key(obj)
+
+		old, ok := prev[key]
+		switch {
+		case !ok:
+			items[key] = obj
+			notify = append(notify, func(h Handler) { h.OnAdd(obj) })
+		case i.modified(old, obj):
+			items[key] = obj
+			notify = append(notify, func(h Handler) { h.OnUpdate(old, obj) })
+		default:
+			items[key] = old
+		}
+	}
+
+	deleted := make([]string, 0, len(prev))
+	for key := range prev {
+		if _, ok := items[key]; !ok {
+			deleted = append(deleted, key)
+		}
+	}
+	sort.Strings(deleted)
+	for _, key := range deleted {
+		old := prev[key]
+		notify = append(notify, func(h Handler) { h.OnDelete(old) })
+	}
+
+	i.cache.replace(items)
+
+	i.mu.Lock()
+	i.synced = true
+	handlers := append([]Handler(nil), i.handlers...)
+	i.mu.Unlock()
+
+	// Handlers are called without holding mu, so they can use HasSynced.
+	for _, fn := range notify {
+		for _, h := range handlers {
+			fn(h)
+		}
+	}
+
+	return nil
+}
+
+func (i *Informer) modified(old, obj interface{}) bool {
+	if i.cfg.Version != nil {
+		oldVersion, version := i.cfg.Version(old), i.cfg.Version(obj)
+		if !oldVersion.IsZero() || !version.IsZero() {
+			return !oldVersion.Equal(version)
+		}
+	}
+	return !reflect.DeepEqual(old, obj)
+}
@@ -0,0 +1,236 @@
+package informer
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// fakeClock fires After when the test sends on ticks.
+type fakeClock struct {
+	ticks chan time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return time.Unix(0, 0) }
+
+func (c *fakeClock) After(time.Duration) <-chan time.Time { return c.ticks }
+
+type object struct {
+	ID        string
+	Region    string
+	Tags      map[string]string
+	UpdatedAt time.Time
+}
+
+func newTestInformer(lists ...[]*object) *Informer {
+	i := 0
+	return New(Config{
+		List: func(ctx context.Context) ([]interface{}, error) {
+			if i >= len(lists) {
+				return nil, errors.New("no more lists")
+			}
+			var objs []interface{}
+			for _, o := range lists[i] {
+				objs = append(objs, o)
+			}
+			i++
+			return objs, nil
+		},
+		Key:     func(obj interface{}) string { return obj.(*object).ID },
+		Version: func(obj interface{}) time.Time { return obj.(*object).UpdatedAt },
+		Indexers: Indexers{
+			IndexRegion: func(obj interface{}) []string { return []string{obj.(*object).Region} },
+			IndexTag: func(obj interface{}) []string {
+				var values []string
+				for k, v := range obj.(*object).Tags {
+					values = append(values, TagIndexValue(k, v))
+				}
+				return values
+			},
+		},
+	})
+}
+
+type recorder struct {
+	events []string
+}
+
+func (r *recorder) handler() Handler {
+	return HandlerFuncs{
+		AddFunc:    func(obj interface{}) { r.events = append(r.events, "add "+obj.(*object).ID) },
+		UpdateFunc: func(_, obj interface{}) { r.events = append(r.events, "update "+obj.(*object).ID) },
+		DeleteFunc: func(obj interface{}) { r.events = append(r.events, "delete "+obj.(*object).ID) },
+	}
+}
+
+func TestInformerResync(t *testing.T) {
+	t0 := time.Unix(0, 0)
+	t1 := t0.Add(time.Minute)
+
+	inf := newTestInformer(
+		[]*object{
+			{ID: "a", Region: "us-east-1", UpdatedAt: t0},
+			{ID: "b", Region: "us-west-2", UpdatedAt: t0, Tags: map[string]string{"env": "prod"}},
+		},
+		[]*object{
+			{ID: "b", Region: "us-east-1", UpdatedAt: t1},
+			{ID: "c", Region: "us-east-1", UpdatedAt: t0, Tags: map[string]string{"env": "prod"}},
+		},
+	)
+	var rec recorder
+	inf.AddEventHandler(rec.handler())
+
+	ctx := context.Background()
+	if err := inf.Resync(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !inf.HasSynced() {
+		t.Error("expected informer to have synced")
+	}
+	if err := inf.Resync(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := inf.Resync(ctx); err == nil {
+		t.Error("expected list error")
+	}
+
+	want := []string{"add a", "add b", "update b", "add c", "delete a"}
+	if !reflect.DeepEqual(rec.events, want) {
+		t.Errorf("got events %v, want %v", rec.events, want)
+	}
+
+	cache := inf.Cache()
+	if got := cache.Keys(); !reflect.DeepEqual(got, []string{"b", "c"}) {
+		t.Errorf("got keys %v", got)
+	}
+	objs, err := cache.ByIndex(IndexRegion, "us-east-1")
+	if err != nil || len(objs) != 2 {
+		t.Errorf("got %v, %v for region index", objs, err)
+	}
+	if objs, _ := cache.ByIndex(IndexRegion, "us-west-2"); len(objs) != 0 {
+		t.Errorf("stale region index entries: %v", objs)
+	}
+	objs, _ = cache.ByIndex(IndexTag, TagIndexValue("env", "prod"))
+	if len(objs) != 1 || objs[0].(*object).ID != "c" {
+		t.Errorf("unexpected tag index entries: %v", objs)
+	}
+	if _, err := cache.ByIndex("missing", "x"); err == nil {
+		t.Error("expected error for missing index")
+	}
+
+	// Late handlers are notified of the cached objects.
+	var late recorder
+	inf.AddEventHandler(late.handler())
+	if want := []string{"add b", "add c"}; !reflect.DeepEqual(late.events, want) {
+		t.Errorf("got events %v, want %v", late.events, want)
+	}
+}
+
+func TestInformerDeepEqual(t *testing.T) {
+	inf := newTestInformer(
+		[]*object{{ID: "a", Region: "us-east-1"}},
+		[]*object{{ID: "a", Region: "us-east-1"}},
+		[]*object{{ID: "a", Region: "eu-west-1"}},
+	)
+	var rec recorder
+	inf.AddEventHandler(rec.handler())
+
+	for n := 0; n < 3; n++ {
+		if err := inf.Resync(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if want := []string{"add a", "update a"}; !reflect.DeepEqual(rec.events, want) {
+		t.Errorf("got events %v, want %v", rec.events, want)
+	}
+}
+
+func TestInformerResyncSwapsCache(t *testing.T) {
+	inf := newTestInformer(
+		[]*object{{ID: "a", Region: "us-west-2"}},
+		[]*object{{ID: "b", Region: "us-east-1"}, {ID: "c", Region: "us-east-1"}},
+	)
+	if err := inf.Resync(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Handlers see the whole list applied, whichever change they're
+	// notified of.
+	cache := inf.Cache()
+	var resyncing bool
+	check := func(obj interface{}) {
+		if !resyncing {
+			return // cached objects replayed to a late handler
+		}
+		objs, _ := cache.ByIndex(IndexRegion, "us-east-1")
+		if cache.Len() != 2 || len(objs) != 2 {
+			t.Errorf("got %d objects, %d in us-east-1 when notified of %s", cache.Len(), len(objs), obj.(*object).ID)
+		}
+		if _, ok := cache.Get("a"); ok {
+			t.Errorf("deleted object still cached when notified of %s", obj.(*object).ID)
+		}
+	}
+	inf.AddEventHandler(HandlerFuncs{AddFunc: check, DeleteFunc: check})
+	resyncing = true
+	if err := inf.Resync(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestInformerRun(t *testing.T) {
+	inf := newTestInformer(
+		[]*object{{ID: "a", Region: "us-east-1"}},
+		[]*object{{ID: "a", Region: "us-east-1"}, {ID: "b", Region: "us-east-1"}},
+	)
+	clock := &fakeClock{ticks: make(chan time.Time)}
+	inf.cfg.Clock = clock
+
+	errs := make(chan error)
+	inf.cfg.OnError = func(err error) { errs <- err }
+
+	// Handlers may read the informer while they are notified.
+	added := make(chan string)
+	inf.AddEventHandler(HandlerFuncs{AddFunc: func(obj interface{}) {
+		if !inf.HasSynced() {
+			t.Error("expected informer to have synced")
+		}
+		added <- obj.(*object).ID
+	}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		inf.Run(ctx)
+	}()
+
+	if id := <-added; id != "a" {
+		t.Errorf("got %q added, want a", id)
+	}
+	clock.ticks <- time.Unix(0, 0)
+	if id := <-added; id != "b" {
+		t.Errorf("got %q added, want b", id)
+	}
+
+	// The third list fails and is reported; the cache is kept.
+	clock.ticks <- time.Unix(0, 0)
+	if err := <-errs; err == nil {
+		t.Error("expected list error")
+	}
+	cancel()
+	<-done
+
+	if got := inf.Cache().Keys(); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("got keys %v", got)
+	}
+}
+
+func TestTagIndexValues(t *testing.T) {
+	tags := [][2]string{{"env", "prod"}, {"team", ""}}
+	got := TagIndexValues(len(tags), func(i int) (string, string) { return tags[i][0], tags[i][1] })
+	if want := []string{"env=prod", "team="}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,261 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+)
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	timestampType = reflect.TypeOf(spotinst.Timestamp{})
+	dateType      = reflect.TypeOf(spotinst.Date{})
+	rawType       = reflect.TypeOf(json.RawMessage{})
+)
+
+// A Generator generates JSON Schema documents from Go types.
+//
+// Struct fields are named after their json tags. Pointer fields are optional
+// and nullable; other fields are required unless tagged omitempty. Every
+// named struct type is emitted once under $defs and referenced elsewhere.
+// String fields with a typed accessor named after them, e.g. ScaleTypeEnum,
+// only accept the values of the constants of the accessor's result type.
+type Generator struct {
+	// Source provides descriptions and enum values. Optional.
+	Source *Source
+
+	// AdditionalProperties controls whether objects generated from structs
+	// accept properties not declared by the struct.
+	AdditionalProperties bool
+}
+
+// Generate returns a schema document for the type of v, which is typically a
+// pointer to a model.
+func (g *Generator) Generate(v interface{}) *Schema {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	r := &reflector{
+		g:     g,
+		defs:  make(map[string]*Schema),
+		names: make(map[reflect.Type]string),
+	}
+	s := r.reflect(t)
+	s.Schema = Draft
+	s.Defs = r.defs
+
+	return s
+}
+
+type reflector struct {
+	g     *Generator
+	defs  map[string]*Schema
+	names map[reflect.Type]string
+}
+
+func (r *reflector) reflect(t reflect.Type) *Schema {
+	switch t {
+	case timeType, timestampType:
+		return &Schema{Type: Types{"string"}, Format: "date-time"}
+	case dateType:
+		return &Schema{Type: Types{"string"}, Format: "date"}
+	case rawType:
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return Nullable(r.reflect(t.Elem()))
+	case reflect.Bool:
+		return &Schema{Type: Types{"boolean"}}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: Types{"integer"}}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: Types{"number"}}
+	case reflect.String:
+		s := &Schema{Type: Types{"string"}}
+		if t.Name() != "" {
+			s.Enum = toInterfaces(r.g.Source.Enum(t.PkgPath(), t.Name()))
+		}
+		return s
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: Types{"string"}, Format: "byte"}
+		}
+		return &Schema{Type: Types{"array"}, Items: r.reflect(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: Types{"object"}, AdditionalProperties: r.reflect(t.Elem())}
+	case reflect.Struct:
+		return r.reflectStruct(t)
+	default:
+		return &Schema{} // interface{} and anything else accepts any value
+	}
+}
+
+func (r *reflector) reflectStruct(t reflect.Type) *Schema {
+	if t.Name() == "" {
+		s := r.object(t)
+		r.addFields(s, t)
+		return s
+	}
+
+	if name, ok := r.names[t]; ok {
+		return &Schema{Ref: "#/$defs/" + name}
+	}
+
+	name := r.defName(t)
+	s := r.object(t)
+	s.Description = r.g.Source.TypeDoc(t.PkgPath(), t.Name())
+
+	// Register before recursing so that cyclic types terminate.
+	r.names[t] = name
+	r.defs[name] = s
+	r.addFields(s, t)
+
+	return &Schema{Ref: "#/$defs/" + name}
+}
+
+func (r *reflector) object(t reflect.Type) *Schema {
+	s := &Schema{
+		Type:       Types{"object"},
+		Properties: make(map[string]*Schema),
+	}
+	if !r.g.AdditionalProperties {
+		s.AdditionalProperties = false
+	}
+	return s
+}
+
+func (r *reflector) addFields(s *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, omitempty, ok := jsonField(f)
+		if !ok {
+			continue
+		}
+
+		// Untagged embedded structs are flattened into the parent.
+		if name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				r.addFields(s, ft)
+			}
+			continue
+		}
+
+		p := r.reflect(f.Type)
+		if et := enumType(t, f); et != nil {
+			if enum := r.g.Source.Enum(et.PkgPath(), et.Name()); len(enum) > 0 {
+				p = withEnum(p, enum)
+			}
+		}
+		if doc := r.g.Source.FieldDoc(t.PkgPath(), t.Name(), f.Name); doc != "" {
+			if p.Ref != "" {
+				p = &Schema{AnyOf: []*Schema{p}}
+			}
+			p.Description = doc
+		}
+		s.Properties[name] = p
+
+		switch f.Type.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface:
+		default:
+			if !omitempty {
+				s.Required = append(s.Required, name)
+			}
+		}
+	}
+}
+
+func (r *reflector) defName(t reflect.Type) string {
+	name := t.Name()
+	if _, ok := r.defs[name]; !ok {
+		return name
+	}
+
+	// Qualify names of distinct types sharing the same name.
+	base := path.Base(t.PkgPath()) + "." + t.Name()
+	name = base
+	for i := 2; ; i++ {
+		if _, ok := r.defs[name]; !ok {
+			return name
+		}
+		name = fmt.Sprintf("%s%d", base, i)
+	}
+}
+
+// enumType returns the enum type of a string field of t, i.e. the result
+// type of the typed accessor named after the field, e.g. ScaleType for
+// func (*ScaleGroupInput) ScaleTypeEnum() ScaleType, or nil if there is none.
+func enumType(t reflect.Type, f reflect.StructField) reflect.Type {
+	m, ok := reflect.PtrTo(t).MethodByName(f.Name + "Enum")
+	if !ok || m.Type.NumIn() != 1 || m.Type.NumOut() != 1 {
+		return nil
+	}
+	if et := m.Type.Out(0); et.Kind() == reflect.String && et.Name() != "" {
+		return et
+	}
+	return nil
+}
+
+// withEnum restricts a string (or nullable string) schema to the given
+// values.
+func withEnum(s *Schema, values []string) *Schema {
+	out := *s
+	out.Enum = toInterfaces(values)
+	for _, t := range s.Type {
+		if t == "null" {
+			out.Enum = append(out.Enum, nil)
+		}
+	}
+	return &out
+}
+
+func toInterfaces(values []string) []interface{} {
+	if len(values) == 0 {
+		return nil
+	}
+	out := make([]interface{}, len(values))
+	for i, v := range values {
+		out[i] = v
+	}
+	return out
+}
+
+// jsonField returns the JSON name of f and whether it's tagged omitempty.
+// The name is empty for untagged embedded fields, which are flattened. It
+// returns false for fields that are not encoded.
+func jsonField(f reflect.StructField) (name string, omitempty, ok bool) {
+	if f.PkgPath != "" && !f.Anonymous {
+		return "", false, false
+	}
+
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", false, false
+	}
+
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			omitempty = true
+		}
+	}
+	if name == "" && !f.Anonymous {
+		name = f.Name
+	}
+
+	return name, omitempty, true
+}
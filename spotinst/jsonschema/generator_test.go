@@ -0,0 +1,59 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// testColor is the color of a testWidget.
+type testColor string
+
+const (
+	testColorRed  testColor = "red"
+	testColorBlue testColor = "blue"
+)
+
+// testWidget is a widget.
+type testWidget struct {
+	// Name is the name of the widget.
+	Name  *string     `json:"name,omitempty"`
+	Color *string     `json:"color,omitempty"`
+	Size  int         `json:"size"`
+	Tags  []*string   `json:"tags,omitempty"`
+	Child *testWidget `json:"child,omitempty"`
+	Shade testColor   `json:"shade,omitempty"`
+
+	forceSendFields []string
+}
+
+func (w *testWidget) ColorEnum() testColor {
+	if w == nil || w.Color == nil {
+		return ""
+	}
+	return testColor(*w.Color)
+}
+
+func TestGenerate(t *testing.T) {
+	g := &Generator{
+		Source: NewSource("github.com/spotinst/spotinst-sdk-go", "../.."),
+	}
+	s := g.Generate(new(testWidget))
+
+	b, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := `{"$schema":"https://json-schema.org/draft/2020-12/schema","$ref":"#/$defs/testWidget",` +
+		`"$defs":{"testWidget":{"description":"testWidget is a widget.","type":"object","properties":{` +
+		`"child":{"anyOf":[{"$ref":"#/$defs/testWidget"},{"type":"null"}]},` +
+		`"color":{"type":["string","null"],"enum":["red","blue",null]},` +
+		`"name":{"description":"Name is the name of the widget.","type":["string","null"]},` +
+		`"shade":{"type":"string","enum":["red","blue"]},` +
+		`"size":{"type":"integer"},` +
+		`"tags":{"type":"array","items":{"type":["string","null"]}}},` +
+		`"required":["size"],"additionalProperties":false}}}`
+	if got := string(b); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,69 @@
+// Package jsonschema generates JSON Schema (draft 2020-12) documents from the
+// SDK's Go model types.
+package jsonschema
+
+import "encoding/json"
+
+// Draft is the JSON Schema dialect of the generated schemas.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// A Schema is a JSON Schema document or subschema. Only the keywords used by
+// the generator are supported.
+type Schema struct {
+	Schema      string             `json:"$schema,omitempty"`
+	ID          string             `json:"$id,omitempty"`
+	Ref         string             `json:"$ref,omitempty"`
+	Defs        map[string]*Schema `json:"$defs,omitempty"`
+	Title       string             `json:"title,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Type        Types              `json:"type,omitempty"`
+	Format      string             `json:"format,omitempty"`
+	Enum        []interface{}      `json:"enum,omitempty"`
+	Const       interface{}        `json:"const,omitempty"`
+	AnyOf       []*Schema          `json:"anyOf,omitempty"`
+	Items       *Schema            `json:"items,omitempty"`
+	Properties  map[string]*Schema `json:"properties,omitempty"`
+	Required    []string           `json:"required,omitempty"`
+
+	// AdditionalProperties is either a *Schema or a bool.
+	AdditionalProperties interface{} `json:"additionalProperties,omitempty"`
+}
+
+// Types is the value of the type keyword. A single type is encoded as a
+// string, multiple types as an array.
+type Types []string
+
+// MarshalJSON implements the json.Marshaler interface.
+func (t Types) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+	return json.Marshal([]string(t))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (t *Types) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*t = Types{s}
+		return nil
+	}
+	return json.Unmarshal(b, (*[]string)(t))
+}
+
+// Nullable returns a copy of s that also accepts null.
+func Nullable(s *Schema) *Schema {
+	if s.Ref != "" {
+		return &Schema{AnyOf: []*Schema{s, {Type: Types{"null"}}}}
+	}
+	if len(s.Type) == 0 {
+		return s // already accepts any value
+	}
+
+	out := *s
+	out.Type = append(append(Types{}, s.Type...), "null")
+	if len(s.Enum) > 0 {
+		out.Enum = append(append([]interface{}{}, s.Enum...), nil)
+	}
+	return &out
+}
@@ -0,0 +1,186 @@
+package jsonschema
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Source provides doc comments and enum values for the types of a Go module
+// by parsing its source code. Packages are parsed lazily, on first use.
+type Source struct {
+	// Module is the module path, e.g. github.com/spotinst/spotinst-sdk-go.
+	Module string
+
+	// Dir is the root directory of the module.
+	Dir string
+
+	mu   sync.Mutex
+	pkgs map[string]*pkgDocs
+}
+
+type pkgDocs struct {
+	types  map[string]string            // type name -> doc
+	fields map[string]map[string]string // type name -> field name -> doc
+	consts map[string][]string          // type name -> constant values
+}
+
+// NewSource returns a Source for the module at dir.
+func NewSource(module, dir string) *Source {
+	return &Source{
+		Module: module,
+		Dir:    dir,
+	}
+}
+
+// TypeDoc returns the doc comment of the named type.
+func (s *Source) TypeDoc(pkgPath, name string) string {
+	if p := s.pkg(pkgPath); p != nil {
+		return p.types[name]
+	}
+	return ""
+}
+
+// FieldDoc returns the doc comment of a struct field.
+func (s *Source) FieldDoc(pkgPath, typeName, fieldName string) string {
+	if p := s.pkg(pkgPath); p != nil {
+		return p.fields[typeName][fieldName]
+	}
+	return ""
+}
+
+// Enum returns the values of the string constants declared with the named
+// type.
+func (s *Source) Enum(pkgPath, name string) []string {
+	if p := s.pkg(pkgPath); p != nil {
+		return p.consts[name]
+	}
+	return nil
+}
+
+func (s *Source) pkg(pkgPath string) *pkgDocs {
+	if s == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if p, ok := s.pkgs[pkgPath]; ok {
+		return p
+	}
+	if s.pkgs == nil {
+		s.pkgs = make(map[string]*pkgDocs)
+	}
+
+	var p *pkgDocs
+	if dir, ok := s.dir(pkgPath); ok {
+		p = parsePackage(dir)
+	}
+	s.pkgs[pkgPath] = p
+
+	return p
+}
+
+func (s *Source) dir(pkgPath string) (string, bool) {
+	if pkgPath == s.Module {
+		return s.Dir, true
+	}
+	if rel := strings.TrimPrefix(pkgPath, s.Module+"/"); rel != pkgPath {
+		return filepath.Join(s.Dir, filepath.FromSlash(rel)), true
+	}
+	return "", false
+}
+
+// parsePackage parses the Go files in dir. Errors are ignored; the result
+// then simply lacks documentation.
+func parsePackage(dir string) *pkgDocs {
+	p := &pkgDocs{
+		types:  make(map[string]string),
+		fields: make(map[string]map[string]string),
+		consts: make(map[string][]string),
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return p
+	}
+
+	fset := token.NewFileSet()
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, e.Name()), nil, parser.ParseComments)
+		if err != nil {
+			continue
+		}
+		for _, decl := range f.Decls {
+			if gd, ok := decl.(*ast.GenDecl); ok {
+				p.addDecl(gd)
+			}
+		}
+	}
+
+	return p
+}
+
+func (p *pkgDocs) addDecl(gd *ast.GenDecl) {
+	switch gd.Tok {
+	case token.TYPE:
+		for _, spec := range gd.Specs {
+			ts := spec.(*ast.TypeSpec)
+			doc := ts.Doc
+			if doc == nil && len(gd.Specs) == 1 {
+				doc = gd.Doc
+			}
+			p.types[ts.Name.Name] = text(doc)
+
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+			fields := make(map[string]string)
+			for _, f := range st.Fields.List {
+				doc := text(f.Doc)
+				if doc == "" {
+					doc = text(f.Comment)
+				}
+				for _, name := range f.Names {
+					fields[name.Name] = doc
+				}
+			}
+			p.fields[ts.Name.Name] = fields
+		}
+
+	case token.CONST:
+		for _, spec := range gd.Specs {
+			vs := spec.(*ast.ValueSpec)
+			typ, ok := vs.Type.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			for _, v := range vs.Values {
+				lit, ok := v.(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					continue
+				}
+				if s, err := strconv.Unquote(lit.Value); err == nil {
+					p.consts[typ.Name] = append(p.consts[typ.Name], s)
+				}
+			}
+		}
+	}
+}
+
+func text(cg *ast.CommentGroup) string {
+	if cg == nil {
+		return ""
+	}
+	return strings.Join(strings.Fields(cg.Text()), " ")
+}
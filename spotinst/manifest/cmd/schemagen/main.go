@@ -0,0 +1,88 @@
+// Command schemagen writes a JSON Schema document for every manifest kind.
+//
+// Usage:
+//
+//	go run ./spotinst/manifest/cmd/schemagen -out schemas
+//
+// It must be run from the root of the module so that doc comments and enum
+// values can be read from the source code.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/jsonschema"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/manifest"
+)
+
+func main() {
+	var (
+		out    = flag.String("out", "schemas", "output directory")
+		dir    = flag.String("dir", ".", "root directory of the module")
+		module = flag.String("module", "github.com/spotinst/spotinst-sdk-go", "module path")
+		bare   = flag.Bool("bare", false, "generate schemas of the models, without the manifest envelope")
+		kinds  = flag.String("kinds", "", "comma-separated list of kinds (default: all)")
+	)
+	flag.Parse()
+
+	if err := run(*out, *dir, *module, *kinds, *bare); err != nil {
+		fmt.Fprintf(os.Stderr, "schemagen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(out, dir, module, kinds string, bare bool) error {
+	g := &jsonschema.Generator{
+		Source: jsonschema.NewSource(module, dir),
+	}
+
+	selected := manifest.Kinds()
+	if kinds != "" {
+		selected = nil
+		for _, k := range strings.Split(kinds, ",") {
+			selected = append(selected, manifest.Kind(strings.TrimSpace(k)))
+		}
+	}
+
+	if err := os.MkdirAll(out, 0755); err != nil {
+		return err
+	}
+
+	for _, kind := range selected {
+		var s *jsonschema.Schema
+		if bare {
+			spec, err := manifest.New(kind)
+			if err != nil {
+				return err
+			}
+			s = g.Generate(spec)
+			s.Title = string(kind)
+		} else {
+			var err error
+			if s, err = manifest.Schema(kind, g); err != nil {
+				return err
+			}
+		}
+
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(s); err != nil {
+			return fmt.Errorf("%s: %v", kind, err)
+		}
+
+		name := filepath.Join(out, string(kind)+".schema.json")
+		if err := os.WriteFile(name, buf.Bytes(), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package manifest
+
+// Identity returns the ID of a model, if it has one, and its name. The names
+// of the models that belong to an Ocean cluster, such as launch specs and
+// rightsizing rules, are unique within their cluster only, so they're scoped
+// by its ID, e.g. "o-1234/default".
+func Identity(spec interface{}) (id, name string) {
+	switch v := spec.(type) {
+	case interface{ GetId() string }:
+		id = v.GetId()
+	case interface{ GetID() string }:
+		id = v.GetID()
+	case interface{ GetPolicyID() string }:
+		id = v.GetPolicyID()
+	}
+
+	switch v := spec.(type) {
+	case interface{ GetName() string }:
+		name = v.GetName()
+	case interface{ GetRuleName() string }:
+		name = v.GetRuleName()
+	}
+	if v, ok := spec.(interface{ GetOceanId() string }); ok && v.GetOceanId() != "" {
+		name = v.GetOceanId() + "/" + name
+	}
+	return id, name
+}
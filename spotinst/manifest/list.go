@@ -0,0 +1,237 @@
+package manifest
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	elastigroupaws "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	elastigroupazurev3 "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/azure/v3"
+	elastigroupgcp "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/service/healthcheck"
+	"github.com/spotinst/spotinst-sdk-go/service/notificationcenter"
+	oceanaws "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	oceanazurenp "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/azure_np"
+	oceangcp "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/right_sizing"
+	"github.com/spotinst/spotinst-sdk-go/service/oceancd"
+	"github.com/spotinst/spotinst-sdk-go/service/organization"
+	"github.com/spotinst/spotinst-sdk-go/service/subscription"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+)
+
+// A ListFunc lists the live resources of a kind.
+type ListFunc func(ctx context.Context) ([]interface{}, error)
+
+// NewListers returns the ListFuncs of the kinds that can be listed for a
+// whole account. Rightsizing rules are listed for every Kubernetes cluster,
+// with their Ocean ID set.
+func NewListers(sess *session.Session, cfgs ...*spotinst.Config) map[Kind]ListFunc {
+	egAWS := elastigroupaws.New(sess, cfgs...)
+	egGCP := elastigroupgcp.New(sess, cfgs...)
+	egAzure := elastigroupazurev3.New(sess, cfgs...)
+	oceanAWS := oceanaws.New(sess, cfgs...)
+	oceanGCP := oceangcp.New(sess, cfgs...)
+	oceanAKS := oceanazurenp.New(sess, cfgs...)
+	rightsizing := right_sizing.New(sess, cfgs...)
+	healthChecks := healthcheck.New(sess, cfgs...)
+	subscriptions := subscription.New(sess, cfgs...)
+	notifications := notificationcenter.New(sess, cfgs...)
+	cd := oceancd.New(sess, cfgs...)
+	org := organization.New(sess, cfgs...)
+
+	// listK8sClusterIDs lists the IDs of the Kubernetes clusters, which
+	// rightsizing rules belong to.
+	listK8sClusterIDs := func(ctx context.Context) ([]*string, error) {
+		var ids []*string
+		aws, err := oceanAWS.ListClusters(ctx, &oceanaws.ListClustersInput{})
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range aws.Clusters {
+			ids = append(ids, c.ID)
+		}
+		gcp, err := oceanGCP.ListClusters(ctx, &oceangcp.ListClustersInput{})
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range gcp.Clusters {
+			ids = append(ids, c.ID)
+		}
+		aks, err := oceanAKS.ListClusters(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range aks.Clusters {
+			ids = append(ids, c.ID)
+		}
+		return ids, nil
+	}
+
+	return map[Kind]ListFunc{
+		KindElastigroupAWS: func(ctx context.Context) ([]interface{}, error) {
+			out, err := egAWS.List(ctx, &elastigroupaws.ListGroupsInput{})
+			if err != nil {
+				return nil, err
+			}
+			return objects(out.Groups), nil
+		},
+		KindElastigroupGCP: func(ctx context.Context) ([]interface{}, error) {
+			out, err := egGCP.List(ctx, &elastigroupgcp.ListGroupsInput{})
+			if err != nil {
+				return nil, err
+			}
+			return objects(out.Groups), nil
+		},
+		KindElastigroupAzureV3: func(ctx context.Context) ([]interface{}, error) {
+			out, err := egAzure.List(ctx, &elastigroupazurev3.ListGroupsInput{})
+			if err != nil {
+				return nil, err
+			}
+			return objects(out.Groups), nil
+		},
+		KindOceanAWS: func(ctx context.Context) ([]interface{}, error) {
+			out, err := oceanAWS.ListClusters(ctx, &oceanaws.ListClustersInput{})
+			if err != nil {
+				return nil, err
+			}
+			return objects(out.Clusters), nil
+		},
+		KindOceanECS: func(ctx context.Context) ([]interface{}, error) {
+			out, err := oceanAWS.ListECSClusters(ctx, &oceanaws.ListECSClustersInput{})
+			if err != nil {
+				return nil, err
+			}
+			return objects(out.Clusters), nil
+		},
+		KindOceanGCP: func(ctx context.Context) ([]interface{}, error) {
+			out, err := oceanGCP.ListClusters(ctx, &oceangcp.ListClustersInput{})
+			if err != nil {
+				return nil, err
+			}
+			return objects(out.Clusters), nil
+		},
+		KindOceanAKSNP: func(ctx context.Context) ([]interface{}, error) {
+			out, err := oceanAKS.ListClusters(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return objects(out.Clusters), nil
+		},
+		KindOceanAWSLaunchSpec: func(ctx context.Context) ([]interface{}, error) {
+			out, err := oceanAWS.ListLaunchSpecs(ctx, &oceanaws.ListLaunchSpecsInput{})
+			if err != nil {
+				return nil, err
+			}
+			return objects(out.LaunchSpecs), nil
+		},
+		KindOceanECSLaunchSpec: func(ctx context.Context) ([]interface{}, error) {
+			out, err := oceanAWS.ListECSLaunchSpecs(ctx, &oceanaws.ListECSLaunchSpecsInput{})
+			if err != nil {
+				return nil, err
+			}
+			return objects(out.LaunchSpecs), nil
+		},
+		KindOceanGCPLaunchSpec: func(ctx context.Context) ([]interface{}, error) {
+			out, err := oceanGCP.ListLaunchSpecs(ctx, &oceangcp.ListLaunchSpecsInput{})
+			if err != nil {
+				return nil, err
+			}
+			return objects(out.LaunchSpecs), nil
+		},
+		KindOceanAKSNPVirtualNodeGroup: func(ctx context.Context) ([]interface{}, error) {
+			out, err := oceanAKS.ListVirtualNodeGroups(ctx, &oceanazurenp.ListVirtualNodeGroupsInput{})
+			if err != nil {
+				return nil, err
+			}
+			return objects(out.VirtualNodeGroups), nil
+		},
+		KindOceanRightsizingRule: func(ctx context.Context) ([]interface{}, error) {
+			ids, err := listK8sClusterIDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			var objs []interface{}
+			for _, id := range ids {
+				out, err := rightsizing.ListRightsizingRules(ctx, &right_sizing.ListRightsizingRulesInput{OceanId: id})
+				if err != nil {
+					return nil, fmt.Errorf("cluster %q: %w", spotinst.StringValue(id), err)
+				}
+				for _, rule := range out.RightsizingRules {
+					if rule.OceanId == nil {
+						rule.SetOceanId(id)
+					}
+					objs = append(objs, rule)
+				}
+			}
+			return objs, nil
+		},
+		KindHealthCheck: func(ctx context.Context) ([]interface{}, error) {
+			out, err := healthChecks.List(ctx, &healthcheck.ListHealthChecksInput{})
+			if err != nil {
+				return nil, err
+			}
+			return objects(out.HealthChecks), nil
+		},
+		KindSubscription: func(ctx context.Context) ([]interface{}, error) {
+			out, err := subscriptions.List(ctx, &subscription.ListSubscriptionsInput{})
+			if err != nil {
+				return nil, err
+			}
+			return objects(out.Subscriptions), nil
+		},
+		KindOceanCDVerificationProvider: func(ctx context.Context) ([]interface{}, error) {
+			out, err := cd.ListVerificationProviders(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return objects(out.VerificationProviders), nil
+		},
+		KindOceanCDVerificationTemplate: func(ctx context.Context) ([]interface{}, error) {
+			out, err := cd.ListVerificationTemplates(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return objects(out.VerificationTemplate), nil
+		},
+		KindOceanCDStrategy: func(ctx context.Context) ([]interface{}, error) {
+			out, err := cd.ListStrategies(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return objects(out.Strategies), nil
+		},
+		KindOceanCDRolloutSpec: func(ctx context.Context) ([]interface{}, error) {
+			out, err := cd.ListRolloutSpecs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return objects(out.RolloutSpecs), nil
+		},
+		KindOrganizationPolicy: func(ctx context.Context) ([]interface{}, error) {
+			out, err := org.ListPolicies(ctx, &organization.ListPoliciesInput{})
+			if err != nil {
+				return nil, err
+			}
+			return objects(out.Policies), nil
+		},
+		KindNotificationPolicy: func(ctx context.Context) ([]interface{}, error) {
+			out, err := notifications.ListNotificationCenterPolicy(ctx, &notificationcenter.ListNotificationCenterPolicyInput{})
+			if err != nil {
+				return nil, err
+			}
+			return objects(out.NotificationCenter), nil
+		},
+	}
+}
+
+// objects converts a slice of models into a slice of interface values.
+func objects(slice interface{}) []interface{} {
+	v := reflect.ValueOf(slice)
+	objs := make([]interface{}, v.Len())
+	for i := range objs {
+		objs[i] = v.Index(i).Interface()
+	}
+	return objs
+}
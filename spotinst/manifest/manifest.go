@@ -0,0 +1,288 @@
+// Package manifest provides loading and saving of resource models as YAML
+// manifests.
+//
+// A manifest document wraps a single model with a kind discriminator:
+//
+//	apiVersion: spotinst.io/v1
+//	kind: ElastigroupAWS
+//	spec:
+//	  name: my-group
+//	  capacity:
+//	    target: 2
+//	  scheduling: null
+//
+// Field names within spec are taken from the models' json tags. Fields set
+// to an explicit null are decoded as if their setter had been called with a
+// nil value, so they are sent to the API as null on update.
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// APIVersion is the API version written to, and accepted in, manifests.
+const APIVersion = "spotinst.io/v1"
+
+// A Manifest describes a single resource.
+type Manifest struct {
+	// APIVersion is the version of the manifest format. Defaults to APIVersion.
+	APIVersion string
+
+	// Kind identifies the type of Spec.
+	Kind Kind
+
+	// Spec is a pointer to the resource model, e.g. *aws.Group.
+	Spec interface{}
+}
+
+// document is the on-disk representation of a Manifest.
+type document struct {
+	APIVersion string      `yaml:"apiVersion"`
+	Kind       Kind        `yaml:"kind"`
+	Spec       interface{} `yaml:"spec"`
+}
+
+// ErrUnsupportedAPIVersion is returned when decoding a manifest with an
+// unknown API version.
+var ErrUnsupportedAPIVersion = errors.New("manifest: unsupported api version")
+
+// NewManifest returns a manifest wrapping spec. The kind is inferred from the
+// type of spec.
+func NewManifest(spec interface{}) (*Manifest, error) {
+	kind, err := KindOf(spec)
+	if err != nil {
+		return nil, err
+	}
+	return &Manifest{
+		APIVersion: APIVersion,
+		Kind:       kind,
+		Spec:       spec,
+	}, nil
+}
+
+// Decode reads all manifests from a (possibly multi-document) YAML stream.
+func Decode(r io.Reader) ([]*Manifest, error) {
+	dec := yaml.NewDecoder(r)
+
+	var out []*Manifest
+	for i := 0; ; i++ {
+		var doc struct {
+			APIVersion string    `yaml:"apiVersion"`
+			Kind       Kind      `yaml:"kind"`
+			Spec       yaml.Node `yaml:"spec"`
+		}
+		if err := dec.Decode(&doc); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("manifest: document %d: %v", i, err)
+		}
+		if doc.Kind == "" && doc.APIVersion == "" && doc.Spec.IsZero() {
+			continue // empty document
+		}
+		if doc.APIVersion != APIVersion {
+			return nil, fmt.Errorf("%w: document %d: %q", ErrUnsupportedAPIVersion, i, doc.APIVersion)
+		}
+
+		spec, err := New(doc.Kind)
+		if err != nil {
+			return nil, fmt.Errorf("manifest: document %d: %v", i, err)
+		}
+		if err := decodeNode(&doc.Spec, spec); err != nil {
+			return nil, fmt.Errorf("manifest: document %d (%s): %v", i, doc.Kind, err)
+		}
+
+		out = append(out, &Manifest{
+			APIVersion: doc.APIVersion,
+			Kind:       doc.Kind,
+			Spec:       spec,
+		})
+	}
+
+	return out, nil
+}
+
+// Encode writes manifests to w as a multi-document YAML stream.
+func Encode(w io.Writer, manifests ...*Manifest) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+
+	for i, m := range manifests {
+		kind := m.Kind
+		if kind == "" {
+			k, err := KindOf(m.Spec)
+			if err != nil {
+				return fmt.Errorf("manifest: document %d: %v", i, err)
+			}
+			kind = k
+		}
+
+		apiVersion := m.APIVersion
+		if apiVersion == "" {
+			apiVersion = APIVersion
+		}
+
+		spec, err := toGeneric(m.Spec)
+		if err != nil {
+			return fmt.Errorf("manifest: document %d (%s): %v", i, kind, err)
+		}
+
+		doc := &document{
+			APIVersion: apiVersion,
+			Kind:       kind,
+			Spec:       spec,
+		}
+		if err := enc.Encode(doc); err != nil {
+			return fmt.Errorf("manifest: document %d (%s): %v", i, kind, err)
+		}
+	}
+
+	return enc.Close()
+}
+
+// Unmarshal decodes a single YAML model (without the manifest envelope)
+// into v, which must be a pointer to a model.
+func Unmarshal(in []byte, v interface{}) error {
+	var node yaml.Node
+	if err := yaml.Unmarshal(in, &node); err != nil {
+		return fmt.Errorf("manifest: %v", err)
+	}
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		return decodeNode(node.Content[0], v)
+	}
+	return nil
+}
+
+// Marshal encodes v (without the manifest envelope) as YAML.
+func Marshal(v interface{}) ([]byte, error) {
+	spec, err := toGeneric(v)
+	if err != nil {
+		return nil, fmt.Errorf("manifest: %v", err)
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(spec); err != nil {
+		return nil, fmt.Errorf("manifest: %v", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("manifest: %v", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// LoadFile reads all manifests from the named file.
+func LoadFile(name string) ([]*Manifest, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Decode(f)
+}
+
+// SaveFile writes manifests to the named file, creating or truncating it.
+func SaveFile(name string, manifests ...*Manifest) error {
+	var buf bytes.Buffer
+	if err := Encode(&buf, manifests...); err != nil {
+		return err
+	}
+	return os.WriteFile(name, buf.Bytes(), 0644)
+}
+
+// decodeNode decodes a YAML node into the model v. The node is converted to
+// JSON first so that the model's json tags and types are honored, then
+// explicit nulls are recorded into the models' nullFields.
+func decodeNode(node *yaml.Node, v interface{}) error {
+	var raw interface{}
+	if err := node.Decode(&raw); err != nil {
+		return err
+	}
+
+	raw = normalize(raw)
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return err
+	}
+
+	applyNulls(v, raw)
+	return nil
+}
+
+// toGeneric encodes v using its MarshalJSON method, which honors the models'
+// forceSendFields and nullFields, and decodes the result into generic maps
+// and slices suitable for YAML encoding.
+func toGeneric(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	var out interface{}
+	if err := dec.Decode(&out); err != nil {
+		return nil, err
+	}
+	return fromJSONNumbers(out), nil
+}
+
+// fromJSONNumbers replaces json.Number values with int64 or float64 values,
+// so that they're encoded as YAML integers or floats rather than strings.
+func fromJSONNumbers(in interface{}) interface{} {
+	switch v := in.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = fromJSONNumbers(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = fromJSONNumbers(e)
+		}
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+	}
+	return in
+}
+
+// normalize converts YAML maps with non-string keys into maps with string
+// keys, so that the result can be encoded as JSON.
+func normalize(in interface{}) interface{} {
+	switch v := in.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = normalize(e)
+		}
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			out[fmt.Sprint(k)] = normalize(e)
+		}
+		return out
+	case []interface{}:
+		for i, e := range v {
+			v[i] = normalize(e)
+		}
+	}
+	return in
+}
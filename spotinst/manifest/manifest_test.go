@@ -0,0 +1,117 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	oceanaws "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/right_sizing"
+	"github.com/spotinst/spotinst-sdk-go/service/organization"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+)
+
+const testManifests = `apiVersion: spotinst.io/v1
+kind: ElastigroupAWS
+spec:
+  name: foo
+  description: null
+  capacity:
+    target: 2
+    maximum: null
+---
+apiVersion: spotinst.io/v1
+kind: OceanAWS
+spec:
+  name: bar
+  region: us-west-2
+`
+
+func TestDecode(t *testing.T) {
+	ms, err := Decode(strings.NewReader(testManifests))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ms) != 2 {
+		t.Fatalf("got %d manifests, want 2", len(ms))
+	}
+
+	group, ok := ms[0].Spec.(*aws.Group)
+	if !ok {
+		t.Fatalf("got %T, want *aws.Group", ms[0].Spec)
+	}
+	b, err := json.Marshal(group)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := string(b), `{"capacity":{"maximum":null,"target":2},"description":null,"name":"foo"}`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	cluster, ok := ms[1].Spec.(*oceanaws.Cluster)
+	if !ok {
+		t.Fatalf("got %T, want *aws.Cluster", ms[1].Spec)
+	}
+	if got, want := *cluster.Region, "us-west-2"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestEncodeRoundTrip(t *testing.T) {
+	ms, err := Decode(strings.NewReader(testManifests))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := Encode(&buf, ms...); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	again, err := Decode(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(again) != len(ms) {
+		t.Fatalf("got %d manifests, want %d", len(again), len(ms))
+	}
+	for i := range ms {
+		want, _ := json.Marshal(ms[i].Spec)
+		got, _ := json.Marshal(again[i].Spec)
+		if !bytes.Equal(got, want) {
+			t.Errorf("manifest %d: got %s, want %s", i, got, want)
+		}
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	tests := []string{
+		"apiVersion: spotinst.io/v2\nkind: ElastigroupAWS\nspec: {}\n",
+		"apiVersion: spotinst.io/v1\nkind: Unknown\nspec: {}\n",
+		"apiVersion: spotinst.io/v1\nkind: ElastigroupAWS\nspec:\n  capacity:\n    target: two\n",
+	}
+	for _, in := range tests {
+		if _, err := Decode(strings.NewReader(in)); err == nil {
+			t.Errorf("Decode(%q): expected error, got nil", in)
+		}
+	}
+}
+
+func TestIdentity(t *testing.T) {
+	for _, tt := range []struct {
+		spec     interface{}
+		id, name string
+	}{
+		{&aws.Group{ID: spotinst.String("sig-1"), Name: spotinst.String("web")}, "sig-1", "web"},
+		{&oceanaws.LaunchSpec{ID: spotinst.String("ols-1"), Name: spotinst.String("gpu"), OceanID: spotinst.String("o-1")}, "ols-1", "o-1/gpu"},
+		{&oceanaws.LaunchSpec{Name: spotinst.String("gpu")}, "", "gpu"},
+		{&right_sizing.RightsizingRule{RuleName: spotinst.String("default"), OceanId: spotinst.String("o-1")}, "", "o-1/default"},
+		{&organization.Policy{PolicyID: spotinst.String("pol-1"), Name: spotinst.String("admins")}, "pol-1", "admins"},
+	} {
+		if id, name := Identity(tt.spec); id != tt.id || name != tt.name {
+			t.Errorf("Identity(%T) = %q, %q, want %q, %q", tt.spec, id, name, tt.id, tt.name)
+		}
+	}
+}
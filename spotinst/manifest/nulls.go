@@ -0,0 +1,134 @@
+package manifest
+
+import (
+	"reflect"
+	"strings"
+)
+
+// applyNulls walks the decoded model v alongside the generic document raw it
+// was decoded from, and clears every field explicitly set to null using the
+// setter of the enclosing model, so that it's encoded as null again by
+// jsonutil.MarshalJSON. Fields without setters are left untouched.
+func applyNulls(v interface{}, raw interface{}) {
+	walkNulls(reflect.ValueOf(v), raw)
+}
+
+func walkNulls(v reflect.Value, raw interface{}) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			return
+		}
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			name, ok := jsonName(sf)
+			if !ok {
+				if sf.Anonymous {
+					walkNulls(v.Field(i), m)
+				}
+				continue
+			}
+			e, ok := m[name]
+			if !ok {
+				continue
+			}
+			if e == nil {
+				addNullField(v, sf.Name)
+				continue
+			}
+			walkNulls(v.Field(i), e)
+		}
+
+	case reflect.Slice, reflect.Array:
+		s, ok := raw.([]interface{})
+		if !ok {
+			return
+		}
+		for i := 0; i < v.Len() && i < len(s); i++ {
+			walkNulls(v.Index(i), s[i])
+		}
+
+	case reflect.Map:
+		m, ok := raw.(map[string]interface{})
+		if !ok || v.Type().Key().Kind() != reflect.String {
+			return
+		}
+		for k, e := range m {
+			// Map values are not addressable, but pointers stored in them
+			// are, which is the only case where nulls need to be recorded.
+			mv := v.MapIndex(reflect.ValueOf(k).Convert(v.Type().Key()))
+			if mv.IsValid() {
+				walkNulls(mv, e)
+			}
+		}
+	}
+}
+
+// jsonName returns the name of the exported struct field sf as encoded by
+// encoding/json. It returns false for unexported, ignored or untagged
+// embedded fields.
+func jsonName(sf reflect.StructField) (string, bool) {
+	if sf.PkgPath != "" && !sf.Anonymous {
+		return "", false
+	}
+	tag := sf.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	name := strings.Split(tag, ",")[0]
+	if name == "" {
+		if sf.Anonymous {
+			return "", false
+		}
+		name = sf.Name
+	}
+	return name, true
+}
+
+// addNullField clears the field name of the addressable struct v by calling
+// its Set<Field>(nil) setter, which records the field in the model's
+// nullFields. Fields without such a setter are left untouched.
+func addNullField(v reflect.Value, name string) {
+	if !v.CanAddr() {
+		return
+	}
+	m := setter(v.Addr(), name)
+	if !m.IsValid() {
+		return
+	}
+	m.Call([]reflect.Value{reflect.Zero(m.Type().In(0))})
+}
+
+// setter returns the setter of the field name of the struct pointed to by
+// v, matching its name case-insensitively (e.g. SetId for the field ID). It
+// returns the zero Value if there's no setter taking a single nillable
+// argument.
+func setter(v reflect.Value, name string) reflect.Value {
+	m := v.MethodByName("Set" + name)
+	if !m.IsValid() {
+		t := v.Type()
+		for i := 0; i < t.NumMethod(); i++ {
+			if strings.EqualFold(t.Method(i).Name, "Set"+name) {
+				m = v.Method(i)
+				break
+			}
+		}
+	}
+	if !m.IsValid() || m.Type().NumIn() != 1 {
+		return reflect.Value{}
+	}
+	switch m.Type().In(0).Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface:
+		return m
+	}
+	return reflect.Value{}
+}
@@ -0,0 +1,151 @@
+package manifest
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	elastigroupaws "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	elastigroupazurev3 "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/azure/v3"
+	elastigroupgcp "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/service/healthcheck"
+	managedinstanceaws "github.com/spotinst/spotinst-sdk-go/service/managedinstance/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/service/mrscaler"
+	"github.com/spotinst/spotinst-sdk-go/service/notificationcenter"
+	oceanaws "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	oceanazurenp "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/azure_np"
+	oceangcp "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/right_sizing"
+	"github.com/spotinst/spotinst-sdk-go/service/oceancd"
+	"github.com/spotinst/spotinst-sdk-go/service/organization"
+	statefulazure "github.com/spotinst/spotinst-sdk-go/service/stateful/providers/azure"
+	"github.com/spotinst/spotinst-sdk-go/service/subscription"
+)
+
+// A Kind identifies the type of the resource described by a manifest.
+type Kind string
+
+// Built-in kinds.
+const (
+	KindElastigroupAWS              Kind = "ElastigroupAWS"
+	KindElastigroupGCP              Kind = "ElastigroupGCP"
+	KindElastigroupAzureV3          Kind = "ElastigroupAzureV3"
+	KindOceanAWS                    Kind = "OceanAWS"
+	KindOceanAWSLaunchSpec          Kind = "OceanAWSLaunchSpec"
+	KindOceanECS                    Kind = "OceanECS"
+	KindOceanECSLaunchSpec          Kind = "OceanECSLaunchSpec"
+	KindOceanExtendedResourceDef    Kind = "OceanExtendedResourceDefinition"
+	KindOceanGCP                    Kind = "OceanGCP"
+	KindOceanGCPLaunchSpec          Kind = "OceanGCPLaunchSpec"
+	KindOceanAKSNP                  Kind = "OceanAKSNP"
+	KindOceanAKSNPVirtualNodeGroup  Kind = "OceanAKSNPVirtualNodeGroup"
+	KindOceanRightsizingRule        Kind = "OceanRightsizingRule"
+	KindOceanCDRolloutSpec          Kind = "OceanCDRolloutSpec"
+	KindOceanCDStrategy             Kind = "OceanCDStrategy"
+	KindOceanCDVerificationProvider Kind = "OceanCDVerificationProvider"
+	KindOceanCDVerificationTemplate Kind = "OceanCDVerificationTemplate"
+	KindStatefulNodeAzure           Kind = "StatefulNodeAzure"
+	KindManagedInstanceAWS          Kind = "ManagedInstanceAWS"
+	KindMrScaler                    Kind = "MrScaler"
+	KindHealthCheck                 Kind = "HealthCheck"
+	KindSubscription                Kind = "Subscription"
+	KindNotificationPolicy          Kind = "NotificationPolicy"
+	KindOrganizationPolicy          Kind = "OrganizationPolicy"
+	KindOrganizationUserGroup       Kind = "OrganizationUserGroup"
+)
+
+// All registered kinds.
+var (
+	kindsMutex sync.RWMutex
+	kinds      = make(map[Kind]reflect.Type)
+	types      = make(map[reflect.Type]Kind)
+)
+
+// Register associates a kind with the model type of v, which must be a
+// pointer to a struct. Registering an existing kind replaces it.
+func Register(kind Kind, v interface{}) {
+	t := reflect.TypeOf(v)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		panic(fmt.Sprintf("manifest: Register of %q with non-pointer-to-struct %T", kind, v))
+	}
+
+	kindsMutex.Lock()
+	defer kindsMutex.Unlock()
+
+	if old, ok := kinds[kind]; ok {
+		delete(types, old)
+	}
+	kinds[kind] = t.Elem()
+	types[t.Elem()] = kind
+}
+
+// Kinds returns a sorted list of all registered kinds.
+func Kinds() []Kind {
+	kindsMutex.RLock()
+	defer kindsMutex.RUnlock()
+
+	out := make([]Kind, 0, len(kinds))
+	for k := range kinds {
+		out = append(out, k)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+// New returns a pointer to a new, empty model of the given kind.
+func New(kind Kind) (interface{}, error) {
+	kindsMutex.RLock()
+	t, ok := kinds[kind]
+	kindsMutex.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("manifest: unknown kind %q", kind)
+	}
+	return reflect.New(t).Interface(), nil
+}
+
+// KindOf returns the kind registered for the model type of v.
+func KindOf(v interface{}) (Kind, error) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	kindsMutex.RLock()
+	kind, ok := types[t]
+	kindsMutex.RUnlock()
+
+	if !ok {
+		return "", fmt.Errorf("manifest: no kind registered for %T", v)
+	}
+	return kind, nil
+}
+
+func init() {
+	Register(KindElastigroupAWS, new(elastigroupaws.Group))
+	Register(KindElastigroupGCP, new(elastigroupgcp.Group))
+	Register(KindElastigroupAzureV3, new(elastigroupazurev3.Group))
+	Register(KindOceanAWS, new(oceanaws.Cluster))
+	Register(KindOceanAWSLaunchSpec, new(oceanaws.LaunchSpec))
+	Register(KindOceanECS, new(oceanaws.ECSCluster))
+	Register(KindOceanECSLaunchSpec, new(oceanaws.ECSLaunchSpec))
+	Register(KindOceanExtendedResourceDef, new(oceanaws.ExtendedResourceDefinition))
+	Register(KindOceanGCP, new(oceangcp.Cluster))
+	Register(KindOceanGCPLaunchSpec, new(oceangcp.LaunchSpec))
+	Register(KindOceanAKSNP, new(oceanazurenp.Cluster))
+	Register(KindOceanAKSNPVirtualNodeGroup, new(oceanazurenp.VirtualNodeGroup))
+	Register(KindOceanRightsizingRule, new(right_sizing.RightsizingRule))
+	Register(KindOceanCDRolloutSpec, new(oceancd.RolloutSpec))
+	Register(KindOceanCDStrategy, new(oceancd.Strategy))
+	Register(KindOceanCDVerificationProvider, new(oceancd.VerificationProvider))
+	Register(KindOceanCDVerificationTemplate, new(oceancd.VerificationTemplate))
+	Register(KindStatefulNodeAzure, new(statefulazure.StatefulNode))
+	Register(KindManagedInstanceAWS, new(managedinstanceaws.ManagedInstance))
+	Register(KindMrScaler, new(mrscaler.Scaler))
+	Register(KindHealthCheck, new(healthcheck.HealthCheck))
+	Register(KindSubscription, new(subscription.Subscription))
+	Register(KindNotificationPolicy, new(notificationcenter.NotificationCenter))
+	Register(KindOrganizationPolicy, new(organization.Policy))
+	Register(KindOrganizationUserGroup, new(organization.UserGroup))
+}
@@ -0,0 +1,29 @@
+package manifest
+
+import (
+	"github.com/spotinst/spotinst-sdk-go/spotinst/jsonschema"
+)
+
+// Schema returns a JSON Schema document that validates manifests of the
+// given kind. The schema of the spec is generated from the kind's model type.
+func Schema(kind Kind, g *jsonschema.Generator) (*jsonschema.Schema, error) {
+	spec, err := New(kind)
+	if err != nil {
+		return nil, err
+	}
+	s := g.Generate(spec)
+
+	return &jsonschema.Schema{
+		Schema: jsonschema.Draft,
+		Title:  string(kind),
+		Type:   jsonschema.Types{"object"},
+		Properties: map[string]*jsonschema.Schema{
+			"apiVersion": {Type: jsonschema.Types{"string"}, Const: APIVersion},
+			"kind":       {Type: jsonschema.Types{"string"}, Const: string(kind)},
+			"spec":       {Ref: s.Ref},
+		},
+		Required:             []string{"apiVersion", "kind", "spec"},
+		AdditionalProperties: false,
+		Defs:                 s.Defs,
+	}, nil
+}
@@ -0,0 +1,155 @@
+package reconcile
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ReadOnlyPaths are the paths ignored by Diff by default.
+var ReadOnlyPaths = []string{"id", "createdAt", "updatedAt"}
+
+// A FieldDiff is a difference between the desired and the live value of a
+// field.
+type FieldDiff struct {
+	// Path is the JSON path of the field, e.g. "capacity.target" or
+	// "compute.launchSpecification.tags[0].tagValue".
+	Path string `json:"path"`
+
+	// Old is the live value, or nil if the field isn't set.
+	Old interface{} `json:"old"`
+
+	// New is the desired value, or nil if the field should be cleared.
+	New interface{} `json:"new"`
+}
+
+// Diff compares the JSON representations of a desired and a live model and
+// returns the differences, sorted by path. Only the fields set in desired,
+// including fields explicitly set to null, are compared, so that fields
+// left to their server-side defaults aren't reported. Arrays of the same
+// length are compared element by element; otherwise they're reported as a
+// whole. The fields at ReadOnlyPaths and at the extra ignored paths are
+// skipped, along with the fields below them. An ignored path matches the
+// elements of an array at any index when written with "[]", e.g.
+// "compute.launchSpecification.networkInterfaces[].networkInterfaceId".
+func Diff(desired, live interface{}, ignore ...string) ([]*FieldDiff, error) {
+	return diff(desired, live, false, ignore)
+}
+
+// DiffAll is like Diff, but also reports the fields set in live and not in
+// desired, e.g. to compare a live model against a snapshot of itself.
+func DiffAll(desired, live interface{}, ignore ...string) ([]*FieldDiff, error) {
+	return diff(desired, live, true, ignore)
+}
+
+func diff(desired, live interface{}, all bool, ignore []string) ([]*FieldDiff, error) {
+	d, err := toGeneric(desired)
+	if err != nil {
+		return nil, err
+	}
+	l, err := toGeneric(live)
+	if err != nil {
+		return nil, err
+	}
+
+	ignored := make(map[string]bool, len(ReadOnlyPaths)+len(ignore))
+	for _, p := range ReadOnlyPaths {
+		ignored[p] = true
+	}
+	for _, p := range ignore {
+		ignored[p] = true
+	}
+
+	w := &differ{all: all, ignored: ignored}
+	w.diff("", d, l)
+	sort.Slice(w.diffs, func(i, j int) bool { return w.diffs[i].Path < w.diffs[j].Path })
+	return w.diffs, nil
+}
+
+type differ struct {
+	all     bool
+	ignored map[string]bool
+	diffs   []*FieldDiff
+}
+
+// arrayIndex matches the indices of a path, which "[]" replaces in the
+// ignored paths.
+var arrayIndex = regexp.MustCompile(`\[\d+\]`)
+
+func (w *differ) diff(path string, desired, live interface{}) {
+	if w.ignored[path] || w.ignored[arrayIndex.ReplaceAllString(path, "[]")] {
+		return
+	}
+
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok && live != nil {
+			break
+		}
+		for k, v := range d {
+			w.diff(joinPath(path, k), v, l[k])
+		}
+		if w.all {
+			for k, v := range l {
+				if _, ok := d[k]; !ok {
+					w.diff(joinPath(path, k), nil, v)
+				}
+			}
+		}
+		return
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok || len(l) != len(d) {
+			break
+		}
+		for i, v := range d {
+			w.diff(fmt.Sprintf("%s[%d]", path, i), v, l[i])
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(desired, live) {
+		w.diffs = append(w.diffs, &FieldDiff{Path: path, Old: live, New: desired})
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// toGeneric encodes v using its MarshalJSON method, which honors the models'
+// forceSendFields and nullFields, and decodes the result into generic maps
+// and slices.
+func toGeneric(v interface{}) (interface{}, error) {
+	if v == nil || (reflect.ValueOf(v).Kind() == reflect.Ptr && reflect.ValueOf(v).IsNil()) {
+		return nil, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// formatValue formats a value for display.
+func formatValue(v interface{}) string {
+	if v == nil {
+		return "null"
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return strings.TrimSpace(string(b))
+}
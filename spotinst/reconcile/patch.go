@@ -0,0 +1,143 @@
+package reconcile
+
+import (
+	"reflect"
+	"strings"
+	"unsafe"
+)
+
+// deepCopy returns a deep copy of a model, including the fields it sends as
+// null.
+func deepCopy(obj interface{}) interface{} {
+	return copyValue(reflect.ValueOf(obj)).Interface()
+}
+
+func copyValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(copyValue(v.Elem()))
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(copyValue(v.Elem()))
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(copyValue(v.Index(i)))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			c.SetMapIndex(iter.Key(), copyValue(iter.Value()))
+		}
+		return c
+	case reflect.Struct:
+		// Copying the struct copies its unexported fields, i.e. the
+		// forceSendFields and nullFields of the models, which are never
+		// modified in place.
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if f := c.Field(i); f.CanSet() {
+				f.Set(copyValue(v.Field(i)))
+			}
+		}
+		return c
+	default:
+		return v
+	}
+}
+
+// pruneUnchanged clears the fields of a model that are not at the paths of
+// diffs, so that only the changed fields are sent. The fields of arrays
+// are never cleared: an array with a changed element is sent as a whole.
+func pruneUnchanged(obj interface{}, diffs []*FieldDiff) {
+	changed := make(map[string]bool, len(diffs))
+	for _, d := range diffs {
+		path := d.Path
+		if i := strings.IndexByte(path, '['); i >= 0 {
+			path = path[:i]
+		}
+		changed[path] = true
+	}
+	prune(reflect.ValueOf(obj).Elem(), "", changed)
+}
+
+func prune(v reflect.Value, prefix string, changed map[string]bool) {
+	t := v.Type()
+	kept := make(map[string]bool)
+	for i := 0; i < v.NumField(); i++ {
+		sf, f := t.Field(i), v.Field(i)
+		if sf.PkgPath != "" {
+			continue
+		}
+		if sf.Anonymous {
+			if f.Kind() == reflect.Ptr && !f.IsNil() {
+				f = f.Elem()
+			}
+			if f.Kind() == reflect.Struct {
+				prune(f, prefix, changed)
+			}
+			kept[sf.Name] = true
+			continue
+		}
+
+		name := strings.Split(sf.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		path := joinPath(prefix, name)
+		switch {
+		case changed[path]:
+			kept[sf.Name] = true
+		case hasChangedBelow(path, changed):
+			if f.Kind() == reflect.Ptr && !f.IsNil() && f.Elem().Kind() == reflect.Struct {
+				prune(f.Elem(), path, changed)
+			}
+			kept[sf.Name] = true
+		default:
+			f.Set(reflect.Zero(f.Type()))
+		}
+	}
+
+	// Only the kept fields are force-sent or sent as null.
+	for _, name := range []string{"forceSendFields", "nullFields"} {
+		f := v.FieldByName(name)
+		if !f.IsValid() || f.Type() != reflect.TypeOf([]string(nil)) || !f.CanAddr() {
+			continue
+		}
+		fields := reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Interface().(*[]string)
+		var filtered []string
+		for _, field := range *fields {
+			if kept[field] {
+				filtered = append(filtered, field)
+			}
+		}
+		*fields = filtered
+	}
+}
+
+func hasChangedBelow(path string, changed map[string]bool) bool {
+	for p := range changed {
+		if strings.HasPrefix(p, path+".") {
+			return true
+		}
+	}
+	return false
+}
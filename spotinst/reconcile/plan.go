@@ -0,0 +1,153 @@
+package reconcile
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/manifest"
+)
+
+var (
+	// ErrNotFound is returned when a desired resource has an ID that matches
+	// no live resource.
+	ErrNotFound = errors.New("reconcile: resource not found")
+
+	// ErrAmbiguousName is returned when a desired resource has no ID and its
+	// name matches more than one live resource.
+	ErrAmbiguousName = errors.New("reconcile: name matches more than one resource")
+)
+
+// An ActionType is the type of change made to a resource.
+type ActionType string
+
+const (
+	// ActionNone leaves a resource that matches its desired state as is.
+	ActionNone ActionType = "none"
+
+	// ActionCreate creates a desired resource that matches no live one.
+	ActionCreate ActionType = "create"
+
+	// ActionUpdate updates a live resource towards its desired state.
+	ActionUpdate ActionType = "update"
+
+	// ActionDelete deletes a live resource selected by the PruneFunc.
+	ActionDelete ActionType = "delete"
+)
+
+// An Action is a change made to a resource.
+type Action struct {
+	Kind manifest.Kind `json:"kind"`
+	Type ActionType    `json:"action"`
+	Name string        `json:"name,omitempty"`
+
+	// ID is the ID of the live resource. It's empty for creates until the
+	// plan is applied.
+	ID string `json:"id,omitempty"`
+
+	// Diffs are the changed fields. For creates, they are all the fields
+	// set in the desired resource.
+	Diffs []*FieldDiff `json:"diffs,omitempty"`
+
+	// Desired is the desired model, e.g. *aws.Group. It's nil for deletes.
+	Desired interface{} `json:"-"`
+
+	// Live is the live model. It's nil for creates.
+	Live interface{} `json:"-"`
+
+	// cluster is the action of the cluster of a launch spec.
+	cluster *Action
+}
+
+// A Plan is an ordered list of actions.
+type Plan struct {
+	Actions []*Action `json:"actions"`
+}
+
+// Count returns the number of actions of the given type.
+func (p *Plan) Count(typ ActionType) int {
+	n := 0
+	for _, a := range p.Actions {
+		if a.Type == typ {
+			n++
+		}
+	}
+	return n
+}
+
+// HasChanges reports whether the plan has actions other than ActionNone.
+func (p *Plan) HasChanges() bool {
+	return p.Count(ActionNone) < len(p.Actions)
+}
+
+// WriteText writes a human-readable description of the plan to w, e.g.:
+//
+//	~ update ElastigroupAWS "web" (sig-12345)
+//	    capacity.target: 2 => 3
+//	+ create OceanAWSLaunchSpec "gpu"
+//	    instanceTypes: null => ["p3.2xlarge"]
+//
+//	Plan: 1 to create, 1 to update, 0 to delete.
+func (p *Plan) WriteText(w io.Writer) error {
+	var b strings.Builder
+	if !p.HasChanges() {
+		b.WriteString("No changes.\n")
+	} else {
+		for _, a := range p.Actions {
+			if a.Type == ActionNone {
+				continue
+			}
+			fmt.Fprintf(&b, "%s %s %s %q", actionSymbols[a.Type], a.Type, a.Kind, a.Name)
+			if a.ID != "" {
+				fmt.Fprintf(&b, " (%s)", a.ID)
+			}
+			b.WriteString("\n")
+			for _, d := range a.Diffs {
+				fmt.Fprintf(&b, "    %s: %s => %s\n", d.Path, formatValue(d.Old), formatValue(d.New))
+			}
+		}
+		fmt.Fprintf(&b, "\nPlan: %d to create, %d to update, %d to delete.\n",
+			p.Count(ActionCreate), p.Count(ActionUpdate), p.Count(ActionDelete))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+var actionSymbols = map[ActionType]string{
+	ActionCreate: "+",
+	ActionUpdate: "~",
+	ActionDelete: "-",
+}
+
+// String returns the human-readable description of the plan.
+func (p *Plan) String() string {
+	var b strings.Builder
+	p.WriteText(&b)
+	return b.String()
+}
+
+// WriteJSON writes the plan to w as indented JSON.
+func (p *Plan) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(p)
+}
+
+// ApplyError is returned when an action of a plan fails. The actions before
+// it have been applied, the ones after it haven't.
+type ApplyError struct {
+	Action *Action
+	Err    error
+}
+
+func (e *ApplyError) Error() string {
+	name := e.Action.Name
+	if name == "" {
+		name = e.Action.ID
+	}
+	return fmt.Sprintf("reconcile: failed to %s %s %q: %v", e.Action.Type, e.Action.Kind, name, e.Err)
+}
+
+func (e *ApplyError) Unwrap() error { return e.Err }
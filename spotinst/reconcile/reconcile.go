@@ -0,0 +1,441 @@
+// Package reconcile converges live resources towards desired manifests.
+//
+// A Reconciler matches desired Elastigroups, Ocean clusters and Ocean launch
+// specs to the live resources of an account, by ID when the desired model
+// has one and by name otherwise, and computes a Plan of the creates, updates
+// and deletes needed to converge them. A plan can be reviewed, as text or
+// JSON, before being applied with the services' Create, Update and Delete
+// methods:
+//
+//	r := reconcile.New(sess)
+//	plan, err := r.Plan(ctx, desired)
+//	if err != nil {
+//		return err
+//	}
+//	plan.WriteText(os.Stdout)
+//	if err := r.Apply(ctx, plan); err != nil {
+//		return err
+//	}
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	elastigroupaws "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	oceanaws "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/manifest"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+)
+
+// Desired holds the desired resources.
+type Desired struct {
+	Groups   []*elastigroupaws.Group
+	Clusters []*oceanaws.Cluster
+
+	// LaunchSpecs are the desired launch specs. Their OceanID is either the
+	// ID of a live cluster or the name of one of the desired Clusters, so
+	// that a cluster and its launch specs can be created in a single plan.
+	LaunchSpecs []*oceanaws.LaunchSpec
+}
+
+// FromManifests returns the resources described by manifests. It returns an
+// error if a manifest is of a kind other than manifest.KindElastigroupAWS,
+// manifest.KindOceanAWS or manifest.KindOceanAWSLaunchSpec.
+func FromManifests(manifests []*manifest.Manifest) (*Desired, error) {
+	desired := new(Desired)
+	for i, m := range manifests {
+		switch spec := m.Spec.(type) {
+		case *elastigroupaws.Group:
+			desired.Groups = append(desired.Groups, spec)
+		case *oceanaws.Cluster:
+			desired.Clusters = append(desired.Clusters, spec)
+		case *oceanaws.LaunchSpec:
+			desired.LaunchSpecs = append(desired.LaunchSpecs, spec)
+		default:
+			return nil, fmt.Errorf("reconcile: manifest %d: unsupported kind %q", i, m.Kind)
+		}
+	}
+	return desired, nil
+}
+
+// A PruneFunc reports whether a live resource of the given kind that
+// matches no desired resource should be deleted.
+type PruneFunc func(kind manifest.Kind, live interface{}) bool
+
+// A Reconciler plans and applies changes to Elastigroups, Ocean clusters and
+// Ocean launch specs.
+type Reconciler struct {
+	Elastigroup elastigroupaws.Service
+	Ocean       oceanaws.Service
+
+	// Prune, if set, selects the unmatched live resources to delete. Only
+	// the launch specs of the clusters of the desired resources are
+	// considered. If nil, nothing is deleted.
+	Prune PruneFunc
+}
+
+// New returns a Reconciler using the AWS Elastigroup and Ocean services.
+func New(sess *session.Session, cfgs ...*spotinst.Config) *Reconciler {
+	return &Reconciler{
+		Elastigroup: elastigroupaws.New(sess, cfgs...),
+		Ocean:       oceanaws.New(sess, cfgs...),
+	}
+}
+
+// Plan lists the live resources and returns the actions needed to converge
+// them towards desired.
+func (r *Reconciler) Plan(ctx context.Context, desired *Desired) (*Plan, error) {
+	p := &planner{r: r, plan: new(Plan)}
+
+	if err := p.planClusters(ctx, desired); err != nil {
+		return nil, err
+	}
+	if err := p.planGroups(ctx, desired.Groups); err != nil {
+		return nil, err
+	}
+	if err := p.planLaunchSpecs(ctx, desired.LaunchSpecs); err != nil {
+		return nil, err
+	}
+
+	// Deletes go last, and in reverse dependency order.
+	plan := p.plan
+	plan.Actions = append(plan.Actions, p.deletes[manifest.KindOceanAWSLaunchSpec]...)
+	plan.Actions = append(plan.Actions, p.deletes[manifest.KindElastigroupAWS]...)
+	plan.Actions = append(plan.Actions, p.deletes[manifest.KindOceanAWS]...)
+	return plan, nil
+}
+
+type planner struct {
+	r       *Reconciler
+	plan    *Plan
+	deletes map[manifest.Kind][]*Action
+
+	// clusters maps the IDs and names of the clusters the launch specs may
+	// refer to to their actions.
+	clusters map[string]*Action
+}
+
+func (p *planner) planClusters(ctx context.Context, d *Desired) error {
+	desired := d.Clusters
+	p.clusters = make(map[string]*Action)
+	if len(desired) == 0 && len(d.LaunchSpecs) == 0 && p.r.Prune == nil {
+		return nil
+	}
+
+	out, err := p.r.Ocean.ListClusters(ctx, &oceanaws.ListClustersInput{})
+	if err != nil {
+		return fmt.Errorf("reconcile: failed to list clusters: %w", err)
+	}
+	live := make([]resource, len(out.Clusters))
+	for i, c := range out.Clusters {
+		live[i] = resource{id: c.GetId(), name: c.GetName(), obj: c}
+	}
+	want := make([]resource, len(desired))
+	for i, c := range desired {
+		want[i] = resource{id: c.GetId(), name: c.GetName(), obj: c}
+	}
+
+	actions, err := p.match(manifest.KindOceanAWS, want, live)
+	if err != nil {
+		return err
+	}
+
+	// Launch specs may refer to any live cluster by ID, and to the desired
+	// clusters by name. Live clusters that are not desired get a no-op
+	// action.
+	for _, l := range live {
+		p.clusters[l.id] = &Action{Kind: manifest.KindOceanAWS, Type: ActionNone, Name: l.name, ID: l.id, Live: l.obj}
+	}
+	for _, a := range actions {
+		if a.ID != "" {
+			p.clusters[a.ID] = a
+		}
+		if a.Name != "" {
+			p.clusters[a.Name] = a
+		}
+	}
+	return nil
+}
+
+func (p *planner) planGroups(ctx context.Context, desired []*elastigroupaws.Group) error {
+	if len(desired) == 0 && p.r.Prune == nil {
+		return nil
+	}
+
+	out, err := p.r.Elastigroup.List(ctx, &elastigroupaws.ListGroupsInput{})
+	if err != nil {
+		return fmt.Errorf("reconcile: failed to list groups: %w", err)
+	}
+	live := make([]resource, len(out.Groups))
+	for i, g := range out.Groups {
+		live[i] = resource{id: g.GetId(), name: g.GetName(), obj: g}
+	}
+	want := make([]resource, len(desired))
+	for i, g := range desired {
+		want[i] = resource{id: g.GetId(), name: g.GetName(), obj: g}
+	}
+
+	_, err = p.match(manifest.KindElastigroupAWS, want, live)
+	return err
+}
+
+func (p *planner) planLaunchSpecs(ctx context.Context, desired []*oceanaws.LaunchSpec) error {
+	// Group the desired launch specs by cluster, resolving references to
+	// the desired clusters by name. When pruning, the launch specs of all
+	// the desired clusters are considered.
+	byCluster := make(map[*Action][]resource)
+	var clusters []*Action
+	add := func(cluster *Action, ls ...resource) {
+		if _, ok := byCluster[cluster]; !ok {
+			clusters = append(clusters, cluster)
+		}
+		byCluster[cluster] = append(byCluster[cluster], ls...)
+	}
+	if p.r.Prune != nil {
+		for _, a := range p.plan.Actions {
+			if a.Kind == manifest.KindOceanAWS {
+				add(a)
+			}
+		}
+	}
+	for i, ls := range desired {
+		cluster, ok := p.clusters[ls.GetOceanId()]
+		if !ok {
+			return fmt.Errorf("reconcile: launch spec %d (%q): cluster %q is neither live nor desired",
+				i, ls.GetName(), ls.GetOceanId())
+		}
+		add(cluster, resource{id: ls.GetId(), name: ls.GetName(), obj: ls})
+	}
+
+	for _, cluster := range clusters {
+		want := byCluster[cluster]
+
+		var live []resource
+		if cluster.Type != ActionCreate {
+			out, err := p.r.Ocean.ListLaunchSpecs(ctx, &oceanaws.ListLaunchSpecsInput{
+				OceanID: spotinst.String(cluster.ID),
+			})
+			if err != nil {
+				return fmt.Errorf("reconcile: failed to list launch specs of cluster %q: %w", cluster.ID, err)
+			}
+			live = make([]resource, len(out.LaunchSpecs))
+			for i, ls := range out.LaunchSpecs {
+				live[i] = resource{id: ls.GetId(), name: ls.GetName(), obj: ls}
+			}
+		}
+
+		actions, err := p.match(manifest.KindOceanAWSLaunchSpec, want, live, "oceanId")
+		if err != nil {
+			return err
+		}
+		for _, a := range actions {
+			a.cluster = cluster
+		}
+	}
+	return nil
+}
+
+type resource struct {
+	id   string
+	name string
+	obj  interface{}
+}
+
+// match matches the desired resources of a kind to the live ones, appends
+// the create and update actions to the plan, records the delete actions,
+// and returns the actions of the desired resources.
+func (p *planner) match(kind manifest.Kind, desired, live []resource, ignore ...string) ([]*Action, error) {
+	byID := make(map[string]resource, len(live))
+	byName := make(map[string][]resource, len(live))
+	for _, l := range live {
+		byID[l.id] = l
+		byName[l.name] = append(byName[l.name], l)
+	}
+
+	matched := make(map[string]bool, len(desired))
+	names := make(map[string]bool, len(desired))
+	actions := make([]*Action, 0, len(desired))
+	for _, d := range desired {
+		if d.id == "" && d.name == "" {
+			return nil, fmt.Errorf("reconcile: %s has neither an ID nor a name", kind)
+		}
+		if d.name != "" {
+			if names[d.name] {
+				return nil, fmt.Errorf("reconcile: duplicate %s %q", kind, d.name)
+			}
+			names[d.name] = true
+		}
+
+		var l resource
+		var found bool
+		switch {
+		case d.id != "":
+			if l, found = byID[d.id]; !found {
+				return nil, fmt.Errorf("%w: %s %q", ErrNotFound, kind, d.id)
+			}
+		case len(byName[d.name]) > 1:
+			return nil, fmt.Errorf("%w: %s %q", ErrAmbiguousName, kind, d.name)
+		case len(byName[d.name]) == 1:
+			l, found = byName[d.name][0], true
+		}
+
+		a := &Action{Kind: kind, Name: d.name, Desired: d.obj}
+		var err error
+		if found {
+			matched[l.id] = true
+			a.ID, a.Live = l.id, l.obj
+			if a.Name == "" {
+				a.Name = l.name
+			}
+			a.Diffs, err = Diff(d.obj, l.obj, ignore...)
+			a.Type = ActionUpdate
+			if len(a.Diffs) == 0 {
+				a.Type = ActionNone
+			}
+		} else {
+			a.Diffs, err = Diff(d.obj, nil, ignore...)
+			a.Type = ActionCreate
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reconcile: %s %q: %w", kind, a.Name, err)
+		}
+
+		p.plan.Actions = append(p.plan.Actions, a)
+		actions = append(actions, a)
+	}
+
+	if p.r.Prune != nil {
+		if p.deletes == nil {
+			p.deletes = make(map[manifest.Kind][]*Action)
+		}
+		for _, l := range live {
+			if !matched[l.id] && p.r.Prune(kind, l.obj) {
+				p.deletes[kind] = append(p.deletes[kind], &Action{
+					Kind: kind,
+					Type: ActionDelete,
+					Name: l.name,
+					ID:   l.id,
+					Live: l.obj,
+				})
+			}
+		}
+	}
+
+	return actions, nil
+}
+
+// Apply applies the actions of a plan in order, and stops at the first
+// failure. Updates send only the fields reported by the actions' diffs, and
+// neither creates nor updates send read-only fields. The IDs of the created
+// resources are recorded in their actions.
+func (r *Reconciler) Apply(ctx context.Context, plan *Plan) error {
+	for _, a := range plan.Actions {
+		if err := r.apply(ctx, a); err != nil {
+			return &ApplyError{Action: a, Err: err}
+		}
+	}
+	return nil
+}
+
+func (r *Reconciler) apply(ctx context.Context, a *Action) error {
+	switch a.Type {
+	case ActionCreate, ActionUpdate, ActionDelete:
+	default:
+		return nil
+	}
+
+	switch a.Kind {
+	case manifest.KindElastigroupAWS:
+		return r.applyGroup(ctx, a)
+	case manifest.KindOceanAWS:
+		return r.applyCluster(ctx, a)
+	case manifest.KindOceanAWSLaunchSpec:
+		return r.applyLaunchSpec(ctx, a)
+	default:
+		return fmt.Errorf("unsupported kind %q", a.Kind)
+	}
+}
+
+func (r *Reconciler) applyGroup(ctx context.Context, a *Action) error {
+	if a.Type == ActionDelete {
+		_, err := r.Elastigroup.Delete(ctx, &elastigroupaws.DeleteGroupInput{
+			GroupID: spotinst.String(a.ID),
+		})
+		return err
+	}
+
+	// Copy the desired model, as the services modify their inputs.
+	group := deepCopy(a.Desired).(*elastigroupaws.Group)
+	elastigroupaws.ClearReadOnly(group)
+	if a.Type == ActionUpdate {
+		pruneUnchanged(group, a.Diffs)
+		group.SetId(spotinst.String(a.ID))
+		_, err := r.Elastigroup.Update(ctx, &elastigroupaws.UpdateGroupInput{Group: group})
+		return err
+	}
+
+	out, err := r.Elastigroup.Create(ctx, &elastigroupaws.CreateGroupInput{Group: group})
+	if err != nil {
+		return err
+	}
+	a.ID = out.Group.GetId()
+	return nil
+}
+
+func (r *Reconciler) applyCluster(ctx context.Context, a *Action) error {
+	if a.Type == ActionDelete {
+		_, err := r.Ocean.DeleteCluster(ctx, &oceanaws.DeleteClusterInput{
+			ClusterID: spotinst.String(a.ID),
+		})
+		return err
+	}
+
+	cluster := deepCopy(a.Desired).(*oceanaws.Cluster)
+	oceanaws.ClearClusterReadOnly(cluster)
+	if a.Type == ActionUpdate {
+		pruneUnchanged(cluster, a.Diffs)
+		cluster.SetId(spotinst.String(a.ID))
+		_, err := r.Ocean.UpdateCluster(ctx, &oceanaws.UpdateClusterInput{Cluster: cluster})
+		return err
+	}
+
+	out, err := r.Ocean.CreateCluster(ctx, &oceanaws.CreateClusterInput{Cluster: cluster})
+	if err != nil {
+		return err
+	}
+	a.ID = out.Cluster.GetId()
+	return nil
+}
+
+func (r *Reconciler) applyLaunchSpec(ctx context.Context, a *Action) error {
+	if a.Type == ActionDelete {
+		_, err := r.Ocean.DeleteLaunchSpec(ctx, &oceanaws.DeleteLaunchSpecInput{
+			LaunchSpecID: spotinst.String(a.ID),
+		})
+		return err
+	}
+
+	if a.cluster == nil || a.cluster.ID == "" {
+		return errors.New("cluster has not been created")
+	}
+	spec := deepCopy(a.Desired).(*oceanaws.LaunchSpec)
+	oceanaws.ClearLaunchSpecReadOnly(spec)
+	if a.Type == ActionUpdate {
+		// The cluster of a launch spec can't change, and isn't diffed.
+		pruneUnchanged(spec, a.Diffs)
+		spec.SetId(spotinst.String(a.ID))
+		_, err := r.Ocean.UpdateLaunchSpec(ctx, &oceanaws.UpdateLaunchSpecInput{LaunchSpec: spec})
+		return err
+	}
+
+	spec.SetOceanId(spotinst.String(a.cluster.ID))
+	out, err := r.Ocean.CreateLaunchSpec(ctx, &oceanaws.CreateLaunchSpecInput{LaunchSpec: spec})
+	if err != nil {
+		return err
+	}
+	a.ID = out.LaunchSpec.GetId()
+	return nil
+}
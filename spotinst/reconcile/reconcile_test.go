@@ -0,0 +1,376 @@
+package reconcile
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	elastigroupaws "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	oceanaws "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/credentials"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/manifest"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+)
+
+type fakeElastigroup struct {
+	elastigroupaws.Service
+
+	groups []*elastigroupaws.Group
+	calls  []string
+}
+
+func (f *fakeElastigroup) List(context.Context, *elastigroupaws.ListGroupsInput) (*elastigroupaws.ListGroupsOutput, error) {
+	return &elastigroupaws.ListGroupsOutput{Groups: f.groups}, nil
+}
+
+func (f *fakeElastigroup) Create(_ context.Context, input *elastigroupaws.CreateGroupInput) (*elastigroupaws.CreateGroupOutput, error) {
+	f.calls = append(f.calls, "create "+input.Group.GetName())
+	g := *input.Group
+	g.SetId(spotinst.String("sig-new"))
+	return &elastigroupaws.CreateGroupOutput{Group: &g}, nil
+}
+
+func (f *fakeElastigroup) Update(_ context.Context, input *elastigroupaws.UpdateGroupInput) (*elastigroupaws.UpdateGroupOutput, error) {
+	f.calls = append(f.calls, "update "+input.Group.GetId())
+	return &elastigroupaws.UpdateGroupOutput{Group: input.Group}, nil
+}
+
+func (f *fakeElastigroup) Delete(_ context.Context, input *elastigroupaws.DeleteGroupInput) (*elastigroupaws.DeleteGroupOutput, error) {
+	f.calls = append(f.calls, "delete "+spotinst.StringValue(input.GroupID))
+	return &elastigroupaws.DeleteGroupOutput{}, nil
+}
+
+type fakeOcean struct {
+	oceanaws.Service
+
+	clusters    []*oceanaws.Cluster
+	launchSpecs []*oceanaws.LaunchSpec
+	calls       []string
+	failOn      string
+}
+
+func (f *fakeOcean) ListClusters(context.Context, *oceanaws.ListClustersInput) (*oceanaws.ListClustersOutput, error) {
+	return &oceanaws.ListClustersOutput{Clusters: f.clusters}, nil
+}
+
+func (f *fakeOcean) CreateCluster(_ context.Context, input *oceanaws.CreateClusterInput) (*oceanaws.CreateClusterOutput, error) {
+	f.calls = append(f.calls, "create "+input.Cluster.GetName())
+	c := *input.Cluster
+	c.SetId(spotinst.String("o-new"))
+	return &oceanaws.CreateClusterOutput{Cluster: &c}, nil
+}
+
+func (f *fakeOcean) ListLaunchSpecs(_ context.Context, input *oceanaws.ListLaunchSpecsInput) (*oceanaws.ListLaunchSpecsOutput, error) {
+	var out []*oceanaws.LaunchSpec
+	for _, ls := range f.launchSpecs {
+		if ls.GetOceanId() == spotinst.StringValue(input.OceanID) {
+			out = append(out, ls)
+		}
+	}
+	return &oceanaws.ListLaunchSpecsOutput{LaunchSpecs: out}, nil
+}
+
+func (f *fakeOcean) CreateLaunchSpec(_ context.Context, input *oceanaws.CreateLaunchSpecInput) (*oceanaws.CreateLaunchSpecOutput, error) {
+	call := fmt.Sprintf("create %s in %s", input.LaunchSpec.GetName(), input.LaunchSpec.GetOceanId())
+	f.calls = append(f.calls, call)
+	if call == f.failOn {
+		return nil, errors.New("boom")
+	}
+	ls := *input.LaunchSpec
+	ls.SetId(spotinst.String("ols-new"))
+	return &oceanaws.CreateLaunchSpecOutput{LaunchSpec: &ls}, nil
+}
+
+func (f *fakeOcean) UpdateLaunchSpec(_ context.Context, input *oceanaws.UpdateLaunchSpecInput) (*oceanaws.UpdateLaunchSpecOutput, error) {
+	f.calls = append(f.calls, "update "+input.LaunchSpec.GetId())
+	return &oceanaws.UpdateLaunchSpecOutput{LaunchSpec: input.LaunchSpec}, nil
+}
+
+func (f *fakeOcean) DeleteLaunchSpec(_ context.Context, input *oceanaws.DeleteLaunchSpecInput) (*oceanaws.DeleteLaunchSpecOutput, error) {
+	f.calls = append(f.calls, "delete "+spotinst.StringValue(input.LaunchSpecID))
+	return &oceanaws.DeleteLaunchSpecOutput{}, nil
+}
+
+func TestDiff(t *testing.T) {
+	desired := &elastigroupaws.Group{
+		ID:       spotinst.String("sig-1"),
+		Name:     spotinst.String("web"),
+		Capacity: &elastigroupaws.Capacity{Target: spotinst.Int(3), Maximum: spotinst.Int(5)},
+	}
+	desired.SetDescription(nil)
+	live := &elastigroupaws.Group{
+		ID:          spotinst.String("sig-2"),
+		Name:        spotinst.String("web"),
+		Description: spotinst.String("web servers"),
+		Region:      spotinst.String("us-west-2"),
+		Capacity:    &elastigroupaws.Capacity{Target: spotinst.Int(2), Maximum: spotinst.Int(5), Minimum: spotinst.Int(1)},
+	}
+
+	diffs, err := Diff(desired, live)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, d := range diffs {
+		got = append(got, fmt.Sprintf("%s: %s => %s", d.Path, formatValue(d.Old), formatValue(d.New)))
+	}
+	want := []string{
+		`capacity.target: 2 => 3`,
+		`description: "web servers" => null`,
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got diffs:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestDiffArrays(t *testing.T) {
+	desired := &oceanaws.LaunchSpec{
+		InstanceTypes: []string{"m5.large", "m5.xlarge"},
+		Labels:        []*oceanaws.Label{{Key: spotinst.String("team"), Value: spotinst.String("b")}},
+	}
+	live := &oceanaws.LaunchSpec{
+		InstanceTypes: []string{"m5.large"},
+		Labels:        []*oceanaws.Label{{Key: spotinst.String("team"), Value: spotinst.String("a")}},
+	}
+
+	diffs, err := Diff(desired, live)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diffs) != 2 || diffs[0].Path != "instanceTypes" || diffs[1].Path != "labels[0].value" {
+		for _, d := range diffs {
+			t.Logf("%+v", d)
+		}
+		t.Fatalf("got %d unexpected diffs", len(diffs))
+	}
+
+	diffs, err = Diff(desired, live, "labels[].value")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diffs) != 1 || diffs[0].Path != "instanceTypes" {
+		t.Fatalf("got diffs %+v, want labels ignored", diffs)
+	}
+}
+
+func TestPlanAndApply(t *testing.T) {
+	eg := &fakeElastigroup{groups: []*elastigroupaws.Group{
+		{ID: spotinst.String("sig-1"), Name: spotinst.String("web"), Capacity: &elastigroupaws.Capacity{Target: spotinst.Int(2)}},
+		{ID: spotinst.String("sig-2"), Name: spotinst.String("old")},
+	}}
+	ocean := &fakeOcean{
+		clusters: []*oceanaws.Cluster{
+			{ID: spotinst.String("o-1"), Name: spotinst.String("prod"), Region: spotinst.String("us-west-2")},
+		},
+		launchSpecs: []*oceanaws.LaunchSpec{
+			{ID: spotinst.String("ols-1"), Name: spotinst.String("default"), OceanID: spotinst.String("o-1"), ImageID: spotinst.String("ami-1")},
+			{ID: spotinst.String("ols-2"), Name: spotinst.String("stale"), OceanID: spotinst.String("o-1")},
+		},
+	}
+	r := &Reconciler{
+		Elastigroup: eg,
+		Ocean:       ocean,
+		Prune: func(kind manifest.Kind, live interface{}) bool {
+			return kind != manifest.KindOceanAWS
+		},
+	}
+
+	desired := &Desired{
+		Groups: []*elastigroupaws.Group{
+			{Name: spotinst.String("web"), Capacity: &elastigroupaws.Capacity{Target: spotinst.Int(3)}},
+			{Name: spotinst.String("api")},
+		},
+		Clusters: []*oceanaws.Cluster{
+			{Name: spotinst.String("prod"), Region: spotinst.String("us-west-2")},
+		},
+		LaunchSpecs: []*oceanaws.LaunchSpec{
+			{Name: spotinst.String("default"), OceanID: spotinst.String("prod"), ImageID: spotinst.String("ami-2")},
+			{Name: spotinst.String("gpu"), OceanID: spotinst.String("o-1")},
+		},
+	}
+
+	ctx := context.Background()
+	plan, err := r.Plan(ctx, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `~ update ElastigroupAWS "web" (sig-1)
+    capacity.target: 2 => 3
++ create ElastigroupAWS "api"
+    name: null => "api"
+~ update OceanAWSLaunchSpec "default" (ols-1)
+    imageId: "ami-1" => "ami-2"
++ create OceanAWSLaunchSpec "gpu"
+    name: null => "gpu"
+- delete OceanAWSLaunchSpec "stale" (ols-2)
+- delete ElastigroupAWS "old" (sig-2)
+
+Plan: 2 to create, 2 to update, 2 to delete.
+`
+	if got := plan.String(); got != want {
+		t.Errorf("got plan:\n%s\nwant:\n%s", got, want)
+	}
+
+	var buf bytes.Buffer
+	if err := plan.WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var decoded Plan
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.Actions) != len(plan.Actions) || decoded.Actions[1].Diffs[0].Path != "capacity.target" {
+		t.Errorf("unexpected JSON plan:\n%s", buf.String())
+	}
+
+	if err := r.Apply(ctx, plan); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := strings.Join(eg.calls, ", "), "update sig-1, create api, delete sig-2"; got != want {
+		t.Errorf("got elastigroup calls %q, want %q", got, want)
+	}
+	if got, want := strings.Join(ocean.calls, ", "), "update ols-1, create gpu in o-1, delete ols-2"; got != want {
+		t.Errorf("got ocean calls %q, want %q", got, want)
+	}
+	if desired.Groups[0].ID != nil || desired.LaunchSpecs[0].GetOceanId() != "prod" {
+		t.Errorf("desired models were modified")
+	}
+}
+
+func TestApplyNewCluster(t *testing.T) {
+	ocean := &fakeOcean{failOn: "create default in o-new"}
+	r := &Reconciler{Ocean: ocean}
+
+	plan, err := r.Plan(context.Background(), &Desired{
+		Clusters:    []*oceanaws.Cluster{{Name: spotinst.String("dev")}},
+		LaunchSpecs: []*oceanaws.LaunchSpec{{Name: spotinst.String("default"), OceanID: spotinst.String("dev")}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = r.Apply(context.Background(), plan)
+	var applyErr *ApplyError
+	if !errors.As(err, &applyErr) || applyErr.Action.Kind != manifest.KindOceanAWSLaunchSpec {
+		t.Fatalf("got error %v, want an ApplyError of the launch spec", err)
+	}
+	if got, want := strings.Join(ocean.calls, ", "), "create dev, create default in o-new"; got != want {
+		t.Errorf("got calls %q, want %q", got, want)
+	}
+	if plan.Actions[0].ID != "o-new" {
+		t.Errorf("got cluster ID %q, want o-new", plan.Actions[0].ID)
+	}
+}
+
+func TestPlanErrors(t *testing.T) {
+	ocean := &fakeOcean{clusters: []*oceanaws.Cluster{
+		{ID: spotinst.String("o-1"), Name: spotinst.String("dup")},
+		{ID: spotinst.String("o-2"), Name: spotinst.String("dup")},
+	}}
+	r := &Reconciler{Ocean: ocean}
+	ctx := context.Background()
+
+	_, err := r.Plan(ctx, &Desired{Clusters: []*oceanaws.Cluster{{Name: spotinst.String("dup")}}})
+	if !errors.Is(err, ErrAmbiguousName) {
+		t.Errorf("got error %v, want ErrAmbiguousName", err)
+	}
+
+	_, err = r.Plan(ctx, &Desired{Clusters: []*oceanaws.Cluster{{ID: spotinst.String("o-3")}}})
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("got error %v, want ErrNotFound", err)
+	}
+
+	_, err = r.Plan(ctx, &Desired{LaunchSpecs: []*oceanaws.LaunchSpec{{Name: spotinst.String("a"), OceanID: spotinst.String("o-3")}}})
+	if err == nil {
+		t.Errorf("expected an error for a launch spec of an unknown cluster")
+	}
+}
+
+func TestApplyUpdateBody(t *testing.T) {
+	const live = `{"response":{"items":[{
+		"id": "sig-1",
+		"name": "web",
+		"description": "web servers",
+		"capacity": {"target": 2, "minimum": 1, "maximum": 5},
+		"compute": {"launchSpecification": {
+			"imageId": "ami-1",
+			"networkInterfaces": [{"networkInterfaceId": "eni-1", "deviceIndex": 0}]
+		}},
+		"createdAt": "2024-01-01T00:00:00.000Z"
+	}]}}`
+
+	var body []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			if r.URL.Path != "/aws/ec2/group/sig-1" {
+				t.Errorf("got update of %s", r.URL.Path)
+			}
+			body, _ = io.ReadAll(r.Body)
+		}
+		io.WriteString(w, live)
+	}))
+	defer srv.Close()
+
+	sess := session.New(spotinst.DefaultConfig().
+		WithBaseURL(srv.URL).
+		WithCredentials(credentials.NewStaticCredentials("token", "act-1")))
+	r := &Reconciler{Elastigroup: elastigroupaws.New(sess)}
+
+	// The desired group is an export of the live one, edited.
+	desired := &elastigroupaws.Group{
+		Name:     spotinst.String("web"),
+		Capacity: &elastigroupaws.Capacity{Target: spotinst.Int(3), Maximum: spotinst.Int(5)},
+		Compute: &elastigroupaws.Compute{LaunchSpecification: &elastigroupaws.LaunchSpecification{
+			ImageID: spotinst.String("ami-1"),
+			NetworkInterfaces: []*elastigroupaws.NetworkInterface{{
+				ID:                       spotinst.String("eni-1"),
+				DeviceIndex:              spotinst.Int(0),
+				AssociatePublicIPAddress: spotinst.Bool(true),
+			}},
+		}},
+		CreatedAt: spotinst.Time(time.Unix(0, 0)),
+	}
+	desired.SetDescription(nil)
+
+	ctx := context.Background()
+	plan, err := r.Plan(ctx, &Desired{Groups: []*elastigroupaws.Group{desired}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Apply(ctx, plan); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the changed fields are sent, without the read-only ones; arrays
+	// are sent whole.
+	var got, want interface{}
+	if err := json.Unmarshal(body, &got); err != nil {
+		t.Fatalf("got body %q: %v", body, err)
+	}
+	json.Unmarshal([]byte(`{"group": {
+		"capacity": {"target": 3},
+		"compute": {"launchSpecification": {
+			"networkInterfaces": [{"deviceIndex": 0, "associatePublicIpAddress": true}]
+		}},
+		"description": null
+	}}`), &want)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got body %s", body)
+	}
+
+	ni := desired.Compute.LaunchSpecification.NetworkInterfaces[0]
+	if desired.CreatedAt == nil || ni.ID == nil || desired.Capacity.Maximum == nil {
+		t.Errorf("desired model was modified")
+	}
+}
@@ -0,0 +1,258 @@
+package tagging
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/manifest"
+)
+
+// Changes are changes of tags. They are applied in order: renames first,
+// then removals, then additions.
+type Changes struct {
+	// Add sets the values of tags by key, adding the tags that aren't set.
+	Add map[string]string `json:"add,omitempty"`
+
+	// Remove removes tags by key.
+	Remove []string `json:"remove,omitempty"`
+
+	// Rename renames tags by key, keeping their values, e.g. "Owner" to
+	// "owner". A renamed tag replaces the tag with its new key, if any.
+	Rename map[string]string `json:"rename,omitempty"`
+}
+
+// Validate returns an error if c has no changes or has empty keys, or if a
+// tag is renamed to a key that is also renamed, removed or the new key of
+// another tag.
+func (c *Changes) Validate() error {
+	if c == nil || (len(c.Add) == 0 && len(c.Remove) == 0 && len(c.Rename) == 0) {
+		return errors.New("tagging: no changes")
+	}
+	for key := range c.Add {
+		if key == "" {
+			return errors.New("tagging: empty key to add")
+		}
+	}
+	for _, key := range c.Remove {
+		if key == "" {
+			return errors.New("tagging: empty key to remove")
+		}
+	}
+	targets := make(map[string]string)
+	for _, from := range sortedKeys(c.Rename) {
+		to := c.Rename[from]
+		if other, ok := targets[to]; ok {
+			return fmt.Errorf("tagging: tags %q and %q both renamed to %q", other, from, to)
+		}
+		targets[to] = from
+		if from == "" || to == "" {
+			return fmt.Errorf("tagging: empty key to rename %q to %q", from, to)
+		}
+		if from == to {
+			return fmt.Errorf("tagging: tag %q renamed to itself", from)
+		}
+		if _, ok := c.Rename[to]; ok {
+			return fmt.Errorf("tagging: tag %q renamed to %q, which is renamed too", from, to)
+		}
+		if contains(c.Remove, to) {
+			return fmt.Errorf("tagging: tag %q renamed to %q, which is removed", from, to)
+		}
+	}
+	return nil
+}
+
+// apply returns the item of r with c applied to its tags.
+func (c *Changes) apply(r *Resource) *Item {
+	item := &Item{Kind: r.Kind, ID: r.ID, Name: r.Name, Before: r.Tags}
+	tags := append([]Tag(nil), r.Tags...)
+
+	for _, from := range sortedKeys(c.Rename) {
+		to := c.Rename[from]
+		i := index(tags, from)
+		if i < 0 {
+			continue
+		}
+		if j := index(tags, to); j >= 0 {
+			item.Changes = append(item.Changes, &Change{Action: ActionRemove, Key: to, Value: tags[j].Value})
+			tags = append(tags[:j], tags[j+1:]...)
+			i = index(tags, from)
+		}
+		item.Changes = append(item.Changes, &Change{
+			Action:   ActionRename,
+			Key:      from,
+			Value:    tags[i].Value,
+			NewKey:   to,
+			NewValue: tags[i].Value,
+		})
+		tags[i].Key = to
+	}
+
+	removed := append([]string(nil), c.Remove...)
+	sort.Strings(removed)
+	for _, key := range removed {
+		if i := index(tags, key); i >= 0 {
+			item.Changes = append(item.Changes, &Change{Action: ActionRemove, Key: key, Value: tags[i].Value})
+			tags = append(tags[:i], tags[i+1:]...)
+		}
+	}
+
+	for _, key := range sortedKeys(c.Add) {
+		value := c.Add[key]
+		if i := index(tags, key); i >= 0 {
+			if tags[i].Value != value {
+				item.Changes = append(item.Changes, &Change{
+					Action:   ActionUpdate,
+					Key:      key,
+					Value:    tags[i].Value,
+					NewKey:   key,
+					NewValue: value,
+				})
+				tags[i].Value = value
+			}
+			continue
+		}
+		item.Changes = append(item.Changes, &Change{Action: ActionAdd, NewKey: key, NewValue: value})
+		tags = append(tags, Tag{Key: key, Value: value})
+	}
+
+	item.Tags = tags
+	return item
+}
+
+func index(tags []Tag, key string) int {
+	for i, tag := range tags {
+		if tag.Key == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// An Action is the type of a change of a tag.
+type Action string
+
+const (
+	// ActionAdd represents a tag that is added.
+	ActionAdd Action = "add"
+
+	// ActionUpdate represents a tag whose value changes.
+	ActionUpdate Action = "update"
+
+	// ActionRemove represents a tag that is removed.
+	ActionRemove Action = "remove"
+
+	// ActionRename represents a tag whose key changes.
+	ActionRename Action = "rename"
+)
+
+// A Change is a change of a tag of a resource.
+type Change struct {
+	Action Action `json:"action"`
+
+	// Key and Value are the tag before the change. They are empty for
+	// added tags.
+	Key   string `json:"key,omitempty"`
+	Value string `json:"value,omitempty"`
+
+	// NewKey and NewValue are the tag after the change. They are empty for
+	// removed tags.
+	NewKey   string `json:"newKey,omitempty"`
+	NewValue string `json:"newValue,omitempty"`
+}
+
+func (c *Change) String() string {
+	switch c.Action {
+	case ActionAdd:
+		return fmt.Sprintf("+ %s=%s", c.NewKey, c.NewValue)
+	case ActionUpdate:
+		return fmt.Sprintf("~ %s=%s -> %s", c.Key, c.Value, c.NewValue)
+	case ActionRemove:
+		return fmt.Sprintf("- %s=%s", c.Key, c.Value)
+	case ActionRename:
+		return fmt.Sprintf("> %s -> %s", c.Key, c.NewKey)
+	default:
+		return string(c.Action)
+	}
+}
+
+// An Item holds the planned changes of the tags of a resource.
+type Item struct {
+	Kind manifest.Kind `json:"kind"`
+	ID   string        `json:"id"`
+	Name string        `json:"name,omitempty"`
+
+	// Before are the tags of the resource when the plan was made.
+	Before []Tag `json:"before"`
+
+	// Tags are the tags of the resource once the changes are applied.
+	Tags []Tag `json:"tags"`
+
+	Changes []*Change `json:"changes,omitempty"`
+}
+
+// HasChanges reports whether the tags of the resource change.
+func (i *Item) HasChanges() bool {
+	return len(i.Changes) > 0
+}
+
+// A Plan holds the planned changes of the tags of the selected resources.
+type Plan struct {
+	// Changes are the changes the plan applies.
+	Changes *Changes `json:"changes"`
+
+	// Items hold an item per selected resource, including the resources
+	// whose tags don't change.
+	Items []*Item `json:"items"`
+}
+
+// Changed returns the items whose tags change.
+func (p *Plan) Changed() []*Item {
+	var changed []*Item
+	for _, item := range p.Items {
+		if item.HasChanges() {
+			changed = append(changed, item)
+		}
+	}
+	return changed
+}
+
+// HasChanges reports whether the tags of any resource change.
+func (p *Plan) HasChanges() bool {
+	return len(p.Changed()) > 0
+}
+
+// WriteText writes the changes of the plan to w as text, with a line per
+// changed tag.
+func (p *Plan) WriteText(w io.Writer) error {
+	var b strings.Builder
+	changed := p.Changed()
+	fmt.Fprintf(&b, "%d of %d resource(s) to change.\n", len(changed), len(p.Items))
+	for _, item := range changed {
+		fmt.Fprintf(&b, "\n%s %q (%s):\n", item.Kind, item.Name, item.ID)
+		for _, c := range item.Changes {
+			fmt.Fprintf(&b, "  %s\n", c)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteJSON writes the plan to w as indented JSON.
+func (p *Plan) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(p)
+}
@@ -0,0 +1,495 @@
+// Package tagging lists and changes the tags of resources of different
+// kinds through a single API.
+//
+// Each kind keeps its tags in its own place, e.g. Elastigroups under
+// "compute.launchSpecification.tags" and Ocean launch specs under "tags",
+// and only some kinds can apply tag changes to their running instances. A
+// Tagger hides these differences behind an Adapter per kind. Changes are
+// planned first, so they can be reviewed, and then applied in bulk:
+//
+//	t := tagging.New(sess)
+//	plan, err := t.Plan(ctx, &tagging.Selector{Tags: map[string]string{"team": "core"}},
+//		&tagging.Changes{
+//			Add:    map[string]string{"cost-center": "1234"},
+//			Rename: map[string]string{"Owner": "owner"},
+//		})
+//	...
+//	plan.WriteText(os.Stdout)
+//	report, err := t.Apply(ctx, plan)
+//	...
+//	err = report.Err()
+package tagging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+
+	elastigroupaws "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	managedinstanceaws "github.com/spotinst/spotinst-sdk-go/service/managedinstance/providers/aws"
+	oceanaws "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	statefulazure "github.com/spotinst/spotinst-sdk-go/service/stateful/providers/azure"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/bulk"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/manifest"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+)
+
+// ErrNotFound is returned when applying a plan to a resource that no longer
+// exists.
+var ErrNotFound = errors.New("tagging: resource not found")
+
+// A Tag is a key/value pair attached to a resource.
+type Tag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// A Resource is a tagged resource.
+type Resource struct {
+	Kind manifest.Kind `json:"kind"`
+	ID   string        `json:"id"`
+
+	// Name is the name of the resource, prefixed with the ID of its cluster
+	// for launch specs, e.g. "o-12345/default".
+	Name string `json:"name,omitempty"`
+
+	// Tags are the tags of the resource, in their order.
+	Tags []Tag `json:"tags"`
+}
+
+// Value returns the value of the tag key of the resource and whether it is
+// set.
+func (r *Resource) Value(key string) (string, bool) {
+	for _, tag := range r.Tags {
+		if tag.Key == key {
+			return tag.Value, true
+		}
+	}
+	return "", false
+}
+
+// An Adapter reads and writes the tags of the resources of a kind.
+type Adapter struct {
+	// List lists the resources of the kind with their tags.
+	List func(ctx context.Context) ([]*Resource, error)
+
+	// Update replaces the tags of the resource id with tags. autoApply
+	// requests the tags to be applied to the running instances of the
+	// resource, and is ignored by the kinds that can't.
+	Update func(ctx context.Context, id string, tags []Tag, autoApply bool) error
+}
+
+// A Selector selects resources. An empty Selector selects all resources.
+type Selector struct {
+	// Kinds are the kinds of the selected resources.
+	Kinds []manifest.Kind
+
+	// IDs are the IDs of the selected resources.
+	IDs []string
+
+	// Names are path.Match patterns of the names of the selected resources,
+	// e.g. "web-*".
+	Names []string
+
+	// Tags are the tags the selected resources must all have. An empty
+	// value selects any value of the tag.
+	Tags map[string]string
+}
+
+// Matches reports whether s selects r.
+func (s *Selector) Matches(r *Resource) bool {
+	if s == nil {
+		return true
+	}
+	if len(s.Kinds) > 0 && !containsKind(s.Kinds, r.Kind) {
+		return false
+	}
+	if len(s.IDs) > 0 && !contains(s.IDs, r.ID) {
+		return false
+	}
+	if len(s.Names) > 0 {
+		matched := false
+		for _, pattern := range s.Names {
+			if ok, _ := path.Match(pattern, r.Name); ok {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	for key, want := range s.Tags {
+		value, ok := r.Value(key)
+		if !ok || (want != "" && value != want) {
+			return false
+		}
+	}
+	return true
+}
+
+// A Tagger lists and changes the tags of resources.
+type Tagger struct {
+	// Adapters holds the adapters by kind. Only the kinds with an adapter
+	// are listed and changed.
+	Adapters map[manifest.Kind]*Adapter
+
+	// AutoApplyTags applies the tag changes to the running instances of the
+	// resources that support it, i.e. Elastigroups and managed instances.
+	// Other resources only tag the instances they launch afterwards.
+	AutoApplyTags bool
+
+	// Executor runs the updates of Apply. Defaults to an Executor with the
+	// default settings of the bulk package.
+	Executor *bulk.Executor
+}
+
+// New returns a Tagger for Elastigroups, Ocean clusters, Ocean launch specs
+// and managed instances on AWS, and stateful nodes on Azure.
+func New(sess *session.Session, cfgs ...*spotinst.Config) *Tagger {
+	groups := elastigroupaws.New(sess, cfgs...)
+	ocean := oceanaws.New(sess, cfgs...)
+	instances := managedinstanceaws.New(sess, cfgs...)
+	nodes := statefulazure.New(sess, cfgs...)
+
+	return &Tagger{
+		Adapters: map[manifest.Kind]*Adapter{
+			manifest.KindElastigroupAWS: {
+				List: func(ctx context.Context) ([]*Resource, error) {
+					out, err := groups.List(ctx, &elastigroupaws.ListGroupsInput{})
+					if err != nil {
+						return nil, err
+					}
+					resources := make([]*Resource, len(out.Groups))
+					for i, g := range out.Groups {
+						resources[i] = &Resource{
+							Kind: manifest.KindElastigroupAWS,
+							ID:   g.GetId(),
+							Name: g.GetName(),
+							Tags: fromElastigroupAWS(g.GetCompute().GetLaunchSpecification().GetTags()),
+						}
+					}
+					return resources, nil
+				},
+				Update: func(ctx context.Context, id string, tags []Tag, autoApply bool) error {
+					group := new(elastigroupaws.Group).
+						SetId(spotinst.String(id)).
+						SetCompute(new(elastigroupaws.Compute).
+							SetLaunchSpecification(new(elastigroupaws.LaunchSpecification).
+								SetTags(toElastigroupAWS(tags))))
+					_, err := groups.Update(ctx, &elastigroupaws.UpdateGroupInput{
+						Group:         group,
+						AutoApplyTags: spotinst.Bool(autoApply),
+					})
+					return err
+				},
+			},
+			manifest.KindOceanAWS: {
+				List: func(ctx context.Context) ([]*Resource, error) {
+					out, err := ocean.ListClusters(ctx, &oceanaws.ListClustersInput{})
+					if err != nil {
+						return nil, err
+					}
+					resources := make([]*Resource, len(out.Clusters))
+					for i, c := range out.Clusters {
+						resources[i] = &Resource{
+							Kind: manifest.KindOceanAWS,
+							ID:   c.GetId(),
+							Name: c.GetName(),
+							Tags: fromOceanAWS(c.GetCompute().GetLaunchSpecification().GetTags()),
+						}
+					}
+					return resources, nil
+				},
+				Update: func(ctx context.Context, id string, tags []Tag, _ bool) error {
+					cluster := new(oceanaws.Cluster).
+						SetId(spotinst.String(id)).
+						SetCompute(new(oceanaws.Compute).
+							SetLaunchSpecification(new(oceanaws.LaunchSpecification).
+								SetTags(toOceanAWS(tags))))
+					_, err := ocean.UpdateCluster(ctx, &oceanaws.UpdateClusterInput{Cluster: cluster})
+					return err
+				},
+			},
+			manifest.KindOceanAWSLaunchSpec: {
+				List: func(ctx context.Context) ([]*Resource, error) {
+					out, err := ocean.ListLaunchSpecs(ctx, &oceanaws.ListLaunchSpecsInput{})
+					if err != nil {
+						return nil, err
+					}
+					resources := make([]*Resource, len(out.LaunchSpecs))
+					for i, ls := range out.LaunchSpecs {
+						resources[i] = &Resource{
+							Kind: manifest.KindOceanAWSLaunchSpec,
+							ID:   ls.GetId(),
+							Name: ls.GetOceanId() + "/" + ls.GetName(),
+							Tags: fromOceanAWS(ls.GetTags()),
+						}
+					}
+					return resources, nil
+				},
+				Update: func(ctx context.Context, id string, tags []Tag, _ bool) error {
+					ls := new(oceanaws.LaunchSpec).
+						SetId(spotinst.String(id)).
+						SetTags(toOceanAWS(tags))
+					_, err := ocean.UpdateLaunchSpec(ctx, &oceanaws.UpdateLaunchSpecInput{LaunchSpec: ls})
+					return err
+				},
+			},
+			manifest.KindManagedInstanceAWS: {
+				List: func(ctx context.Context) ([]*Resource, error) {
+					out, err := instances.List(ctx, &managedinstanceaws.ListManagedInstancesInput{})
+					if err != nil {
+						return nil, err
+					}
+					resources := make([]*Resource, len(out.ManagedInstances))
+					for i, mi := range out.ManagedInstances {
+						resources[i] = &Resource{
+							Kind: manifest.KindManagedInstanceAWS,
+							ID:   mi.GetId(),
+							Name: mi.GetName(),
+							Tags: fromManagedInstanceAWS(mi.GetCompute().GetLaunchSpecification().GetTags()),
+						}
+					}
+					return resources, nil
+				},
+				Update: func(ctx context.Context, id string, tags []Tag, autoApply bool) error {
+					mi := new(managedinstanceaws.ManagedInstance).
+						SetId(spotinst.String(id)).
+						SetCompute(new(managedinstanceaws.Compute).
+							SetLaunchSpecification(new(managedinstanceaws.LaunchSpecification).
+								SetTags(toManagedInstanceAWS(tags))))
+					_, err := instances.Update(ctx, &managedinstanceaws.UpdateManagedInstanceInput{
+						ManagedInstance: mi,
+						AutoApplyTags:   spotinst.Bool(autoApply),
+					})
+					return err
+				},
+			},
+			manifest.KindStatefulNodeAzure: {
+				List: func(ctx context.Context) ([]*Resource, error) {
+					out, err := nodes.List(ctx, &statefulazure.ListStatefulNodesInput{})
+					if err != nil {
+						return nil, err
+					}
+					resources := make([]*Resource, len(out.StatefulNodes))
+					for i, n := range out.StatefulNodes {
+						resources[i] = &Resource{
+							Kind: manifest.KindStatefulNodeAzure,
+							ID:   n.GetID(),
+							Name: n.GetName(),
+							Tags: fromStatefulAzure(n.GetCompute().GetLaunchSpecification().GetTags()),
+						}
+					}
+					return resources, nil
+				},
+				Update: func(ctx context.Context, id string, tags []Tag, _ bool) error {
+					node := new(statefulazure.StatefulNode).
+						SetID(spotinst.String(id)).
+						SetCompute(new(statefulazure.Compute).
+							SetLaunchSpecification(new(statefulazure.LaunchSpecification).
+								SetTags(toStatefulAzure(tags))))
+					_, err := nodes.Update(ctx, &statefulazure.UpdateStatefulNodeInput{StatefulNode: node})
+					return err
+				},
+			},
+		},
+	}
+}
+
+// List lists the resources selected by sel, sorted by kind.
+func (t *Tagger) List(ctx context.Context, sel *Selector) ([]*Resource, error) {
+	var kinds []manifest.Kind
+	for kind := range t.Adapters {
+		if sel == nil || len(sel.Kinds) == 0 || containsKind(sel.Kinds, kind) {
+			kinds = append(kinds, kind)
+		}
+	}
+	sort.Slice(kinds, func(i, j int) bool { return kinds[i] < kinds[j] })
+
+	var out []*Resource
+	for _, kind := range kinds {
+		resources, err := t.Adapters[kind].List(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("tagging: failed to list %s resources: %w", kind, err)
+		}
+		for _, r := range resources {
+			if sel.Matches(r) {
+				out = append(out, r)
+			}
+		}
+	}
+	return out, nil
+}
+
+// Plan lists the resources selected by sel and returns the changes of
+// their tags, without applying them.
+func (t *Tagger) Plan(ctx context.Context, sel *Selector, changes *Changes) (*Plan, error) {
+	if err := changes.Validate(); err != nil {
+		return nil, err
+	}
+	resources, err := t.List(ctx, sel)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{Changes: changes}
+	for _, r := range resources {
+		plan.Items = append(plan.Items, changes.apply(r))
+	}
+	return plan, nil
+}
+
+// Apply applies the changes of plan to the resources of its items that have
+// changes, and returns a report with a result per resource, identified as
+// "Kind/ID". The resources are listed again right before the update and
+// the changes are applied to their current tags, so that the tags changed
+// since the plan was made are kept.
+func (t *Tagger) Apply(ctx context.Context, plan *Plan) (*bulk.Report, error) {
+	items := make(map[string]*Item)
+	var ids []string
+	var kinds []manifest.Kind
+	for _, item := range plan.Items {
+		if !item.HasChanges() {
+			continue
+		}
+		if _, ok := t.Adapters[item.Kind]; !ok {
+			return nil, fmt.Errorf("tagging: unsupported kind %q", item.Kind)
+		}
+		if !containsKind(kinds, item.Kind) {
+			kinds = append(kinds, item.Kind)
+		}
+		id := key(item.Kind, item.ID)
+		items[id] = item
+		ids = append(ids, id)
+	}
+
+	current, err := t.List(ctx, &Selector{Kinds: kinds})
+	if err != nil {
+		return nil, err
+	}
+	resources := make(map[string]*Resource, len(current))
+	for _, r := range current {
+		resources[key(r.Kind, r.ID)] = r
+	}
+
+	executor := t.Executor
+	if executor == nil {
+		executor = new(bulk.Executor)
+	}
+	return executor.Run(ctx, ids, func(ctx context.Context, id string) (interface{}, error) {
+		r, ok := resources[id]
+		if !ok {
+			return nil, ErrNotFound
+		}
+		item := plan.Changes.apply(r)
+		if !item.HasChanges() {
+			return item, nil
+		}
+		if err := t.Adapters[r.Kind].Update(ctx, r.ID, item.Tags, t.AutoApplyTags); err != nil {
+			return nil, err
+		}
+		return item, nil
+	}), nil
+}
+
+func key(kind manifest.Kind, id string) string {
+	return string(kind) + "/" + id
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsKind(list []manifest.Kind, kind manifest.Kind) bool {
+	for _, e := range list {
+		if e == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func fromElastigroupAWS(in []*elastigroupaws.Tag) []Tag {
+	out := make([]Tag, 0, len(in))
+	for _, tag := range in {
+		out = append(out, Tag{Key: spotinst.StringValue(tag.Key), Value: spotinst.StringValue(tag.Value)})
+	}
+	return out
+}
+
+func toElastigroupAWS(in []Tag) []*elastigroupaws.Tag {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]*elastigroupaws.Tag, len(in))
+	for i, tag := range in {
+		out[i] = new(elastigroupaws.Tag).SetKey(spotinst.String(tag.Key)).SetValue(spotinst.String(tag.Value))
+	}
+	return out
+}
+
+func fromOceanAWS(in []*oceanaws.Tag) []Tag {
+	out := make([]Tag, 0, len(in))
+	for _, tag := range in {
+		out = append(out, Tag{Key: spotinst.StringValue(tag.Key), Value: spotinst.StringValue(tag.Value)})
+	}
+	return out
+}
+
+func toOceanAWS(in []Tag) []*oceanaws.Tag {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]*oceanaws.Tag, len(in))
+	for i, tag := range in {
+		out[i] = new(oceanaws.Tag).SetKey(spotinst.String(tag.Key)).SetValue(spotinst.String(tag.Value))
+	}
+	return out
+}
+
+func fromManagedInstanceAWS(in []*managedinstanceaws.Tag) []Tag {
+	out := make([]Tag, 0, len(in))
+	for _, tag := range in {
+		out = append(out, Tag{Key: spotinst.StringValue(tag.Key), Value: spotinst.StringValue(tag.Value)})
+	}
+	return out
+}
+
+func toManagedInstanceAWS(in []Tag) []*managedinstanceaws.Tag {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]*managedinstanceaws.Tag, len(in))
+	for i, tag := range in {
+		out[i] = new(managedinstanceaws.Tag).SetKey(spotinst.String(tag.Key)).SetValue(spotinst.String(tag.Value))
+	}
+	return out
+}
+
+func fromStatefulAzure(in []*statefulazure.Tag) []Tag {
+	out := make([]Tag, 0, len(in))
+	for _, tag := range in {
+		out = append(out, Tag{Key: spotinst.StringValue(tag.TagKey), Value: spotinst.StringValue(tag.TagValue)})
+	}
+	return out
+}
+
+func toStatefulAzure(in []Tag) []*statefulazure.Tag {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]*statefulazure.Tag, len(in))
+	for i, tag := range in {
+		out[i] = new(statefulazure.Tag).SetTagKey(spotinst.String(tag.Key)).SetTagValue(spotinst.String(tag.Value))
+	}
+	return out
+}
@@ -0,0 +1,222 @@
+package tagging
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/bulk"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/manifest"
+)
+
+type fakeAdapter struct {
+	mu        sync.Mutex
+	kind      manifest.Kind
+	resources []*Resource
+	autoApply map[string]bool
+}
+
+func (f *fakeAdapter) adapter() *Adapter {
+	return &Adapter{
+		List: func(context.Context) ([]*Resource, error) {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			out := make([]*Resource, len(f.resources))
+			for i, r := range f.resources {
+				out[i] = &Resource{Kind: f.kind, ID: r.ID, Name: r.Name, Tags: append([]Tag(nil), r.Tags...)}
+			}
+			return out, nil
+		},
+		Update: func(_ context.Context, id string, tags []Tag, autoApply bool) error {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			for _, r := range f.resources {
+				if r.ID == id {
+					r.Tags = tags
+					if f.autoApply == nil {
+						f.autoApply = make(map[string]bool)
+					}
+					f.autoApply[id] = autoApply
+					return nil
+				}
+			}
+			return ErrNotFound
+		},
+	}
+}
+
+func tags(kv ...string) []Tag {
+	out := make([]Tag, 0, len(kv)/2)
+	for i := 0; i < len(kv); i += 2 {
+		out = append(out, Tag{Key: kv[i], Value: kv[i+1]})
+	}
+	return out
+}
+
+func TestPlanAndApply(t *testing.T) {
+	groups := &fakeAdapter{kind: manifest.KindElastigroupAWS, resources: []*Resource{
+		{ID: "sig-1", Name: "web", Tags: tags("team", "core", "Owner", "jane", "owner", "old")},
+		{ID: "sig-2", Name: "api", Tags: tags("team", "data")},
+	}}
+	specs := &fakeAdapter{kind: manifest.KindOceanAWSLaunchSpec, resources: []*Resource{
+		{ID: "ols-1", Name: "o-1/default", Tags: tags("team", "core", "cost-center", "1234")},
+	}}
+	tagger := &Tagger{
+		Adapters: map[manifest.Kind]*Adapter{
+			manifest.KindElastigroupAWS:     groups.adapter(),
+			manifest.KindOceanAWSLaunchSpec: specs.adapter(),
+		},
+		AutoApplyTags: true,
+		Executor:      &bulk.Executor{Concurrency: 2},
+	}
+
+	ctx := context.Background()
+	sel := &Selector{Tags: map[string]string{"team": "core"}}
+	plan, err := tagger.Plan(ctx, sel, &Changes{
+		Add:    map[string]string{"cost-center": "1234", "env": "prod"},
+		Remove: []string{"tmp"},
+		Rename: map[string]string{"Owner": "owner"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Items) != 2 {
+		t.Fatalf("got %d items, want 2", len(plan.Items))
+	}
+
+	web := plan.Items[0]
+	wantChanges := []*Change{
+		{Action: ActionRemove, Key: "owner", Value: "old"},
+		{Action: ActionRename, Key: "Owner", Value: "jane", NewKey: "owner", NewValue: "jane"},
+		{Action: ActionAdd, NewKey: "cost-center", NewValue: "1234"},
+		{Action: ActionAdd, NewKey: "env", NewValue: "prod"},
+	}
+	if !reflect.DeepEqual(web.Changes, wantChanges) {
+		var b bytes.Buffer
+		plan.WriteJSON(&b)
+		t.Fatalf("got plan %s", b.String())
+	}
+	wantTags := tags("team", "core", "owner", "jane", "cost-center", "1234", "env", "prod")
+	if !reflect.DeepEqual(web.Tags, wantTags) {
+		t.Errorf("got tags %v, want %v", web.Tags, wantTags)
+	}
+
+	var text bytes.Buffer
+	if err := plan.WriteText(&text); err != nil {
+		t.Fatal(err)
+	}
+	want := `2 of 2 resource(s) to change.
+
+ElastigroupAWS "web" (sig-1):
+  - owner=old
+  > Owner -> owner
+  + cost-center=1234
+  + env=prod
+
+OceanAWSLaunchSpec "o-1/default" (ols-1):
+  + env=prod
+`
+	if text.String() != want {
+		t.Errorf("got text:\n%s\nwant:\n%s", text.String(), want)
+	}
+
+	// Tags changed since the plan was made are kept.
+	specs.resources[0].Tags = append(specs.resources[0].Tags, Tag{Key: "app", Value: "nginx"})
+
+	report, err := tagger.Apply(ctx, plan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := report.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n := report.Count(bulk.StatusSucceeded); n != 2 {
+		t.Errorf("got %d succeeded, want 2", n)
+	}
+	if !reflect.DeepEqual(groups.resources[0].Tags, wantTags) {
+		t.Errorf("got tags %v, want %v", groups.resources[0].Tags, wantTags)
+	}
+	wantTags = tags("team", "core", "cost-center", "1234", "app", "nginx", "env", "prod")
+	if !reflect.DeepEqual(specs.resources[0].Tags, wantTags) {
+		t.Errorf("got tags %v, want %v", specs.resources[0].Tags, wantTags)
+	}
+	if !groups.autoApply["sig-1"] {
+		t.Error("tags not auto-applied")
+	}
+	if _, ok := groups.autoApply["sig-2"]; ok {
+		t.Error("unselected resource updated")
+	}
+
+	// A plan applied twice has nothing left to change.
+	plan, err = tagger.Plan(ctx, sel, plan.Changes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plan.HasChanges() {
+		t.Error("got changes after apply")
+	}
+}
+
+func TestApplyDeleted(t *testing.T) {
+	groups := &fakeAdapter{kind: manifest.KindElastigroupAWS, resources: []*Resource{
+		{ID: "sig-1", Name: "web"},
+	}}
+	tagger := &Tagger{Adapters: map[manifest.Kind]*Adapter{manifest.KindElastigroupAWS: groups.adapter()}}
+
+	ctx := context.Background()
+	plan, err := tagger.Plan(ctx, &Selector{IDs: []string{"sig-1"}}, &Changes{Add: map[string]string{"env": "prod"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	groups.resources = nil
+
+	report, err := tagger.Apply(ctx, plan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	failed := report.Failed()
+	if len(failed) != 1 || failed[0].ID != "ElastigroupAWS/sig-1" || !errors.Is(failed[0].Err, ErrNotFound) {
+		t.Errorf("got failed results %+v", failed)
+	}
+}
+
+func TestSelector(t *testing.T) {
+	r := &Resource{Kind: manifest.KindOceanAWS, ID: "o-1", Name: "prod-eks", Tags: tags("env", "prod")}
+	for _, tt := range []struct {
+		sel  *Selector
+		want bool
+	}{
+		{nil, true},
+		{&Selector{}, true},
+		{&Selector{Kinds: []manifest.Kind{manifest.KindElastigroupAWS}}, false},
+		{&Selector{IDs: []string{"o-1", "o-2"}}, true},
+		{&Selector{Names: []string{"dev-*", "prod-*"}}, true},
+		{&Selector{Names: []string{"dev-*"}}, false},
+		{&Selector{Tags: map[string]string{"env": ""}}, true},
+		{&Selector{Tags: map[string]string{"env": "dev"}}, false},
+		{&Selector{Tags: map[string]string{"team": ""}}, false},
+	} {
+		if got := tt.sel.Matches(r); got != tt.want {
+			t.Errorf("%+v.Matches() = %v, want %v", tt.sel, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, c := range []*Changes{
+		nil,
+		{},
+		{Add: map[string]string{"": "x"}},
+		{Rename: map[string]string{"a": "a"}},
+		{Rename: map[string]string{"a": "c", "b": "c"}},
+		{Rename: map[string]string{"a": "b", "b": "c"}},
+		{Rename: map[string]string{"a": "b"}, Remove: []string{"b"}},
+	} {
+		if err := c.Validate(); err == nil {
+			t.Errorf("%+v.Validate() = nil, want error", c)
+		}
+	}
+}
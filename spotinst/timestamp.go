@@ -0,0 +1,169 @@
+package spotinst
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// TimestampLayout is the layout used when formatting timestamps sent to
+	// the Spotinst API.
+	TimestampLayout = "2006-01-02T15:04:05.000Z07:00"
+
+	// DateLayout is the layout used when formatting dates sent to the
+	// Spotinst API.
+	DateLayout = "2006-01-02"
+)
+
+// timestampLayouts is a list of layouts the Spotinst API is known to use
+// when returning timestamps. Layouts without a time zone are interpreted as
+// UTC.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.000Z0700",
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04:05.000",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05.000",
+	"2006-01-02 15:04:05",
+	DateLayout,
+}
+
+// ParseTime parses a timestamp in any of the formats returned by the Spotinst
+// API, including epoch milliseconds. Empty strings yield the zero time.
+func ParseTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	if ms, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.UnixMilli(ms).UTC(), nil
+	}
+
+	for _, layout := range timestampLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.UTC); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("spotinst: unable to parse time %q", s)
+}
+
+// TimeFromString parses the string pointer passed in using ParseTime. It
+// returns the zero time if the pointer is nil.
+func TimeFromString(v *string) (time.Time, error) {
+	if v == nil {
+		return time.Time{}, nil
+	}
+	return ParseTime(*v)
+}
+
+// FormatTimestamp formats t using TimestampLayout in UTC.
+func FormatTimestamp(t time.Time) string {
+	return t.UTC().Format(TimestampLayout)
+}
+
+// FormatDate formats t using DateLayout in UTC.
+func FormatDate(t time.Time) string {
+	return t.UTC().Format(DateLayout)
+}
+
+// FormatEpochMillis formats t as the number of milliseconds elapsed since
+// January 1, 1970 UTC.
+func FormatEpochMillis(t time.Time) string {
+	return strconv.FormatInt(t.UnixMilli(), 10)
+}
+
+// Timestamp is a time.Time that can be decoded from any of the timestamp
+// formats returned by the Spotinst API. It is encoded using TimestampLayout.
+type Timestamp struct {
+	time.Time
+}
+
+// NewTimestamp returns a pointer to a Timestamp holding t.
+func NewTimestamp(t time.Time) *Timestamp {
+	return &Timestamp{Time: t}
+}
+
+// String returns the timestamp formatted using TimestampLayout.
+func (t Timestamp) String() string {
+	return FormatTimestamp(t.Time)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Both quoted
+// strings and bare numbers (epoch milliseconds) are accepted.
+func (t *Timestamp) UnmarshalJSON(b []byte) error {
+	v, err := parseJSONTime(b)
+	if err != nil {
+		return err
+	}
+	t.Time = v
+	return nil
+}
+
+// Date is a time.Time that only carries a calendar date. It is encoded using
+// DateLayout and can be decoded from any of the formats accepted by
+// ParseTime.
+type Date struct {
+	time.Time
+}
+
+// NewDate returns a pointer to a Date holding the calendar date of t in UTC.
+func NewDate(t time.Time) *Date {
+	y, m, d := t.UTC().Date()
+	return &Date{Time: time.Date(y, m, d, 0, 0, 0, 0, time.UTC)}
+}
+
+// String returns the date formatted using DateLayout.
+func (d Date) String() string {
+	return FormatDate(d.Time)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (d *Date) UnmarshalJSON(b []byte) error {
+	v, err := parseJSONTime(b)
+	if err != nil {
+		return err
+	}
+	d.Time = v
+	return nil
+}
+
+func parseJSONTime(b []byte) (time.Time, error) {
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 || bytes.Equal(b, []byte("null")) {
+		return time.Time{}, nil
+	}
+
+	var s string
+	if b[0] == '"' {
+		if err := json.Unmarshal(b, &s); err != nil {
+			return time.Time{}, err
+		}
+	} else {
+		s = string(b)
+	}
+
+	return ParseTime(s)
+}
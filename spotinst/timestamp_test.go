@@ -0,0 +1,73 @@
+package spotinst
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseTime(t *testing.T) {
+	want := time.Date(2023, time.March, 7, 10, 20, 30, 0, time.UTC)
+	wantMillis := want.Add(123 * time.Millisecond)
+
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{in: "", want: time.Time{}},
+		{in: "2023-03-07T10:20:30Z", want: want},
+		{in: "2023-03-07T10:20:30.123Z", want: wantMillis},
+		{in: "2023-03-07T12:20:30+02:00", want: want},
+		{in: "2023-03-07T12:20:30.123+0200", want: wantMillis},
+		{in: "2023-03-07T10:20:30.123", want: wantMillis},
+		{in: "2023-03-07T10:20:30", want: want},
+		{in: "2023-03-07 10:20:30", want: want},
+		{in: "2023-03-07", want: time.Date(2023, time.March, 7, 0, 0, 0, 0, time.UTC)},
+		{in: "1678184430123", want: wantMillis},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseTime(tt.in)
+		if err != nil {
+			t.Errorf("ParseTime(%q): unexpected error: %v", tt.in, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("ParseTime(%q): got %v, want %v", tt.in, got, tt.want)
+		}
+	}
+
+	if _, err := ParseTime("yesterday"); err == nil {
+		t.Error("ParseTime(\"yesterday\"): expected error, got nil")
+	}
+}
+
+func TestTimestampJSON(t *testing.T) {
+	var v struct {
+		A Timestamp  `json:"a"`
+		B Timestamp  `json:"b"`
+		C *Timestamp `json:"c"`
+		D Date       `json:"d"`
+	}
+
+	in := `{"a":"2023-03-07T10:20:30.000+0000","b":1678184430000,"c":null,"d":"2023-03-07"}`
+	if err := json.Unmarshal([]byte(in), &v); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := time.Date(2023, time.March, 7, 10, 20, 30, 0, time.UTC)
+	if !v.A.Equal(want) || !v.B.Equal(want) {
+		t.Errorf("got %v and %v, want %v", v.A, v.B, want)
+	}
+	if v.C != nil {
+		t.Errorf("got %v, want nil", v.C)
+	}
+
+	out, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := string(out), `{"a":"2023-03-07T10:20:30.000Z","b":"2023-03-07T10:20:30.000Z","c":null,"d":"2023-03-07"}`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
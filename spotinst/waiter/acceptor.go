@@ -0,0 +1,178 @@
+package waiter
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+)
+
+// A State is the state decided by an acceptor.
+type State int
+
+const (
+	// Retry continues waiting.
+	Retry State = iota
+
+	// Success stops waiting successfully.
+	Success
+
+	// Failure stops waiting with an error.
+	Failure
+)
+
+func (s State) String() string {
+	switch s {
+	case Retry:
+		return "retry"
+	case Success:
+		return "success"
+	case Failure:
+		return "failure"
+	default:
+		return fmt.Sprintf("State(%d)", int(s))
+	}
+}
+
+// A Matcher reports whether the results of an attempt match.
+type Matcher func(output interface{}, err error) bool
+
+// An Acceptor transitions a waiter to State when its Matcher matches.
+type Acceptor struct {
+	State   State
+	Matcher Matcher
+}
+
+// OutputMatcher returns a Matcher that calls fn with the output of
+// successful attempts.
+func OutputMatcher(fn func(output interface{}) bool) Matcher {
+	return func(output interface{}, err error) bool {
+		return err == nil && fn(output)
+	}
+}
+
+// PathMatcher returns a Matcher that matches successful attempts whose output
+// has a value at path equal to any of the expected values. The path is a
+// dot-separated list of Go field names; pointers are dereferenced along the
+// way and values are compared case insensitively by their string form. For
+// example, "Progress.Unit" matches the Unit of the Progress of the output.
+func PathMatcher(path string, expected ...string) Matcher {
+	return OutputMatcher(func(output interface{}) bool {
+		v, ok := lookup(reflect.ValueOf(output), strings.Split(path, "."))
+		if !ok {
+			return false
+		}
+		s := fmt.Sprint(v.Interface())
+		for _, e := range expected {
+			if strings.EqualFold(s, e) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func lookup(v reflect.Value, path []string) (reflect.Value, bool) {
+	for {
+		for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+			if v.IsNil() {
+				return v, false
+			}
+			v = v.Elem()
+		}
+		if len(path) == 0 {
+			return v, true
+		}
+		if v.Kind() != reflect.Struct {
+			return v, false
+		}
+		v = v.FieldByName(path[0])
+		if !v.IsValid() {
+			return v, false
+		}
+		path = path[1:]
+	}
+}
+
+// ErrorMatcher returns a Matcher that matches failed attempts whose error
+// satisfies fn.
+func ErrorMatcher(fn func(err error) bool) Matcher {
+	return func(output interface{}, err error) bool {
+		return err != nil && fn(err)
+	}
+}
+
+// ErrorCodeMatcher returns a Matcher that matches failed attempts whose
+// error is an API error with any of the given codes.
+func ErrorCodeMatcher(codes ...string) Matcher {
+	return ErrorMatcher(func(err error) bool {
+		for _, e := range apiErrors(err) {
+			for _, code := range codes {
+				if e.Code == code {
+					return true
+				}
+			}
+		}
+		return false
+	})
+}
+
+// StatusCodeMatcher returns a Matcher that matches failed attempts whose
+// error is an API error with any of the given HTTP status codes.
+func StatusCodeMatcher(codes ...int) Matcher {
+	return ErrorMatcher(func(err error) bool {
+		for _, e := range apiErrors(err) {
+			status := statusCode(e)
+			for _, code := range codes {
+				if status == code {
+					return true
+				}
+			}
+		}
+		return false
+	})
+}
+
+// NotFoundMatcher matches failed attempts whose error is an API error with
+// status 404 Not Found.
+var NotFoundMatcher = StatusCodeMatcher(http.StatusNotFound)
+
+// RetryableErrorMatcher matches failed attempts whose error is likely to be
+// transient: an API error with status 429 Too Many Requests or a 5xx status,
+// or a network error.
+var RetryableErrorMatcher = ErrorMatcher(func(err error) bool {
+	for _, e := range apiErrors(err) {
+		if status := statusCode(e); status == http.StatusTooManyRequests || status >= http.StatusInternalServerError {
+			return true
+		}
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+})
+
+func statusCode(e client.Error) int {
+	if e.Response != nil {
+		return e.Response.StatusCode
+	}
+	if n, err := strconv.Atoi(e.Code); err == nil {
+		return n
+	}
+	return 0
+}
+
+func apiErrors(err error) []client.Error {
+	var es client.Errors
+	if errors.As(err, &es) {
+		return es
+	}
+	var e client.Error
+	if errors.As(err, &e) {
+		return []client.Error{e}
+	}
+	return nil
+}
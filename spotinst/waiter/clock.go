@@ -0,0 +1,22 @@
+package waiter
+
+import "time"
+
+// A Clock measures time and sleeps. It can be replaced in tests to avoid
+// actually sleeping between attempts.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// After waits for the duration to elapse and then sends the current
+	// time on the returned channel.
+	After(d time.Duration) <-chan time.Time
+}
+
+// SystemClock is a Clock backed by the time package.
+var SystemClock Clock = systemClock{}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
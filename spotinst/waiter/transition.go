@@ -0,0 +1,84 @@
+package waiter
+
+import (
+	"context"
+	"time"
+)
+
+// A Transition performs an action on a resource, such as pausing or
+// recycling an instance, and waits for the resource to reach the state the
+// action leads to.
+type Transition struct {
+	// Name identifies the transition's waiter in errors.
+	Name string
+
+	// Do performs the action.
+	Do func(ctx context.Context) error
+
+	// Poll reads the resource.
+	Poll PollFunc
+
+	// Done reports whether the resource is in the target state, and Failed
+	// whether it is in a failed state. Failed is checked first.
+	Done   func(output interface{}) bool
+	Failed func(output interface{}) bool
+
+	// Reenter is set for actions, such as recycling, that take the resource
+	// out of the target state and back. The resource is then read before the
+	// action and, if it is already in the target state, only counts as done
+	// once it has been seen leaving that state.
+	Reenter bool
+}
+
+// Run performs the action and polls the resource until it reaches the target
+// state or fails, in which case an error wrapping ErrFailureState is
+// returned. Unless the transition reenters the target state, a resource that
+// is already in the target state when first polled is done, since the API
+// accepted the action.
+//
+// Run returns the last output read by Poll, which is nil if the action
+// failed or the resource could never be read.
+func (t *Transition) Run(ctx context.Context, opts ...Option) (interface{}, error) {
+	left := true
+	if t.Reenter {
+		before, err := t.Poll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		left = !t.Done(before)
+	}
+
+	if err := t.Do(ctx); err != nil {
+		return nil, err
+	}
+
+	var last interface{}
+	w := &Waiter{
+		Name: t.Name,
+		Poll: func(ctx context.Context) (interface{}, error) {
+			out, err := t.Poll(ctx)
+			if err == nil {
+				last = out
+				if !t.Done(out) {
+					left = true
+				}
+			}
+			return out, err
+		},
+		Acceptors: []Acceptor{
+			{State: Failure, Matcher: OutputMatcher(t.Failed)},
+			{
+				State: Success,
+				Matcher: OutputMatcher(func(output interface{}) bool {
+					return left && t.Done(output)
+				}),
+			},
+		},
+		Options: DefaultOptions(),
+	}
+	w.Options.MinDelay = 10 * time.Second
+	w.Options.MaxDelay = 30 * time.Second
+
+	_, err := w.Apply(opts...).Wait(ctx)
+	return last, err
+}
@@ -0,0 +1,115 @@
+package waiter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTransition(t *testing.T) {
+	doErr := errors.New("do failed")
+	pollErr := errors.New("poll failed")
+	for _, tt := range []struct {
+		name     string
+		reenter  bool
+		doErr    error
+		statuses []string
+		pollErr  error
+		want     string
+		polls    int
+		err      error
+	}{
+		{
+			name:     "reached",
+			statuses: []string{"pausing", "pausing", "paused"},
+			want:     "paused",
+			polls:    3,
+		},
+		{
+			name:     "already in target state",
+			statuses: []string{"paused"},
+			want:     "paused",
+			polls:    1,
+		},
+		{
+			name:     "reenter from target state",
+			reenter:  true,
+			statuses: []string{"paused", "paused", "pausing", "paused"},
+			want:     "paused",
+			polls:    4,
+		},
+		{
+			name:     "reenter from another state",
+			reenter:  true,
+			statuses: []string{"running", "paused"},
+			want:     "paused",
+			polls:    2,
+		},
+		{
+			name:     "failed",
+			statuses: []string{"pausing", "error"},
+			want:     "error",
+			polls:    2,
+			err:      ErrFailureState,
+		},
+		{
+			name:  "action failed",
+			doErr: doErr,
+			err:   doErr,
+		},
+		{
+			name:     "poll failed",
+			statuses: []string{"pausing"},
+			pollErr:  pollErr,
+			want:     "pausing",
+			polls:    2,
+			err:      pollErr,
+		},
+		{
+			name:     "max duration",
+			statuses: []string{"pausing"},
+			want:     "pausing",
+			err:      ErrMaxDuration,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var polls int
+			tr := &Transition{
+				Name: "Test",
+				Do:   func(context.Context) error { return tt.doErr },
+				Poll: func(context.Context) (interface{}, error) {
+					polls++
+					if tt.pollErr != nil && polls > 1 {
+						return nil, tt.pollErr
+					}
+					if polls > len(tt.statuses) {
+						return tt.statuses[len(tt.statuses)-1], nil
+					}
+					return tt.statuses[polls-1], nil
+				},
+				Done:    func(out interface{}) bool { return out == "paused" },
+				Failed:  func(out interface{}) bool { return out == "error" },
+				Reenter: tt.reenter,
+			}
+
+			clock := &fakeClock{now: time.Unix(0, 0)}
+			out, err := tr.Run(context.Background(), WithClock(clock), WithMaxDuration(time.Hour))
+			if !errors.Is(err, tt.err) || (tt.err == nil && err != nil) {
+				t.Fatalf("got error %v, want %v", err, tt.err)
+			}
+			if tt.want == "" {
+				if out != nil {
+					t.Errorf("got output %v, want nil", out)
+				}
+				return
+			}
+			if out != tt.want {
+				t.Errorf("got output %v, want %q", out, tt.want)
+			}
+			if tt.polls > 0 && polls != tt.polls {
+				t.Errorf("got %d polls, want %d", polls, tt.polls)
+			}
+		})
+	}
+}
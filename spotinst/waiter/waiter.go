@@ -0,0 +1,309 @@
+// Package waiter provides a generic mechanism to poll an API operation until
+// the resource it describes reaches a desired state.
+//
+// A Waiter repeatedly invokes its Poll function, sleeping between attempts
+// with exponential backoff and jitter, and evaluates the output (or error) of
+// each attempt against a list of acceptors. The first matching acceptor
+// decides whether waiting succeeded, failed or should continue. When no
+// acceptor matches, waiting continues if the attempt succeeded and stops with
+// the attempt's error otherwise.
+package waiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+)
+
+const (
+	// DefaultMinDelay is the default delay before the second attempt.
+	DefaultMinDelay = 5 * time.Second
+
+	// DefaultMaxDelay is the default upper bound of the delay between attempts.
+	DefaultMaxDelay = time.Minute
+
+	// DefaultMaxDuration is the default upper bound of the total time spent
+	// waiting.
+	DefaultMaxDuration = 30 * time.Minute
+
+	// DefaultJitter is the default jitter factor.
+	DefaultJitter = 0.2
+)
+
+var (
+	// ErrFailureState is returned when a failure acceptor matches.
+	ErrFailureState = errors.New("failure state reached")
+
+	// ErrMaxDuration is returned when the maximum duration elapses before
+	// a success acceptor matches.
+	ErrMaxDuration = errors.New("max duration exceeded")
+
+	// ErrMaxAttempts is returned when the maximum number of attempts is
+	// reached before a success acceptor matches.
+	ErrMaxAttempts = errors.New("max attempts exceeded")
+)
+
+// PollFunc invokes the API operation being waited on.
+type PollFunc func(ctx context.Context) (interface{}, error)
+
+// A Waiter polls an API operation until an acceptor reaches a terminal state.
+type Waiter struct {
+	// Name identifies the waiter in errors, e.g. "RollCompleted".
+	Name string
+
+	// Poll invokes the API operation.
+	Poll PollFunc
+
+	// Acceptors are evaluated in order after each attempt.
+	Acceptors []Acceptor
+
+	// Options configure timing and progress reporting.
+	Options Options
+}
+
+// Options configure a Waiter.
+type Options struct {
+	// MinDelay is the delay before the second attempt. Subsequent delays
+	// double, up to MaxDelay.
+	MinDelay time.Duration
+
+	// MaxDelay is the upper bound of the delay between attempts.
+	MaxDelay time.Duration
+
+	// MaxDuration is the upper bound of the total time spent waiting. Zero
+	// means no limit other than the context's.
+	MaxDuration time.Duration
+
+	// MaxAttempts is the maximum number of attempts. Zero means no limit.
+	MaxAttempts int
+
+	// Jitter randomizes each delay by up to ±Jitter of its value. It must be
+	// in the range [0, 1].
+	Jitter float64
+
+	// Clock is used to measure time and sleep. Defaults to the system clock.
+	Clock Clock
+
+	// Progress, if set, is called after each attempt.
+	Progress func(Attempt)
+}
+
+// An Option configures a Waiter.
+type Option func(*Options)
+
+// WithMinDelay sets the delay before the second attempt.
+func WithMinDelay(d time.Duration) Option {
+	return func(o *Options) { o.MinDelay = d }
+}
+
+// WithMaxDelay sets the upper bound of the delay between attempts.
+func WithMaxDelay(d time.Duration) Option {
+	return func(o *Options) { o.MaxDelay = d }
+}
+
+// WithMaxDuration sets the upper bound of the total time spent waiting.
+func WithMaxDuration(d time.Duration) Option {
+	return func(o *Options) { o.MaxDuration = d }
+}
+
+// WithMaxAttempts sets the maximum number of attempts.
+func WithMaxAttempts(n int) Option {
+	return func(o *Options) { o.MaxAttempts = n }
+}
+
+// WithJitter sets the jitter factor.
+func WithJitter(f float64) Option {
+	return func(o *Options) { o.Jitter = f }
+}
+
+// WithClock sets the clock used to measure time and sleep.
+func WithClock(c Clock) Option {
+	return func(o *Options) { o.Clock = c }
+}
+
+// WithProgress sets a callback invoked after each attempt.
+func WithProgress(fn func(Attempt)) Option {
+	return func(o *Options) { o.Progress = fn }
+}
+
+// DefaultOptions returns the default options.
+func DefaultOptions() Options {
+	return Options{
+		MinDelay:    DefaultMinDelay,
+		MaxDelay:    DefaultMaxDelay,
+		MaxDuration: DefaultMaxDuration,
+		Jitter:      DefaultJitter,
+		Clock:       SystemClock,
+	}
+}
+
+// Apply applies opts to the waiter's options and returns the waiter.
+func (w *Waiter) Apply(opts ...Option) *Waiter {
+	for _, opt := range opts {
+		opt(&w.Options)
+	}
+	return w
+}
+
+// An Attempt describes the outcome of a single poll.
+type Attempt struct {
+	// Number is the 1-based number of the attempt.
+	Number int
+
+	// Elapsed is the time elapsed since waiting started.
+	Elapsed time.Duration
+
+	// Output and Err are the results of the poll.
+	Output interface{}
+	Err    error
+
+	// State is the state decided by the acceptors.
+	State State
+
+	// Delay is the delay before the next attempt, if any.
+	Delay time.Duration
+}
+
+// Error is returned when waiting ends without reaching a success state.
+type Error struct {
+	// Name is the name of the waiter.
+	Name string
+
+	// Reason is one of ErrFailureState, ErrMaxDuration, ErrMaxAttempts or
+	// a context error.
+	Reason error
+
+	// Attempts is the number of attempts made.
+	Attempts int
+
+	// Output and Err are the results of the last attempt.
+	Output interface{}
+	Err    error
+}
+
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("waiter: %s: %v after %d attempt(s)", e.Name, e.Reason, e.Attempts)
+	if e.Err != nil {
+		msg = fmt.Sprintf("%s: %v", msg, e.Err)
+	}
+	return msg
+}
+
+// Unwrap returns the reason waiting ended.
+func (e *Error) Unwrap() error {
+	return e.Reason
+}
+
+// Wait polls until an acceptor reaches a terminal state, the context is
+// canceled, or the waiter's limits are exceeded. It returns the output of
+// the last attempt.
+func (w *Waiter) Wait(ctx context.Context) (interface{}, error) {
+	opts := w.Options
+	if opts.Clock == nil {
+		opts.Clock = SystemClock
+	}
+	if opts.MinDelay <= 0 {
+		opts.MinDelay = DefaultMinDelay
+	}
+	if opts.MaxDelay < opts.MinDelay {
+		opts.MaxDelay = opts.MinDelay
+	}
+
+	start := opts.Clock.Now()
+	newError := func(reason error, attempts int, out interface{}, err error) error {
+		return &Error{
+			Name:     w.Name,
+			Reason:   reason,
+			Attempts: attempts,
+			Output:   out,
+			Err:      err,
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		out, err := w.Poll(ctx)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return out, newError(ctxErr, attempt, out, err)
+		}
+
+		state, matched := w.evaluate(out, err)
+		if !matched {
+			if err != nil {
+				state = Failure
+			} else {
+				state = Retry
+			}
+		}
+
+		elapsed := opts.Clock.Now().Sub(start)
+		a := Attempt{
+			Number:  attempt,
+			Elapsed: elapsed,
+			Output:  out,
+			Err:     err,
+			State:   state,
+		}
+
+		if state == Retry {
+			a.Delay = opts.delay(attempt)
+			if opts.MaxDuration > 0 {
+				if remaining := opts.MaxDuration - elapsed; remaining <= 0 {
+					a.Delay = 0
+				} else if a.Delay > remaining {
+					a.Delay = remaining
+				}
+			}
+		}
+		if opts.Progress != nil {
+			opts.Progress(a)
+		}
+
+		switch state {
+		case Success:
+			return out, nil
+		case Failure:
+			if !matched {
+				return out, err
+			}
+			return out, newError(ErrFailureState, attempt, out, err)
+		}
+
+		if opts.MaxAttempts > 0 && attempt >= opts.MaxAttempts {
+			return out, newError(ErrMaxAttempts, attempt, out, err)
+		}
+		if opts.MaxDuration > 0 && a.Delay <= 0 {
+			return out, newError(ErrMaxDuration, attempt, out, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return out, newError(ctx.Err(), attempt, out, err)
+		case <-opts.Clock.After(a.Delay):
+		}
+	}
+}
+
+func (w *Waiter) evaluate(out interface{}, err error) (State, bool) {
+	for _, a := range w.Acceptors {
+		if a.Matcher(out, err) {
+			return a.State, true
+		}
+	}
+	return Retry, false
+}
+
+// delay returns the delay after the given attempt: MinDelay doubled after
+// each attempt, capped at MaxDelay, randomized by Jitter.
+func (o Options) delay(attempt int) time.Duration {
+	d := float64(o.MinDelay) * math.Pow(2, float64(attempt-1))
+	if d > float64(o.MaxDelay) {
+		d = float64(o.MaxDelay)
+	}
+	if o.Jitter > 0 {
+		d += d * o.Jitter * (2*rand.Float64() - 1)
+	}
+	return time.Duration(d)
+}
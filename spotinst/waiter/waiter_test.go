@@ -0,0 +1,170 @@
+package waiter
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+)
+
+// fakeClock advances instantly when slept on.
+type fakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+type status struct {
+	Status *string
+}
+
+func poller(statuses ...string) PollFunc {
+	i := 0
+	return func(ctx context.Context) (interface{}, error) {
+		s := statuses[i]
+		if i < len(statuses)-1 {
+			i++
+		}
+		return &status{Status: spotinst.String(s)}, nil
+	}
+}
+
+func newTestWaiter(poll PollFunc, clock Clock, opts ...Option) *Waiter {
+	w := &Waiter{
+		Name: "Test",
+		Poll: poll,
+		Acceptors: []Acceptor{
+			{State: Success, Matcher: PathMatcher("Status", "done")},
+			{State: Failure, Matcher: PathMatcher("Status", "failed")},
+		},
+		Options: DefaultOptions(),
+	}
+	return w.Apply(append([]Option{WithClock(clock), WithJitter(0)}, opts...)...)
+}
+
+func TestWaitSuccess(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+
+	var attempts []Attempt
+	w := newTestWaiter(poller("pending", "pending", "pending", "DONE"), clock,
+		WithMinDelay(time.Second),
+		WithMaxDelay(3*time.Second),
+		WithProgress(func(a Attempt) { attempts = append(attempts, a) }))
+
+	if _, err := w.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []time.Duration{time.Second, 2 * time.Second, 3 * time.Second}
+	if len(clock.sleeps) != len(want) {
+		t.Fatalf("got sleeps %v, want %v", clock.sleeps, want)
+	}
+	for i := range want {
+		if clock.sleeps[i] != want[i] {
+			t.Errorf("sleep %d: got %v, want %v", i, clock.sleeps[i], want[i])
+		}
+	}
+	if len(attempts) != 4 || attempts[3].State != Success || attempts[3].Elapsed != 6*time.Second {
+		t.Errorf("unexpected attempts: %+v", attempts)
+	}
+}
+
+func TestWaitFailure(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	w := newTestWaiter(poller("pending", "failed"), clock)
+
+	_, err := w.Wait(context.Background())
+	if !errors.Is(err, ErrFailureState) {
+		t.Fatalf("got %v, want %v", err, ErrFailureState)
+	}
+	var werr *Error
+	if !errors.As(err, &werr) || werr.Attempts != 2 {
+		t.Errorf("unexpected error: %#v", err)
+	}
+}
+
+func TestWaitLimits(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	w := newTestWaiter(poller("pending"), clock,
+		WithMinDelay(time.Minute),
+		WithMaxDuration(90*time.Second))
+
+	if _, err := w.Wait(context.Background()); !errors.Is(err, ErrMaxDuration) {
+		t.Errorf("got %v, want %v", err, ErrMaxDuration)
+	}
+	if got, want := clock.now.Sub(time.Unix(0, 0)), 90*time.Second; got != want {
+		t.Errorf("got elapsed %v, want %v", got, want)
+	}
+
+	w = newTestWaiter(poller("pending"), clock, WithMaxAttempts(3))
+	if _, err := w.Wait(context.Background()); !errors.Is(err, ErrMaxAttempts) {
+		t.Errorf("got %v, want %v", err, ErrMaxAttempts)
+	}
+}
+
+func TestWaitErrors(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	notFound := client.Errors{{
+		Response: &http.Response{StatusCode: http.StatusNotFound},
+		Code:     "GROUP_DOESNT_EXIST",
+	}}
+
+	// Unmatched errors stop waiting immediately.
+	w := newTestWaiter(func(ctx context.Context) (interface{}, error) { return nil, notFound }, clock)
+	if _, err := w.Wait(context.Background()); err == nil || errors.Is(err, ErrFailureState) {
+		t.Errorf("got %v, want the poll error", err)
+	}
+
+	// Matched errors transition to the acceptor's state.
+	w.Acceptors = append(w.Acceptors, Acceptor{State: Success, Matcher: NotFoundMatcher})
+	if _, err := w.Wait(context.Background()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	w.Acceptors = []Acceptor{{State: Success, Matcher: ErrorCodeMatcher("GROUP_DOESNT_EXIST")}}
+	if _, err := w.Wait(context.Background()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	w = newTestWaiter(poller("pending"), clock)
+	if _, err := w.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestRetryableErrorMatcher(t *testing.T) {
+	apiError := func(status int) error {
+		return client.Errors{{Response: &http.Response{StatusCode: status}}}
+	}
+	for _, tt := range []struct {
+		err  error
+		want bool
+	}{
+		{err: nil, want: false},
+		{err: errors.New("boom"), want: false},
+		{err: apiError(http.StatusNotFound), want: false},
+		{err: apiError(http.StatusTooManyRequests), want: true},
+		{err: apiError(http.StatusServiceUnavailable), want: true},
+		{err: &url.Error{Op: "Get", URL: "https://api.spotinst.io", Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}, want: true},
+	} {
+		if got := RetryableErrorMatcher(nil, tt.err); got != tt.want {
+			t.Errorf("RetryableErrorMatcher(%v): got %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}